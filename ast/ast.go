@@ -17,6 +17,7 @@
 package ast
 
 import (
+	"sort"
 	"strings"
 
 	"ballerina-lang-go/common"
@@ -90,7 +91,7 @@ const (
 func AsMask(flagSet common.Set[model.Flag]) Flags {
 	mask := Flags(0)
 	for flag := range flagSet.Values() {
-		mask |= Flags(flag)
+		mask |= flagToFlagsBit(flag)
 	}
 	return mask
 }
@@ -328,7 +329,7 @@ type (
 		FlagSet                         common.UnorderedSet[model.Flag]
 		Symbol                          *BSymbol
 		ListenerType                    BType
-		ResourceFunctions               []BLangFunction
+		ResourceFunctions               []BLangResourceFunction
 		InferredServiceType             BType
 	}
 
@@ -472,6 +473,16 @@ type (
 		InterfaceFunction  bool
 	}
 
+	BLangResourceFunction struct {
+		BLangFunction
+		MethodName *BLangIdentifier
+		// segments of the resource path, with "^" for a path parameter, "^^" for a rest path parameter and "." for
+		// the root path
+		ResourcePath  []BLangIdentifier
+		PathParams    []BLangSimpleVariable
+		RestPathParam *BLangSimpleVariable
+	}
+
 	BLangTypeDefinition struct {
 		BLangNodeBase
 		name                            *BLangIdentifier
@@ -482,6 +493,7 @@ type (
 		precedence                      int
 		symbol                          *BSymbol
 		cycleDepth                      int
+		semType                         semtypes.SemType
 		isBuiltinTypeDef                bool
 		hasCyclicReference              bool
 		referencedFieldsDefined         bool
@@ -530,6 +542,7 @@ var (
 	_ model.MarkdownDocumentationReferenceAttributeNode = &BLangMarkdownReferenceDocumentation{}
 	_ model.ExprFunctionBodyNode                        = &BLangExprFunctionBody{}
 	_ model.FunctionNode                                = &BLangFunction{}
+	_ model.FunctionNode                                = &BLangResourceFunction{}
 )
 
 var (
@@ -552,6 +565,7 @@ var (
 	_ BLangNode = &BLangConstant{}
 	_ BLangNode = &BLangSimpleVariable{}
 	_ BLangNode = &BLangFunction{}
+	_ BLangNode = &BLangResourceFunction{}
 	_ BLangNode = &BLangTypeDefinition{}
 )
 
@@ -653,6 +667,26 @@ func (this *BLangAnnotation) AddAnnotationAttachment(annAttachment model.Annotat
 	panic("annAttachment is not a BLangAnnotationAttachment")
 }
 
+// AddAttachPoint adds a construct the annotation may be attached to.
+func (this *BLangAnnotation) AddAttachPoint(attachPoint model.AttachPoint) {
+	this.attachPoints.Add(attachPoint)
+}
+
+// GetAttachPoints returns the constructs the annotation may be attached to, ordered by point.
+func (this *BLangAnnotation) GetAttachPoints() []model.AttachPoint {
+	var attachPoints []model.AttachPoint
+	for attachPoint := range this.attachPoints.Values() {
+		attachPoints = append(attachPoints, attachPoint)
+	}
+	sort.Slice(attachPoints, func(i, j int) bool {
+		if attachPoints[i].Point != attachPoints[j].Point {
+			return attachPoints[i].Point < attachPoints[j].Point
+		}
+		return !attachPoints[i].Source && attachPoints[j].Source
+	})
+	return attachPoints
+}
+
 func (this *BLangAnnotation) GetMarkdownDocumentationAttachment() model.MarkdownDocumentationNode {
 	// migrated from BLangAnnotation.java:110:5
 	return this.MarkdownDocumentationAttachment
@@ -1135,6 +1169,35 @@ func (this *BLangMarkdownDocumentation) AddReference(reference model.MarkdownDoc
 	}
 }
 
+// GetDocAttachment returns the documentation in the form it is attached to the symbols and the BIR.
+func (this *BLangMarkdownDocumentation) GetDocAttachment() model.MarkdownDocAttachment {
+	docAttachment := model.MarkdownDocAttachment{}
+	if len(this.DocumentationLines) > 0 {
+		docAttachment.Description = common.ToPointer(this.GetDocumentation())
+	}
+	for i := range this.Parameters {
+		docAttachment.Parameters = append(docAttachment.Parameters, getDocAttachmentParameter(&this.Parameters[i]))
+	}
+	docAttachment.ReturnValueDescription = this.GetReturnParameterDocumentation()
+	if this.DeprecationDocumentation != nil {
+		docAttachment.DeprecatedDocumentation = common.ToPointer(this.DeprecationDocumentation.GetDocumentation())
+	}
+	if this.DeprecatedParametersDocumentation != nil {
+		for i := range this.DeprecatedParametersDocumentation.Parameters {
+			docAttachment.DeprecatedParameters = append(docAttachment.DeprecatedParameters,
+				getDocAttachmentParameter(&this.DeprecatedParametersDocumentation.Parameters[i]))
+		}
+	}
+	return docAttachment
+}
+
+func getDocAttachmentParameter(parameter *BLangMarkdownParameterDocumentation) model.Parameters {
+	return model.Parameters{
+		Name:        common.ToPointer(parameter.ParameterName.GetValue()),
+		Description: common.ToPointer(parameter.GetParameterDocumentation()),
+	}
+}
+
 func (this *BLangMarkdownReferenceDocumentation) GetType() model.DocumentationReferenceType {
 	return this.Type
 }
@@ -1158,7 +1221,11 @@ func (this *BLangService) SetName(name model.IdentifierNode) {
 }
 
 func (this *BLangService) GetResources() []model.FunctionNode {
-	return []model.FunctionNode{}
+	result := make([]model.FunctionNode, len(this.ResourceFunctions))
+	for i := range this.ResourceFunctions {
+		result[i] = &this.ResourceFunctions[i]
+	}
+	return result
 }
 
 func (this *BLangService) IsAnonymousService() bool {
@@ -1241,6 +1308,10 @@ func (this *BLangFunction) GetKind() model.NodeKind {
 	return model.NodeKind_FUNCTION
 }
 
+func (this *BLangResourceFunction) GetKind() model.NodeKind {
+	return model.NodeKind_RESOURCE_FUNC
+}
+
 func (b *BLangInvokableNodeBase) GetName() model.IdentifierNode {
 	return b.Name
 }
@@ -1588,6 +1659,11 @@ func (this *BLangTypeDefinition) GetKind() model.NodeKind {
 	return model.NodeKind_TYPE_DEFINITION
 }
 
+// GetSemType returns the semtype the type definition was resolved to, or nil if it is yet to be resolved.
+func (this *BLangTypeDefinition) GetSemType() semtypes.SemType {
+	return this.semType
+}
+
 func (this *BLangXMLNS) GetNamespaceURI() model.ExpressionNode {
 	return this.namespaceURI
 }
@@ -1917,15 +1993,33 @@ func createConstantNode() *BLangConstant {
 }
 
 func GetCompilationUnit(cx *context.CompilerContext, syntaxTree *tree.SyntaxTree) *BLangCompilationUnit {
+	return GetModuleCompilationUnit(cx, cx.GetDefaultPackage(), "", syntaxTree)
+}
+
+// GetModuleCompilationUnit builds the compilation unit named name of the module identified by packageID.
+func GetModuleCompilationUnit(cx *context.CompilerContext, packageID *model.PackageID, name string, syntaxTree *tree.SyntaxTree) *BLangCompilationUnit {
 	nodeBuilder := NewNodeBuilder(cx)
+	nodeBuilder.PackageID = packageID
+	nodeBuilder.CurrentCompUnitName = name
 	compilationUnit := nodeBuilder.TransformModulePart(syntaxTree.RootNode.(*tree.ModulePart))
 	return compilationUnit.(*BLangCompilationUnit)
 }
 
-// TODO: get rid of this once we have a proper project api. This just remaps compilation unit to a BLangPackage.
-func ToPackage(compilationUnit *BLangCompilationUnit) *BLangPackage {
+// ToPackage merges the compilation units of a module into a BLangPackage. The package takes the package ID of the
+// first compilation unit.
+func ToPackage(compilationUnits ...*BLangCompilationUnit) *BLangPackage {
 	p := BLangPackage{}
-	p.PackageID = compilationUnit.packageID
+	for i, compilationUnit := range compilationUnits {
+		if i == 0 {
+			p.PackageID = compilationUnit.packageID
+		}
+		p.CompUnits = append(p.CompUnits, *compilationUnit)
+		addTopLevelNodes(&p, compilationUnit)
+	}
+	return &p
+}
+
+func addTopLevelNodes(p *BLangPackage, compilationUnit *BLangCompilationUnit) {
 	for _, node := range compilationUnit.TopLevelNodes {
 		switch node.(type) {
 		case *BLangImportPackage:
@@ -1936,13 +2030,16 @@ func ToPackage(compilationUnit *BLangCompilationUnit) *BLangPackage {
 			p.Services = append(p.Services, *node.(*BLangService))
 		case *BLangFunction:
 			p.Functions = append(p.Functions, *node.(*BLangFunction))
+		case *BLangSimpleVariable:
+			p.GlobalVars = append(p.GlobalVars, *node.(*BLangSimpleVariable))
 		case *BLangTypeDefinition:
 			p.TypeDefinitions = append(p.TypeDefinitions, *node.(*BLangTypeDefinition))
 		case *BLangAnnotation:
 			p.Annotations = append(p.Annotations, *node.(*BLangAnnotation))
+		case *BLangXMLNS:
+			p.XmlnsList = append(p.XmlnsList, *node.(*BLangXMLNS))
 		default:
 			p.TopLevelNodes = append(p.TopLevelNodes, node)
 		}
 	}
-	return &p
 }
@@ -67,6 +67,24 @@ type (
 		Body *BLangBlockStmt
 		Env  *SymbolEnv
 	}
+	BLangFromClause struct {
+		BLangNodeBase
+		Collection BLangExpression
+		// the variable the members of the collection are bound to, which has no initializer
+		VariableDefinitionNode *BLangSimpleVariableDef
+	}
+	BLangWhereClause struct {
+		BLangNodeBase
+		Expression BLangExpression
+	}
+	BLangLetClause struct {
+		BLangNodeBase
+		LetVarDeclarations []*BLangSimpleVariableDef
+	}
+	BLangSelectClause struct {
+		BLangNodeBase
+		Expression BLangExpression
+	}
 	BLangOnFailClause struct {
 		BLangNodeBase
 		Body                   *BLangBlockStmt
@@ -88,6 +106,10 @@ var (
 	_ BLangNode = &BLangCollectClause{}
 	_ BLangNode = &BLangDoClause{}
 	_ BLangNode = &BLangOnFailClause{}
+	_ BLangNode = &BLangFromClause{}
+	_ BLangNode = &BLangWhereClause{}
+	_ BLangNode = &BLangLetClause{}
+	_ BLangNode = &BLangSelectClause{}
 )
 
 func (this *BLangCollectClause) GetKind() model.NodeKind {
@@ -166,3 +188,19 @@ func (this *BLangOnFailClause) GetKind() model.NodeKind {
 	// migrated from BLangOnFailClause.java:93:5
 	return model.NodeKind_ON_FAIL
 }
+
+func (this *BLangFromClause) GetKind() model.NodeKind {
+	return model.NodeKind_FROM
+}
+
+func (this *BLangWhereClause) GetKind() model.NodeKind {
+	return model.NodeKind_WHERE
+}
+
+func (this *BLangLetClause) GetKind() model.NodeKind {
+	return model.NodeKind_LET_CLAUSE
+}
+
+func (this *BLangSelectClause) GetKind() model.NodeKind {
+	return model.NodeKind_SELECT
+}
@@ -235,6 +235,50 @@ type (
 		NoMessagePossible        bool
 	}
 
+	BLangWorkerAsyncSendExpr struct {
+		BLangWorkerSendExprBase
+	}
+
+	BLangWorkerSyncSendExpr struct {
+		BLangWorkerSendExprBase
+	}
+
+	BLangMultipleWorkerReceive struct {
+		BLangExpressionBase
+		ReceiveFields []BLangReceiveField
+	}
+
+	BLangReceiveField struct {
+		Key           *BLangIdentifier
+		WorkerReceive *BLangWorkerReceive
+	}
+
+	BLangWorkerFlushExpr struct {
+		BLangExpressionBase
+		WorkerIdentifier *BLangIdentifier
+		// workers the flush waits on; all the workers sent to so far if there is no worker identifier
+		WorkerIdentifierList []*BLangIdentifier
+	}
+
+	BLangWaitExpr struct {
+		BLangExpressionBase
+		// more than one expression for an alternate wait
+		ExprList []BLangExpression
+	}
+
+	BLangWaitForAllExpr struct {
+		BLangExpressionBase
+		KeyValuePairs []BLangWaitKeyValue
+	}
+
+	BLangWaitKeyValue struct {
+		BLangNodeBase
+		Key *BLangIdentifier
+		// KeyExpr is set for a field without a value, e.g. `wait {w1}`
+		KeyExpr   *BLangSimpleVarRef
+		ValueExpr BLangExpression
+	}
+
 	BLangInvocation struct {
 		BLangExpressionBase
 		PkgAlias                  *BLangIdentifier
@@ -258,6 +302,14 @@ type (
 		Expression BLangExpression
 	}
 
+	// BLangTernaryExpr is a conditional expression `Expr ? ThenExpr : ElseExpr`
+	BLangTernaryExpr struct {
+		BLangExpressionBase
+		Expr     BLangExpression
+		ThenExpr BLangExpression
+		ElseExpr BLangExpression
+	}
+
 	BLangTypedescExpr struct {
 		BLangExpressionBase
 		TypeNode model.TypeNode
@@ -282,6 +334,201 @@ type (
 		IsTypedescExpr bool
 		TypedescType   BType
 	}
+
+	BLangRecordLiteral struct {
+		BLangExpressionBase
+		Fields []BLangRecordKeyValueField
+	}
+
+	BLangTableConstructorExpr struct {
+		BLangExpressionBase
+		// the key of the table, or nil if the key is given by the contextually expected type
+		TableKeySpecifier *BLangTableKeySpecifier
+		RecordLiteralList []*BLangRecordLiteral
+	}
+
+	BLangFieldBaseAccess struct {
+		BLangAccessExpressionBase
+		Field BLangIdentifier
+	}
+
+	BLangQueryExpr struct {
+		BLangExpressionBase
+		// the clauses of the query in order, starting with a from clause and ending with a select clause
+		QueryClauseList []BLangNode
+		// whether the query is a `table` query, which produces a table instead of a list
+		IsTable bool
+		// whether the query is a `stream` query, which produces a stream instead of a list
+		IsStream bool
+		// the key of the table produced by a `table` query, or nil if it has none
+		TableKeySpecifier *BLangTableKeySpecifier
+	}
+
+	BLangTypeInit struct {
+		BLangExpressionBase
+		// the class being instantiated, which is nil for an implicit new expression whose type is inferred from the
+		// context
+		UserDefinedType model.TypeNode
+		ArgsExpr        []BLangExpression
+	}
+
+	BLangRecordKeyValueField struct {
+		BLangNodeBase
+		Key       *BLangRecordKey
+		ValueExpr BLangExpression
+		Readonly  bool
+	}
+
+	BLangRecordKey struct {
+		BLangNodeBase
+		// the field name, which is a *BLangSimpleVarRef for an identifier and a *BLangLiteral for a string literal
+		Expr        BLangExpression
+		ComputedKey bool
+	}
+
+	BLangStringTemplateLiteral struct {
+		BLangExpressionBase
+		Exprs []BLangExpression
+	}
+
+	BLangRawTemplateLiteral struct {
+		BLangExpressionBase
+		Strings    []*BLangLiteral
+		Insertions []BLangExpression
+	}
+
+	BLangXMLQName struct {
+		BLangExpressionBase
+		Prefix    BLangIdentifier
+		LocalName BLangIdentifier
+	}
+
+	BLangXMLAttribute struct {
+		BLangExpressionBase
+		Name             *BLangXMLQName
+		Value            *BLangXMLQuotedString
+		IsNamespaceDeclr bool
+	}
+
+	BLangXMLQuotedString struct {
+		BLangExpressionBase
+		QuoteType     XMLQuoteType
+		TextFragments []BLangExpression
+	}
+
+	BLangXMLElementLiteral struct {
+		BLangExpressionBase
+		StartTagName     *BLangXMLQName
+		EndTagName       *BLangXMLQName
+		Attributes       []*BLangXMLAttribute
+		Children         []BLangExpression
+		InlineNamespaces []*BLangXMLNS
+	}
+
+	BLangXMLTextLiteral struct {
+		BLangExpressionBase
+		TextFragments []BLangExpression
+	}
+
+	BLangXMLCommentLiteral struct {
+		BLangExpressionBase
+		TextFragments []BLangExpression
+	}
+
+	BLangXMLProcInsLiteral struct {
+		BLangExpressionBase
+		Target        *BLangLiteral
+		DataFragments []BLangExpression
+	}
+
+	BLangXMLSequenceLiteral struct {
+		BLangExpressionBase
+		XMLItems []BLangExpression
+	}
+
+	BLangRegExpTemplateLiteral struct {
+		BLangExpressionBase
+		ReDisjunction *BLangReDisjunction
+	}
+
+	BLangReDisjunction struct {
+		BLangExpressionBase
+		SequenceList []*BLangReSequence
+	}
+
+	BLangReSequence struct {
+		BLangExpressionBase
+		TermList []BLangExpression
+	}
+
+	BLangReAtomQuantifier struct {
+		BLangExpressionBase
+		Atom       BLangExpression
+		Quantifier *BLangReQuantifier
+	}
+
+	BLangReAtomCharOrEscape struct {
+		BLangExpressionBase
+		CharOrEscape BLangExpression
+	}
+
+	BLangReCharacterClass struct {
+		BLangExpressionBase
+		CharClassStart *BLangLiteral
+		Negation       *BLangLiteral
+		CharSet        *BLangReCharSet
+		CharClassEnd   *BLangLiteral
+	}
+
+	BLangReCharSet struct {
+		BLangExpressionBase
+		CharSetAtoms []BLangExpression
+	}
+
+	BLangReCharSetRange struct {
+		BLangExpressionBase
+		LhsCharSetAtom BLangExpression
+		Dash           *BLangLiteral
+		RhsCharSetAtom BLangExpression
+	}
+
+	BLangReCapturingGroups struct {
+		BLangExpressionBase
+		OpenParen   *BLangLiteral
+		FlagExpr    *BLangReFlagExpression
+		Disjunction *BLangReDisjunction
+		CloseParen  *BLangLiteral
+	}
+
+	BLangReFlagExpression struct {
+		BLangExpressionBase
+		QuestionMark *BLangLiteral
+		FlagsOnOff   *BLangReFlagsOnOff
+		Colon        *BLangLiteral
+	}
+
+	BLangReFlagsOnOff struct {
+		BLangExpressionBase
+		Flags *BLangLiteral
+	}
+
+	BLangReQuantifier struct {
+		BLangExpressionBase
+		Quantifier    *BLangLiteral
+		NonGreedyChar *BLangLiteral
+	}
+
+	BLangReAssertion struct {
+		BLangExpressionBase
+		Assertion *BLangLiteral
+	}
+)
+
+type XMLQuoteType uint8
+
+const (
+	XMLQuoteType_DOUBLE_QUOTE XMLQuoteType = iota
+	XMLQuoteType_SINGLE_QUOTE
 )
 
 var (
@@ -303,15 +550,65 @@ var (
 	_ model.MarkDownDocumentationDeprecationAttributeNode          = &BLangMarkDownDeprecationDocumentation{}
 	_ model.MarkDownDocumentationDeprecatedParametersAttributeNode = &BLangMarkDownDeprecatedParametersDocumentation{}
 	_ model.WorkerReceiveNode                                      = &BLangWorkerReceive{}
+	_ BLangExpression                                              = &BLangWorkerReceive{}
+	_ BLangExpression                                              = &BLangAlternateWorkerReceive{}
+	_ BLangExpression                                              = &BLangMultipleWorkerReceive{}
+	_ BLangExpression                                              = &BLangWorkerAsyncSendExpr{}
+	_ BLangExpression                                              = &BLangWorkerSyncSendExpr{}
+	_ BLangExpression                                              = &BLangWorkerFlushExpr{}
+	_ BLangExpression                                              = &BLangWaitExpr{}
+	_ BLangExpression                                              = &BLangWaitForAllExpr{}
+	_ BLangExpression                                              = &BLangLambdaFunction{}
 	_ model.LambdaFunctionNode                                     = &BLangLambdaFunction{}
 	_ model.InvocationNode                                         = &BLangInvocation{}
 	_ BLangExpression                                              = &BLangInvocation{}
 	_ model.GroupExpressionNode                                    = &BLangGroupExpr{}
+	_ model.TernaryExpressionNode                                  = &BLangTernaryExpr{}
+	_ BLangExpression                                              = &BLangTernaryExpr{}
 	_ model.TypedescExpressionNode                                 = &BLangTypedescExpr{}
 	_ model.LiteralNode                                            = &BLangNumericLiteral{}
 	_ model.UnaryExpressionNode                                    = &BLangUnaryExpr{}
 	_ model.IndexBasedAccessNode                                   = &BLangIndexBasedAccess{}
 	_ model.ListConstructorExprNode                                = &BLangListConstructorExpr{}
+	_ BLangExpression                                              = &BLangTypeInit{}
+	_ BLangExpression                                              = &BLangTableConstructorExpr{}
+	_ BLangExpression                                              = &BLangFieldBaseAccess{}
+	_ BLangExpression                                              = &BLangQueryExpr{}
+	_ model.RecordField                                            = &BLangRecordKeyValueField{}
+	_ model.StringTemplateLiteralNode                              = &BLangStringTemplateLiteral{}
+	_ model.RawTemplateLiteralNode                                 = &BLangRawTemplateLiteral{}
+	_ BLangExpression                                              = &BLangStringTemplateLiteral{}
+	_ BLangExpression                                              = &BLangRawTemplateLiteral{}
+	_ model.XMLQNameNode                                           = &BLangXMLQName{}
+	_ model.XMLAttributeNode                                       = &BLangXMLAttribute{}
+	_ model.XMLQuotedStringNode                                    = &BLangXMLQuotedString{}
+	_ model.XMLElementLiteralNode                                  = &BLangXMLElementLiteral{}
+	_ model.XMLTextLiteralNode                                     = &BLangXMLTextLiteral{}
+	_ model.XMLCommentLiteralNode                                  = &BLangXMLCommentLiteral{}
+	_ model.XMLProcessingInstructionLiteralNode                    = &BLangXMLProcInsLiteral{}
+	_ model.XMLSequenceLiteralNode                                 = &BLangXMLSequenceLiteral{}
+	_ BLangExpression                                              = &BLangXMLQName{}
+	_ BLangExpression                                              = &BLangXMLAttribute{}
+	_ BLangExpression                                              = &BLangXMLQuotedString{}
+	_ BLangExpression                                              = &BLangXMLElementLiteral{}
+	_ BLangExpression                                              = &BLangXMLTextLiteral{}
+	_ BLangExpression                                              = &BLangXMLCommentLiteral{}
+	_ BLangExpression                                              = &BLangXMLProcInsLiteral{}
+	_ BLangExpression                                              = &BLangXMLSequenceLiteral{}
+	_ model.RegExpTemplateLiteralNode                              = &BLangRegExpTemplateLiteral{}
+	_ BLangExpression                                              = &BLangRegExpTemplateLiteral{}
+	_ BLangExpression                                              = &BLangReDisjunction{}
+	_ BLangExpression                                              = &BLangReSequence{}
+	_ BLangExpression                                              = &BLangReAtomQuantifier{}
+	_ BLangExpression                                              = &BLangReAtomCharOrEscape{}
+	_ BLangExpression                                              = &BLangReCharacterClass{}
+	_ BLangExpression                                              = &BLangReCharSet{}
+	_ BLangExpression                                              = &BLangReCharSetRange{}
+	_ BLangExpression                                              = &BLangReCapturingGroups{}
+	_ BLangExpression                                              = &BLangReFlagExpression{}
+	_ BLangExpression                                              = &BLangReFlagsOnOff{}
+	_ BLangExpression                                              = &BLangReQuantifier{}
+	_ BLangExpression                                              = &BLangReAssertion{}
 )
 
 var (
@@ -333,6 +630,13 @@ var (
 	_ BLangNode = &BLangDynamicArgExpr{}
 	_ BLangNode = &BLangElvisExpr{}
 	_ BLangNode = &BLangWorkerReceive{}
+	_ BLangNode = &BLangMultipleWorkerReceive{}
+	_ BLangNode = &BLangWorkerAsyncSendExpr{}
+	_ BLangNode = &BLangWorkerSyncSendExpr{}
+	_ BLangNode = &BLangWorkerFlushExpr{}
+	_ BLangNode = &BLangWaitExpr{}
+	_ BLangNode = &BLangWaitForAllExpr{}
+	_ BLangNode = &BLangWaitKeyValue{}
 	_ BLangNode = &BLangInvocation{}
 	_ BLangNode = &BLangMarkdownDocumentationLine{}
 	_ BLangNode = &BLangMarkdownParameterDocumentation{}
@@ -340,9 +644,39 @@ var (
 	_ BLangNode = &BLangMarkDownDeprecationDocumentation{}
 	_ BLangNode = &BLangMarkDownDeprecatedParametersDocumentation{}
 	_ BLangNode = &BLangGroupExpr{}
+	_ BLangNode = &BLangTernaryExpr{}
 	_ BLangNode = &BLangTypedescExpr{}
 	_ BLangNode = &BLangIndexBasedAccess{}
 	_ BLangNode = &BLangListConstructorExpr{}
+	_ BLangNode = &BLangTypeInit{}
+	_ BLangNode = &BLangRecordLiteral{}
+	_ BLangNode = &BLangTableConstructorExpr{}
+	_ BLangNode = &BLangFieldBaseAccess{}
+	_ BLangNode = &BLangQueryExpr{}
+	_ BLangNode = &BLangRecordKeyValueField{}
+	_ BLangNode = &BLangStringTemplateLiteral{}
+	_ BLangNode = &BLangRawTemplateLiteral{}
+	_ BLangNode = &BLangXMLQName{}
+	_ BLangNode = &BLangXMLAttribute{}
+	_ BLangNode = &BLangXMLQuotedString{}
+	_ BLangNode = &BLangXMLElementLiteral{}
+	_ BLangNode = &BLangXMLTextLiteral{}
+	_ BLangNode = &BLangXMLCommentLiteral{}
+	_ BLangNode = &BLangXMLProcInsLiteral{}
+	_ BLangNode = &BLangXMLSequenceLiteral{}
+	_ BLangNode = &BLangRegExpTemplateLiteral{}
+	_ BLangNode = &BLangReDisjunction{}
+	_ BLangNode = &BLangReSequence{}
+	_ BLangNode = &BLangReAtomQuantifier{}
+	_ BLangNode = &BLangReAtomCharOrEscape{}
+	_ BLangNode = &BLangReCharacterClass{}
+	_ BLangNode = &BLangReCharSet{}
+	_ BLangNode = &BLangReCharSetRange{}
+	_ BLangNode = &BLangReCapturingGroups{}
+	_ BLangNode = &BLangReFlagExpression{}
+	_ BLangNode = &BLangReFlagsOnOff{}
+	_ BLangNode = &BLangReQuantifier{}
+	_ BLangNode = &BLangReAssertion{}
 )
 
 func (this *BLangGroupExpr) GetKind() model.NodeKind {
@@ -404,6 +738,14 @@ func (this *BLangLambdaFunction) GetKind() model.NodeKind {
 	return model.NodeKind_LAMBDA
 }
 
+func (this *BLangAlternateWorkerReceive) GetWorkerReceives() []BLangWorkerReceive {
+	return this.workerReceives
+}
+
+func (this *BLangAlternateWorkerReceive) AddWorkerReceive(workerReceive BLangWorkerReceive) {
+	this.workerReceives = append(this.workerReceives, workerReceive)
+}
+
 func (this *BLangAlternateWorkerReceive) ToActionString() string {
 	// migrated from BLangAlternateWorkerReceive.java:70:5
 	panic("Not implemented")
@@ -835,6 +1177,30 @@ func (this *BLangGroupExpr) SetTypeCheckedType(ty BType) {
 	panic("not implemented")
 }
 
+func (this *BLangRecordLiteral) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangTableConstructorExpr) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangFieldBaseAccess) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangQueryExpr) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangAnnotAccessExpr) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangTypeInit) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
 func (this *BLangUnaryExpr) GetExpression() model.ExpressionNode {
 	return this.Expr
 }
@@ -863,6 +1229,50 @@ func (this *BLangListConstructorExpr) GetKind() model.NodeKind {
 	return model.NodeKind_LIST_CONSTRUCTOR_EXPR
 }
 
+func (this *BLangRecordLiteral) GetKind() model.NodeKind {
+	return model.NodeKind_RECORD_LITERAL_EXPR
+}
+
+func (this *BLangTableConstructorExpr) GetKind() model.NodeKind {
+	return model.NodeKind_TABLE_CONSTRUCTOR_EXPR
+}
+
+func (this *BLangFieldBaseAccess) GetKind() model.NodeKind {
+	return model.NodeKind_FIELD_BASED_ACCESS_EXPR
+}
+
+func (this *BLangFieldBaseAccess) GetExpression() model.ExpressionNode {
+	return this.Expr
+}
+
+func (this *BLangQueryExpr) GetKind() model.NodeKind {
+	return model.NodeKind_QUERY_EXPR
+}
+
+func (this *BLangTypeInit) GetKind() model.NodeKind {
+	return model.NodeKind_TYPE_INIT_EXPR
+}
+
+func (this *BLangRecordKeyValueField) GetKind() model.NodeKind {
+	return model.NodeKind_RECORD_LITERAL_KEY_VALUE
+}
+
+func (this *BLangRecordKeyValueField) IsKeyValueField() bool {
+	return true
+}
+
+// Name returns the name of the field identified by a key that is not computed.
+func (this *BLangRecordKey) Name() string {
+	switch expr := this.Expr.(type) {
+	case *BLangSimpleVarRef:
+		return expr.VariableName.Value
+	case *BLangLiteral:
+		return expr.Value.(string)
+	default:
+		panic("unexpected record key expression")
+	}
+}
+
 func (this *BLangListConstructorExpr) GetExpressions() []model.ExpressionNode {
 	result := make([]model.ExpressionNode, len(this.Exprs))
 	for i := range this.Exprs {
@@ -871,6 +1281,373 @@ func (this *BLangListConstructorExpr) GetExpressions() []model.ExpressionNode {
 	return result
 }
 
+func (this *BLangStringTemplateLiteral) GetKind() model.NodeKind {
+	return model.NodeKind_STRING_TEMPLATE_LITERAL
+}
+
+func (this *BLangStringTemplateLiteral) GetExpressions() []model.ExpressionNode {
+	result := make([]model.ExpressionNode, len(this.Exprs))
+	for i := range this.Exprs {
+		result[i] = this.Exprs[i]
+	}
+	return result
+}
+
+func (this *BLangStringTemplateLiteral) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangRawTemplateLiteral) GetKind() model.NodeKind {
+	return model.NodeKind_RAW_TEMPLATE_LITERAL
+}
+
+func (this *BLangRawTemplateLiteral) GetStrings() []model.LiteralNode {
+	result := make([]model.LiteralNode, len(this.Strings))
+	for i := range this.Strings {
+		result[i] = this.Strings[i]
+	}
+	return result
+}
+
+func (this *BLangRawTemplateLiteral) GetInsertions() []model.ExpressionNode {
+	result := make([]model.ExpressionNode, len(this.Insertions))
+	for i := range this.Insertions {
+		result[i] = this.Insertions[i]
+	}
+	return result
+}
+
+func (this *BLangRawTemplateLiteral) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangXMLQName) GetKind() model.NodeKind {
+	return model.NodeKind_XML_QNAME
+}
+
+func (this *BLangXMLQName) GetPrefix() model.IdentifierNode {
+	return &this.Prefix
+}
+
+func (this *BLangXMLQName) GetLocalname() model.IdentifierNode {
+	return &this.LocalName
+}
+
+func (this *BLangXMLQName) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangXMLAttribute) GetKind() model.NodeKind {
+	return model.NodeKind_XML_ATTRIBUTE
+}
+
+func (this *BLangXMLAttribute) GetName() model.ExpressionNode {
+	return this.Name
+}
+
+func (this *BLangXMLAttribute) GetValue() model.ExpressionNode {
+	return this.Value
+}
+
+func (this *BLangXMLAttribute) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangXMLQuotedString) GetKind() model.NodeKind {
+	return model.NodeKind_XML_QUOTED_STRING
+}
+
+func (this *BLangXMLQuotedString) GetTextFragments() []model.ExpressionNode {
+	return toExpressionNodes(this.TextFragments)
+}
+
+func (this *BLangXMLQuotedString) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangXMLElementLiteral) GetKind() model.NodeKind {
+	return model.NodeKind_XML_ELEMENT_LITERAL
+}
+
+func (this *BLangXMLElementLiteral) GetStartTagName() model.ExpressionNode {
+	return this.StartTagName
+}
+
+func (this *BLangXMLElementLiteral) GetEndTagName() model.ExpressionNode {
+	if this.EndTagName == nil {
+		return nil
+	}
+	return this.EndTagName
+}
+
+func (this *BLangXMLElementLiteral) GetAttributes() []model.XMLAttributeNode {
+	result := make([]model.XMLAttributeNode, len(this.Attributes))
+	for i := range this.Attributes {
+		result[i] = this.Attributes[i]
+	}
+	return result
+}
+
+func (this *BLangXMLElementLiteral) GetContent() []model.ExpressionNode {
+	return toExpressionNodes(this.Children)
+}
+
+func (this *BLangXMLElementLiteral) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangXMLTextLiteral) GetKind() model.NodeKind {
+	return model.NodeKind_XML_TEXT_LITERAL
+}
+
+func (this *BLangXMLTextLiteral) GetTextFragments() []model.ExpressionNode {
+	return toExpressionNodes(this.TextFragments)
+}
+
+func (this *BLangXMLTextLiteral) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangXMLCommentLiteral) GetKind() model.NodeKind {
+	return model.NodeKind_XML_COMMENT_LITERAL
+}
+
+func (this *BLangXMLCommentLiteral) GetTextFragments() []model.ExpressionNode {
+	return toExpressionNodes(this.TextFragments)
+}
+
+func (this *BLangXMLCommentLiteral) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangXMLProcInsLiteral) GetKind() model.NodeKind {
+	return model.NodeKind_XML_PI_LITERAL
+}
+
+func (this *BLangXMLProcInsLiteral) GetTarget() model.ExpressionNode {
+	return this.Target
+}
+
+func (this *BLangXMLProcInsLiteral) GetDataTextFragments() []model.ExpressionNode {
+	return toExpressionNodes(this.DataFragments)
+}
+
+func (this *BLangXMLProcInsLiteral) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangXMLSequenceLiteral) GetKind() model.NodeKind {
+	return model.NodeKind_XML_SEQUENCE_LITERAL
+}
+
+func (this *BLangXMLSequenceLiteral) GetXMLItems() []model.ExpressionNode {
+	return toExpressionNodes(this.XMLItems)
+}
+
+func (this *BLangXMLSequenceLiteral) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangRegExpTemplateLiteral) GetKind() model.NodeKind {
+	return model.NodeKind_REG_EXP_TEMPLATE_LITERAL
+}
+
+func (this *BLangRegExpTemplateLiteral) GetReDisjunction() model.ExpressionNode {
+	return this.ReDisjunction
+}
+
+func (this *BLangRegExpTemplateLiteral) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangReDisjunction) GetKind() model.NodeKind {
+	return model.NodeKind_REG_EXP_DISJUNCTION
+}
+
+func (this *BLangReDisjunction) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangReSequence) GetKind() model.NodeKind {
+	return model.NodeKind_REG_EXP_SEQUENCE
+}
+
+func (this *BLangReSequence) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangReAtomQuantifier) GetKind() model.NodeKind {
+	return model.NodeKind_REG_EXP_ATOM_QUANTIFIER
+}
+
+func (this *BLangReAtomQuantifier) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangReAtomCharOrEscape) GetKind() model.NodeKind {
+	return model.NodeKind_REG_EXP_ATOM_CHAR_ESCAPE
+}
+
+func (this *BLangReAtomCharOrEscape) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangReCharacterClass) GetKind() model.NodeKind {
+	return model.NodeKind_REG_EXP_CHARACTER_CLASS
+}
+
+func (this *BLangReCharacterClass) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangReCharSet) GetKind() model.NodeKind {
+	return model.NodeKind_REG_EXP_CHAR_SET
+}
+
+func (this *BLangReCharSet) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangReCharSetRange) GetKind() model.NodeKind {
+	return model.NodeKind_REG_EXP_CHAR_SET_RANGE
+}
+
+func (this *BLangReCharSetRange) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangReCapturingGroups) GetKind() model.NodeKind {
+	return model.NodeKind_REG_EXP_CAPTURING_GROUP
+}
+
+func (this *BLangReCapturingGroups) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangReFlagExpression) GetKind() model.NodeKind {
+	return model.NodeKind_REG_EXP_FLAG_EXPR
+}
+
+func (this *BLangReFlagExpression) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangReFlagsOnOff) GetKind() model.NodeKind {
+	return model.NodeKind_REG_EXP_FLAGS_ON_OFF
+}
+
+func (this *BLangReFlagsOnOff) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangReQuantifier) GetKind() model.NodeKind {
+	return model.NodeKind_REG_EXP_QUANTIFIER
+}
+
+func (this *BLangReQuantifier) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangReAssertion) GetKind() model.NodeKind {
+	return model.NodeKind_REG_EXP_ASSERTION
+}
+
+func (this *BLangReAssertion) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangWorkerAsyncSendExpr) GetKind() model.NodeKind {
+	return model.NodeKind_WORKER_ASYNC_SEND
+}
+
+func (this *BLangWorkerAsyncSendExpr) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangWorkerSyncSendExpr) GetKind() model.NodeKind {
+	return model.NodeKind_WORKER_SYNC_SEND
+}
+
+func (this *BLangWorkerSyncSendExpr) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangMultipleWorkerReceive) GetKind() model.NodeKind {
+	return model.NodeKind_MULTIPLE_WORKER_RECEIVE
+}
+
+func (this *BLangMultipleWorkerReceive) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangWorkerFlushExpr) GetKind() model.NodeKind {
+	return model.NodeKind_WORKER_FLUSH
+}
+
+func (this *BLangWorkerFlushExpr) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangWaitExpr) GetKind() model.NodeKind {
+	return model.NodeKind_WAIT_EXPR
+}
+
+func (this *BLangWaitExpr) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangWaitForAllExpr) GetKind() model.NodeKind {
+	return model.NodeKind_WAIT_EXPR
+}
+
+func (this *BLangWaitForAllExpr) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangWaitKeyValue) GetKind() model.NodeKind {
+	return model.NodeKind_WAIT_LITERAL_KEY_VALUE
+}
+
+func (this *BLangWorkerReceive) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangAlternateWorkerReceive) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func (this *BLangLambdaFunction) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
+func toExpressionNodes(exprs []BLangExpression) []model.ExpressionNode {
+	result := make([]model.ExpressionNode, len(exprs))
+	for i := range exprs {
+		result[i] = exprs[i]
+	}
+	return result
+}
+
+func (this *BLangTernaryExpr) GetKind() model.NodeKind {
+	return model.NodeKind_TERNARY_EXPR
+}
+
+func (this *BLangTernaryExpr) GetCondition() model.ExpressionNode {
+	return this.Expr
+}
+
+func (this *BLangTernaryExpr) GetThenExpression() model.ExpressionNode {
+	return this.ThenExpr
+}
+
+func (this *BLangTernaryExpr) GetElseExpression() model.ExpressionNode {
+	return this.ElseExpr
+}
+
+func (this *BLangTernaryExpr) SetTypeCheckedType(ty BType) {
+	panic("not implemented")
+}
+
 func createBLangUnaryExpr(location Location, operator model.OperatorKind, expr BLangExpression) *BLangUnaryExpr {
 	exprNode := &BLangUnaryExpr{}
 	exprNode.pos = location
@@ -0,0 +1,577 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ast
+
+import (
+	"fmt"
+
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+)
+
+// IsolationInfo is the result of the isolation analysis of a package. It tells which functions of the package are
+// isolated, either because they are declared isolated or because they are inferred to be, and why the others are not.
+type IsolationInfo struct {
+	functions map[string]*functionIsolation
+	// names of the methods of each service, keyed by the name of the service class
+	services map[string][]string
+}
+
+// NonIsolationCause is a reason for a function not being isolated. It is either an access of a mutable module level
+// variable or a call to a function that is not isolated.
+type NonIsolationCause struct {
+	// Function is the function in which the access or the call happens
+	Function string
+	// Variable is the module level variable accessed. Empty for a call
+	Variable string
+	// Callee is the function called. Empty for a variable access
+	Callee string
+}
+
+func (c NonIsolationCause) String() string {
+	if c.Variable != "" {
+		return fmt.Sprintf("function '%s' accesses mutable module level variable '%s'", c.Function, c.Variable)
+	}
+	return fmt.Sprintf("function '%s' calls non-isolated function '%s'", c.Function, c.Callee)
+}
+
+type functionIsolation struct {
+	declared bool
+	isolated bool
+	causes   []NonIsolationCause
+}
+
+// IsIsolated returns whether function, which must be a module level function of the package, is isolated.
+func (info *IsolationInfo) IsIsolated(function string) bool {
+	fn, ok := info.functions[function]
+	if !ok {
+		panic(fmt.Sprintf("undefined function '%s'", function))
+	}
+	return fn.isolated
+}
+
+// NonIsolationCauses returns the chain of causes that make function not isolated. The chain starts at function and
+// follows the calls to functions that are not isolated, up to the function that accesses a mutable module level
+// variable (or calls a function that is not defined in the package). It is empty if function is isolated.
+func (info *IsolationInfo) NonIsolationCauses(function string) []NonIsolationCause {
+	var chain []NonIsolationCause
+	visited := make(map[string]bool)
+	for name := function; !visited[name]; {
+		visited[name] = true
+		fn, ok := info.functions[name]
+		if !ok || fn.isolated {
+			break
+		}
+		cause := info.firstCause(fn)
+		chain = append(chain, cause)
+		if cause.Variable != "" {
+			break
+		}
+		name = cause.Callee
+	}
+	return chain
+}
+
+// IsServiceIsolated returns whether all the methods of service, which must be the name of the class of a service
+// declaration of the package, are isolated.
+func (info *IsolationInfo) IsServiceIsolated(service string) bool {
+	return len(info.ServiceNonIsolationCauses(service)) == 0
+}
+
+// ServiceNonIsolationCauses returns the causes that make service, which must be the name of the class of a service
+// declaration of the package, not isolated. These are the chains of causes of each method of the service that is not
+// isolated, in the order the methods are declared. It is empty if service is isolated.
+func (info *IsolationInfo) ServiceNonIsolationCauses(service string) []NonIsolationCause {
+	methods, ok := info.services[service]
+	if !ok {
+		panic(fmt.Sprintf("undefined service '%s'", service))
+	}
+	var causes []NonIsolationCause
+	for _, method := range methods {
+		causes = append(causes, info.NonIsolationCauses(method)...)
+	}
+	return causes
+}
+
+// firstCause returns the first cause of fn that holds after the inference, ignoring the calls to functions that turned
+// out to be isolated.
+func (info *IsolationInfo) firstCause(fn *functionIsolation) NonIsolationCause {
+	for _, cause := range fn.causes {
+		if cause.Variable != "" || !info.isIsolatedCallee(cause.Callee) {
+			return cause
+		}
+	}
+	panic("non-isolated function without a cause")
+}
+
+func (info *IsolationInfo) isIsolatedCallee(name string) bool {
+	fn, ok := info.functions[name]
+	return ok && fn.isolated
+}
+
+type moduleVarKind uint8
+
+const (
+	// moduleVarMutable variables can't be accessed by isolated functions
+	moduleVarMutable moduleVarKind = iota
+	// moduleVarImmutable variables are final and readonly, so they can be accessed freely
+	moduleVarImmutable
+	// moduleVarIsolated variables can only be accessed within a lock statement
+	moduleVarIsolated
+)
+
+// lockAccess records what a lock statement does that matters for isolation.
+type lockAccess struct {
+	// isolatedVar is the isolated variable accessed within the lock statement, if any
+	isolatedVar string
+	callees     []string
+}
+
+type isolationAnalyzer struct {
+	resolver   *SemTypeResolver
+	moduleVars map[string]moduleVarKind
+	info       *IsolationInfo
+	// lock statements that access an isolated variable, across the package
+	isolatedLocks []*lockAccess
+
+	// state of the function or initializer being analyzed
+	funcName string
+	causes   []NonIsolationCause
+	scopes   []map[string]bool
+	locks    []*lockAccess
+}
+
+// AnalyzeIsolation does the isolation analysis of pkg. It panics if a function or a module level variable declared
+// isolated breaks the rules of isolation, or if an isolated variable is accessed outside a lock statement. The
+// functions that aren't declared isolated are inferred to be isolated if they follow the same rules.
+//
+// Functions of other modules are assumed to be isolated, as are lang library methods.
+//
+// Isolated objects aren't analyzed: the node builder doesn't transform class definitions or object constructors yet,
+// so the only objects of a package are its services, whose isolation follows from the isolation of their methods.
+func AnalyzeIsolation(pkg *BLangPackage, resolver *SemTypeResolver) *IsolationInfo {
+	a := &isolationAnalyzer{
+		resolver:   resolver,
+		moduleVars: make(map[string]moduleVarKind),
+		info: &IsolationInfo{
+			functions: make(map[string]*functionIsolation),
+			services:  make(map[string][]string),
+		},
+	}
+	for i := range pkg.GlobalVars {
+		a.moduleVars[pkg.GlobalVars[i].Name.Value] = a.moduleVarKind(&pkg.GlobalVars[i])
+	}
+	for i := range pkg.Functions {
+		fn := &pkg.Functions[i]
+		a.info.functions[fn.Name.Value] = &functionIsolation{declared: fn.FlagSet.Contains(model.Flag_ISOLATED), isolated: true}
+	}
+	for i := range pkg.Services {
+		a.info.services[pkg.Services[i].ServiceClass.Name.Value] = nil
+	}
+	methods := serviceMethods(pkg)
+	for _, method := range methods {
+		a.info.services[method.service] = append(a.info.services[method.service], method.name)
+		a.info.functions[method.name] = &functionIsolation{declared: method.fn.FlagSet.Contains(model.Flag_ISOLATED), isolated: true}
+	}
+
+	initCauses := make(map[string][]NonIsolationCause)
+	for i := range pkg.GlobalVars {
+		globalVar := &pkg.GlobalVars[i]
+		a.startAnalysis(globalVar.Name.Value)
+		a.analyzeExpr(globalVar.Expr.(BLangExpression))
+		initCauses[globalVar.Name.Value] = a.causes
+	}
+	for i := range pkg.Functions {
+		fn := &pkg.Functions[i]
+		a.startAnalysis(fn.Name.Value)
+		for j := range fn.RequiredParams {
+			a.declare(fn.RequiredParams[j].Name.Value)
+		}
+		a.analyzeFunctionBody(fn.Body)
+		a.info.functions[fn.Name.Value].causes = a.causes
+	}
+	for _, method := range methods {
+		a.startAnalysis(method.name)
+		for _, param := range method.params {
+			a.declare(param)
+		}
+		a.analyzeFunctionBody(method.fn.Body)
+		a.info.functions[method.name].causes = a.causes
+	}
+
+	a.inferIsolation()
+	a.validateDeclaredIsolation(pkg, initCauses)
+	return a.info
+}
+
+// serviceMethod is a method of a service, named after the service class as `class.method`.
+type serviceMethod struct {
+	// service is the name of the service class
+	service string
+	name    string
+	fn      *BLangFunction
+	params  []string
+}
+
+func serviceMethods(pkg *BLangPackage) []serviceMethod {
+	var methods []serviceMethod
+	for i := range pkg.Services {
+		service := &pkg.Services[i]
+		className := service.ServiceClass.Name.Value
+		for j := range service.ServiceClass.Functions {
+			fn := &service.ServiceClass.Functions[j]
+			methods = append(methods, serviceMethod{service: className, name: className + "." + fn.Name.Value, fn: fn, params: paramNames(fn)})
+		}
+		for j := range service.ResourceFunctions {
+			resourceFunc := &service.ResourceFunctions[j]
+			params := paramNames(&resourceFunc.BLangFunction)
+			for k := range resourceFunc.PathParams {
+				params = append(params, resourceFunc.PathParams[k].Name.Value)
+			}
+			if resourceFunc.RestPathParam != nil {
+				params = append(params, resourceFunc.RestPathParam.Name.Value)
+			}
+			methods = append(methods, serviceMethod{service: className, name: className + "." + resourceFunc.Name.Value, fn: &resourceFunc.BLangFunction, params: params})
+		}
+	}
+	return methods
+}
+
+func paramNames(fn *BLangFunction) []string {
+	names := make([]string, len(fn.RequiredParams))
+	for i := range fn.RequiredParams {
+		names[i] = fn.RequiredParams[i].Name.Value
+	}
+	return names
+}
+
+func (a *isolationAnalyzer) moduleVarKind(globalVar *BLangSimpleVariable) moduleVarKind {
+	if globalVar.FlagSet.Contains(model.Flag_LISTENER) {
+		// listeners are objects of classes of other modules, which aren't known to be readonly
+		return moduleVarMutable
+	}
+	if globalVar.FlagSet.Contains(model.Flag_ISOLATED) {
+		return moduleVarIsolated
+	}
+	if !globalVar.FlagSet.Contains(model.Flag_FINAL) {
+		return moduleVarMutable
+	}
+	if globalVar.TypeNode == nil {
+		// The type of a variable declared with var is not known yet, except for literals which are always readonly
+		if _, _, ok := unwrapLiteral(globalVar.Expr.(BLangExpression)); ok {
+			return moduleVarImmutable
+		}
+		return moduleVarMutable
+	}
+	ty := a.resolver.ResolveTypeNode(globalVar.TypeNode)
+	if semtypes.IsSubtype(a.resolver.Context(), ty, semtypes.VAL_READONLY) {
+		return moduleVarImmutable
+	}
+	return moduleVarMutable
+}
+
+// inferIsolation computes the greatest fixpoint of the isolated functions, so that recursive functions are isolated
+// unless something else makes them not isolated.
+func (a *isolationAnalyzer) inferIsolation() {
+	for changed := true; changed; {
+		changed = false
+		for _, fn := range a.info.functions {
+			if !fn.isolated {
+				continue
+			}
+			for _, cause := range fn.causes {
+				if cause.Variable != "" || !a.info.isIsolatedCallee(cause.Callee) {
+					fn.isolated = false
+					changed = true
+					break
+				}
+			}
+		}
+	}
+}
+
+func (a *isolationAnalyzer) validateDeclaredIsolation(pkg *BLangPackage, initCauses map[string][]NonIsolationCause) {
+	for i := range pkg.GlobalVars {
+		globalVar := &pkg.GlobalVars[i]
+		if a.moduleVars[globalVar.Name.Value] != moduleVarIsolated {
+			continue
+		}
+		for _, cause := range initCauses[globalVar.Name.Value] {
+			if cause.Variable != "" || !a.info.isIsolatedCallee(cause.Callee) {
+				panic(fmt.Sprintf("invalid initial value expression: expected an isolated expression for isolated variable '%s'", globalVar.Name.Value))
+			}
+		}
+	}
+	names := make([]string, 0, len(pkg.Functions))
+	for i := range pkg.Functions {
+		names = append(names, pkg.Functions[i].Name.Value)
+	}
+	for _, method := range serviceMethods(pkg) {
+		names = append(names, method.name)
+	}
+	for _, name := range names {
+		fn := a.info.functions[name]
+		if !fn.declared || fn.isolated {
+			continue
+		}
+		cause := a.info.firstCause(fn)
+		if cause.Variable != "" {
+			panic(fmt.Sprintf("invalid access of mutable storage in an 'isolated' function: '%s'", cause.Variable))
+		}
+		panic(fmt.Sprintf("invalid invocation of a non-isolated function in an 'isolated' function: '%s'", cause.Callee))
+	}
+	for _, lock := range a.isolatedLocks {
+		for _, callee := range lock.callees {
+			if !a.info.isIsolatedCallee(callee) {
+				panic(fmt.Sprintf("invalid invocation of a non-isolated function in a lock statement accessing isolated variable '%s': '%s'", lock.isolatedVar, callee))
+			}
+		}
+	}
+}
+
+func (a *isolationAnalyzer) startAnalysis(funcName string) {
+	a.funcName = funcName
+	a.causes = nil
+	a.scopes = []map[string]bool{make(map[string]bool)}
+	a.locks = nil
+}
+
+func (a *isolationAnalyzer) declare(name string) {
+	a.scopes[len(a.scopes)-1][name] = true
+}
+
+func (a *isolationAnalyzer) isLocal(name string) bool {
+	for _, scope := range a.scopes {
+		if scope[name] {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *isolationAnalyzer) analyzeFunctionBody(body model.FunctionBodyNode) {
+	switch body := body.(type) {
+	case *BLangBlockFunctionBody:
+		a.analyzeStmts(body.Stmts)
+	case *BLangExprFunctionBody:
+		a.analyzeExpr(body.Expr.(BLangExpression))
+	default:
+		panic("unexpected function body type")
+	}
+}
+
+func (a *isolationAnalyzer) analyzeStmts(stmts []BLangStatement) {
+	a.scopes = append(a.scopes, make(map[string]bool))
+	for _, stmt := range stmts {
+		a.analyzeStmt(stmt)
+	}
+	a.scopes = a.scopes[:len(a.scopes)-1]
+}
+
+func (a *isolationAnalyzer) analyzeStmt(stmt BLangStatement) {
+	switch stmt := stmt.(type) {
+	case *BLangExpressionStmt:
+		a.analyzeExpr(stmt.Expr)
+	case *BLangSimpleVariableDef:
+		if stmt.IsWorker {
+			a.analyzeFunctionBody(stmt.Var.Expr.(*BLangLambdaFunction).Function.Body)
+		} else if stmt.Var.Expr != nil {
+			a.analyzeExpr(stmt.Var.Expr.(BLangExpression))
+		}
+		a.declare(stmt.Var.Name.Value)
+	case *BLangAssignment:
+		a.analyzeExpr(stmt.VarRef)
+		a.analyzeExpr(stmt.Expr)
+	case *BLangReturn:
+		if stmt.Expr != nil {
+			a.analyzeExpr(stmt.Expr)
+		}
+	case *BLangBlockStmt:
+		a.analyzeStmts(stmt.Stmts)
+	case *BLangIf:
+		a.analyzeExpr(stmt.Expr)
+		a.analyzeStmts(stmt.Body.Stmts)
+		if stmt.ElseStmt != nil {
+			a.analyzeStmt(stmt.ElseStmt)
+		}
+	case *BLangWhile:
+		a.analyzeExpr(stmt.Expr)
+		a.analyzeStmts(stmt.Body.Stmts)
+	case *BLangForeach:
+		a.analyzeExpr(stmt.Collection)
+		a.scopes = append(a.scopes, make(map[string]bool))
+		a.declare(stmt.VariableDefinitionNode.Var.Name.Value)
+		a.analyzeStmts(stmt.Body.Stmts)
+		a.scopes = a.scopes[:len(a.scopes)-1]
+	case *BLangLock:
+		lock := &lockAccess{}
+		a.locks = append(a.locks, lock)
+		a.analyzeStmts(stmt.Body.Stmts)
+		a.locks = a.locks[:len(a.locks)-1]
+		if lock.isolatedVar != "" {
+			a.isolatedLocks = append(a.isolatedLocks, lock)
+		}
+	case *BLangBreak, *BLangContinue, *BLangForkJoin, *BLangXMLNSStatement, *BLangTypeDefinition:
+	default:
+		panic("unexpected statement type")
+	}
+}
+
+func (a *isolationAnalyzer) analyzeExprs(exprs []BLangExpression) {
+	for _, expr := range exprs {
+		a.analyzeExpr(expr)
+	}
+}
+
+// analyzeExpr records the module level variables accessed and the functions called by expr. Expressions that can't
+// contain either, such as literals, are ignored.
+func (a *isolationAnalyzer) analyzeExpr(expr BLangExpression) {
+	switch expr := expr.(type) {
+	case *BLangSimpleVarRef:
+		a.analyzeVarRef(expr)
+	case *BLangInvocation:
+		a.analyzeInvocation(expr)
+	case *BLangBinaryExpr:
+		a.analyzeExpr(expr.LhsExpr)
+		a.analyzeExpr(expr.RhsExpr)
+	case *BLangUnaryExpr:
+		a.analyzeExpr(expr.Expr)
+	case *BLangGroupExpr:
+		a.analyzeExpr(expr.Expression)
+	case *BLangTernaryExpr:
+		a.analyzeExprs([]BLangExpression{expr.Expr, expr.ThenExpr, expr.ElseExpr})
+	case *BLangIndexBasedAccess:
+		a.analyzeExpr(expr.Expr)
+		a.analyzeExpr(expr.IndexExpr)
+	case *BLangFieldBaseAccess:
+		a.analyzeExpr(expr.Expr)
+	case *BLangTableConstructorExpr:
+		for _, row := range expr.RecordLiteralList {
+			a.analyzeExpr(row)
+		}
+	case *BLangQueryExpr:
+		a.analyzeQueryExpr(expr)
+	case *BLangTypeInit:
+		a.analyzeExprs(expr.ArgsExpr)
+	case *BLangListConstructorExpr:
+		a.analyzeExprs(expr.Exprs)
+	case *BLangRecordLiteral:
+		for _, field := range expr.Fields {
+			a.analyzeExpr(field.ValueExpr)
+		}
+	case *BLangAnnotAccessExpr:
+		a.analyzeExpr(expr.Expr)
+	case *BLangStringTemplateLiteral:
+		a.analyzeExprs(expr.Exprs)
+	case *BLangRawTemplateLiteral:
+		a.analyzeExprs(expr.Insertions)
+	case *BLangXMLElementLiteral:
+		for _, attribute := range expr.Attributes {
+			a.analyzeExprs(attribute.Value.TextFragments)
+		}
+		a.analyzeExprs(expr.Children)
+	case *BLangXMLQuotedString:
+		a.analyzeExprs(expr.TextFragments)
+	case *BLangXMLTextLiteral:
+		a.analyzeExprs(expr.TextFragments)
+	case *BLangXMLCommentLiteral:
+		a.analyzeExprs(expr.TextFragments)
+	case *BLangXMLProcInsLiteral:
+		a.analyzeExprs(expr.DataFragments)
+	case *BLangXMLSequenceLiteral:
+		a.analyzeExprs(expr.XMLItems)
+	case *BLangWorkerAsyncSendExpr:
+		a.analyzeExpr(expr.Expr)
+	case *BLangWorkerSyncSendExpr:
+		a.analyzeExpr(expr.Expr)
+	case *BLangWaitExpr:
+		a.analyzeExprs(expr.ExprList)
+	case *BLangWaitForAllExpr:
+		for _, keyValue := range expr.KeyValuePairs {
+			if keyValue.ValueExpr != nil {
+				a.analyzeExpr(keyValue.ValueExpr)
+			} else {
+				a.analyzeExpr(keyValue.KeyExpr)
+			}
+		}
+	}
+}
+
+// analyzeQueryExpr analyzes the clauses of a query in order. The variables bound by its from and let clauses are
+// local to the query.
+func (a *isolationAnalyzer) analyzeQueryExpr(expr *BLangQueryExpr) {
+	a.scopes = append(a.scopes, make(map[string]bool))
+	for _, clause := range expr.QueryClauseList {
+		switch clause := clause.(type) {
+		case *BLangFromClause:
+			a.analyzeExpr(clause.Collection)
+			a.declare(clause.VariableDefinitionNode.Var.Name.Value)
+		case *BLangWhereClause:
+			a.analyzeExpr(clause.Expression)
+		case *BLangLetClause:
+			for _, letVarDeclaration := range clause.LetVarDeclarations {
+				a.analyzeExpr(letVarDeclaration.Var.Expr.(BLangExpression))
+				a.declare(letVarDeclaration.Var.Name.Value)
+			}
+		case *BLangSelectClause:
+			a.analyzeExpr(clause.Expression)
+		default:
+			panic("unexpected query clause type")
+		}
+	}
+	a.scopes = a.scopes[:len(a.scopes)-1]
+}
+
+func (a *isolationAnalyzer) analyzeVarRef(varRef *BLangSimpleVarRef) {
+	name := varRef.VariableName.Value
+	if (varRef.PkgAlias != nil && varRef.PkgAlias.Value != "") || a.isLocal(name) {
+		return
+	}
+	kind, ok := a.moduleVars[name]
+	if !ok {
+		// constants and functions are immutable
+		return
+	}
+	switch kind {
+	case moduleVarMutable:
+		a.causes = append(a.causes, NonIsolationCause{Function: a.funcName, Variable: name})
+	case moduleVarIsolated:
+		if len(a.locks) == 0 {
+			panic(fmt.Sprintf("invalid access of an isolated variable outside a lock statement: '%s'", name))
+		}
+		lock := a.locks[len(a.locks)-1]
+		if lock.isolatedVar != "" && lock.isolatedVar != name {
+			panic(fmt.Sprintf("invalid attempt to access more than one isolated variable within a lock statement: '%s' and '%s'", lock.isolatedVar, name))
+		}
+		lock.isolatedVar = name
+	}
+}
+
+func (a *isolationAnalyzer) analyzeInvocation(invocation *BLangInvocation) {
+	if invocation.Expr != nil {
+		a.analyzeExpr(invocation.Expr)
+	}
+	a.analyzeExprs(invocation.ArgExprs)
+	if (invocation.PkgAlias != nil && invocation.PkgAlias.Value != "") || invocation.Expr != nil {
+		return
+	}
+	name := invocation.Name.Value
+	a.causes = append(a.causes, NonIsolationCause{Function: a.funcName, Callee: name})
+	for _, lock := range a.locks {
+		lock.callees = append(lock.callees, name)
+	}
+}
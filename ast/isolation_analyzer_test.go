@@ -0,0 +1,225 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ast
+
+import (
+	"strings"
+	"testing"
+
+	"ballerina-lang-go/semtypes"
+)
+
+const isolationSource = `
+import ballerina/io;
+
+const int LIMIT = 10;
+final int threshold = 5;
+final int[] mutableList = [1, 2];
+int count = 0;
+isolated int[] ids = [];
+
+isolated function addId(int id) {
+    lock {
+        ids[id] = id;
+    }
+}
+
+function pure(int x) returns int {
+    return x + threshold + LIMIT;
+}
+
+function callsPure() returns int {
+    return pure(1);
+}
+
+function increment() {
+    count = count + 1;
+}
+
+function callsIncrement() {
+    increment();
+}
+
+function readsList() returns int {
+    return mutableList[0];
+}
+
+function even(int n) returns boolean {
+    if n == 0 {
+        return true;
+    }
+    return odd(n - 1);
+}
+
+function odd(int n) returns boolean {
+    if n == 0 {
+        return false;
+    }
+    return even(n - 1);
+}
+
+function prints(int count) {
+    io:println(count);
+}
+`
+
+func analyzeTestIsolation(t *testing.T, source string) *IsolationInfo {
+	t.Helper()
+	pkg := parseTestPackage(t, source)
+	resolver := NewSemTypeResolver(semtypes.GetTypeEnv(), pkg)
+	resolver.ResolveTypeDefinitions()
+	return AnalyzeIsolation(pkg, resolver)
+}
+
+func TestAnalyzeIsolation(t *testing.T) {
+	info := analyzeTestIsolation(t, isolationSource)
+	tests := []struct {
+		function string
+		isolated bool
+		causes   []string
+	}{
+		{"addId", true, nil},
+		{"pure", true, nil},
+		{"callsPure", true, nil},
+		{"increment", false, []string{"function 'increment' accesses mutable module level variable 'count'"}},
+		{"callsIncrement", false, []string{
+			"function 'callsIncrement' calls non-isolated function 'increment'",
+			"function 'increment' accesses mutable module level variable 'count'",
+		}},
+		{"readsList", false, []string{"function 'readsList' accesses mutable module level variable 'mutableList'"}},
+		{"even", true, nil},
+		{"odd", true, nil},
+		{"prints", true, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.function, func(t *testing.T) {
+			if info.IsIsolated(test.function) != test.isolated {
+				t.Errorf("expected IsIsolated to be %v", test.isolated)
+			}
+			var causes []string
+			for _, cause := range info.NonIsolationCauses(test.function) {
+				causes = append(causes, cause.String())
+			}
+			if strings.Join(causes, "\n") != strings.Join(test.causes, "\n") {
+				t.Errorf("expected causes %q, got %q", test.causes, causes)
+			}
+		})
+	}
+}
+
+func TestAnalyzeServiceIsolation(t *testing.T) {
+	pkg := parseTestPackage(t, `
+import ballerina/http;
+
+int count = 0;
+
+function increment() {
+    count = count + 1;
+}
+
+service /greeting on new http:Listener(9090) {
+    resource function get value() returns int {
+        return 1;
+    }
+}
+
+service /counter on new http:Listener(9091) {
+    resource function get value() returns int {
+        return 1;
+    }
+
+    remote function bump() {
+        increment();
+    }
+}
+`)
+	resolver := NewSemTypeResolver(semtypes.GetTypeEnv(), pkg)
+	resolver.ResolveTypeDefinitions()
+	info := AnalyzeIsolation(pkg, resolver)
+	greeting := pkg.Services[0].ServiceClass.Name.Value
+	counter := pkg.Services[1].ServiceClass.Name.Value
+	if !info.IsServiceIsolated(greeting) {
+		t.Errorf("expected service '%s' to be isolated", greeting)
+	}
+	if info.IsServiceIsolated(counter) {
+		t.Errorf("expected service '%s' not to be isolated", counter)
+	}
+	var causes []string
+	for _, cause := range info.ServiceNonIsolationCauses(counter) {
+		causes = append(causes, cause.String())
+	}
+	expected := []string{
+		"function '" + counter + ".bump' calls non-isolated function 'increment'",
+		"function 'increment' accesses mutable module level variable 'count'",
+	}
+	if strings.Join(causes, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected causes %q, got %q", expected, causes)
+	}
+}
+
+func TestAnalyzeInvalidIsolation(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		message string
+	}{
+		{
+			"mutable access in isolated function",
+			"int count = 0;\nisolated function f() returns int {\n    return count;\n}\n",
+			"invalid access of mutable storage in an 'isolated' function: 'count'",
+		},
+		{
+			"non-isolated call in isolated function",
+			"int count = 0;\nfunction g() {\n    count = 1;\n}\nisolated function f() {\n    g();\n}\n",
+			"invalid invocation of a non-isolated function in an 'isolated' function: 'g'",
+		},
+		{
+			"isolated variable outside lock",
+			"isolated int count = 0;\nfunction f() returns int {\n    return count;\n}\n",
+			"invalid access of an isolated variable outside a lock statement: 'count'",
+		},
+		{
+			"two isolated variables in a lock",
+			"isolated int a = 0;\nisolated int b = 0;\nfunction f() {\n    lock {\n        a = b;\n    }\n}\n",
+			"invalid attempt to access more than one isolated variable within a lock statement: 'a' and 'b'",
+		},
+		{
+			"non-isolated call in a lock accessing an isolated variable",
+			"int count = 0;\nisolated int a = 0;\nfunction g() returns int {\n    return count;\n}\nfunction f() {\n    lock {\n        a = g();\n    }\n}\n",
+			"invalid invocation of a non-isolated function in a lock statement accessing isolated variable 'a': 'g'",
+		},
+		{
+			"non-isolated initializer of an isolated variable",
+			"int count = 0;\nisolated int a = count;\n",
+			"invalid initial value expression: expected an isolated expression for isolated variable 'a'",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if r == nil {
+					t.Fatalf("expected a panic")
+				}
+				if r != test.message {
+					t.Errorf("expected panic %q, got %q", test.message, r)
+				}
+			}()
+			analyzeTestIsolation(t, test.source)
+		})
+	}
+}
@@ -64,6 +64,11 @@ const (
 	INFERRED_ARRAY_INDICATOR = -2
 )
 
+const (
+	XMLNS_PREFIX         = "xmlns"
+	XMLNS_DEFAULT_PREFIX = ""
+)
+
 func (n *NodeBuilder) TransformSyntaxNode(node tree.Node) BLangNode {
 	switch t := node.(type) {
 	case *tree.ModulePart:
@@ -692,10 +697,7 @@ func (n *NodeBuilder) createSimpleVarInner(name tree.Token, typeName tree.Node,
 	}
 
 	// Line 6025-6027: Handle annotations
-	if annotations.Size() > 0 {
-		// Panic instead of processing annotations (not yet implemented)
-		panic("annotations not yet supported")
-	}
+	n.addAnnotationAttachments(bLSimpleVar, annotations)
 
 	// Line 6029: return bLSimpleVar;
 	return bLSimpleVar
@@ -799,12 +801,8 @@ func (n *NodeBuilder) isFunctionCallAsync(functionCallExpressionNode *tree.Funct
 // createBLangInvocation creates a BLangInvocation from a name node and arguments
 // migrated from BLangNodeBuilder.java:6343:5
 func (n *NodeBuilder) createBLangInvocation(nameNode tree.Node, arguments tree.NodeList[tree.FunctionArgumentNode], position Location, isAsync bool) *BLangInvocation {
-	var bLInvocation BLangInvocation
-	if isAsync {
-		panic("unimplemented")
-	} else {
-		bLInvocation = BLangInvocation{}
-	}
+	bLInvocation := BLangInvocation{}
+	bLInvocation.Async = isAsync
 
 	nameReference := n.createBLangNameReference(nameNode)
 	bLInvocation.PkgAlias = &nameReference[0]
@@ -1248,8 +1246,8 @@ func (n *NodeBuilder) populateFuncSignature(bLFunction *BLangFunction, funcSigna
 		// Pop "return" from the stack
 		n.anonTypeNameSuffixes = n.anonTypeNameSuffixes[:len(n.anonTypeNameSuffixes)-1]
 		annots := retTypeDescNode.Annotations()
-		if annots.Size() > 0 {
-			panic("unimplemented")
+		for annot := range annots.Iterator() {
+			bLFunction.AddReturnTypeAnnotationAttachment(n.TransformAnnotation(annot).(*BLangAnnotationAttachment))
 		}
 	} else {
 		bLValueType := BLangValueType{}
@@ -1260,28 +1258,65 @@ func (n *NodeBuilder) populateFuncSignature(bLFunction *BLangFunction, funcSigna
 }
 
 func (n *NodeBuilder) TransformFunctionDefinition(funcDefNode *tree.FunctionDefinition) BLangNode {
-	// Check for metadata and panic if present (per user requirement)
-	metadata := funcDefNode.Metadata()
-	if metadata != nil && !metadata.IsMissing() {
-		panic("TransformFunctionDefinition: metadata not yet supported")
-	}
-
-	// Check for resource functions - panic for now
 	relativeResourcePath := funcDefNode.RelativeResourcePath()
-	hasResourcePath := relativeResourcePath.Size() > 0
-	if hasResourcePath {
-		panic("TransformFunctionDefinition: resource functions not yet supported")
+	if relativeResourcePath.Size() > 0 {
+		return n.createResourceFunctionNode(funcDefNode)
 	}
 
 	// Create function node
 	bLFunction := n.createFunctionNode(funcDefNode.FunctionName(), funcDefNode.QualifierList(), funcDefNode.FunctionSignature(), funcDefNode.FunctionBody())
 	bLFunction.pos = getPositionWithoutMetadata(funcDefNode)
-
-	// TODO: handle metadata
+	bLFunction.MarkdownDocumentationAttachment = n.createMarkdownDocumentationAttachment(funcDefNode.Metadata())
+	n.addMetadataAnnotationAttachments(bLFunction, funcDefNode.Metadata())
 
 	return bLFunction
 }
 
+// createResourceFunctionNode creates a resource method, whose name is made of the accessor and the segments of the
+// resource path, such as `$get$users$*` for `resource function get users/[int id]()`.
+func (n *NodeBuilder) createResourceFunctionNode(funcDefNode *tree.FunctionDefinition) *BLangResourceFunction {
+	resourceFunc := &BLangResourceFunction{}
+	methodName := createIdentifierFromToken(getPosition(funcDefNode.FunctionName()), funcDefNode.FunctionName())
+	resourceFunc.MethodName = &methodName
+
+	nameSegments := []string{methodName.Value}
+	relativeResourcePath := funcDefNode.RelativeResourcePath()
+	for i := 0; i < relativeResourcePath.Size(); i++ {
+		pathSegment := relativeResourcePath.Get(i)
+		var segment string
+		switch pathSegment.Kind() {
+		case common.SLASH_TOKEN:
+			continue
+		case common.DOT_TOKEN:
+			segment = "."
+			nameSegments = append(nameSegments, segment)
+		case common.RESOURCE_PATH_SEGMENT_PARAM:
+			pathParam := n.TransformResourcePathParameter(pathSegment.(*tree.ResourcePathParameterNode)).(*BLangSimpleVariable)
+			resourceFunc.PathParams = append(resourceFunc.PathParams, *pathParam)
+			segment = "^"
+			nameSegments = append(nameSegments, "*")
+		case common.RESOURCE_PATH_REST_PARAM:
+			resourceFunc.RestPathParam = n.TransformResourcePathParameter(pathSegment.(*tree.ResourcePathParameterNode)).(*BLangSimpleVariable)
+			segment = "^^"
+			nameSegments = append(nameSegments, "**")
+		default:
+			identifier := createIdentifierFromToken(getPosition(pathSegment), pathSegment.(tree.Token))
+			segment = identifier.Value
+			nameSegments = append(nameSegments, segment)
+		}
+		segmentText := segment
+		resourceFunc.ResourcePath = append(resourceFunc.ResourcePath, createIdentifier(getPosition(pathSegment), &segmentText, &segmentText))
+	}
+
+	funcName := "$" + strings.Join(nameSegments, "$")
+	name := createIdentifier(getPosition(funcDefNode.FunctionName()), &funcName, &funcName)
+	n.populateFunctionNode(name, funcDefNode.QualifierList(), funcDefNode.FunctionSignature(), funcDefNode.FunctionBody(), &resourceFunc.BLangFunction)
+	resourceFunc.pos = getPositionWithoutMetadata(funcDefNode)
+	resourceFunc.MarkdownDocumentationAttachment = n.createMarkdownDocumentationAttachment(funcDefNode.Metadata())
+	n.addMetadataAnnotationAttachments(resourceFunc, funcDefNode.Metadata())
+	return resourceFunc
+}
+
 func (n *NodeBuilder) createFunctionNode(funcName *tree.IdentifierToken, qualifierList tree.NodeList[tree.Token], funcSignature *tree.FunctionSignatureNode, funcBody tree.FunctionBodyNode) *BLangFunction {
 	blFunction := BLangFunction{}
 	name := createIdentifierFromTokenInternal(getPosition(funcName), funcName, false)
@@ -1386,15 +1421,133 @@ func (n *NodeBuilder) TransformImportDeclaration(importDeclarationNode *tree.Imp
 }
 
 func (n *NodeBuilder) TransformListenerDeclaration(listenerDeclarationNode *tree.ListenerDeclarationNode) BLangNode {
-	panic("TransformListenerDeclaration unimplemented")
+	variable := createSimpleVariableNode()
+	variable.pos = getPositionWithoutMetadata(listenerDeclarationNode)
+	name := createIdentifierFromToken(getPosition(listenerDeclarationNode.VariableName()), listenerDeclarationNode.VariableName())
+	variable.SetName(&name)
+	variable.AddFlag(model.Flag_LISTENER)
+	variable.AddFlag(model.Flag_FINAL)
+	if visibilityQualifier := listenerDeclarationNode.VisibilityQualifier(); visibilityQualifier != nil && visibilityQualifier.Kind() == common.PUBLIC_KEYWORD {
+		variable.AddFlag(model.Flag_PUBLIC)
+	}
+	typeDesc := listenerDeclarationNode.TypeDescriptor()
+	variable.SetIsDeclaredWithVar(isDeclaredWithVar(typeDesc))
+	if !variable.IsDeclaredWithVar {
+		variable.SetTypeNode(n.createTypeNode(typeDesc))
+	}
+	initializer := n.createExpression(listenerDeclarationNode.Initializer())
+	if typeInit, ok := initializer.(*BLangTypeInit); ok && typeInit.UserDefinedType == nil {
+		if variable.IsDeclaredWithVar {
+			panic("cannot infer the type of the listener from an implicit new expression")
+		}
+		typeInit.UserDefinedType = variable.TypeNode
+	}
+	variable.SetInitialExpression(initializer)
+	rejectMetadataAnnotations(listenerDeclarationNode.Metadata())
+	if doc := n.createMarkdownDocumentationAttachment(listenerDeclarationNode.Metadata()); doc != nil {
+		variable.MarkdownDocumentationAttachment = doc
+	}
+	return variable
 }
 
 func (n *NodeBuilder) TransformTypeDefinition(typeDefinitionNode *tree.TypeDefinitionNode) BLangNode {
-	panic("TransformTypeDefinition unimplemented")
+	typeDef := NewBLangTypeDefinition()
+	identifierNode := createIdentifierFromToken(getPosition(typeDefinitionNode.TypeName()), typeDefinitionNode.TypeName())
+	typeDef.SetName(&identifierNode)
+	if doc := n.createMarkdownDocumentationAttachment(typeDefinitionNode.Metadata()); doc != nil {
+		typeDef.SetMarkdownDocumentationAttachment(doc)
+	}
+	n.addMetadataAnnotationAttachments(typeDef, typeDefinitionNode.Metadata())
+
+	n.anonTypeNameSuffixes = append(n.anonTypeNameSuffixes, identifierNode.Value)
+	typeDef.SetTypeNode(n.createTypeNode(typeDefinitionNode.TypeDescriptor()))
+	n.anonTypeNameSuffixes = n.anonTypeNameSuffixes[:len(n.anonTypeNameSuffixes)-1]
+
+	visibilityQualifier := typeDefinitionNode.VisibilityQualifier()
+	if visibilityQualifier != nil && visibilityQualifier.Kind() == common.PUBLIC_KEYWORD {
+		typeDef.AddFlag(model.Flag_PUBLIC)
+	}
+	typeDef.SetPosition(getPositionWithoutMetadata(typeDefinitionNode))
+	return typeDef
 }
 
+// TransformServiceDeclaration creates the service and the anonymous service class of its methods. Resource methods are
+// kept in the service, while remote and plain methods go to the class.
 func (n *NodeBuilder) TransformServiceDeclaration(serviceDeclarationNode *tree.ServiceDeclarationNode) BLangNode {
-	panic("TransformServiceDeclaration unimplemented")
+	pos := getPositionWithoutMetadata(serviceDeclarationNode)
+	service := &BLangService{}
+	service.pos = pos
+	serviceName := n.cx.GetNextAnonymousServiceKey(n.PackageID)
+	name := createIdentifier(pos, &serviceName, &serviceName)
+	service.Name = &name
+	if serviceDeclarationNode.TypeDescriptor() != nil {
+		panic("service declarations with a type descriptor not yet supported")
+	}
+
+	serviceClass := NewBLangClassDefinition()
+	serviceClass.pos = pos
+	className := n.getNextAnonymousTypeKey(n.PackageID, n.anonTypeNameSuffixes)
+	classIdentifier := createIdentifier(pos, &className, &className)
+	serviceClass.Name = &classIdentifier
+	serviceClass.FlagSet.Add(model.Flag_SERVICE)
+	serviceClass.IsServiceDecl = true
+	service.ServiceClass = &serviceClass
+
+	qualifiers := serviceDeclarationNode.Qualifiers()
+	for qualifier := range qualifiers.Iterator() {
+		switch qualifier.Kind() {
+		case common.ISOLATED_KEYWORD:
+			service.AddFlag(model.Flag_ISOLATED)
+			serviceClass.AddFlag(model.Flag_ISOLATED)
+		default:
+			panic("unexpected service qualifier")
+		}
+	}
+
+	absoluteResourcePath := serviceDeclarationNode.AbsoluteResourcePath()
+	if absoluteResourcePath.Size() > 0 {
+		// the root path `/` has no segments, while a missing path is nil
+		service.AbsoluteResourcePath = []model.IdentifierNode{}
+	}
+	for i := 0; i < absoluteResourcePath.Size(); i++ {
+		pathSegment := absoluteResourcePath.Get(i)
+		switch pathSegment.Kind() {
+		case common.SLASH_TOKEN:
+			continue
+		case common.STRING_LITERAL:
+			service.AbsoluteResourcePath = nil
+			service.ServiceNameLiteral = n.createExpression(pathSegment).(*BLangLiteral)
+		default:
+			identifier := createIdentifierFromToken(getPosition(pathSegment), pathSegment.(tree.Token))
+			service.AbsoluteResourcePath = append(service.AbsoluteResourcePath, &identifier)
+		}
+	}
+
+	expressions := serviceDeclarationNode.Expressions()
+	for expr := range expressions.Iterator() {
+		service.AttachedExprs = append(service.AttachedExprs, n.createExpression(expr))
+	}
+
+	members := serviceDeclarationNode.Members()
+	for member := range members.Iterator() {
+		funcDefNode, ok := member.(*tree.FunctionDefinition)
+		if !ok {
+			panic("service fields not yet supported")
+		}
+		switch function := n.TransformFunctionDefinition(funcDefNode).(type) {
+		case *BLangResourceFunction:
+			service.ResourceFunctions = append(service.ResourceFunctions, *function)
+		case *BLangFunction:
+			serviceClass.Functions = append(serviceClass.Functions, *function)
+		}
+	}
+
+	metadata := serviceDeclarationNode.Metadata()
+	if doc := n.createMarkdownDocumentationAttachment(metadata); doc != nil {
+		service.MarkdownDocumentationAttachment = doc
+	}
+	n.addMetadataAnnotationAttachments(service, metadata)
+	return service
 }
 
 func (n *NodeBuilder) TransformAssignmentStatement(assignmentStatementNode *tree.AssignmentStatementNode) BLangNode {
@@ -1469,9 +1622,6 @@ func (n *NodeBuilder) createBLangVarDef(location Location, typedBindingPattern *
 		}
 		variable.SetInitialExpression(expr)
 
-		// Line 3037: Set variable
-		bLVarDef.SetVariable(variable)
-
 		// Line 3038-3040: Handle final flag
 		if finalKeyword != nil {
 			variable.GetFlags().Add(model.Flag_FINAL)
@@ -1481,10 +1631,13 @@ func (n *NodeBuilder) createBLangVarDef(location Location, typedBindingPattern *
 		typeDesc := typedBindingPattern.TypeDescriptor()
 		isDeclaredWithVar := isDeclaredWithVar(typeDesc)
 		variable.SetIsDeclaredWithVar(isDeclaredWithVar)
-		if isDeclaredWithVar {
+		if !isDeclaredWithVar {
 			variable.SetTypeNode(n.createTypeNode(typeDesc))
 		}
 
+		// Line 3037: Set variable. SetVariable copies the variable, so this is done once it is fully populated
+		bLVarDef.SetVariable(variable)
+
 		// Line 3048: Return variable definition
 		return bLVarDef
 
@@ -1672,19 +1825,56 @@ func (n *NodeBuilder) TransformReturnStatement(returnStatementNode *tree.ReturnS
 }
 
 func (n *NodeBuilder) TransformLocalTypeDefinitionStatement(localTypeDefinitionStatementNode *tree.LocalTypeDefinitionStatementNode) BLangNode {
-	panic("TransformLocalTypeDefinitionStatement unimplemented")
+	annotations := localTypeDefinitionStatementNode.Annotations()
+	if annotations.Size() > 0 {
+		panic("annotations not yet supported")
+	}
+	typeDef := NewBLangTypeDefinition()
+	typeName := localTypeDefinitionStatementNode.TypeName().(tree.Token)
+	identifierNode := createIdentifierFromToken(getPosition(typeName), typeName)
+	typeDef.SetName(&identifierNode)
+
+	n.anonTypeNameSuffixes = append(n.anonTypeNameSuffixes, identifierNode.Value)
+	typeDef.SetTypeNode(n.createTypeNode(localTypeDefinitionStatementNode.TypeDescriptor()))
+	n.anonTypeNameSuffixes = n.anonTypeNameSuffixes[:len(n.anonTypeNameSuffixes)-1]
+
+	typeDef.SetPosition(getPosition(localTypeDefinitionStatementNode))
+	return typeDef
 }
 
 func (n *NodeBuilder) TransformLockStatement(lockStatementNode *tree.LockStatementNode) BLangNode {
-	panic("TransformLockStatement unimplemented")
+	lockNode := &BLangLock{}
+	lockNode.pos = getPosition(lockStatementNode)
+	lockBlock := n.TransformBlockStatement(lockStatementNode.BlockStatement()).(*BLangBlockStmt)
+	lockBlock.pos = getPosition(lockStatementNode.BlockStatement())
+	lockNode.Body = *lockBlock
+	if lockStatementNode.OnFailClause() != nil {
+		panic("on fail clause in lock statement not yet supported")
+	}
+	return lockNode
 }
 
 func (n *NodeBuilder) TransformForkStatement(forkStatementNode *tree.ForkStatementNode) BLangNode {
-	panic("TransformForkStatement unimplemented")
+	forkJoin := &BLangForkJoin{}
+	forkJoin.pos = getPosition(forkStatementNode)
+	return forkJoin
 }
 
 func (n *NodeBuilder) TransformForEachStatement(forEachStatementNode *tree.ForEachStatementNode) BLangNode {
-	panic("TransformForEachStatement unimplemented")
+	if forEachStatementNode.OnFailClause() != nil {
+		panic("on fail clauses in foreach statements not yet supported")
+	}
+	foreach := &BLangForeach{}
+	foreach.pos = getPosition(forEachStatementNode)
+	typedBindingPattern := forEachStatementNode.TypedBindingPattern()
+	foreach.VariableDefinitionNode = n.createBLangVarDef(getPosition(typedBindingPattern), typedBindingPattern, nil,
+		nil).(*BLangSimpleVariableDef)
+	foreach.IsDeclaredWithVar = isDeclaredWithVar(typedBindingPattern.TypeDescriptor())
+	foreach.Collection = n.createExpression(forEachStatementNode.ActionOrExpressionNode())
+	body := n.TransformBlockStatement(forEachStatementNode.BlockStatement()).(*BLangBlockStmt)
+	body.pos = getPosition(forEachStatementNode.BlockStatement())
+	foreach.Body = *body
+	return foreach
 }
 
 func (n *NodeBuilder) TransformBinaryExpression(binaryExpressionNode *tree.BinaryExpressionNode) BLangNode {
@@ -1714,7 +1904,15 @@ func (n *NodeBuilder) TransformCheckExpression(checkExpressionNode *tree.CheckEx
 }
 
 func (n *NodeBuilder) TransformFieldAccessExpression(fieldAccessExpressionNode *tree.FieldAccessExpressionNode) BLangNode {
-	panic("TransformFieldAccessExpression unimplemented")
+	fieldName, ok := fieldAccessExpressionNode.FieldName().(*tree.SimpleNameReferenceNode)
+	if !ok {
+		panic("qualified field names in field access expressions not yet supported")
+	}
+	fieldAccess := &BLangFieldBaseAccess{}
+	fieldAccess.pos = getPosition(fieldAccessExpressionNode)
+	fieldAccess.Field = createIdentifierFromToken(getPosition(fieldName), fieldName.Name())
+	fieldAccess.Expr = n.createExpression(fieldAccessExpressionNode.Expression())
+	return fieldAccess
 }
 
 func (n *NodeBuilder) TransformFunctionCallExpression(functionCallExpressionNode *tree.FunctionCallExpressionNode) BLangNode {
@@ -1738,23 +1936,43 @@ func (n *NodeBuilder) TransformMethodCallExpression(methodCallExpressionNode *tr
 }
 
 func (n *NodeBuilder) TransformMappingConstructorExpression(mappingConstructorExpressionNode *tree.MappingConstructorExpressionNode) BLangNode {
-	panic("TransformMappingConstructorExpression unimplemented")
+	recordLiteral := &BLangRecordLiteral{}
+	recordLiteral.pos = getPosition(mappingConstructorExpressionNode)
+	fields := mappingConstructorExpressionNode.Fields()
+	for field := range fields.Iterator() {
+		switch field := field.(type) {
+		case *tree.SpecificFieldNode:
+			recordLiteral.Fields = append(recordLiteral.Fields, *n.TransformSpecificField(field).(*BLangRecordKeyValueField))
+		case *tree.ComputedNameFieldNode:
+			panic("computed name fields in mapping constructors not yet supported")
+		case *tree.SpreadFieldNode:
+			panic("spread fields in mapping constructors not yet supported")
+		default:
+			panic("unexpected mapping constructor field")
+		}
+	}
+	return recordLiteral
 }
 
 func (n *NodeBuilder) TransformIndexedExpression(indexedExpressionNode *tree.IndexedExpressionNode) BLangNode {
 	indexBasedAccess := &BLangIndexBasedAccess{}
 	indexBasedAccess.pos = getPosition(indexedExpressionNode)
-	keys := indexedExpressionNode.KeyExpression()
-	if keys.Size() == 0 {
+	// the key list is separated by commas, which the iterator skips
+	var keys []tree.ExpressionNode
+	keyList := indexedExpressionNode.KeyExpression()
+	for key := range keyList.Iterator() {
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
 		panic("missing key expression in member access expression")
-	} else if keys.Size() == 1 {
-		indexBasedAccess.IndexExpr = n.createExpression(keys.Get(0))
+	} else if len(keys) == 1 {
+		indexBasedAccess.IndexExpr = n.createExpression(keys[0])
 	} else {
 		listConstructorExpr := &BLangListConstructorExpr{}
-		listConstructorExpr.pos = getPositionRange(keys.Get(0), keys.Get(keys.Size()-1))
-		exprs := make([]BLangExpression, 0, keys.Size())
-		for i := 0; i < keys.Size(); i++ {
-			exprs = append(exprs, n.createExpression(keys.Get(i)))
+		listConstructorExpr.pos = getPositionRange(keys[0], keys[len(keys)-1])
+		exprs := make([]BLangExpression, 0, len(keys))
+		for _, key := range keys {
+			exprs = append(exprs, n.createExpression(key))
 		}
 		listConstructorExpr.Exprs = exprs
 		indexBasedAccess.IndexExpr = listConstructorExpr
@@ -1780,12 +1998,6 @@ func (n *NodeBuilder) TransformComputedNameField(computedNameFieldNode *tree.Com
 }
 
 func (n *NodeBuilder) TransformConstantDeclaration(constantDeclarationNode *tree.ConstantDeclarationNode) BLangNode {
-	// Check for metadata and panic if present (per user requirement)
-	metadata := constantDeclarationNode.Metadata()
-	if metadata != nil && !metadata.IsMissing() {
-		panic("TransformConstantDeclaration: metadata not yet supported")
-	}
-
 	// Line 940: BLangConstant constantNode = (BLangConstant) TreeBuilder.createConstantNode();
 	constantNode := createConstantNode()
 
@@ -1812,7 +2024,11 @@ func (n *NodeBuilder) TransformConstantDeclaration(constantDeclarationNode *tree
 		constantNode.TypeNode = n.createTypeNode(typeDescriptor)
 	}
 
-	// Lines 950-952: Skip annotations and documentation (metadata check will panic if present)
+	// Lines 950-952: Annotations are not yet supported
+	rejectMetadataAnnotations(constantDeclarationNode.Metadata())
+	if doc := n.createMarkdownDocumentationAttachment(constantDeclarationNode.Metadata()); doc != nil {
+		constantNode.MarkdownDocumentationAttachment = doc
+	}
 
 	// Line 954: constantNode.flagSet.add(Flag.CONSTANT);
 	constantNode.FlagSet.Add(model.Flag_CONSTANT)
@@ -1989,7 +2205,24 @@ func (n *NodeBuilder) TransformImportPrefix(importPrefixNode *tree.ImportPrefixN
 }
 
 func (n *NodeBuilder) TransformSpecificField(specificFieldNode *tree.SpecificFieldNode) BLangNode {
-	panic("TransformSpecificField unimplemented")
+	keyValueField := &BLangRecordKeyValueField{}
+	keyValueField.pos = getPosition(specificFieldNode)
+	keyValueField.Readonly = specificFieldNode.ReadonlyKeyword() != nil
+
+	fieldName := specificFieldNode.FieldName()
+	key := &BLangRecordKey{}
+	key.pos = getPosition(fieldName)
+	key.Expr = n.createExpression(fieldName)
+	keyValueField.Key = key
+
+	valueExpr := specificFieldNode.ValueExpr()
+	if valueExpr == nil {
+		// A field without a value, such as `{x}`, takes the value of the variable of the same name
+		keyValueField.ValueExpr = n.createExpression(fieldName)
+	} else {
+		keyValueField.ValueExpr = n.createExpression(valueExpr)
+	}
+	return keyValueField
 }
 
 func (n *NodeBuilder) TransformSpreadField(spreadFieldNode *tree.SpreadFieldNode) BLangNode {
@@ -2021,7 +2254,26 @@ func (n *NodeBuilder) TransformObjectConstructorExpression(objectConstructorExpr
 }
 
 func (n *NodeBuilder) TransformRecordTypeDescriptor(recordTypeDescriptorNode *tree.RecordTypeDescriptorNode) BLangNode {
-	panic("TransformRecordTypeDescriptor unimplemented")
+	recordTypeNode := &BLangRecordTypeNode{}
+	recordTypeNode.pos = getPosition(recordTypeDescriptorNode)
+	recordTypeNode.Sealed = recordTypeDescriptorNode.BodyStartDelimiter().Kind() == common.OPEN_BRACE_PIPE_TOKEN
+	fields := recordTypeDescriptorNode.Fields()
+	for field := range fields.Iterator() {
+		switch field := field.(type) {
+		case *tree.RecordFieldNode:
+			recordTypeNode.Fields = append(recordTypeNode.Fields, *n.TransformRecordField(field).(*BLangSimpleVariable))
+		case *tree.RecordFieldWithDefaultValueNode:
+			panic("record fields with default values not yet supported")
+		case *tree.TypeReferenceNode:
+			panic("record type inclusions not yet supported")
+		default:
+			panic("unexpected record field")
+		}
+	}
+	if restDescriptor := recordTypeDescriptorNode.RecordRestDescriptor(); restDescriptor != nil {
+		recordTypeNode.RestFieldType = n.createTypeNode(restDescriptor.TypeName())
+	}
+	return recordTypeNode
 }
 
 func (n *NodeBuilder) TransformReturnTypeDescriptor(returnTypeDescriptorNode *tree.ReturnTypeDescriptorNode) BLangNode {
@@ -2029,11 +2281,21 @@ func (n *NodeBuilder) TransformReturnTypeDescriptor(returnTypeDescriptorNode *tr
 }
 
 func (n *NodeBuilder) TransformNilTypeDescriptor(nilTypeDescriptorNode *tree.NilTypeDescriptorNode) BLangNode {
-	panic("TransformNilTypeDescriptor unimplemented")
+	return n.createBuiltInTypeNode(nilTypeDescriptorNode).(BLangNode)
 }
 
 func (n *NodeBuilder) TransformOptionalTypeDescriptor(optionalTypeDescriptorNode *tree.OptionalTypeDescriptorNode) BLangNode {
-	panic("TransformOptionalTypeDescriptor unimplemented")
+	pos := getPosition(optionalTypeDescriptorNode)
+	unionTypeNode := &BLangUnionTypeNode{}
+	unionTypeNode.pos = pos
+	unionTypeNode.Nullable = true
+	unionTypeNode.AddMemberTypeNode(n.createTypeNode(optionalTypeDescriptorNode.TypeDescriptor()))
+
+	nilTypeNode := &BLangValueType{}
+	nilTypeNode.pos = getPosition(optionalTypeDescriptorNode.QuestionMarkToken())
+	nilTypeNode.TypeKind = model.TypeKind_NIL
+	unionTypeNode.AddMemberTypeNode(nilTypeNode)
+	return unionTypeNode
 }
 
 func (n *NodeBuilder) TransformObjectField(objectFieldNode *tree.ObjectFieldNode) BLangNode {
@@ -2041,7 +2303,28 @@ func (n *NodeBuilder) TransformObjectField(objectFieldNode *tree.ObjectFieldNode
 }
 
 func (n *NodeBuilder) TransformRecordField(recordFieldNode *tree.RecordFieldNode) BLangNode {
-	panic("TransformRecordField unimplemented")
+	var annotations tree.NodeList[*tree.AnnotationNode]
+	metadata := recordFieldNode.Metadata()
+	if metadata != nil && !metadata.IsMissing() {
+		annotations = metadata.Annotations()
+	}
+	n.anonTypeNameSuffixes = append(n.anonTypeNameSuffixes, recordFieldNode.FieldName().Text())
+	field := n.createSimpleVarInner(recordFieldNode.FieldName(), recordFieldNode.TypeName(), nil, nil, annotations)
+	n.anonTypeNameSuffixes = n.anonTypeNameSuffixes[:len(n.anonTypeNameSuffixes)-1]
+	field.pos = getPositionWithoutMetadata(recordFieldNode)
+	if doc := n.createMarkdownDocumentationAttachment(metadata); doc != nil {
+		field.MarkdownDocumentationAttachment = doc
+	}
+	if recordFieldNode.QuestionMarkToken() != nil {
+		field.AddFlag(model.Flag_OPTIONAL)
+	} else {
+		field.AddFlag(model.Flag_REQUIRED)
+	}
+	if recordFieldNode.ReadonlyKeyword() != nil {
+		field.AddFlag(model.Flag_READONLY)
+	}
+	field.AddFlag(model.Flag_FIELD)
+	return field
 }
 
 func (n *NodeBuilder) TransformRecordFieldWithDefaultValue(recordFieldWithDefaultValueNode *tree.RecordFieldWithDefaultValueNode) BLangNode {
@@ -2057,15 +2340,97 @@ func (n *NodeBuilder) TransformTypeReference(typeReferenceNode *tree.TypeReferen
 }
 
 func (n *NodeBuilder) TransformAnnotation(annotationNode *tree.AnnotationNode) BLangNode {
-	panic("TransformAnnotation unimplemented")
+	annotAttachment := &BLangAnnotationAttachment{}
+	annotAttachment.pos = getPosition(annotationNode)
+	nameReference := n.createBLangNameReference(annotationNode.AnnotReference())
+	annotAttachment.PkgAlias = &nameReference[0]
+	annotAttachment.AnnotationName = &nameReference[1]
+	if annotValue := annotationNode.AnnotValue(); annotValue != nil {
+		annotAttachment.Expr = n.createExpression(annotValue)
+	}
+	return annotAttachment
+}
+
+// addAnnotationAttachments attaches each of the annotations to node.
+func (n *NodeBuilder) addAnnotationAttachments(node model.AnnotatableNode, annotations tree.NodeList[*tree.AnnotationNode]) {
+	for annotation := range annotations.Iterator() {
+		node.AddAnnotationAttachment(n.TransformAnnotation(annotation).(*BLangAnnotationAttachment))
+	}
+}
+
+// addMetadataAnnotationAttachments attaches the annotations in the metadata, if there is any, to node.
+func (n *NodeBuilder) addMetadataAnnotationAttachments(node model.AnnotatableNode, metadata *tree.MetadataNode) {
+	if metadata == nil || metadata.IsMissing() {
+		return
+	}
+	n.addAnnotationAttachments(node, metadata.Annotations())
+}
+
+// rejectMetadataAnnotations panics if the metadata of a construct that cannot be annotated yet has annotations.
+func rejectMetadataAnnotations(metadata *tree.MetadataNode) {
+	if metadata == nil || metadata.IsMissing() {
+		return
+	}
+	annotations := metadata.Annotations()
+	if annotations.Size() > 0 {
+		panic("annotations not yet supported")
+	}
 }
 
 func (n *NodeBuilder) TransformMetadata(metadataNode *tree.MetadataNode) BLangNode {
 	panic("TransformMetadata unimplemented")
 }
 
+// createMarkdownDocumentationAttachment returns the documentation in the metadata, or nil if there is none.
+func (n *NodeBuilder) createMarkdownDocumentationAttachment(metadata *tree.MetadataNode) *BLangMarkdownDocumentation {
+	if metadata == nil || metadata.IsMissing() {
+		return nil
+	}
+	documentationString := metadata.DocumentationString()
+	if documentationString == nil {
+		return nil
+	}
+	return n.TransformSyntaxNode(documentationString).(*BLangMarkdownDocumentation)
+}
+
 func (n *NodeBuilder) TransformModuleVariableDeclaration(moduleVariableDeclarationNode *tree.ModuleVariableDeclarationNode) BLangNode {
-	panic("TransformModuleVariableDeclaration unimplemented")
+	typedBindingPattern := moduleVariableDeclarationNode.TypedBindingPattern()
+	bindingPattern := typedBindingPattern.BindingPattern()
+	if bindingPattern.Kind() != common.CAPTURE_BINDING_PATTERN {
+		panic("module level variables with binding patterns not yet supported")
+	}
+	variable := n.getBLangVariableNode(bindingPattern, getPositionWithoutMetadata(moduleVariableDeclarationNode)).(*BLangSimpleVariable)
+	if visibilityQualifier := moduleVariableDeclarationNode.VisibilityQualifier(); visibilityQualifier != nil && visibilityQualifier.Kind() == common.PUBLIC_KEYWORD {
+		variable.AddFlag(model.Flag_PUBLIC)
+	}
+	qualifiers := moduleVariableDeclarationNode.Qualifiers()
+	for i := 0; i < qualifiers.Size(); i++ {
+		switch qualifiers.Get(i).Kind() {
+		case common.FINAL_KEYWORD:
+			variable.AddFlag(model.Flag_FINAL)
+		case common.ISOLATED_KEYWORD:
+			variable.AddFlag(model.Flag_ISOLATED)
+		case common.CONFIGURABLE_KEYWORD:
+			panic("configurable variables not yet supported")
+		default:
+			panic("unexpected module variable qualifier")
+		}
+	}
+	typeDesc := typedBindingPattern.TypeDescriptor()
+	variable.SetIsDeclaredWithVar(isDeclaredWithVar(typeDesc))
+	if !variable.IsDeclaredWithVar {
+		variable.SetTypeNode(n.createTypeNode(typeDesc))
+	}
+	initializer := moduleVariableDeclarationNode.Initializer()
+	if initializer == nil {
+		panic("module level variables without an initializer not yet supported")
+	}
+	variable.SetInitialExpression(n.createExpression(initializer))
+	rejectMetadataAnnotations(moduleVariableDeclarationNode.Metadata())
+	if doc := n.createMarkdownDocumentationAttachment(moduleVariableDeclarationNode.Metadata()); doc != nil {
+		variable.MarkdownDocumentationAttachment = doc
+	}
+	return variable
 }
 
 func (n *NodeBuilder) TransformTypeTestExpression(typeTestExpressionNode *tree.TypeTestExpressionNode) BLangNode {
@@ -2077,7 +2442,15 @@ func (n *NodeBuilder) TransformRemoteMethodCallAction(remoteMethodCallActionNode
 }
 
 func (n *NodeBuilder) TransformMapTypeDescriptor(mapTypeDescriptorNode *tree.MapTypeDescriptorNode) BLangNode {
-	panic("TransformMapTypeDescriptor unimplemented")
+	refType := &BLangBuiltInRefTypeNode{}
+	refType.TypeKind = model.TypeKind_MAP
+	refType.pos = getPosition(mapTypeDescriptorNode)
+
+	constrainedType := &BLangConstrainedType{}
+	constrainedType.Type = refType
+	constrainedType.Constraint = n.createTypeNode(mapTypeDescriptorNode.MapTypeParamsNode().TypeNode())
+	constrainedType.pos = refType.pos
+	return constrainedType
 }
 
 func (n *NodeBuilder) TransformNilLiteral(nilLiteralNode *tree.NilLiteralNode) BLangNode {
@@ -2085,7 +2458,46 @@ func (n *NodeBuilder) TransformNilLiteral(nilLiteralNode *tree.NilLiteralNode) B
 }
 
 func (n *NodeBuilder) TransformAnnotationDeclaration(annotationDeclarationNode *tree.AnnotationDeclarationNode) BLangNode {
-	panic("TransformAnnotationDeclaration unimplemented")
+	annotationDecl := &BLangAnnotation{}
+	annotationDecl.pos = getPositionWithoutMetadata(annotationDeclarationNode)
+	annotationTag := annotationDeclarationNode.AnnotationTag()
+	name := createIdentifierFromToken(getPosition(annotationTag), annotationTag)
+	annotationDecl.SetName(&name)
+
+	if visibilityQualifier := annotationDeclarationNode.VisibilityQualifier(); visibilityQualifier != nil && visibilityQualifier.Kind() == common.PUBLIC_KEYWORD {
+		annotationDecl.AddFlag(model.Flag_PUBLIC)
+	}
+	if annotationDeclarationNode.ConstKeyword() != nil {
+		annotationDecl.AddFlag(model.Flag_CONSTANT)
+	}
+	if typeDesc := annotationDeclarationNode.TypeDescriptor(); typeDesc != nil {
+		annotationDecl.SetTypeNode(n.createTypeNode(typeDesc))
+	}
+
+	attachPoints := annotationDeclarationNode.AttachPoints()
+	for attachPoint := range attachPoints.Iterator() {
+		if attachPoint, ok := attachPoint.(*tree.AnnotationAttachPointNode); ok {
+			annotationDecl.AddAttachPoint(createAttachPoint(attachPoint))
+		}
+	}
+
+	metadata := annotationDeclarationNode.Metadata()
+	rejectMetadataAnnotations(metadata)
+	if doc := n.createMarkdownDocumentationAttachment(metadata); doc != nil {
+		annotationDecl.SetMarkdownDocumentationAttachment(doc)
+	}
+	return annotationDecl
+}
+
+// createAttachPoint returns the attach point named by the identifiers of the node, such as `object function`,
+// which are joined without spaces to match the points of model.AttachPoint.
+func createAttachPoint(attachPointNode *tree.AnnotationAttachPointNode) model.AttachPoint {
+	var point strings.Builder
+	identifiers := attachPointNode.Identifiers()
+	for identifier := range identifiers.Iterator() {
+		point.WriteString(identifier.Text())
+	}
+	return model.AttachPoint{Point: model.Point(point.String()), Source: attachPointNode.SourceKeyword() != nil}
 }
 
 func (n *NodeBuilder) TransformAnnotationAttachPoint(annotationAttachPointNode *tree.AnnotationAttachPointNode) BLangNode {
@@ -2093,11 +2505,32 @@ func (n *NodeBuilder) TransformAnnotationAttachPoint(annotationAttachPointNode *
 }
 
 func (n *NodeBuilder) TransformXMLNamespaceDeclaration(xMLNamespaceDeclarationNode *tree.XMLNamespaceDeclarationNode) BLangNode {
-	panic("TransformXMLNamespaceDeclaration unimplemented")
+	xmlns := n.createXMLNS(xMLNamespaceDeclarationNode.Namespaceuri(), xMLNamespaceDeclarationNode.NamespacePrefix(),
+		getPosition(xMLNamespaceDeclarationNode))
+	xmlnsStmt := &BLangXMLNSStatement{}
+	xmlnsStmt.XMLNSDecl = &BLangLocalXMLNS{BLangXMLNS: *xmlns}
+	xmlnsStmt.pos = getPosition(xMLNamespaceDeclarationNode)
+	return xmlnsStmt
 }
 
 func (n *NodeBuilder) TransformModuleXMLNamespaceDeclaration(moduleXMLNamespaceDeclarationNode *tree.ModuleXMLNamespaceDeclarationNode) BLangNode {
-	panic("TransformModuleXMLNamespaceDeclaration unimplemented")
+	return n.createXMLNS(moduleXMLNamespaceDeclarationNode.Namespaceuri(), moduleXMLNamespaceDeclarationNode.NamespacePrefix(),
+		getPosition(moduleXMLNamespaceDeclarationNode))
+}
+
+// createXMLNS creates a namespace declaration. A declaration without a prefix declares the default namespace.
+func (n *NodeBuilder) createXMLNS(namespaceURI tree.ExpressionNode, prefix *tree.IdentifierToken, pos Location) *BLangXMLNS {
+	xmlns := &BLangXMLNS{}
+	xmlns.SetNamespaceURI(n.createExpression(namespaceURI))
+	if prefix != nil {
+		prefixIdentifier := createIdentifierFromTokenInternal(getPosition(prefix), prefix, true)
+		xmlns.SetPrefix(&prefixIdentifier)
+	} else {
+		prefixIdentifier := createDefaultXMLPrefix(pos)
+		xmlns.SetPrefix(&prefixIdentifier)
+	}
+	xmlns.pos = pos
+	return xmlns
 }
 
 func (n *NodeBuilder) TransformFunctionBodyBlock(functionBodyBlockNode *tree.FunctionBodyBlockNode) BLangNode {
@@ -2107,7 +2540,11 @@ func (n *NodeBuilder) TransformFunctionBodyBlock(functionBodyBlockNode *tree.Fun
 	stmtList := statements
 	namedWorkerDeclarator := functionBodyBlockNode.NamedWorkerDeclarator()
 	if namedWorkerDeclarator != nil {
-		panic("unimplemented")
+		n.generateAndAddBLangStatements(namedWorkerDeclarator.WorkerInitStatements(), &stmtList, 0, functionBodyBlockNode)
+		workerDeclarations := namedWorkerDeclarator.NamedWorkerDeclarations()
+		for workerDeclarationNode := range workerDeclarations.Iterator() {
+			stmtList = append(stmtList, n.TransformSyntaxNode(workerDeclarationNode).(BLangStatement))
+		}
 	}
 
 	n.generateAndAddBLangStatements(functionBodyBlockNode.Statements(), &stmtList, 0, functionBodyBlockNode)
@@ -2119,11 +2556,75 @@ func (n *NodeBuilder) TransformFunctionBodyBlock(functionBodyBlockNode *tree.Fun
 }
 
 func (n *NodeBuilder) generateForkStatements(statements *[]BLangStatement, forkStatementNode *tree.ForkStatementNode) {
-	panic("generateForkStatements unimplemented")
+	forkJoin := n.TransformSyntaxNode(forkStatementNode).(*BLangForkJoin)
+	workerDeclarations := forkStatementNode.NamedWorkerDeclarations()
+	for workerDeclarationNode := range workerDeclarations.Iterator() {
+		workerDef := n.TransformSyntaxNode(workerDeclarationNode).(*BLangSimpleVariableDef)
+		workerDef.IsInFork = true
+		workerDef.Var.AddFlag(model.Flag_FORKED)
+		workerDef.Var.Expr.(*BLangLambdaFunction).Function.AddFlag(model.Flag_FORKED)
+		*statements = append(*statements, workerDef)
+		forkJoin.Workers = append(forkJoin.Workers, workerDef)
+	}
+	*statements = append(*statements, forkJoin)
 }
 
+// TransformNamedWorkerDeclaration creates a worker variable initialized with a lambda holding the worker body.
+// Unlike jBallerina there is no separate `start` of the lambda; the worker starts at its definition.
 func (n *NodeBuilder) TransformNamedWorkerDeclaration(namedWorkerDeclarationNode *tree.NamedWorkerDeclarationNode) BLangNode {
-	panic("TransformNamedWorkerDeclaration unimplemented")
+	annotations := namedWorkerDeclarationNode.Annotations()
+	if annotations.Size() > 0 {
+		panic("annotations not yet supported")
+	}
+	if namedWorkerDeclarationNode.TransactionalKeyword() != nil {
+		panic("transactional workers not yet supported")
+	}
+	if namedWorkerDeclarationNode.OnFailClause() != nil {
+		panic("on fail clause in workers not yet supported")
+	}
+	workerName := namedWorkerDeclarationNode.WorkerName()
+	workerPos := getPosition(namedWorkerDeclarationNode)
+
+	bLFunction := &BLangFunction{}
+	name := createIdentifierFromToken(getPosition(workerName), workerName)
+	bLFunction.Name = &name
+	bLFunction.AddFlag(model.Flag_LAMBDA)
+	bLFunction.AddFlag(model.Flag_ANONYMOUS)
+	bLFunction.AddFlag(model.Flag_WORKER)
+	bLFunction.pos = workerPos
+	if retTypeDesc, ok := namedWorkerDeclarationNode.ReturnTypeDesc().(*tree.ReturnTypeDescriptorNode); ok {
+		retAnnotations := retTypeDesc.Annotations()
+		if retAnnotations.Size() > 0 {
+			panic("annotations not yet supported")
+		}
+		bLFunction.SetReturnTypeNode(n.createTypeNode(retTypeDesc.Type()))
+	} else {
+		bLValueType := BLangValueType{}
+		bLValueType.TypeKind = model.TypeKind_NIL
+		bLFunction.SetReturnTypeNode(&bLValueType)
+	}
+
+	// TransformBlockStatement leaves the local context, but the worker is still within the enclosing function
+	workerBody := n.TransformSyntaxNode(namedWorkerDeclarationNode.WorkerBody()).(*BLangBlockStmt)
+	n.isInLocalContext = true
+	body := &BLangBlockFunctionBody{}
+	body.Stmts = workerBody.Stmts
+	body.pos = workerBody.pos
+	bLFunction.Body = body
+
+	lambdaExpr := &BLangLambdaFunction{}
+	lambdaExpr.Function = bLFunction
+	lambdaExpr.pos = workerPos
+
+	variable := createSimpleVariableNodeWithLocationTokenLocation(workerPos, workerName, getPosition(workerName))
+	variable.AddFlag(model.Flag_WORKER)
+	variable.SetInitialExpression(lambdaExpr)
+
+	workerDef := &BLangSimpleVariableDef{}
+	workerDef.pos = workerPos
+	workerDef.IsWorker = true
+	workerDef.SetVariable(variable)
+	return workerDef
 }
 
 func (n *NodeBuilder) TransformNamedWorkerDeclarator(namedWorkerDeclarator *tree.NamedWorkerDeclarator) BLangNode {
@@ -2157,6 +2658,9 @@ func (n *NodeBuilder) TransformListConstructorExpression(listConstructorExpressi
 	expressions := listConstructorExpressionNode.Expressions()
 	for i := 0; i < expressions.Size(); i++ {
 		listMember := expressions.Get(i)
+		if listMember.Kind() == common.COMMA_TOKEN {
+			continue
+		}
 		var memberExpr BLangExpression
 		if listMember.Kind() == common.SPREAD_MEMBER {
 			panic("spread member expression handling not yet implemented")
@@ -2179,20 +2683,86 @@ func (n *NodeBuilder) TransformTypeCastParam(typeCastParamNode *tree.TypeCastPar
 	panic("TransformTypeCastParam unimplemented")
 }
 
+// TransformUnionTypeDescriptor flattens the (left associative) union type descriptor into a single union type node.
+// All the singleton members are collected into a single finite type node, which is returned by itself if the union
+// has no other members.
 func (n *NodeBuilder) TransformUnionTypeDescriptor(unionTypeDescriptorNode *tree.UnionTypeDescriptorNode) BLangNode {
-	panic("TransformUnionTypeDescriptor unimplemented")
+	finiteTypeNode := &BLangFiniteTypeNode{}
+	unionTypeNode := &BLangUnionTypeNode{}
+	unionTypeNode.pos = getPosition(unionTypeDescriptorNode)
+	n.addUnionTypeMembers(unionTypeNode, finiteTypeNode, unionTypeDescriptorNode)
+
+	if len(finiteTypeNode.ValueSpace) == 0 {
+		return unionTypeNode
+	}
+	finiteTypeNode.pos = unionTypeNode.pos
+	if len(unionTypeNode.MemberTypeNodes) == 0 {
+		return finiteTypeNode
+	}
+	unionTypeNode.AddMemberTypeNode(finiteTypeNode)
+	return unionTypeNode
+}
+
+func (n *NodeBuilder) addUnionTypeMembers(unionTypeNode *BLangUnionTypeNode, finiteTypeNode *BLangFiniteTypeNode, typeDesc tree.Node) {
+	switch typeDesc := typeDesc.(type) {
+	case *tree.UnionTypeDescriptorNode:
+		n.addUnionTypeMembers(unionTypeNode, finiteTypeNode, typeDesc.LeftTypeDesc())
+		n.addUnionTypeMembers(unionTypeNode, finiteTypeNode, typeDesc.RightTypeDesc())
+		return
+	}
+	typeNode := n.createTypeNode(typeDesc)
+	if singleton, ok := typeNode.(*BLangFiniteTypeNode); ok && !singleton.Grouped {
+		finiteTypeNode.ValueSpace = append(finiteTypeNode.ValueSpace, singleton.ValueSpace...)
+		return
+	}
+	if union, ok := typeNode.(*BLangUnionTypeNode); ok && !union.Grouped && !union.Nullable {
+		unionTypeNode.MemberTypeNodes = append(unionTypeNode.MemberTypeNodes, union.MemberTypeNodes...)
+		return
+	}
+	unionTypeNode.AddMemberTypeNode(typeNode)
 }
 
 func (n *NodeBuilder) TransformTableConstructorExpression(tableConstructorExpressionNode *tree.TableConstructorExpressionNode) BLangNode {
-	panic("TransformTableConstructorExpression unimplemented")
+	tableConstructorExpr := &BLangTableConstructorExpr{}
+	tableConstructorExpr.pos = getPosition(tableConstructorExpressionNode)
+	if keySpecifier := tableConstructorExpressionNode.KeySpecifier(); keySpecifier != nil {
+		tableConstructorExpr.TableKeySpecifier = n.TransformKeySpecifier(keySpecifier).(*BLangTableKeySpecifier)
+	}
+	rows := tableConstructorExpressionNode.Rows()
+	for row := range rows.Iterator() {
+		if row.Kind() == common.COMMA_TOKEN {
+			continue
+		}
+		recordLiteral, ok := n.createExpression(row).(*BLangRecordLiteral)
+		if !ok {
+			panic("unexpected table constructor row")
+		}
+		tableConstructorExpr.RecordLiteralList = append(tableConstructorExpr.RecordLiteralList, recordLiteral)
+	}
+	return tableConstructorExpr
 }
 
 func (n *NodeBuilder) TransformKeySpecifier(keySpecifierNode *tree.KeySpecifierNode) BLangNode {
-	panic("TransformKeySpecifier unimplemented")
+	keySpecifier := &BLangTableKeySpecifier{}
+	keySpecifier.pos = getPosition(keySpecifierNode)
+	fieldNames := keySpecifierNode.FieldNames()
+	for fieldName := range fieldNames.Iterator() {
+		keySpecifier.FieldNameIdentifierList = append(keySpecifier.FieldNameIdentifierList,
+			createIdentifierFromToken(getPosition(fieldName), fieldName))
+	}
+	return keySpecifier
 }
 
 func (n *NodeBuilder) TransformStreamTypeDescriptor(streamTypeDescriptorNode *tree.StreamTypeDescriptorNode) BLangNode {
-	panic("TransformStreamTypeDescriptor unimplemented")
+	streamType := &BLangStreamType{}
+	streamType.pos = getPosition(streamTypeDescriptorNode)
+	if params, ok := streamTypeDescriptorNode.StreamTypeParamsNode().(*tree.StreamTypeParamsNode); ok {
+		streamType.Constraint = n.createTypeNode(params.LeftTypeDescNode())
+		if params.RightTypeDescNode() != nil {
+			streamType.Error = n.createTypeNode(params.RightTypeDescNode())
+		}
+	}
+	return streamType
 }
 
 func (n *NodeBuilder) TransformStreamTypeParams(streamTypeParamsNode *tree.StreamTypeParamsNode) BLangNode {
@@ -2204,67 +2774,336 @@ func (n *NodeBuilder) TransformLetExpression(letExpressionNode *tree.LetExpressi
 }
 
 func (n *NodeBuilder) TransformLetVariableDeclaration(letVariableDeclarationNode *tree.LetVariableDeclarationNode) BLangNode {
-	panic("TransformLetVariableDeclaration unimplemented")
+	annotations := letVariableDeclarationNode.Annotations()
+	if annotations.Size() > 0 {
+		panic("annotations not yet supported")
+	}
+	return n.createBLangVarDef(getPosition(letVariableDeclarationNode), letVariableDeclarationNode.TypedBindingPattern(),
+		letVariableDeclarationNode.Expression(), nil).(BLangNode)
 }
 
 func (n *NodeBuilder) TransformTemplateExpression(templateExpressionNode *tree.TemplateExpressionNode) BLangNode {
-	panic("TransformTemplateExpression unimplemented")
+	switch templateExpressionNode.Kind() {
+	case common.STRING_TEMPLATE_EXPRESSION:
+		return n.createStringTemplateLiteral(templateExpressionNode.Content(), getPosition(templateExpressionNode))
+	case common.RAW_TEMPLATE_EXPRESSION:
+		return n.createRawTemplateLiteral(templateExpressionNode.Content(), getPosition(templateExpressionNode))
+	case common.XML_TEMPLATE_EXPRESSION:
+		return n.createXMLTemplateLiteral(templateExpressionNode.Content(), getPosition(templateExpressionNode))
+	case common.REGEX_TEMPLATE_EXPRESSION:
+		return n.createRegExpTemplateLiteral(templateExpressionNode.Content(), getPosition(templateExpressionNode))
+	default:
+		panic("TransformTemplateExpression: unsupported template kind")
+	}
+}
+
+// createStringTemplateLiteral creates a string template literal from the template members
+func (n *NodeBuilder) createStringTemplateLiteral(memberNodes tree.NodeList[tree.Node], location Location) BLangNode {
+	stringTemplateLiteral := &BLangStringTemplateLiteral{}
+	for memberNode := range memberNodes.Iterator() {
+		stringTemplateLiteral.Exprs = append(stringTemplateLiteral.Exprs, n.TransformSyntaxNode(memberNode).(BLangExpression))
+	}
+	if len(stringTemplateLiteral.Exprs) == 0 {
+		stringTemplateLiteral.Exprs = append(stringTemplateLiteral.Exprs, n.createStringLiteral("", location))
+	}
+	stringTemplateLiteral.pos = location
+	return stringTemplateLiteral
+}
+
+// createRawTemplateLiteral splits the template members into the strings and insertions of a raw template. There is
+// always one more string than insertions, so empty strings are added around adjacent or trailing insertions.
+func (n *NodeBuilder) createRawTemplateLiteral(members tree.NodeList[tree.Node], location Location) BLangNode {
+	literal := &BLangRawTemplateLiteral{}
+	literal.pos = location
+
+	prevNodeWasInterpolation := false
+	var lastMember tree.Node
+	first := true
+	for member := range members.Iterator() {
+		if first && member.Kind() == common.INTERPOLATION {
+			literal.Strings = append(literal.Strings, n.createStringLiteral("", getPosition(member)))
+		}
+		first = false
+		lastMember = member
+		if member.Kind() == common.INTERPOLATION {
+			literal.Insertions = append(literal.Insertions, n.TransformSyntaxNode(member).(BLangExpression))
+			if prevNodeWasInterpolation {
+				literal.Strings = append(literal.Strings, n.createStringLiteral("", getPosition(member)))
+			}
+			prevNodeWasInterpolation = true
+		} else {
+			literal.Strings = append(literal.Strings, n.TransformSyntaxNode(member).(*BLangLiteral))
+			prevNodeWasInterpolation = false
+		}
+	}
+
+	if prevNodeWasInterpolation {
+		literal.Strings = append(literal.Strings, n.createStringLiteral("", getPosition(lastMember)))
+	} else if len(literal.Strings) == 0 {
+		literal.Strings = append(literal.Strings, n.createStringLiteral("", location))
+	}
+	return literal
+}
+
+// createStringLiteral creates a string typed literal with the given value
+func (n *NodeBuilder) createStringLiteral(value string, pos Location) *BLangLiteral {
+	strLiteral := &BLangLiteral{}
+	strLiteral.Value = value
+	strLiteral.OriginalValue = value
+	strLiteral.pos = pos
+	strLiteral.SetBType(n.symbolTable.GetTypeFromTag(model.TypeTags_STRING))
+	return strLiteral
+}
+
+// createXMLTemplateLiteral creates the literal for the items of an xml template. A template with a single item
+// evaluates to that item, otherwise the items form a sequence.
+func (n *NodeBuilder) createXMLTemplateLiteral(items tree.NodeList[tree.Node], location Location) BLangNode {
+	var xmlItems []BLangExpression
+	var textNodes []tree.Node
+	for item := range items.Iterator() {
+		switch item.Kind() {
+		case common.XML_TEXT, common.INTERPOLATION:
+			textNodes = append(textNodes, item)
+			continue
+		}
+		if len(textNodes) > 0 {
+			xmlItems = append(xmlItems, n.createXMLTextLiteral(textNodes))
+			textNodes = nil
+		}
+		xmlItems = append(xmlItems, n.createExpression(item))
+	}
+	if len(textNodes) > 0 {
+		xmlItems = append(xmlItems, n.createXMLTextLiteral(textNodes))
+	}
+
+	switch len(xmlItems) {
+	case 0:
+		textLiteral := &BLangXMLTextLiteral{}
+		textLiteral.TextFragments = []BLangExpression{n.createStringLiteral("", location)}
+		textLiteral.pos = location
+		return textLiteral
+	case 1:
+		return xmlItems[0]
+	default:
+		sequenceLiteral := &BLangXMLSequenceLiteral{}
+		sequenceLiteral.XMLItems = xmlItems
+		sequenceLiteral.pos = location
+		return sequenceLiteral
+	}
+}
+
+// createXMLTextLiteral creates a single text literal from adjacent text and interpolation items
+func (n *NodeBuilder) createXMLTextLiteral(textNodes []tree.Node) *BLangXMLTextLiteral {
+	textLiteral := &BLangXMLTextLiteral{}
+	for _, textNode := range textNodes {
+		textLiteral.TextFragments = append(textLiteral.TextFragments, n.createExpression(textNode))
+	}
+	textLiteral.pos = getPosition(textNodes[0])
+	return textLiteral
+}
+
+// createXMLItems creates the children of an element, merging adjacent text and interpolation items
+func (n *NodeBuilder) createXMLItems(items tree.NodeList[tree.XMLItemNode]) []BLangExpression {
+	var children []BLangExpression
+	var textNodes []tree.Node
+	for item := range items.Iterator() {
+		switch item.Kind() {
+		case common.XML_TEXT, common.INTERPOLATION:
+			textNodes = append(textNodes, item)
+			continue
+		}
+		if len(textNodes) > 0 {
+			children = append(children, n.createXMLTextLiteral(textNodes))
+			textNodes = nil
+		}
+		children = append(children, n.createExpression(item))
+	}
+	if len(textNodes) > 0 {
+		children = append(children, n.createXMLTextLiteral(textNodes))
+	}
+	return children
+}
+
+// createXMLTextFragments creates the fragments of a quoted string, comment, or processing instruction
+func (n *NodeBuilder) createXMLTextFragments(content tree.NodeList[tree.Node], location Location) []BLangExpression {
+	var fragments []BLangExpression
+	for node := range content.Iterator() {
+		fragments = append(fragments, n.createExpression(node))
+	}
+	if len(fragments) == 0 {
+		fragments = append(fragments, n.createStringLiteral("", location))
+	}
+	return fragments
+}
+
+func (n *NodeBuilder) createRegExpTemplateLiteral(reSequences tree.NodeList[tree.Node], location Location) BLangNode {
+	regExpTemplateLiteral := &BLangRegExpTemplateLiteral{}
+	regExpTemplateLiteral.ReDisjunction = n.createReDisjunction(reSequences, location)
+	regExpTemplateLiteral.pos = location
+	return regExpTemplateLiteral
+}
+
+// createReDisjunction creates a disjunction from the sequences of a regexp, skipping the `|` tokens between them
+func (n *NodeBuilder) createReDisjunction(reSequences tree.NodeList[tree.Node], location Location) *BLangReDisjunction {
+	disjunction := &BLangReDisjunction{}
+	for node := range reSequences.Iterator() {
+		if node.Kind() == common.PIPE_TOKEN {
+			continue
+		}
+		disjunction.SequenceList = append(disjunction.SequenceList, n.TransformSyntaxNode(node).(*BLangReSequence))
+	}
+	disjunction.pos = location
+	return disjunction
 }
 
 func (n *NodeBuilder) TransformXMLElement(xMLElementNode *tree.XMLElementNode) BLangNode {
-	panic("TransformXMLElement unimplemented")
+	startTag := xMLElementNode.StartTag()
+	xmlElement := &BLangXMLElementLiteral{}
+	xmlElement.StartTagName = n.TransformSyntaxNode(startTag).(*BLangXMLQName)
+	xmlElement.EndTagName = n.TransformSyntaxNode(xMLElementNode.EndTag()).(*BLangXMLQName)
+	n.populateXMLAttributes(xmlElement, startTag.Attributes())
+	xmlElement.Children = n.createXMLItems(xMLElementNode.Content())
+	xmlElement.pos = getPosition(xMLElementNode)
+	return xmlElement
 }
 
 func (n *NodeBuilder) TransformXMLStartTag(xMLStartTagNode *tree.XMLStartTagNode) BLangNode {
-	panic("TransformXMLStartTag unimplemented")
+	return n.TransformSyntaxNode(xMLStartTagNode.Name())
 }
 
 func (n *NodeBuilder) TransformXMLEndTag(xMLEndTagNode *tree.XMLEndTagNode) BLangNode {
-	panic("TransformXMLEndTag unimplemented")
+	return n.TransformSyntaxNode(xMLEndTagNode.Name())
 }
 
 func (n *NodeBuilder) TransformXMLSimpleName(xMLSimpleNameNode *tree.XMLSimpleNameNode) BLangNode {
-	panic("TransformXMLSimpleName unimplemented")
+	return n.createXMLQName(nil, xMLSimpleNameNode.Name(), getPosition(xMLSimpleNameNode))
 }
 
 func (n *NodeBuilder) TransformXMLQualifiedName(xMLQualifiedNameNode *tree.XMLQualifiedNameNode) BLangNode {
-	panic("TransformXMLQualifiedName unimplemented")
+	return n.createXMLQName(xMLQualifiedNameNode.Prefix().Name(), xMLQualifiedNameNode.Name().Name(),
+		getPosition(xMLQualifiedNameNode))
+}
+
+func (n *NodeBuilder) createXMLQName(prefix tree.Token, localName tree.Token, pos Location) *BLangXMLQName {
+	qname := &BLangXMLQName{}
+	if prefix != nil {
+		qname.Prefix = createIdentifierFromTokenInternal(getPosition(prefix), prefix, true)
+	} else {
+		qname.Prefix = createDefaultXMLPrefix(pos)
+	}
+	qname.LocalName = createIdentifierFromTokenInternal(getPosition(localName), localName, true)
+	qname.pos = pos
+	return qname
+}
+
+func createDefaultXMLPrefix(pos Location) BLangIdentifier {
+	prefix := XMLNS_DEFAULT_PREFIX
+	return createIdentifier(pos, &prefix, &prefix)
 }
 
 func (n *NodeBuilder) TransformXMLEmptyElement(xMLEmptyElementNode *tree.XMLEmptyElementNode) BLangNode {
-	panic("TransformXMLEmptyElement unimplemented")
+	xmlElement := &BLangXMLElementLiteral{}
+	xmlElement.StartTagName = n.TransformSyntaxNode(xMLEmptyElementNode.Name()).(*BLangXMLQName)
+	n.populateXMLAttributes(xmlElement, xMLEmptyElementNode.Attributes())
+	xmlElement.pos = getPosition(xMLEmptyElementNode)
+	return xmlElement
+}
+
+// populateXMLAttributes adds the attributes of a start tag to the element. Namespace declarations are also
+// recorded as inline namespaces of the element.
+func (n *NodeBuilder) populateXMLAttributes(xmlElement *BLangXMLElementLiteral, attributes tree.NodeList[*tree.XMLAttributeNode]) {
+	for attributeNode := range attributes.Iterator() {
+		attribute := n.TransformSyntaxNode(attributeNode).(*BLangXMLAttribute)
+		xmlElement.Attributes = append(xmlElement.Attributes, attribute)
+		if !attribute.IsNamespaceDeclr {
+			continue
+		}
+		xmlns := &BLangXMLNS{}
+		xmlns.SetNamespaceURI(attribute.Value)
+		if attribute.Name.Prefix.Value == XMLNS_PREFIX {
+			xmlns.SetPrefix(&attribute.Name.LocalName)
+		} else {
+			prefix := createDefaultXMLPrefix(attribute.pos)
+			xmlns.SetPrefix(&prefix)
+		}
+		xmlns.pos = attribute.pos
+		xmlElement.InlineNamespaces = append(xmlElement.InlineNamespaces, xmlns)
+	}
 }
 
 func (n *NodeBuilder) TransformInterpolation(interpolationNode *tree.InterpolationNode) BLangNode {
-	panic("TransformInterpolation unimplemented")
+	return n.createExpression(interpolationNode.Expression())
 }
 
 func (n *NodeBuilder) TransformXMLText(xMLTextNode *tree.XMLTextNode) BLangNode {
-	panic("TransformXMLText unimplemented")
+	return n.createSimpleLiteral(xMLTextNode.Content()).(BLangNode)
 }
 
 func (n *NodeBuilder) TransformXMLAttribute(xMLAttributeNode *tree.XMLAttributeNode) BLangNode {
-	panic("TransformXMLAttribute unimplemented")
+	attribute := &BLangXMLAttribute{}
+	attribute.Name = n.TransformSyntaxNode(xMLAttributeNode.AttributeName()).(*BLangXMLQName)
+	attribute.Value = n.TransformSyntaxNode(xMLAttributeNode.Value()).(*BLangXMLQuotedString)
+	attribute.IsNamespaceDeclr = attribute.Name.Prefix.Value == XMLNS_PREFIX ||
+		(attribute.Name.Prefix.Value == XMLNS_DEFAULT_PREFIX && attribute.Name.LocalName.Value == XMLNS_PREFIX)
+	attribute.pos = getPosition(xMLAttributeNode)
+	return attribute
 }
 
 func (n *NodeBuilder) TransformXMLAttributeValue(xMLAttributeValue *tree.XMLAttributeValue) BLangNode {
-	panic("TransformXMLAttributeValue unimplemented")
+	quotedString := &BLangXMLQuotedString{}
+	quotedString.pos = getPosition(xMLAttributeValue)
+	if xMLAttributeValue.StartQuote().Kind() == common.SINGLE_QUOTE_TOKEN {
+		quotedString.QuoteType = XMLQuoteType_SINGLE_QUOTE
+	} else {
+		quotedString.QuoteType = XMLQuoteType_DOUBLE_QUOTE
+	}
+	quotedString.TextFragments = n.createXMLTextFragments(xMLAttributeValue.Value(), quotedString.pos)
+	return quotedString
 }
 
 func (n *NodeBuilder) TransformXMLComment(xMLComment *tree.XMLComment) BLangNode {
-	panic("TransformXMLComment unimplemented")
+	// the parser drops the interpolations in a comment from its content, so they must be reported here
+	if tree.HasSyntaxDiagnostic(xMLComment.InternalNode(), &common.ERROR_INTERPOLATION_IS_NOT_ALLOWED_WITHIN_XML_COMMENTS) {
+		panic("interpolation is not allowed within XML comments")
+	}
+	commentLiteral := &BLangXMLCommentLiteral{}
+	commentLiteral.pos = getPosition(xMLComment)
+	commentLiteral.TextFragments = n.createXMLTextFragments(xMLComment.Content(), commentLiteral.pos)
+	return commentLiteral
 }
 
 func (n *NodeBuilder) TransformXMLCDATA(xMLCDATANode *tree.XMLCDATANode) BLangNode {
-	panic("TransformXMLCDATA unimplemented")
+	// CDATA sections don't have a separate representation, they are just text
+	textLiteral := &BLangXMLTextLiteral{}
+	textLiteral.pos = getPosition(xMLCDATANode)
+	textLiteral.TextFragments = n.createXMLTextFragments(xMLCDATANode.Content(), textLiteral.pos)
+	return textLiteral
 }
 
 func (n *NodeBuilder) TransformXMLProcessingInstruction(xMLProcessingInstruction *tree.XMLProcessingInstruction) BLangNode {
-	panic("TransformXMLProcessingInstruction unimplemented")
+	procInsLiteral := &BLangXMLProcInsLiteral{}
+	procInsLiteral.pos = getPosition(xMLProcessingInstruction)
+	target := xMLProcessingInstruction.Target().(*tree.XMLSimpleNameNode)
+	procInsLiteral.Target = n.createStringLiteral(target.Name().Text(), getPosition(target))
+	procInsLiteral.DataFragments = n.createXMLTextFragments(xMLProcessingInstruction.Data(), procInsLiteral.pos)
+	return procInsLiteral
 }
 
 func (n *NodeBuilder) TransformTableTypeDescriptor(tableTypeDescriptorNode *tree.TableTypeDescriptorNode) BLangNode {
-	panic("TransformTableTypeDescriptor unimplemented")
+	tableTypeNode := &BLangTableTypeNode{}
+	tableTypeNode.pos = getPosition(tableTypeDescriptorNode)
+	rowTypeParameter := tableTypeDescriptorNode.RowTypeParameterNode().(*tree.TypeParameterNode)
+	tableTypeNode.Constraint = n.createTypeNode(rowTypeParameter.TypeNode())
+	switch keyConstraint := tableTypeDescriptorNode.KeyConstraintNode().(type) {
+	case nil:
+	case *tree.KeySpecifierNode:
+		tableTypeNode.TableKeySpecifier = n.TransformKeySpecifier(keyConstraint).(*BLangTableKeySpecifier)
+	case *tree.KeyTypeConstraintNode:
+		tableTypeNode.TableKeyTypeConstraint = n.TransformKeyTypeConstraint(keyConstraint).(*BLangTableKeyTypeConstraint)
+	default:
+		panic("unexpected table key constraint")
+	}
+	return tableTypeNode
 }
 
 func (n *NodeBuilder) TransformTypeParameter(typeParameterNode *tree.TypeParameterNode) BLangNode {
@@ -2272,7 +3111,11 @@ func (n *NodeBuilder) TransformTypeParameter(typeParameterNode *tree.TypeParamet
 }
 
 func (n *NodeBuilder) TransformKeyTypeConstraint(keyTypeConstraintNode *tree.KeyTypeConstraintNode) BLangNode {
-	panic("TransformKeyTypeConstraint unimplemented")
+	keyTypeConstraint := &BLangTableKeyTypeConstraint{}
+	keyTypeConstraint.pos = getPosition(keyTypeConstraintNode)
+	typeParameter := keyTypeConstraintNode.TypeParameterNode().(*tree.TypeParameterNode)
+	keyTypeConstraint.KeyType = n.createTypeNode(typeParameter.TypeNode())
+	return keyTypeConstraint
 }
 
 func (n *NodeBuilder) TransformFunctionTypeDescriptor(functionTypeDescriptorNode *tree.FunctionTypeDescriptorNode) BLangNode {
@@ -2292,19 +3135,49 @@ func (n *NodeBuilder) TransformExpressionFunctionBody(expressionFunctionBodyNode
 }
 
 func (n *NodeBuilder) TransformTupleTypeDescriptor(tupleTypeDescriptorNode *tree.TupleTypeDescriptorNode) BLangNode {
-	panic("TransformTupleTypeDescriptor unimplemented")
+	tupleTypeNode := &BLangTupleTypeNode{}
+	tupleTypeNode.pos = getPosition(tupleTypeDescriptorNode)
+	members := tupleTypeDescriptorNode.MemberTypeDesc()
+	for member := range members.Iterator() {
+		switch member := member.(type) {
+		case *tree.RestDescriptorNode:
+			tupleTypeNode.RestParamType = n.createTypeNode(member.TypeDescriptor())
+		case *tree.MemberTypeDescriptorNode:
+			tupleTypeNode.MemberTypeNodes = append(tupleTypeNode.MemberTypeNodes, n.TransformMemberTypeDescriptor(member).(model.TypeNode))
+		default:
+			// Separators
+		}
+	}
+	return tupleTypeNode
 }
 
 func (n *NodeBuilder) TransformParenthesisedTypeDescriptor(parenthesisedTypeDescriptorNode *tree.ParenthesisedTypeDescriptorNode) BLangNode {
-	panic("TransformParenthesisedTypeDescriptor unimplemented")
+	typeNode := n.createTypeNode(parenthesisedTypeDescriptorNode.Typedesc())
+	typeNode.(typeNodeBase).setGrouped(true)
+	return typeNode.(BLangNode)
 }
 
 func (n *NodeBuilder) TransformExplicitNewExpression(explicitNewExpressionNode *tree.ExplicitNewExpressionNode) BLangNode {
-	panic("TransformExplicitNewExpression unimplemented")
+	typeInit := n.createTypeInit(explicitNewExpressionNode.ParenthesizedArgList(), getPosition(explicitNewExpressionNode))
+	typeInit.UserDefinedType = n.createTypeNode(explicitNewExpressionNode.TypeDescriptor())
+	return typeInit
 }
 
 func (n *NodeBuilder) TransformImplicitNewExpression(implicitNewExpressionNode *tree.ImplicitNewExpressionNode) BLangNode {
-	panic("TransformImplicitNewExpression unimplemented")
+	return n.createTypeInit(implicitNewExpressionNode.ParenthesizedArgList(), getPosition(implicitNewExpressionNode))
+}
+
+func (n *NodeBuilder) createTypeInit(argList *tree.ParenthesizedArgList, pos Location) *BLangTypeInit {
+	typeInit := &BLangTypeInit{}
+	typeInit.pos = pos
+	if argList == nil {
+		return typeInit
+	}
+	arguments := argList.Arguments()
+	for arg := range arguments.Iterator() {
+		typeInit.ArgsExpr = append(typeInit.ArgsExpr, n.createExpression(arg))
+	}
+	return typeInit
 }
 
 func (n *NodeBuilder) TransformParenthesizedArgList(parenthesizedArgList *tree.ParenthesizedArgList) BLangNode {
@@ -2316,15 +3189,31 @@ func (n *NodeBuilder) TransformQueryConstructType(queryConstructTypeNode *tree.Q
 }
 
 func (n *NodeBuilder) TransformFromClause(fromClauseNode *tree.FromClauseNode) BLangNode {
-	panic("TransformFromClause unimplemented")
+	fromClause := &BLangFromClause{}
+	fromClause.pos = getPosition(fromClauseNode)
+	typedBindingPattern := fromClauseNode.TypedBindingPattern()
+	fromClause.VariableDefinitionNode = n.createBLangVarDef(getPosition(typedBindingPattern), typedBindingPattern,
+		nil, nil).(*BLangSimpleVariableDef)
+	fromClause.Collection = n.createExpression(fromClauseNode.Expression())
+	return fromClause
 }
 
 func (n *NodeBuilder) TransformWhereClause(whereClauseNode *tree.WhereClauseNode) BLangNode {
-	panic("TransformWhereClause unimplemented")
+	whereClause := &BLangWhereClause{}
+	whereClause.pos = getPosition(whereClauseNode)
+	whereClause.Expression = n.createExpression(whereClauseNode.Expression())
+	return whereClause
 }
 
 func (n *NodeBuilder) TransformLetClause(letClauseNode *tree.LetClauseNode) BLangNode {
-	panic("TransformLetClause unimplemented")
+	letClause := &BLangLetClause{}
+	letClause.pos = getPosition(letClauseNode)
+	letVarDeclarations := letClauseNode.LetVarDeclarations()
+	for letVarDeclaration := range letVarDeclarations.Iterator() {
+		letClause.LetVarDeclarations = append(letClause.LetVarDeclarations,
+			n.TransformLetVariableDeclaration(letVarDeclaration).(*BLangSimpleVariableDef))
+	}
+	return letClause
 }
 
 func (n *NodeBuilder) TransformJoinClause(joinClauseNode *tree.JoinClauseNode) BLangNode {
@@ -2348,7 +3237,10 @@ func (n *NodeBuilder) TransformQueryPipeline(queryPipelineNode *tree.QueryPipeli
 }
 
 func (n *NodeBuilder) TransformSelectClause(selectClauseNode *tree.SelectClauseNode) BLangNode {
-	panic("TransformSelectClause unimplemented")
+	selectClause := &BLangSelectClause{}
+	selectClause.pos = getPosition(selectClauseNode)
+	selectClause.Expression = n.createExpression(selectClauseNode.Expression())
+	return selectClause
 }
 
 func (n *NodeBuilder) TransformCollectClause(collectClauseNode *tree.CollectClauseNode) BLangNode {
@@ -2356,7 +3248,29 @@ func (n *NodeBuilder) TransformCollectClause(collectClauseNode *tree.CollectClau
 }
 
 func (n *NodeBuilder) TransformQueryExpression(queryExpressionNode *tree.QueryExpressionNode) BLangNode {
-	panic("TransformQueryExpression unimplemented")
+	queryExpr := &BLangQueryExpr{}
+	queryExpr.pos = getPosition(queryExpressionNode)
+	if constructType := queryExpressionNode.QueryConstructType(); constructType != nil {
+		if constructType.Keyword().Kind() == common.STREAM_KEYWORD {
+			queryExpr.IsStream = true
+		} else {
+			queryExpr.IsTable = true
+			if keySpecifier := constructType.KeySpecifier(); keySpecifier != nil {
+				queryExpr.TableKeySpecifier = n.TransformKeySpecifier(keySpecifier).(*BLangTableKeySpecifier)
+			}
+		}
+	}
+	if queryExpressionNode.OnConflictClause() != nil {
+		panic("on conflict clauses not yet supported")
+	}
+	queryPipeline := queryExpressionNode.QueryPipeline()
+	queryExpr.QueryClauseList = append(queryExpr.QueryClauseList, n.TransformFromClause(queryPipeline.FromClause()))
+	intermediateClauses := queryPipeline.IntermediateClauses()
+	for clause := range intermediateClauses.Iterator() {
+		queryExpr.QueryClauseList = append(queryExpr.QueryClauseList, n.TransformSyntaxNode(clause))
+	}
+	queryExpr.QueryClauseList = append(queryExpr.QueryClauseList, n.TransformSyntaxNode(queryExpressionNode.ResultClause()))
+	return queryExpr
 }
 
 func (n *NodeBuilder) TransformQueryAction(queryActionNode *tree.QueryActionNode) BLangNode {
@@ -2364,7 +3278,19 @@ func (n *NodeBuilder) TransformQueryAction(queryActionNode *tree.QueryActionNode
 }
 
 func (n *NodeBuilder) TransformIntersectionTypeDescriptor(intersectionTypeDescriptorNode *tree.IntersectionTypeDescriptorNode) BLangNode {
-	panic("TransformIntersectionTypeDescriptor unimplemented")
+	lhsType := n.createTypeNode(intersectionTypeDescriptorNode.LeftTypeDesc())
+	rhsType := n.createTypeNode(intersectionTypeDescriptorNode.RightTypeDesc())
+
+	intersectionTypeNode := &BLangIntersectionTypeNode{}
+	intersectionTypeNode.pos = getPosition(intersectionTypeDescriptorNode)
+	for _, typeNode := range []model.TypeNode{lhsType, rhsType} {
+		if constituent, ok := typeNode.(*BLangIntersectionTypeNode); ok && !constituent.Grouped {
+			intersectionTypeNode.ConstituentTypeNodes = append(intersectionTypeNode.ConstituentTypeNodes, constituent.ConstituentTypeNodes...)
+		} else {
+			intersectionTypeNode.AddConstituentTypeNode(typeNode)
+		}
+	}
+	return intersectionTypeNode
 }
 
 func (n *NodeBuilder) TransformImplicitAnonymousFunctionParameters(implicitAnonymousFunctionParameters *tree.ImplicitAnonymousFunctionParameters) BLangNode {
@@ -2376,15 +3302,61 @@ func (n *NodeBuilder) TransformImplicitAnonymousFunctionExpression(implicitAnony
 }
 
 func (n *NodeBuilder) TransformStartAction(startActionNode *tree.StartActionNode) BLangNode {
-	panic("TransformStartAction unimplemented")
+	annotations := startActionNode.Annotations()
+	if annotations.Size() > 0 {
+		panic("annotations not yet supported")
+	}
+	invocation, ok := n.createActionOrExpression(startActionNode.Expression()).(*BLangInvocation)
+	if !ok {
+		panic("start action expects a function call")
+	}
+	invocation.Async = true
+	invocation.pos = getPosition(startActionNode)
+	return invocation
 }
 
 func (n *NodeBuilder) TransformFlushAction(flushActionNode *tree.FlushActionNode) BLangNode {
-	panic("TransformFlushAction unimplemented")
+	workerFlushExpr := &BLangWorkerFlushExpr{}
+	if peerWorker, ok := flushActionNode.PeerWorker().(*tree.SimpleNameReferenceNode); ok {
+		workerIdentifier := createIdentifierFromToken(getPosition(peerWorker), peerWorker.Name())
+		workerFlushExpr.WorkerIdentifier = &workerIdentifier
+	}
+	workerFlushExpr.pos = getPosition(flushActionNode)
+	return workerFlushExpr
 }
 
 func (n *NodeBuilder) TransformSingletonTypeDescriptor(singletonTypeDescriptorNode *tree.SingletonTypeDescriptorNode) BLangNode {
-	panic("TransformSingletonTypeDescriptor unimplemented")
+	finiteTypeNode := &BLangFiniteTypeNode{}
+	literal := n.createSingletonLiteral(singletonTypeDescriptorNode.SimpleContExprNode())
+	finiteTypeNode.pos = literal.(BLangNode).GetPosition()
+	finiteTypeNode.AddValue(literal)
+	return finiteTypeNode
+}
+
+// createSingletonLiteral creates the literal of a singleton type, which may be a signed numeric literal.
+func (n *NodeBuilder) createSingletonLiteral(literal tree.Node) model.LiteralNode {
+	unaryExpr, ok := literal.(*tree.UnaryExpressionNode)
+	if !ok {
+		return n.createSimpleLiteralInner(literal, true)
+	}
+	operator := unaryExpr.UnaryOperator().Kind()
+	bLiteral := n.createSimpleLiteralInner(unaryExpr.Expression(), true)
+	if operator == common.PLUS_TOKEN {
+		return bLiteral
+	} else if operator != common.MINUS_TOKEN {
+		panic("unexpected operator in singleton type: " + unaryExpr.UnaryOperator().Text())
+	}
+	switch value := bLiteral.GetValue().(type) {
+	case int64:
+		bLiteral.SetValue(-value)
+	case string:
+		bLiteral.SetValue("-" + value)
+	default:
+		panic("unexpected signed literal in singleton type")
+	}
+	bLiteral.SetOriginalValue("-" + bLiteral.GetOriginalValue())
+	bLiteral.(BLangNode).SetPosition(getPosition(unaryExpr))
+	return bLiteral
 }
 
 func (n *NodeBuilder) TransformMethodDeclaration(methodDeclarationNode *tree.MethodDeclarationNode) BLangNode {
@@ -2434,23 +3406,87 @@ func (n *NodeBuilder) TransformNamedArgBindingPattern(namedArgBindingPatternNode
 }
 
 func (n *NodeBuilder) TransformAsyncSendAction(asyncSendActionNode *tree.AsyncSendActionNode) BLangNode {
-	panic("TransformAsyncSendAction unimplemented")
+	workerSendNode := &BLangWorkerAsyncSendExpr{}
+	workerSendNode.WorkerIdentifier = createWorkerIdentifier(asyncSendActionNode.PeerWorker())
+	workerSendNode.Expr = n.createExpression(asyncSendActionNode.Expression())
+	workerSendNode.pos = getPosition(asyncSendActionNode)
+	return workerSendNode
 }
 
 func (n *NodeBuilder) TransformSyncSendAction(syncSendActionNode *tree.SyncSendActionNode) BLangNode {
-	panic("TransformSyncSendAction unimplemented")
+	workerSendNode := &BLangWorkerSyncSendExpr{}
+	workerSendNode.WorkerIdentifier = createWorkerIdentifier(syncSendActionNode.PeerWorker())
+	workerSendNode.Expr = n.createExpression(syncSendActionNode.Expression())
+	workerSendNode.pos = getPosition(syncSendActionNode)
+	return workerSendNode
+}
+
+// createWorkerIdentifier creates the identifier of a peer worker. The default worker is referred to as `function`.
+func createWorkerIdentifier(peerWorker *tree.SimpleNameReferenceNode) *BLangIdentifier {
+	workerIdentifier := createIdentifierFromToken(getPosition(peerWorker), peerWorker.Name())
+	return &workerIdentifier
 }
 
 func (n *NodeBuilder) TransformReceiveAction(receiveActionNode *tree.ReceiveActionNode) BLangNode {
-	panic("TransformReceiveAction unimplemented")
+	receiveWorkers := receiveActionNode.ReceiveWorkers()
+	receiveExprPos := getPosition(receiveActionNode)
+	switch receiveWorkers := receiveWorkers.(type) {
+	case *tree.SimpleNameReferenceNode:
+		return createSingleWorkerReceive(receiveExprPos, receiveWorkers)
+	case *tree.AlternateReceiveNode:
+		alternateWorkerRecv := n.TransformAlternateReceive(receiveWorkers).(*BLangAlternateWorkerReceive)
+		alternateWorkerRecv.pos = receiveExprPos
+		return alternateWorkerRecv
+	case *tree.ReceiveFieldsNode:
+		multipleWorkerRecv := n.TransformReceiveFields(receiveWorkers).(*BLangMultipleWorkerReceive)
+		multipleWorkerRecv.pos = receiveExprPos
+		return multipleWorkerRecv
+	default:
+		panic("unexpected receive workers in receive action")
+	}
+}
+
+func createSingleWorkerReceive(pos Location, peerWorker *tree.SimpleNameReferenceNode) *BLangWorkerReceive {
+	workerReceiveExpr := &BLangWorkerReceive{}
+	workerReceiveExpr.WorkerIdentifier = createWorkerIdentifier(peerWorker)
+	workerReceiveExpr.pos = pos
+	return workerReceiveExpr
 }
 
 func (n *NodeBuilder) TransformReceiveFields(receiveFieldsNode *tree.ReceiveFieldsNode) BLangNode {
-	panic("TransformReceiveFields unimplemented")
+	multipleWorkerRecv := &BLangMultipleWorkerReceive{}
+	multipleWorkerRecv.pos = getPosition(receiveFieldsNode)
+	receiveFields := receiveFieldsNode.ReceiveFields()
+	for receiveField := range receiveFields.Iterator() {
+		var fieldName, peerWorker *tree.SimpleNameReferenceNode
+		switch receiveField := receiveField.(type) {
+		case *tree.ReceiveFieldNode:
+			fieldName = receiveField.FieldName()
+			peerWorker = receiveField.PeerWorker()
+		case *tree.SimpleNameReferenceNode:
+			fieldName = receiveField
+			peerWorker = receiveField
+		default:
+			// field separator
+			continue
+		}
+		key := createIdentifierFromToken(getPosition(fieldName), fieldName.Name())
+		multipleWorkerRecv.ReceiveFields = append(multipleWorkerRecv.ReceiveFields, BLangReceiveField{
+			Key:           &key,
+			WorkerReceive: createSingleWorkerReceive(getPosition(peerWorker), peerWorker),
+		})
+	}
+	return multipleWorkerRecv
 }
 
 func (n *NodeBuilder) TransformAlternateReceive(alternateReceiveNode *tree.AlternateReceiveNode) BLangNode {
-	panic("TransformAlternateReceive unimplemented")
+	alternateWorkerRecv := &BLangAlternateWorkerReceive{}
+	alternateWorkerRecv.pos = getPosition(alternateReceiveNode)
+	workers := alternateReceiveNode.Workers()
+	for peerWorker := range workers.Iterator() {
+		alternateWorkerRecv.AddWorkerReceive(*createSingleWorkerReceive(getPosition(peerWorker), peerWorker))
+	}
+	return alternateWorkerRecv
 }
 
 func (n *NodeBuilder) TransformRestDescriptor(restDescriptorNode *tree.RestDescriptorNode) BLangNode {
@@ -2466,7 +3502,48 @@ func (n *NodeBuilder) TransformTrippleGTToken(trippleGTTokenNode *tree.TrippleGT
 }
 
 func (n *NodeBuilder) TransformWaitAction(waitActionNode *tree.WaitActionNode) BLangNode {
-	panic("TransformWaitAction unimplemented")
+	waitFutureExpr := waitActionNode.WaitFutureExpr()
+	if waitFieldsList, ok := waitFutureExpr.(*tree.WaitFieldsListNode); ok {
+		waitForAllExpr := &BLangWaitForAllExpr{}
+		waitFields := waitFieldsList.WaitFields()
+		for waitField := range waitFields.Iterator() {
+			if _, isSeparator := waitField.(tree.Token); isSeparator {
+				continue
+			}
+			waitForAllExpr.KeyValuePairs = append(waitForAllExpr.KeyValuePairs, n.createWaitKeyValue(waitField))
+		}
+		waitForAllExpr.pos = getPosition(waitActionNode)
+		return waitForAllExpr
+	}
+	waitExpr := &BLangWaitExpr{}
+	waitExpr.ExprList = n.createAlternateWaitExprs(waitFutureExpr, nil)
+	waitExpr.pos = getPosition(waitActionNode)
+	return waitExpr
+}
+
+// createAlternateWaitExprs flattens the futures of an alternate wait, e.g. `wait f1 | f2 | f3`.
+func (n *NodeBuilder) createAlternateWaitExprs(waitFutureExpr tree.Node, exprs []BLangExpression) []BLangExpression {
+	if binaryExpr, ok := waitFutureExpr.(*tree.BinaryExpressionNode); ok && binaryExpr.Operator().Kind() == common.PIPE_TOKEN {
+		exprs = n.createAlternateWaitExprs(binaryExpr.LhsExpr(), exprs)
+		return n.createAlternateWaitExprs(binaryExpr.RhsExpr(), exprs)
+	}
+	return append(exprs, n.createExpression(waitFutureExpr))
+}
+
+func (n *NodeBuilder) createWaitKeyValue(waitField tree.Node) BLangWaitKeyValue {
+	keyValue := BLangWaitKeyValue{}
+	keyValue.pos = getPosition(waitField)
+	if waitFieldNode, ok := waitField.(*tree.WaitFieldNode); ok {
+		key := createIdentifierFromToken(getPosition(waitFieldNode.FieldName()), waitFieldNode.FieldName().Name())
+		keyValue.Key = &key
+		keyValue.ValueExpr = n.createExpression(waitFieldNode.WaitFutureExpr())
+		return keyValue
+	}
+	varName := waitField.(*tree.SimpleNameReferenceNode)
+	key := createIdentifierFromToken(getPosition(varName), varName.Name())
+	keyValue.Key = &key
+	keyValue.KeyExpr = n.createExpression(varName).(*BLangSimpleVarRef)
+	return keyValue
 }
 
 func (n *NodeBuilder) TransformWaitFieldsList(waitFieldsListNode *tree.WaitFieldsListNode) BLangNode {
@@ -2478,7 +3555,13 @@ func (n *NodeBuilder) TransformWaitField(waitFieldNode *tree.WaitFieldNode) BLan
 }
 
 func (n *NodeBuilder) TransformAnnotAccessExpression(annotAccessExpressionNode *tree.AnnotAccessExpressionNode) BLangNode {
-	panic("TransformAnnotAccessExpression unimplemented")
+	annotAccessExpr := &BLangAnnotAccessExpr{}
+	annotAccessExpr.pos = getPosition(annotAccessExpressionNode)
+	nameReference := n.createBLangNameReference(annotAccessExpressionNode.AnnotTagReference())
+	annotAccessExpr.PkgAlias = &nameReference[0]
+	annotAccessExpr.AnnotationName = &nameReference[1]
+	annotAccessExpr.Expr = n.createExpression(annotAccessExpressionNode.Expression())
+	return annotAccessExpr
 }
 
 func (n *NodeBuilder) TransformOptionalFieldAccessExpression(optionalFieldAccessExpressionNode *tree.OptionalFieldAccessExpressionNode) BLangNode {
@@ -2486,7 +3569,12 @@ func (n *NodeBuilder) TransformOptionalFieldAccessExpression(optionalFieldAccess
 }
 
 func (n *NodeBuilder) TransformConditionalExpression(conditionalExpressionNode *tree.ConditionalExpressionNode) BLangNode {
-	panic("TransformConditionalExpression unimplemented")
+	ternaryExpr := &BLangTernaryExpr{}
+	ternaryExpr.pos = getPosition(conditionalExpressionNode)
+	ternaryExpr.Expr = n.createExpression(conditionalExpressionNode.LhsExpression())
+	ternaryExpr.ThenExpr = n.createExpression(conditionalExpressionNode.MiddleExpression())
+	ternaryExpr.ElseExpr = n.createExpression(conditionalExpressionNode.EndExpression())
+	return ternaryExpr
 }
 
 func (n *NodeBuilder) TransformEnumDeclaration(enumDeclarationNode *tree.EnumDeclarationNode) BLangNode {
@@ -2597,7 +3685,9 @@ func (n *NodeBuilder) TransformMatchGuard(matchGuardNode *tree.MatchGuardNode) B
 }
 
 func (n *NodeBuilder) TransformDistinctTypeDescriptor(distinctTypeDescriptorNode *tree.DistinctTypeDescriptorNode) BLangNode {
-	panic("TransformDistinctTypeDescriptor unimplemented")
+	typeNode := n.createTypeNode(distinctTypeDescriptorNode.TypeDescriptor())
+	typeNode.(typeNodeBase).addFlag(model.Flag_DISTINCT)
+	return typeNode.(BLangNode)
 }
 
 func (n *NodeBuilder) TransformListMatchPattern(listMatchPatternNode *tree.ListMatchPatternNode) BLangNode {
@@ -2624,8 +3714,132 @@ func (n *NodeBuilder) TransformNamedArgMatchPattern(namedArgMatchPatternNode *tr
 	panic("TransformNamedArgMatchPattern unimplemented")
 }
 
+// TransformMarkdownDocumentation groups the documentation lines into the description, parameter, return and
+// deprecation sections. A line without a `+` or `# Deprecated` prefix continues the section of the previous line.
 func (n *NodeBuilder) TransformMarkdownDocumentation(markdownDocumentationNode *tree.MarkdownDocumentationNode) BLangNode {
-	panic("TransformMarkdownDocumentation unimplemented")
+	doc := &BLangMarkdownDocumentation{}
+	var paraDoc *BLangMarkdownParameterDocumentation
+	var returnParaDoc *BLangMarkdownReturnParameterDocumentation
+	var deprecationDoc *BLangMarkDownDeprecationDocumentation
+	var deprecatedParaDoc *BLangMarkDownDeprecatedParametersDocumentation
+	inDeprecationSection := false
+
+	docLines := markdownDocumentationNode.DocumentationLines()
+	for docLine := range docLines.Iterator() {
+		switch docLine := docLine.(type) {
+		case *tree.MarkdownDocumentationLineNode:
+			if docLine.Kind() == common.MARKDOWN_DEPRECATION_DOCUMENTATION_LINE {
+				// The first element is the `# Deprecated` literal
+				docElements := docLine.DocumentElements()
+				rest := n.createDocumentationText(doc, docElements, 1)
+				if rest == "parameters" {
+					if deprecatedParaDoc == nil {
+						deprecatedParaDoc = &BLangMarkDownDeprecatedParametersDocumentation{}
+						deprecatedParaDoc.pos = getPosition(docLine)
+					}
+					inDeprecationSection = false
+				} else {
+					if deprecationDoc == nil {
+						deprecationDoc = &BLangMarkDownDeprecationDocumentation{}
+						deprecationDoc.pos = getPosition(docLine)
+					}
+					deprecationDoc.AddDeprecationLine("# " + docElements.Get(0).(tree.Token).Text())
+					deprecationDoc.IsCorrectDeprecationLine = rest == ""
+					inDeprecationSection = true
+				}
+				paraDoc, returnParaDoc = nil, nil
+				continue
+			}
+			docText := n.createDocumentationText(doc, docLine.DocumentElements(), 0)
+			switch {
+			case inDeprecationSection:
+				deprecationDoc.AddDeprecationDocumentationLine(docText)
+			case returnParaDoc != nil:
+				returnParaDoc.AddReturnParameterDocumentationLine(docText)
+			case paraDoc != nil:
+				paraDoc.AddParameterDocumentationLine(docText)
+			default:
+				line := BLangMarkdownDocumentationLine{Text: docText}
+				line.pos = getPosition(docLine)
+				doc.DocumentationLines = append(doc.DocumentationLines, line)
+			}
+		case *tree.MarkdownParameterDocumentationLineNode:
+			docText := n.createDocumentationText(doc, docLine.DocumentElements(), 0)
+			if docLine.Kind() == common.MARKDOWN_RETURN_PARAMETER_DOCUMENTATION_LINE {
+				returnParaDoc = &BLangMarkdownReturnParameterDocumentation{}
+				returnParaDoc.AddReturnParameterDocumentationLine(docText)
+				returnParaDoc.pos = getPosition(docLine)
+				doc.ReturnParameter = returnParaDoc
+				paraDoc, inDeprecationSection = nil, false
+				continue
+			}
+			paramName := docLine.ParameterName()
+			paramNameValue := ""
+			if !paramName.IsMissing() {
+				paramNameValue = paramName.Text()
+			}
+			name := createIdentifier(getPosition(paramName), &paramNameValue, &paramNameValue)
+			paraDoc = &BLangMarkdownParameterDocumentation{ParameterName: &name}
+			paraDoc.AddParameterDocumentationLine(docText)
+			paraDoc.pos = getPosition(paramName)
+			if deprecatedParaDoc != nil || inDeprecationSection {
+				if deprecatedParaDoc == nil {
+					deprecatedParaDoc = &BLangMarkDownDeprecatedParametersDocumentation{}
+					deprecatedParaDoc.pos = getPosition(docLine)
+				}
+				deprecatedParaDoc.Parameters = append(deprecatedParaDoc.Parameters, *paraDoc)
+				// Continuation lines of a deprecated parameter go to the copy held by the section
+				paraDoc = &deprecatedParaDoc.Parameters[len(deprecatedParaDoc.Parameters)-1]
+			} else {
+				doc.Parameters = append(doc.Parameters, *paraDoc)
+				paraDoc = &doc.Parameters[len(doc.Parameters)-1]
+			}
+			returnParaDoc, inDeprecationSection = nil, false
+		case *tree.MarkdownCodeBlockNode:
+			n.addCodeBlockLines(doc, docLine)
+		}
+	}
+
+	doc.DeprecationDocumentation = deprecationDoc
+	doc.DeprecatedParametersDocumentation = deprecatedParaDoc
+	doc.pos = getPosition(markdownDocumentationNode)
+	return doc
+}
+
+// createDocumentationText returns the text of the document elements starting from the given index, and records the
+// name references in the documentation.
+func (n *NodeBuilder) createDocumentationText(doc *BLangMarkdownDocumentation, docElements tree.NodeList[tree.Node], from int) string {
+	var docText strings.Builder
+	for i := from; i < docElements.Size(); i++ {
+		docElement := docElements.Get(i)
+		if docElement.Kind() == common.BALLERINA_NAME_REFERENCE {
+			reference := n.TransformSyntaxNode(docElement).(*BLangMarkdownReferenceDocumentation)
+			doc.References = append(doc.References, *reference)
+		}
+		docText.WriteString(tree.ToSourceCode(docElement.InternalNode()))
+	}
+	return strings.TrimSpace(docText.String())
+}
+
+// addCodeBlockLines adds the lines of a code block, including the fences, as description lines.
+func (n *NodeBuilder) addCodeBlockLines(doc *BLangMarkdownDocumentation, codeBlock *tree.MarkdownCodeBlockNode) {
+	addLine := func(text string, node tree.Node) {
+		line := BLangMarkdownDocumentationLine{Text: text}
+		line.pos = getPosition(node)
+		doc.DocumentationLines = append(doc.DocumentationLines, line)
+	}
+	startFence := codeBlock.StartBacktick().Text()
+	if langAttribute := codeBlock.LangAttribute(); langAttribute != nil {
+		startFence += strings.TrimSpace(langAttribute.Text())
+	}
+	addLine(startFence, codeBlock.StartBacktick())
+	codeLines := codeBlock.CodeLines()
+	for codeLine := range codeLines.Iterator() {
+		addLine(strings.TrimPrefix(codeLine.CodeDescription().Text(), " "), codeLine)
+	}
+	if !codeBlock.EndBacktick().IsMissing() {
+		addLine(codeBlock.EndBacktick().Text(), codeBlock.EndBacktick())
+	}
 }
 
 func (n *NodeBuilder) TransformMarkdownDocumentationLine(markdownDocumentationLineNode *tree.MarkdownDocumentationLineNode) BLangNode {
@@ -2636,8 +3850,34 @@ func (n *NodeBuilder) TransformMarkdownParameterDocumentationLine(markdownParame
 	panic("TransformMarkdownParameterDocumentationLine unimplemented")
 }
 
+// TransformBallerinaNameReference splits a reference of the form `module:Type.name()` into its parts.
 func (n *NodeBuilder) TransformBallerinaNameReference(ballerinaNameReferenceNode *tree.BallerinaNameReferenceNode) BLangNode {
-	panic("TransformBallerinaNameReference unimplemented")
+	reference := &BLangMarkdownReferenceDocumentation{}
+	reference.pos = getPosition(ballerinaNameReferenceNode)
+	reference.Type = model.DocumentationReferenceType_BACKTICK_CONTENT
+	if referenceType := ballerinaNameReferenceNode.ReferenceType(); referenceType != nil {
+		reference.Type = model.DocumentationReferenceType(referenceType.Text())
+	}
+	nameReference := ballerinaNameReferenceNode.NameReference()
+	if nameReference.IsMissing() {
+		reference.HasParserWarnings = true
+		return reference
+	}
+	content := tree.ToSourceCode(nameReference.InternalNode())
+	reference.ReferenceName = content
+	reference.HasParserWarnings = len(nameReference.InternalNode().Diagnostics()) > 0
+
+	content = strings.TrimSuffix(content, "()")
+	if qualifier, name, found := strings.Cut(content, ":"); found {
+		reference.Qualifier = qualifier
+		content = name
+	}
+	if typeName, name, found := strings.Cut(content, "."); found {
+		reference.TypeName = typeName
+		content = name
+	}
+	reference.Identifier = content
+	return reference
 }
 
 func (n *NodeBuilder) TransformInlineCodeReference(inlineCodeReferenceNode *tree.InlineCodeReferenceNode) BLangNode {
@@ -2681,7 +3921,25 @@ func (n *NodeBuilder) TransformClassDefinition(classDefinitionNode *tree.ClassDe
 }
 
 func (n *NodeBuilder) TransformResourcePathParameter(resourcePathParameterNode *tree.ResourcePathParameterNode) BLangNode {
-	panic("TransformResourcePathParameter unimplemented")
+	paramName := resourcePathParameterNode.ParamName()
+	if paramName == nil {
+		panic("resource path parameters without a name not yet supported")
+	}
+	pathParam := createSimpleVariableNode()
+	pathParam.pos = getPosition(resourcePathParameterNode)
+	name := createIdentifierFromToken(getPosition(paramName), paramName)
+	pathParam.SetName(&name)
+	typeNode := n.createTypeNode(resourcePathParameterNode.TypeDescriptor())
+	if resourcePathParameterNode.Kind() == common.RESOURCE_PATH_REST_PARAM {
+		arrayType := &BLangArrayType{}
+		arrayType.pos = getPosition(resourcePathParameterNode.TypeDescriptor())
+		arrayType.Elemtype = typeNode
+		arrayType.Dimensions = 1
+		typeNode = arrayType
+	}
+	pathParam.SetTypeNode(typeNode)
+	n.addAnnotationAttachments(pathParam, resourcePathParameterNode.Annotations())
+	return pathParam
 }
 
 func (n *NodeBuilder) TransformRequiredExpression(requiredExpressionNode *tree.RequiredExpressionNode) BLangNode {
@@ -2693,7 +3951,27 @@ func (n *NodeBuilder) TransformErrorConstructorExpression(errorConstructorExpres
 }
 
 func (n *NodeBuilder) TransformParameterizedTypeDescriptor(parameterizedTypeDescriptorNode *tree.ParameterizedTypeDescriptorNode) BLangNode {
-	panic("TransformParameterizedTypeDescriptor unimplemented")
+	refType := &BLangBuiltInRefTypeNode{}
+	switch parameterizedTypeDescriptorNode.Kind() {
+	case common.XML_TYPE_DESC:
+		refType.TypeKind = model.TypeKind_XML
+	case common.ERROR_TYPE_DESC:
+		refType.TypeKind = model.TypeKind_ERROR
+	case common.FUTURE_TYPE_DESC:
+		refType.TypeKind = model.TypeKind_FUTURE
+	default:
+		panic("TransformParameterizedTypeDescriptor: unsupported parameterized type")
+	}
+	refType.pos = getPosition(parameterizedTypeDescriptorNode)
+	typeParam := parameterizedTypeDescriptorNode.TypeParamNode()
+	if typeParam == nil {
+		return refType
+	}
+	constrainedType := &BLangConstrainedType{}
+	constrainedType.Type = refType
+	constrainedType.Constraint = n.createTypeNode(typeParam.TypeNode())
+	constrainedType.pos = refType.pos
+	return constrainedType
 }
 
 func (n *NodeBuilder) TransformSpreadMember(spreadMemberNode *tree.SpreadMemberNode) BLangNode {
@@ -2713,95 +3991,205 @@ func (n *NodeBuilder) TransformResourceAccessRestSegment(resourceAccessRestSegme
 }
 
 func (n *NodeBuilder) TransformReSequence(reSequenceNode *tree.ReSequenceNode) BLangNode {
-	panic("TransformReSequence unimplemented")
+	reSequence := &BLangReSequence{}
+	terms := reSequenceNode.ReTerm()
+	for term := range terms.Iterator() {
+		reSequence.TermList = append(reSequence.TermList, n.createExpression(term))
+	}
+	reSequence.pos = getPosition(reSequenceNode)
+	return reSequence
 }
 
 func (n *NodeBuilder) TransformReAtomQuantifier(reAtomQuantifierNode *tree.ReAtomQuantifierNode) BLangNode {
-	panic("TransformReAtomQuantifier unimplemented")
+	atomQuantifier := &BLangReAtomQuantifier{}
+	atomQuantifier.Atom = n.createExpression(reAtomQuantifierNode.ReAtom())
+	if quantifier := reAtomQuantifierNode.ReQuantifier(); quantifier != nil {
+		atomQuantifier.Quantifier = n.TransformSyntaxNode(quantifier).(*BLangReQuantifier)
+	}
+	atomQuantifier.pos = getPosition(reAtomQuantifierNode)
+	return atomQuantifier
 }
 
+// TransformReAtomCharOrEscape keeps interpolations as expressions. Characters and escapes are kept as their
+// source text.
 func (n *NodeBuilder) TransformReAtomCharOrEscape(reAtomCharOrEscapeNode *tree.ReAtomCharOrEscapeNode) BLangNode {
-	panic("TransformReAtomCharOrEscape unimplemented")
+	atomCharOrEscape := &BLangReAtomCharOrEscape{}
+	charOrEscape := reAtomCharOrEscapeNode.ReAtomCharOrEscape()
+	if charOrEscape.Kind() == common.INTERPOLATION {
+		atomCharOrEscape.CharOrEscape = n.createExpression(charOrEscape)
+	} else {
+		atomCharOrEscape.CharOrEscape = n.createReLiteral(charOrEscape)
+	}
+	atomCharOrEscape.pos = getPosition(reAtomCharOrEscapeNode)
+	return atomCharOrEscape
 }
 
 func (n *NodeBuilder) TransformReQuoteEscape(reQuoteEscapeNode *tree.ReQuoteEscapeNode) BLangNode {
-	panic("TransformReQuoteEscape unimplemented")
+	return n.createReLiteral(reQuoteEscapeNode)
 }
 
 func (n *NodeBuilder) TransformReSimpleCharClassEscape(reSimpleCharClassEscapeNode *tree.ReSimpleCharClassEscapeNode) BLangNode {
-	panic("TransformReSimpleCharClassEscape unimplemented")
+	return n.createReLiteral(reSimpleCharClassEscapeNode)
 }
 
 func (n *NodeBuilder) TransformReUnicodePropertyEscape(reUnicodePropertyEscapeNode *tree.ReUnicodePropertyEscapeNode) BLangNode {
-	panic("TransformReUnicodePropertyEscape unimplemented")
+	return n.createReLiteral(reUnicodePropertyEscapeNode)
 }
 
 func (n *NodeBuilder) TransformReUnicodeScript(reUnicodeScriptNode *tree.ReUnicodeScriptNode) BLangNode {
-	panic("TransformReUnicodeScript unimplemented")
+	return n.createReLiteral(reUnicodeScriptNode)
 }
 
 func (n *NodeBuilder) TransformReUnicodeGeneralCategory(reUnicodeGeneralCategoryNode *tree.ReUnicodeGeneralCategoryNode) BLangNode {
-	panic("TransformReUnicodeGeneralCategory unimplemented")
+	return n.createReLiteral(reUnicodeGeneralCategoryNode)
 }
 
 func (n *NodeBuilder) TransformReCharacterClass(reCharacterClassNode *tree.ReCharacterClassNode) BLangNode {
-	panic("TransformReCharacterClass unimplemented")
+	characterClass := &BLangReCharacterClass{}
+	characterClass.CharClassStart = n.createReLiteral(reCharacterClassNode.OpenBracket())
+	if negation := reCharacterClassNode.Negation(); negation != nil {
+		characterClass.Negation = n.createReLiteral(negation)
+	}
+	characterClass.CharSet = &BLangReCharSet{}
+	characterClass.CharSet.pos = getPosition(reCharacterClassNode)
+	if charSet := reCharacterClassNode.ReCharSet(); charSet != nil {
+		characterClass.CharSet = n.createReCharSet(charSet)
+	}
+	characterClass.CharClassEnd = n.createReLiteral(reCharacterClassNode.CloseBracket())
+	characterClass.pos = getPosition(reCharacterClassNode)
+	return characterClass
+}
+
+// createReCharSet flattens the nested char set nodes of the syntax tree into a single list of atoms and ranges
+func (n *NodeBuilder) createReCharSet(charSetNode tree.Node) *BLangReCharSet {
+	charSet := &BLangReCharSet{}
+	for node := charSetNode; node != nil; {
+		var rest tree.Node
+		switch composite := node.(type) {
+		case *tree.ReCharSetRangeWithReCharSetNode:
+			node, rest = composite.ReCharSetRange(), composite.ReCharSet()
+		case *tree.ReCharSetAtomWithReCharSetNoDashNode:
+			node, rest = composite.ReCharSetAtom(), composite.ReCharSetNoDash()
+		case *tree.ReCharSetRangeNoDashWithReCharSetNode:
+			node, rest = composite.ReCharSetRangeNoDash(), composite.ReCharSet()
+		case *tree.ReCharSetAtomNoDashWithReCharSetNoDashNode:
+			node, rest = composite.ReCharSetAtomNoDash(), composite.ReCharSetNoDash()
+		}
+		switch node.Kind() {
+		case common.RE_CHAR_SET_RANGE, common.RE_CHAR_SET_RANGE_NO_DASH:
+			charSet.CharSetAtoms = append(charSet.CharSetAtoms, n.createExpression(node))
+		default:
+			charSet.CharSetAtoms = append(charSet.CharSetAtoms, n.createReLiteral(node))
+		}
+		node = rest
+	}
+	charSet.pos = getPosition(charSetNode)
+	return charSet
 }
 
 func (n *NodeBuilder) TransformReCharSetRangeWithReCharSet(reCharSetRangeWithReCharSetNode *tree.ReCharSetRangeWithReCharSetNode) BLangNode {
-	panic("TransformReCharSetRangeWithReCharSet unimplemented")
+	return n.createReCharSet(reCharSetRangeWithReCharSetNode)
 }
 
 func (n *NodeBuilder) TransformReCharSetRange(reCharSetRangeNode *tree.ReCharSetRangeNode) BLangNode {
-	panic("TransformReCharSetRange unimplemented")
+	return n.createReCharSetRange(reCharSetRangeNode.LhsReCharSetAtom(), reCharSetRangeNode.MinusToken(),
+		reCharSetRangeNode.RhsReCharSetAtom(), getPosition(reCharSetRangeNode))
+}
+
+func (n *NodeBuilder) createReCharSetRange(lhs tree.Node, dash tree.Token, rhs tree.Node, pos Location) *BLangReCharSetRange {
+	charSetRange := &BLangReCharSetRange{}
+	charSetRange.LhsCharSetAtom = n.createReLiteral(lhs)
+	charSetRange.Dash = n.createReLiteral(dash)
+	charSetRange.RhsCharSetAtom = n.createReLiteral(rhs)
+	charSetRange.pos = pos
+	return charSetRange
 }
 
 func (n *NodeBuilder) TransformReCharSetAtomWithReCharSetNoDash(reCharSetAtomWithReCharSetNoDashNode *tree.ReCharSetAtomWithReCharSetNoDashNode) BLangNode {
-	panic("TransformReCharSetAtomWithReCharSetNoDash unimplemented")
+	return n.createReCharSet(reCharSetAtomWithReCharSetNoDashNode)
 }
 
 func (n *NodeBuilder) TransformReCharSetRangeNoDashWithReCharSet(reCharSetRangeNoDashWithReCharSetNode *tree.ReCharSetRangeNoDashWithReCharSetNode) BLangNode {
-	panic("TransformReCharSetRangeNoDashWithReCharSet unimplemented")
+	return n.createReCharSet(reCharSetRangeNoDashWithReCharSetNode)
 }
 
 func (n *NodeBuilder) TransformReCharSetRangeNoDash(reCharSetRangeNoDashNode *tree.ReCharSetRangeNoDashNode) BLangNode {
-	panic("TransformReCharSetRangeNoDash unimplemented")
+	return n.createReCharSetRange(reCharSetRangeNoDashNode.ReCharSetAtomNoDash(), reCharSetRangeNoDashNode.MinusToken(),
+		reCharSetRangeNoDashNode.ReCharSetAtom(), getPosition(reCharSetRangeNoDashNode))
 }
 
 func (n *NodeBuilder) TransformReCharSetAtomNoDashWithReCharSetNoDash(reCharSetAtomNoDashWithReCharSetNoDashNode *tree.ReCharSetAtomNoDashWithReCharSetNoDashNode) BLangNode {
-	panic("TransformReCharSetAtomNoDashWithReCharSetNoDash unimplemented")
+	return n.createReCharSet(reCharSetAtomNoDashWithReCharSetNoDashNode)
 }
 
 func (n *NodeBuilder) TransformReCapturingGroups(reCapturingGroupsNode *tree.ReCapturingGroupsNode) BLangNode {
-	panic("TransformReCapturingGroups unimplemented")
+	capturingGroups := &BLangReCapturingGroups{}
+	capturingGroups.OpenParen = n.createReLiteral(reCapturingGroupsNode.OpenParenthesis())
+	if flagExpr := reCapturingGroupsNode.ReFlagExpression(); flagExpr != nil {
+		capturingGroups.FlagExpr = n.TransformSyntaxNode(flagExpr).(*BLangReFlagExpression)
+	}
+	capturingGroups.Disjunction = n.createReDisjunction(reCapturingGroupsNode.ReSequences(),
+		getPosition(reCapturingGroupsNode))
+	capturingGroups.CloseParen = n.createReLiteral(reCapturingGroupsNode.CloseParenthesis())
+	capturingGroups.pos = getPosition(reCapturingGroupsNode)
+	return capturingGroups
 }
 
 func (n *NodeBuilder) TransformReFlagExpression(reFlagExpressionNode *tree.ReFlagExpressionNode) BLangNode {
-	panic("TransformReFlagExpression unimplemented")
+	flagExpr := &BLangReFlagExpression{}
+	flagExpr.QuestionMark = n.createReLiteral(reFlagExpressionNode.QuestionMark())
+	flagExpr.FlagsOnOff = n.TransformSyntaxNode(reFlagExpressionNode.ReFlagsOnOff()).(*BLangReFlagsOnOff)
+	flagExpr.Colon = n.createReLiteral(reFlagExpressionNode.Colon())
+	flagExpr.pos = getPosition(reFlagExpressionNode)
+	return flagExpr
 }
 
 func (n *NodeBuilder) TransformReFlagsOnOff(reFlagsOnOffNode *tree.ReFlagsOnOffNode) BLangNode {
-	panic("TransformReFlagsOnOff unimplemented")
+	flagsOnOff := &BLangReFlagsOnOff{}
+	flagsOnOff.Flags = n.createReLiteral(reFlagsOnOffNode)
+	flagsOnOff.pos = getPosition(reFlagsOnOffNode)
+	return flagsOnOff
 }
 
 func (n *NodeBuilder) TransformReFlags(reFlagsNode *tree.ReFlagsNode) BLangNode {
-	panic("TransformReFlags unimplemented")
+	return n.createReLiteral(reFlagsNode)
 }
 
 func (n *NodeBuilder) TransformReAssertion(reAssertionNode *tree.ReAssertionNode) BLangNode {
-	panic("TransformReAssertion unimplemented")
+	assertion := &BLangReAssertion{}
+	assertion.Assertion = n.createReLiteral(reAssertionNode.ReAssertion())
+	assertion.pos = getPosition(reAssertionNode)
+	return assertion
 }
 
 func (n *NodeBuilder) TransformReQuantifier(reQuantifierNode *tree.ReQuantifierNode) BLangNode {
-	panic("TransformReQuantifier unimplemented")
+	quantifier := &BLangReQuantifier{}
+	quantifier.Quantifier = n.createReLiteral(reQuantifierNode.ReBaseQuantifier())
+	if nonGreedyChar := reQuantifierNode.NonGreedyChar(); nonGreedyChar != nil {
+		quantifier.NonGreedyChar = n.createReLiteral(nonGreedyChar)
+	}
+	quantifier.pos = getPosition(reQuantifierNode)
+	return quantifier
 }
 
 func (n *NodeBuilder) TransformReBracedQuantifier(reBracedQuantifierNode *tree.ReBracedQuantifierNode) BLangNode {
-	panic("TransformReBracedQuantifier unimplemented")
+	return n.createReLiteral(reBracedQuantifierNode)
+}
+
+// createReLiteral creates a string literal holding the source text of a regexp token or node
+func (n *NodeBuilder) createReLiteral(node tree.Node) *BLangLiteral {
+	if token, ok := node.(tree.Token); ok {
+		return n.createSimpleLiteral(token).(*BLangLiteral)
+	}
+	return n.createStringLiteral(tree.ToSourceCode(node.InternalNode()), getPosition(node))
 }
 
 func (n *NodeBuilder) TransformMemberTypeDescriptor(memberTypeDescriptorNode *tree.MemberTypeDescriptorNode) BLangNode {
-	panic("TransformMemberTypeDescriptor unimplemented")
+	annotations := memberTypeDescriptorNode.Annotations()
+	if annotations.Size() > 0 {
+		panic("annotations not yet supported")
+	}
+	return n.createTypeNode(memberTypeDescriptorNode.TypeDescriptor()).(BLangNode)
 }
 
 func (n *NodeBuilder) TransformReceiveField(receiveFieldNode *tree.ReceiveFieldNode) BLangNode {
@@ -2813,7 +4201,16 @@ func (n *NodeBuilder) TransformNaturalExpression(naturalExpressionNode *tree.Nat
 }
 
 func (n *NodeBuilder) TransformToken(token tree.Token) BLangNode {
-	panic("TransformToken unimplemented")
+	kind := token.Kind()
+	switch kind {
+	case common.XML_TEXT_CONTENT, common.TEMPLATE_STRING, common.CLOSE_BRACE_TOKEN, common.PROMPT_CONTENT:
+		return n.createSimpleLiteral(token).(BLangNode)
+	default:
+		if isTokenInRegExp(kind) {
+			return n.createSimpleLiteral(token).(BLangNode)
+		}
+		panic("TransformToken: unsupported syntax kind")
+	}
 }
 
 func (n *NodeBuilder) TransformIdentifierToken(identifier *tree.IdentifierToken) BLangNode {
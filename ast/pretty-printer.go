@@ -46,6 +46,12 @@ func (p *PrettyPrinter) PrintInner(node BLangNode) {
 		p.printImportPackage(t)
 	case *BLangFunction:
 		p.printFunction(t)
+	case *BLangResourceFunction:
+		p.printResourceFunction(t)
+	case *BLangService:
+		p.printService(t)
+	case *BLangTypeInit:
+		p.printTypeInit(t)
 	case *BLangBlockFunctionBody:
 		p.printBlockFunctionBody(t)
 	case *BLangSimpleVariable:
@@ -70,14 +76,20 @@ func (p *PrettyPrinter) PrintInner(node BLangNode) {
 		p.printValueType(t)
 	case *BLangBuiltInRefTypeNode:
 		p.printBuiltInRefTypeNode(t)
+	case *BLangUserDefinedType:
+		p.printUserDefinedType(t)
 	case *BLangUnaryExpr:
 		p.printUnaryExpr(t)
 	case *BLangSimpleVariableDef:
 		p.printSimpleVariableDef(t)
 	case *BLangGroupExpr:
 		p.printGroupExpr(t)
+	case *BLangTernaryExpr:
+		p.printTernaryExpr(t)
 	case *BLangWhile:
 		p.printWhile(t)
+	case *BLangForeach:
+		p.printForeach(t)
 	case *BLangArrayType:
 		p.printArrayType(t)
 	case *BLangConstant:
@@ -92,6 +104,124 @@ func (p *PrettyPrinter) PrintInner(node BLangNode) {
 		p.printIndexBasedAccess(t)
 	case *BLangWildCardBindingPattern:
 		p.printWildCardBindingPattern(t)
+	case *BLangStringTemplateLiteral:
+		p.printStringTemplateLiteral(t)
+	case *BLangRawTemplateLiteral:
+		p.printRawTemplateLiteral(t)
+	case *BLangConstrainedType:
+		p.printConstrainedType(t)
+	case *BLangTypeDefinition:
+		p.printTypeDefinition(t)
+	case *BLangUnionTypeNode:
+		p.printUnionTypeNode(t)
+	case *BLangIntersectionTypeNode:
+		p.printIntersectionTypeNode(t)
+	case *BLangTupleTypeNode:
+		p.printTupleTypeNode(t)
+	case *BLangFiniteTypeNode:
+		p.printFiniteTypeNode(t)
+	case *BLangXMLNS:
+		p.printXMLNS(t, "xmlns")
+	case *BLangXMLNSStatement:
+		p.printXMLNS(&t.XMLNSDecl.BLangXMLNS, "xmlns-stmt")
+	case *BLangXMLQName:
+		p.printXMLQName(t)
+	case *BLangXMLAttribute:
+		p.printXMLAttribute(t)
+	case *BLangXMLQuotedString:
+		p.printXMLQuotedString(t)
+	case *BLangXMLElementLiteral:
+		p.printXMLElementLiteral(t)
+	case *BLangXMLTextLiteral:
+		p.printXMLFragments("xml-text-literal", t.TextFragments)
+	case *BLangXMLCommentLiteral:
+		p.printXMLFragments("xml-comment-literal", t.TextFragments)
+	case *BLangXMLProcInsLiteral:
+		p.printXMLProcInsLiteral(t)
+	case *BLangXMLSequenceLiteral:
+		p.printXMLFragments("xml-sequence-literal", t.XMLItems)
+	case *BLangRegExpTemplateLiteral:
+		p.printRegExpTemplateLiteral(t)
+	case *BLangReDisjunction:
+		p.printReDisjunction(t)
+	case *BLangReSequence:
+		p.printReSequence(t)
+	case *BLangReAtomQuantifier:
+		p.printReAtomQuantifier(t)
+	case *BLangReAtomCharOrEscape:
+		p.printReAtomCharOrEscape(t)
+	case *BLangReCharacterClass:
+		p.printReCharacterClass(t)
+	case *BLangReCharSet:
+		p.printReCharSet(t)
+	case *BLangReCharSetRange:
+		p.printReCharSetRange(t)
+	case *BLangReCapturingGroups:
+		p.printReCapturingGroups(t)
+	case *BLangReFlagExpression:
+		p.printReFlagExpression(t)
+	case *BLangReFlagsOnOff:
+		p.printReFlagsOnOff(t)
+	case *BLangReQuantifier:
+		p.printReQuantifier(t)
+	case *BLangReAssertion:
+		p.printReAssertion(t)
+	case *BLangLambdaFunction:
+		p.printLambdaFunction(t)
+	case *BLangForkJoin:
+		p.printForkJoin(t)
+	case *BLangLock:
+		p.printLock(t)
+	case *BLangListConstructorExpr:
+		p.printListConstructorExpr(t)
+	case *BLangRecordLiteral:
+		p.printRecordLiteral(t)
+	case *BLangRecordTypeNode:
+		p.printRecordTypeNode(t)
+	case *BLangTableTypeNode:
+		p.printTableTypeNode(t)
+	case *BLangTableKeySpecifier:
+		p.printTableKeySpecifier(t)
+	case *BLangTableKeyTypeConstraint:
+		p.printTableKeyTypeConstraint(t)
+	case *BLangStreamType:
+		p.printStreamType(t)
+	case *BLangTableConstructorExpr:
+		p.printTableConstructorExpr(t)
+	case *BLangFieldBaseAccess:
+		p.printFieldBaseAccess(t)
+	case *BLangQueryExpr:
+		p.printQueryExpr(t)
+	case *BLangFromClause:
+		p.printFromClause(t)
+	case *BLangWhereClause:
+		p.printWhereClause(t)
+	case *BLangLetClause:
+		p.printLetClause(t)
+	case *BLangSelectClause:
+		p.printSelectClause(t)
+	case *BLangAnnotation:
+		p.printAnnotation(t)
+	case *BLangAnnotationAttachment:
+		p.printAnnotationAttachment(t)
+	case *BLangAnnotAccessExpr:
+		p.printAnnotAccessExpr(t)
+	case *BLangWorkerAsyncSendExpr:
+		p.printWorkerSend("worker-async-send", &t.BLangWorkerSendExprBase)
+	case *BLangWorkerSyncSendExpr:
+		p.printWorkerSend("worker-sync-send", &t.BLangWorkerSendExprBase)
+	case *BLangWorkerReceive:
+		p.printWorkerReceive(t)
+	case *BLangAlternateWorkerReceive:
+		p.printAlternateWorkerReceive(t)
+	case *BLangMultipleWorkerReceive:
+		p.printMultipleWorkerReceive(t)
+	case *BLangWorkerFlushExpr:
+		p.printWorkerFlushExpr(t)
+	case *BLangWaitExpr:
+		p.printWaitExpr(t)
+	case *BLangWaitForAllExpr:
+		p.printWaitForAllExpr(t)
 	default:
 		fmt.Println(p.buffer.String())
 		panic("Unsupported node type: " + reflect.TypeOf(t).String())
@@ -127,6 +257,10 @@ func (p *PrettyPrinter) printCompilationUnit(node *BLangCompilationUnit) {
 	p.printBLangNodeBase(&node.BLangNodeBase)
 	p.indentLevel++
 	for _, topLevelNode := range node.TopLevelNodes {
+		if variable, ok := topLevelNode.(*BLangSimpleVariable); ok {
+			p.printModuleVariable(variable)
+			continue
+		}
 		p.PrintInner(topLevelNode.(BLangNode))
 	}
 	p.indentLevel--
@@ -211,6 +345,24 @@ func (p *PrettyPrinter) printFlags(flagSet interface{}) {
 		if checker.Contains(model.Flag_PRIVATE) {
 			p.printString("private")
 		}
+		if checker.Contains(model.Flag_DISTINCT) {
+			p.printString("distinct")
+		}
+		if checker.Contains(model.Flag_ISOLATED) {
+			p.printString("isolated")
+		}
+		if checker.Contains(model.Flag_FINAL) {
+			p.printString("final")
+		}
+		if checker.Contains(model.Flag_LISTENER) {
+			p.printString("listener")
+		}
+		if checker.Contains(model.Flag_REMOTE) {
+			p.printString("remote")
+		}
+		if checker.Contains(model.Flag_RESOURCE) {
+			p.printString("resource")
+		}
 		// Add more flags as needed
 	}
 }
@@ -249,6 +401,9 @@ func (p *PrettyPrinter) printBinaryExpr(node *BLangBinaryExpr) {
 func (p *PrettyPrinter) printInvocation(node *BLangInvocation) {
 	p.startNode()
 	p.printString("invocation")
+	if node.Async {
+		p.printString("async")
+	}
 
 	// Print function name with optional package alias
 	if node.PkgAlias != nil && node.PkgAlias.Value != "" {
@@ -337,10 +492,22 @@ func (p *PrettyPrinter) printValueType(node *BLangValueType) {
 func (p *PrettyPrinter) printBuiltInRefTypeNode(node *BLangBuiltInRefTypeNode) {
 	p.startNode()
 	p.printString("builtin-ref-type")
+	p.printFlags(&node.FlagSet)
 	p.printTypeKind(node.TypeKind)
 	p.endNode()
 }
 
+func (p *PrettyPrinter) printUserDefinedType(node *BLangUserDefinedType) {
+	p.startNode()
+	p.printString("user-defined-type")
+	if node.PkgAlias.Value != "" {
+		p.printString(node.PkgAlias.Value + " " + node.TypeName.Value)
+	} else {
+		p.printString(node.TypeName.Value)
+	}
+	p.endNode()
+}
+
 // Variable and function body printers
 func (p *PrettyPrinter) printSimpleVariable(node *BLangSimpleVariable) {
 	p.startNode()
@@ -353,6 +520,7 @@ func (p *PrettyPrinter) printSimpleVariable(node *BLangSimpleVariable) {
 		p.indentLevel--
 		p.printSticky(")")
 	}
+	p.printAnnotationAttachments(node.AnnAttachments)
 	p.endNode()
 }
 
@@ -377,6 +545,7 @@ func (p *PrettyPrinter) printFunction(node *BLangFunction) {
 
 	// Print function name
 	p.printString(node.Name.Value)
+	p.printAnnotationAttachments(node.AnnAttachments)
 
 	// Print parameters if present
 	p.printString("(")
@@ -396,6 +565,7 @@ func (p *PrettyPrinter) printFunction(node *BLangFunction) {
 		p.PrintInner(node.ReturnTypeNode.(BLangNode))
 		p.indentLevel--
 	}
+	p.printAnnotationAttachments(node.ReturnTypeAnnAttachments)
 
 	p.printSticky(")")
 	// Print function body if present
@@ -408,6 +578,86 @@ func (p *PrettyPrinter) printFunction(node *BLangFunction) {
 	p.endNode()
 }
 
+func (p *PrettyPrinter) printResourceFunction(node *BLangResourceFunction) {
+	p.startNode()
+	p.printString("resource-function")
+	p.printString(node.MethodName.Value)
+	p.printString("(path")
+	for _, segment := range node.ResourcePath {
+		p.printString(segment.Value)
+	}
+	p.printSticky(")")
+	p.indentLevel++
+	for i := range node.PathParams {
+		p.PrintInner(&node.PathParams[i])
+	}
+	if node.RestPathParam != nil {
+		p.PrintInner(node.RestPathParam)
+	}
+	p.printFunction(&node.BLangFunction)
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printService(node *BLangService) {
+	p.startNode()
+	p.printString("service")
+	p.printFlags(&node.FlagSet)
+	p.printString(node.Name.Value)
+	p.printAnnotationAttachments(node.GetAnnotationAttachments())
+	if node.ServiceNameLiteral != nil {
+		p.printString("(path")
+		p.indentLevel++
+		p.PrintInner(node.ServiceNameLiteral)
+		p.indentLevel--
+		p.printSticky(")")
+	} else if node.AbsoluteResourcePath != nil {
+		p.printString("(path")
+		for _, segment := range node.AbsoluteResourcePath {
+			p.printString(segment.GetValue())
+		}
+		p.printSticky(")")
+	}
+	p.printString("(on")
+	p.indentLevel++
+	for _, expr := range node.AttachedExprs {
+		p.PrintInner(expr)
+	}
+	p.indentLevel--
+	p.printSticky(")")
+	p.indentLevel++
+	p.startNode()
+	p.printString("service-class")
+	p.printString(node.ServiceClass.Name.Value)
+	p.indentLevel++
+	for i := range node.ServiceClass.Functions {
+		p.PrintInner(&node.ServiceClass.Functions[i])
+	}
+	p.indentLevel--
+	p.endNode()
+	for i := range node.ResourceFunctions {
+		p.PrintInner(&node.ResourceFunctions[i])
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printTypeInit(node *BLangTypeInit) {
+	p.startNode()
+	p.printString("type-init-expr")
+	p.indentLevel++
+	if node.UserDefinedType != nil {
+		p.PrintInner(node.UserDefinedType.(BLangNode))
+	}
+	p.printString("(")
+	for _, arg := range node.ArgsExpr {
+		p.PrintInner(arg)
+	}
+	p.printSticky(")")
+	p.indentLevel--
+	p.endNode()
+}
+
 // Unary expression printer
 func (p *PrettyPrinter) printUnaryExpr(node *BLangUnaryExpr) {
 	p.startNode()
@@ -425,6 +675,9 @@ func (p *PrettyPrinter) printSimpleVariableDef(node *BLangSimpleVariableDef) {
 	p.printString("var-def")
 	p.indentLevel++
 	p.PrintInner(&node.Var)
+	if node.IsWorker {
+		p.PrintInner(node.Var.Expr.(BLangNode))
+	}
 	if node.IsInFork {
 		p.printString("in-fork")
 	}
@@ -445,6 +698,17 @@ func (p *PrettyPrinter) printGroupExpr(node *BLangGroupExpr) {
 	p.endNode()
 }
 
+func (p *PrettyPrinter) printTernaryExpr(node *BLangTernaryExpr) {
+	p.startNode()
+	p.printString("ternary-expr")
+	p.indentLevel++
+	p.PrintInner(node.Expr.(BLangNode))
+	p.PrintInner(node.ThenExpr.(BLangNode))
+	p.PrintInner(node.ElseExpr.(BLangNode))
+	p.indentLevel--
+	p.endNode()
+}
+
 // While loop printer
 func (p *PrettyPrinter) printWhile(node *BLangWhile) {
 	p.startNode()
@@ -457,6 +721,17 @@ func (p *PrettyPrinter) printWhile(node *BLangWhile) {
 	p.endNode()
 }
 
+func (p *PrettyPrinter) printForeach(node *BLangForeach) {
+	p.startNode()
+	p.printString("foreach")
+	p.indentLevel++
+	p.PrintInner(&node.VariableDefinitionNode.Var)
+	p.PrintInner(node.Collection)
+	p.PrintInner(&node.Body)
+	p.indentLevel--
+	p.endNode()
+}
+
 // Array type printer
 func (p *PrettyPrinter) printArrayType(node *BLangArrayType) {
 	p.startNode()
@@ -500,6 +775,26 @@ func (p *PrettyPrinter) printConstant(node *BLangConstant) {
 	p.endNode()
 }
 
+func (p *PrettyPrinter) printModuleVariable(node *BLangSimpleVariable) {
+	p.startNode()
+	p.printString("module-variable")
+	p.printFlags(node.FlagSet)
+	p.printString(node.Name.Value)
+	p.printString("(")
+	if node.TypeNode != nil {
+		p.indentLevel++
+		p.PrintInner(node.TypeNode.(BLangNode))
+		p.indentLevel--
+	}
+	p.printSticky(")")
+	p.printString("(")
+	p.indentLevel++
+	p.PrintInner(node.Expr.(BLangNode))
+	p.indentLevel--
+	p.printSticky(")")
+	p.endNode()
+}
+
 // Break statement printer
 func (p *PrettyPrinter) printBreak(node *BLangBreak) {
 	p.startNode()
@@ -542,3 +837,687 @@ func (p *PrettyPrinter) printWildCardBindingPattern(node *BLangWildCardBindingPa
 	p.printString("wildcard-binding-pattern")
 	p.endNode()
 }
+
+// String template literal printer
+func (p *PrettyPrinter) printStringTemplateLiteral(node *BLangStringTemplateLiteral) {
+	p.startNode()
+	p.printString("string-template-literal")
+	p.indentLevel++
+	for _, expr := range node.Exprs {
+		p.PrintInner(expr.(BLangNode))
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+// Raw template literal printer
+func (p *PrettyPrinter) printRawTemplateLiteral(node *BLangRawTemplateLiteral) {
+	p.startNode()
+	p.printString("raw-template-literal")
+	p.indentLevel++
+	p.printString("(strings")
+	for _, str := range node.Strings {
+		p.PrintInner(str)
+	}
+	p.printSticky(")")
+	p.printString("(insertions")
+	for _, insertion := range node.Insertions {
+		p.PrintInner(insertion.(BLangNode))
+	}
+	p.printSticky(")")
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printConstrainedType(node *BLangConstrainedType) {
+	p.startNode()
+	p.printString("constrained-type")
+	p.indentLevel++
+	p.PrintInner(node.Type.(BLangNode))
+	p.PrintInner(node.Constraint.(BLangNode))
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printTypeDefinition(node *BLangTypeDefinition) {
+	p.startNode()
+	p.printString("type-definition")
+	p.printFlags(&node.flagSet)
+	p.printString(node.name.Value)
+	p.printAnnotationAttachments(node.GetAnnotationAttachments())
+	p.indentLevel++
+	p.PrintInner(node.typeNode.(BLangNode))
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printUnionTypeNode(node *BLangUnionTypeNode) {
+	p.startNode()
+	p.printString("union-type")
+	p.printFlags(&node.FlagSet)
+	p.printTypeNodes(node.MemberTypeNodes)
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printIntersectionTypeNode(node *BLangIntersectionTypeNode) {
+	p.startNode()
+	p.printString("intersection-type")
+	p.printFlags(&node.FlagSet)
+	p.printTypeNodes(node.ConstituentTypeNodes)
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printTupleTypeNode(node *BLangTupleTypeNode) {
+	p.startNode()
+	p.printString("tuple-type")
+	p.printTypeNodes(node.MemberTypeNodes)
+	if node.RestParamType != nil {
+		p.printString("(rest")
+		p.indentLevel++
+		p.PrintInner(node.RestParamType.(BLangNode))
+		p.indentLevel--
+		p.printSticky(")")
+	}
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printFiniteTypeNode(node *BLangFiniteTypeNode) {
+	p.startNode()
+	p.printString("finite-type")
+	p.indentLevel++
+	for _, value := range node.ValueSpace {
+		p.PrintInner(value.(BLangNode))
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printTypeNodes(typeNodes []model.TypeNode) {
+	p.indentLevel++
+	for _, typeNode := range typeNodes {
+		p.PrintInner(typeNode.(BLangNode))
+	}
+	p.indentLevel--
+}
+
+// XML literal printers
+func (p *PrettyPrinter) printXMLNS(node *BLangXMLNS, label string) {
+	p.startNode()
+	p.printString(label)
+	if node.prefix.Value != "" {
+		p.printString(node.prefix.Value)
+	}
+	p.indentLevel++
+	p.PrintInner(node.namespaceURI)
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printXMLQName(node *BLangXMLQName) {
+	p.startNode()
+	p.printString("xml-qname")
+	if node.Prefix.Value != "" {
+		p.printString(node.Prefix.Value + ":" + node.LocalName.Value)
+	} else {
+		p.printString(node.LocalName.Value)
+	}
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printXMLAttribute(node *BLangXMLAttribute) {
+	p.startNode()
+	p.printString("xml-attribute")
+	if node.IsNamespaceDeclr {
+		p.printString("namespace-declaration")
+	}
+	p.indentLevel++
+	p.PrintInner(node.Name)
+	p.PrintInner(node.Value)
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printXMLQuotedString(node *BLangXMLQuotedString) {
+	label := "xml-quoted-string"
+	if node.QuoteType == XMLQuoteType_SINGLE_QUOTE {
+		label += " single-quote"
+	}
+	p.printXMLFragments(label, node.TextFragments)
+}
+
+func (p *PrettyPrinter) printXMLElementLiteral(node *BLangXMLElementLiteral) {
+	p.startNode()
+	p.printString("xml-element-literal")
+	p.indentLevel++
+	p.PrintInner(node.StartTagName)
+	if node.EndTagName != nil {
+		p.PrintInner(node.EndTagName)
+	}
+	p.printString("(attributes")
+	for _, attribute := range node.Attributes {
+		p.PrintInner(attribute)
+	}
+	p.printSticky(")")
+	p.printString("(children")
+	for _, child := range node.Children {
+		p.PrintInner(child.(BLangNode))
+	}
+	p.printSticky(")")
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printXMLProcInsLiteral(node *BLangXMLProcInsLiteral) {
+	p.startNode()
+	p.printString("xml-pi-literal")
+	p.indentLevel++
+	p.PrintInner(node.Target)
+	for _, fragment := range node.DataFragments {
+		p.PrintInner(fragment.(BLangNode))
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printXMLFragments(label string, fragments []BLangExpression) {
+	p.startNode()
+	p.printString(label)
+	p.indentLevel++
+	for _, fragment := range fragments {
+		p.PrintInner(fragment.(BLangNode))
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+// RegExp literal printers
+func (p *PrettyPrinter) printRegExpTemplateLiteral(node *BLangRegExpTemplateLiteral) {
+	p.startNode()
+	p.printString("regexp-template-literal")
+	p.indentLevel++
+	p.PrintInner(node.ReDisjunction)
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printReDisjunction(node *BLangReDisjunction) {
+	p.startNode()
+	p.printString("re-disjunction")
+	p.indentLevel++
+	for _, sequence := range node.SequenceList {
+		p.PrintInner(sequence)
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printReSequence(node *BLangReSequence) {
+	p.startNode()
+	p.printString("re-sequence")
+	p.indentLevel++
+	for _, term := range node.TermList {
+		p.PrintInner(term.(BLangNode))
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printReAtomQuantifier(node *BLangReAtomQuantifier) {
+	p.startNode()
+	p.printString("re-atom-quantifier")
+	p.indentLevel++
+	p.PrintInner(node.Atom.(BLangNode))
+	if node.Quantifier != nil {
+		p.PrintInner(node.Quantifier)
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printReAtomCharOrEscape(node *BLangReAtomCharOrEscape) {
+	p.startNode()
+	p.printString("re-atom-char-or-escape")
+	p.indentLevel++
+	p.PrintInner(node.CharOrEscape.(BLangNode))
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printReCharacterClass(node *BLangReCharacterClass) {
+	p.startNode()
+	p.printString("re-character-class")
+	if node.Negation != nil {
+		p.printString("negated")
+	}
+	p.indentLevel++
+	p.PrintInner(node.CharSet)
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printReCharSet(node *BLangReCharSet) {
+	p.startNode()
+	p.printString("re-char-set")
+	p.indentLevel++
+	for _, atom := range node.CharSetAtoms {
+		p.PrintInner(atom.(BLangNode))
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printReCharSetRange(node *BLangReCharSetRange) {
+	p.startNode()
+	p.printString("re-char-set-range")
+	p.indentLevel++
+	p.PrintInner(node.LhsCharSetAtom.(BLangNode))
+	p.PrintInner(node.RhsCharSetAtom.(BLangNode))
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printReCapturingGroups(node *BLangReCapturingGroups) {
+	p.startNode()
+	p.printString("re-capturing-group")
+	p.indentLevel++
+	if node.FlagExpr != nil {
+		p.PrintInner(node.FlagExpr)
+	}
+	p.PrintInner(node.Disjunction)
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printReFlagExpression(node *BLangReFlagExpression) {
+	p.startNode()
+	p.printString("re-flag-expression")
+	p.indentLevel++
+	p.PrintInner(node.FlagsOnOff)
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printReFlagsOnOff(node *BLangReFlagsOnOff) {
+	p.startNode()
+	p.printString("re-flags-on-off")
+	p.printString(fmt.Sprintf("%v", node.Flags.Value))
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printReQuantifier(node *BLangReQuantifier) {
+	p.startNode()
+	p.printString("re-quantifier")
+	p.printString(fmt.Sprintf("%v", node.Quantifier.Value))
+	if node.NonGreedyChar != nil {
+		p.printString("non-greedy")
+	}
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printReAssertion(node *BLangReAssertion) {
+	p.startNode()
+	p.printString("re-assertion")
+	p.printString(fmt.Sprintf("%v", node.Assertion.Value))
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printLambdaFunction(node *BLangLambdaFunction) {
+	p.startNode()
+	p.printString("lambda")
+	p.indentLevel++
+	p.PrintInner(node.Function)
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printForkJoin(node *BLangForkJoin) {
+	p.startNode()
+	p.printString("fork-join")
+	for _, worker := range node.Workers {
+		p.printString(worker.Var.Name.Value)
+	}
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printListConstructorExpr(node *BLangListConstructorExpr) {
+	p.startNode()
+	p.printString("list-constructor-expr")
+	p.indentLevel++
+	for _, expr := range node.Exprs {
+		p.PrintInner(expr.(BLangNode))
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printRecordLiteral(node *BLangRecordLiteral) {
+	p.startNode()
+	p.printString("record-literal")
+	p.indentLevel++
+	for _, field := range node.Fields {
+		p.startNode()
+		p.printString("key-value")
+		if field.Readonly {
+			p.printString("readonly")
+		}
+		p.printString(field.Key.Name())
+		p.indentLevel++
+		p.PrintInner(field.ValueExpr)
+		p.indentLevel--
+		p.endNode()
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printTableTypeNode(node *BLangTableTypeNode) {
+	p.startNode()
+	p.printString("table-type")
+	p.indentLevel++
+	p.PrintInner(node.Constraint.(BLangNode))
+	if node.TableKeySpecifier != nil {
+		p.PrintInner(node.TableKeySpecifier)
+	}
+	if node.TableKeyTypeConstraint != nil {
+		p.PrintInner(node.TableKeyTypeConstraint)
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printStreamType(node *BLangStreamType) {
+	p.startNode()
+	p.printString("stream-type")
+	p.indentLevel++
+	if node.Constraint != nil {
+		p.PrintInner(node.Constraint.(BLangNode))
+	}
+	if node.Error != nil {
+		p.PrintInner(node.Error.(BLangNode))
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printTableKeySpecifier(node *BLangTableKeySpecifier) {
+	p.startNode()
+	p.printString("key")
+	for _, fieldName := range node.FieldNameIdentifierList {
+		p.printString(fieldName.Value)
+	}
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printTableKeyTypeConstraint(node *BLangTableKeyTypeConstraint) {
+	p.startNode()
+	p.printString("key-type")
+	p.indentLevel++
+	p.PrintInner(node.KeyType.(BLangNode))
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printTableConstructorExpr(node *BLangTableConstructorExpr) {
+	p.startNode()
+	p.printString("table-constructor")
+	p.indentLevel++
+	if node.TableKeySpecifier != nil {
+		p.PrintInner(node.TableKeySpecifier)
+	}
+	for _, row := range node.RecordLiteralList {
+		p.PrintInner(row)
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printFieldBaseAccess(node *BLangFieldBaseAccess) {
+	p.startNode()
+	p.printString("field-based-access")
+	p.printString(node.Field.Value)
+	p.indentLevel++
+	p.PrintInner(node.Expr.(BLangNode))
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printQueryExpr(node *BLangQueryExpr) {
+	p.startNode()
+	p.printString("query-expr")
+	if node.IsTable {
+		p.printString("table")
+	}
+	if node.IsStream {
+		p.printString("stream")
+	}
+	p.indentLevel++
+	if node.TableKeySpecifier != nil {
+		p.PrintInner(node.TableKeySpecifier)
+	}
+	for _, clause := range node.QueryClauseList {
+		p.PrintInner(clause)
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printFromClause(node *BLangFromClause) {
+	p.startNode()
+	p.printString("from-clause")
+	p.indentLevel++
+	p.PrintInner(&node.VariableDefinitionNode.Var)
+	p.PrintInner(node.Collection)
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printWhereClause(node *BLangWhereClause) {
+	p.startNode()
+	p.printString("where-clause")
+	p.indentLevel++
+	p.PrintInner(node.Expression)
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printLetClause(node *BLangLetClause) {
+	p.startNode()
+	p.printString("let-clause")
+	p.indentLevel++
+	for _, letVarDeclaration := range node.LetVarDeclarations {
+		p.PrintInner(&letVarDeclaration.Var)
+		p.PrintInner(letVarDeclaration.Var.Expr.(BLangNode))
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printSelectClause(node *BLangSelectClause) {
+	p.startNode()
+	p.printString("select-clause")
+	p.indentLevel++
+	p.PrintInner(node.Expression)
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printRecordTypeNode(node *BLangRecordTypeNode) {
+	p.startNode()
+	p.printString("record-type")
+	if node.Sealed {
+		p.printString("closed")
+	}
+	p.indentLevel++
+	for i := range node.Fields {
+		field := &node.Fields[i]
+		p.startNode()
+		p.printString("field")
+		if field.FlagSet.Contains(model.Flag_READONLY) {
+			p.printString("readonly")
+		}
+		p.printString(field.Name.Value)
+		if field.FlagSet.Contains(model.Flag_OPTIONAL) {
+			p.printSticky("?")
+		}
+		p.indentLevel++
+		p.PrintInner(field.TypeNode.(BLangNode))
+		p.indentLevel--
+		p.printAnnotationAttachments(field.AnnAttachments)
+		p.endNode()
+	}
+	if node.RestFieldType != nil {
+		p.printString("(rest")
+		p.indentLevel++
+		p.PrintInner(node.RestFieldType.(BLangNode))
+		p.indentLevel--
+		p.printSticky(")")
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printAnnotation(node *BLangAnnotation) {
+	p.startNode()
+	p.printString("annotation")
+	p.printFlags(&node.FlagSet)
+	if node.FlagSet.Contains(model.Flag_CONSTANT) {
+		p.printString("const")
+	}
+	p.printString(node.Name.Value)
+	if node.TypeNode != nil {
+		p.indentLevel++
+		p.PrintInner(node.TypeNode.(BLangNode))
+		p.indentLevel--
+	}
+	p.printString("(on")
+	for _, attachPoint := range node.GetAttachPoints() {
+		if attachPoint.Source {
+			p.printString("source")
+		}
+		p.printString(string(attachPoint.Point))
+	}
+	p.printSticky(")")
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printAnnotationAttachments(attachments []model.AnnotationAttachmentNode) {
+	p.indentLevel++
+	for _, attachment := range attachments {
+		p.PrintInner(attachment.(BLangNode))
+	}
+	p.indentLevel--
+}
+
+func (p *PrettyPrinter) printAnnotationAttachment(node *BLangAnnotationAttachment) {
+	p.startNode()
+	p.printString("annotation-attachment")
+	p.printAnnotationName(node.PkgAlias, node.AnnotationName)
+	if node.Expr != nil {
+		p.indentLevel++
+		p.PrintInner(node.Expr)
+		p.indentLevel--
+	}
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printAnnotAccessExpr(node *BLangAnnotAccessExpr) {
+	p.startNode()
+	p.printString("annot-access-expr")
+	p.printAnnotationName(node.PkgAlias, node.AnnotationName)
+	p.indentLevel++
+	p.PrintInner(node.Expr)
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printAnnotationName(pkgAlias *BLangIdentifier, name *BLangIdentifier) {
+	if pkgAlias != nil && pkgAlias.Value != "" {
+		p.printString(pkgAlias.Value + ":" + name.Value)
+	} else {
+		p.printString(name.Value)
+	}
+}
+
+func (p *PrettyPrinter) printLock(node *BLangLock) {
+	p.startNode()
+	p.printString("lock")
+	p.indentLevel++
+	p.PrintInner(&node.Body)
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printWorkerSend(label string, node *BLangWorkerSendExprBase) {
+	p.startNode()
+	p.printString(label)
+	p.printString(node.WorkerIdentifier.Value)
+	p.indentLevel++
+	p.PrintInner(node.Expr.(BLangNode))
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printWorkerReceive(node *BLangWorkerReceive) {
+	p.startNode()
+	p.printString("worker-receive")
+	p.printString(node.WorkerIdentifier.Value)
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printAlternateWorkerReceive(node *BLangAlternateWorkerReceive) {
+	p.startNode()
+	p.printString("alternate-worker-receive")
+	for _, workerReceive := range node.GetWorkerReceives() {
+		p.printString(workerReceive.WorkerIdentifier.Value)
+	}
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printMultipleWorkerReceive(node *BLangMultipleWorkerReceive) {
+	p.startNode()
+	p.printString("multiple-worker-receive")
+	for _, receiveField := range node.ReceiveFields {
+		p.printString("(" + receiveField.Key.Value)
+		p.printString(receiveField.WorkerReceive.WorkerIdentifier.Value)
+		p.printSticky(")")
+	}
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printWorkerFlushExpr(node *BLangWorkerFlushExpr) {
+	p.startNode()
+	p.printString("worker-flush")
+	if node.WorkerIdentifier != nil {
+		p.printString(node.WorkerIdentifier.Value)
+	}
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printWaitExpr(node *BLangWaitExpr) {
+	p.startNode()
+	p.printString("wait-expr")
+	p.indentLevel++
+	for _, expr := range node.ExprList {
+		p.PrintInner(expr.(BLangNode))
+	}
+	p.indentLevel--
+	p.endNode()
+}
+
+func (p *PrettyPrinter) printWaitForAllExpr(node *BLangWaitForAllExpr) {
+	p.startNode()
+	p.printString("wait-for-all-expr")
+	p.indentLevel++
+	for _, keyValue := range node.KeyValuePairs {
+		p.startNode()
+		p.printString(keyValue.Key.Value)
+		if keyValue.ValueExpr != nil {
+			p.indentLevel++
+			p.PrintInner(keyValue.ValueExpr.(BLangNode))
+			p.indentLevel--
+		}
+		p.endNode()
+	}
+	p.indentLevel--
+	p.endNode()
+}
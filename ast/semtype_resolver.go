@@ -0,0 +1,748 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+)
+
+// SemTypeResolver resolves type definitions and type descriptors to semtypes.
+//
+// Type definitions are resolved lazily, in the order they are referenced. A reference to a type definition that is
+// being resolved is only valid if it goes through a list or mapping constructor, in which case the recursion is
+// broken by the ListDefinition or MappingDefinition cached on the constructor's type node. Such references are
+// resolved at a greater depth, so a reference found at the same depth as the type definition is an invalid cycle.
+type SemTypeResolver struct {
+	env semtypes.Env
+	cx  semtypes.Context
+	// type definitions and constants in scope, keyed by name
+	defns map[string]BLangNode
+	// type definitions in scope, in the order they were added
+	typeDefns []*BLangTypeDefinition
+	// lookups of the types of imported modules, keyed by import prefix
+	imports map[string]ImportedTypeLookup
+	parent  *SemTypeResolver
+}
+
+// ImportedTypeLookup returns the type named name of an imported module. It panics if the module has no such type
+// or if the type is not visible to the importing module.
+type ImportedTypeLookup func(name string) semtypes.SemType
+
+// NewSemTypeResolver creates a resolver for the module level type definitions and constants of pkg.
+func NewSemTypeResolver(env semtypes.Env, pkg *BLangPackage) *SemTypeResolver {
+	r := &SemTypeResolver{
+		env:   env,
+		cx:    semtypes.TypeCheckContext(env),
+		defns: make(map[string]BLangNode),
+	}
+	for i := range pkg.TypeDefinitions {
+		r.AddTypeDefinition(&pkg.TypeDefinitions[i])
+	}
+	for i := range pkg.Constants {
+		r.defns[pkg.Constants[i].Name.Value] = &pkg.Constants[i]
+	}
+	return r
+}
+
+// NewScope creates a resolver for a nested scope, such as a function body, in which local type definitions shadow
+// the definitions of this resolver.
+func (r *SemTypeResolver) NewScope() *SemTypeResolver {
+	return &SemTypeResolver{env: r.env, cx: r.cx, defns: make(map[string]BLangNode), parent: r}
+}
+
+// AddTypeDefinition makes typeDefinition visible in the scope of the resolver.
+func (r *SemTypeResolver) AddTypeDefinition(typeDefinition *BLangTypeDefinition) {
+	r.defns[typeDefinition.name.Value] = typeDefinition
+	r.typeDefns = append(r.typeDefns, typeDefinition)
+}
+
+// ResolveTypeDefinitions resolves all the type definitions in the scope of the resolver.
+func (r *SemTypeResolver) ResolveTypeDefinitions() {
+	for _, typeDefinition := range r.typeDefns {
+		r.ResolveTypeDefinition(typeDefinition)
+	}
+}
+
+// ResolveTypeDefinition resolves typeDefinition, which must be in the scope of the resolver.
+func (r *SemTypeResolver) ResolveTypeDefinition(typeDefinition *BLangTypeDefinition) semtypes.SemType {
+	return r.resolveTypeDefn(typeDefinition, 0)
+}
+
+// ResolveTypeNode resolves a type descriptor that is not part of a type definition, such as the type of a variable.
+func (r *SemTypeResolver) ResolveTypeNode(typeNode model.TypeNode) semtypes.SemType {
+	return r.resolveTypeDesc(nil, 0, typeNode)
+}
+
+// SupportsTypeNode reports whether ResolveTypeNode can resolve typeNode. The type definitions typeNode refers to are
+// assumed to be resolvable, since the definitions in scope are resolved as they are defined.
+func (r *SemTypeResolver) SupportsTypeNode(typeNode model.TypeNode) bool {
+	supportsAll := func(typeNodes ...model.TypeNode) bool {
+		for _, typeNode := range typeNodes {
+			if typeNode != nil && !r.SupportsTypeNode(typeNode) {
+				return false
+			}
+		}
+		return true
+	}
+	switch td := typeNode.(type) {
+	case *BLangValueType:
+		return isSupportedValueType(td.TypeKind)
+	case *BLangBuiltInRefTypeNode:
+		switch td.TypeKind {
+		case model.TypeKind_ERROR, model.TypeKind_XML, model.TypeKind_MAP, model.TypeKind_TYPEDESC,
+			model.TypeKind_FUTURE:
+			return true
+		}
+		return isSupportedValueType(td.TypeKind)
+	case *BLangUserDefinedType:
+		if td.PkgAlias.Value != "" {
+			if r.lookupImport(td.PkgAlias.Value) != nil {
+				return true
+			}
+			switch td.TypeName.Value {
+			case "Element", "Comment", "Text", "ProcessingInstruction":
+				return td.PkgAlias.Value == "xml"
+			}
+			return false
+		}
+		switch defn := r.lookup(td.TypeName.Value).(type) {
+		case *BLangTypeDefinition:
+			return true
+		case *BLangConstant:
+			return defn.AssociatedTypeDefinition != nil
+		}
+		return false
+	case *BLangFiniteTypeNode:
+		for _, value := range td.ValueSpace {
+			if _, ok := literalShape(value); !ok {
+				return false
+			}
+		}
+		return true
+	case *BLangUnionTypeNode:
+		return supportsAll(td.MemberTypeNodes...)
+	case *BLangIntersectionTypeNode:
+		return supportsAll(td.ConstituentTypeNodes...)
+	case *BLangArrayType:
+		if len(td.Sizes) < td.Dimensions {
+			return false
+		}
+		for _, size := range td.Sizes {
+			if literal, ok := size.(*BLangLiteral); !ok {
+				return false
+			} else if _, ok := literal.Value.(int64); !ok {
+				if _, ok := literal.Value.(int); !ok {
+					return false
+				}
+			}
+		}
+		return supportsAll(td.Elemtype)
+	case *BLangTupleTypeNode:
+		return supportsAll(td.MemberTypeNodes...) && supportsAll(td.RestParamType)
+	case *BLangConstrainedType:
+		refType, ok := td.Type.(*BLangBuiltInRefTypeNode)
+		if !ok {
+			return false
+		}
+		switch refType.TypeKind {
+		case model.TypeKind_MAP, model.TypeKind_XML, model.TypeKind_ERROR, model.TypeKind_FUTURE:
+			return supportsAll(td.Constraint)
+		}
+		return false
+	case *BLangRecordTypeNode:
+		for i := range td.Fields {
+			if !supportsAll(td.Fields[i].TypeNode) {
+				return false
+			}
+		}
+		return supportsAll(td.RestFieldType)
+	case *BLangTableTypeNode:
+		if td.TableKeyTypeConstraint != nil && !supportsAll(td.TableKeyTypeConstraint.KeyType) {
+			return false
+		}
+		return supportsAll(td.Constraint)
+	case *BLangStreamType:
+		return supportsAll(td.Constraint, td.Error)
+	default:
+		return false
+	}
+}
+
+func isSupportedValueType(typeKind model.TypeKind) bool {
+	switch typeKind {
+	case model.TypeKind_INT, model.TypeKind_BYTE, model.TypeKind_FLOAT, model.TypeKind_DECIMAL, model.TypeKind_STRING,
+		model.TypeKind_BOOLEAN, model.TypeKind_NIL, model.TypeKind_ANY, model.TypeKind_ANYDATA, model.TypeKind_JSON,
+		model.TypeKind_READONLY, model.TypeKind_HANDLE, model.TypeKind_NEVER:
+		return true
+	default:
+		return false
+	}
+}
+
+// LookupTypeDefinition returns the type definition named name in the scope of the resolver, or nil if there is none.
+func (r *SemTypeResolver) LookupTypeDefinition(name string) *BLangTypeDefinition {
+	typeDefinition, _ := r.lookup(name).(*BLangTypeDefinition)
+	return typeDefinition
+}
+
+// LookupConstant returns the constant named name in the scope of the resolver, or nil if there is none.
+func (r *SemTypeResolver) LookupConstant(name string) *BLangConstant {
+	constant, _ := r.lookup(name).(*BLangConstant)
+	return constant
+}
+
+// Context returns the type check context of the resolver's environment.
+func (r *SemTypeResolver) Context() semtypes.Context {
+	return r.cx
+}
+
+// AddImport makes the types of the module imported with prefix alias resolvable through lookup.
+func (r *SemTypeResolver) AddImport(alias string, lookup ImportedTypeLookup) {
+	if r.imports == nil {
+		r.imports = make(map[string]ImportedTypeLookup)
+	}
+	r.imports[alias] = lookup
+}
+
+func (r *SemTypeResolver) lookupImport(alias string) ImportedTypeLookup {
+	for scope := r; scope != nil; scope = scope.parent {
+		if lookup, ok := scope.imports[alias]; ok {
+			return lookup
+		}
+	}
+	return nil
+}
+
+func (r *SemTypeResolver) lookup(name string) BLangNode {
+	for scope := r; scope != nil; scope = scope.parent {
+		if defn, ok := scope.defns[name]; ok {
+			return defn
+		}
+	}
+	return nil
+}
+
+func (r *SemTypeResolver) resolveTypeDefn(defn *BLangTypeDefinition, depth int) semtypes.SemType {
+	if defn.semType != nil {
+		return defn.semType
+	}
+	if depth == defn.cycleDepth {
+		panic(fmt.Sprintf("invalid cyclic type reference in '%s'", defn.name.Value))
+	}
+	defn.cycleDepth = depth
+	s := r.resolveTypeDesc(defn, depth, defn.typeNode)
+	if defn.semType == nil {
+		// The type definition may already have been resolved by a recursive reference
+		defn.semType = s
+		defn.cycleDepth = -1
+	}
+	return s
+}
+
+func (r *SemTypeResolver) resolveTypeDesc(defn *BLangTypeDefinition, depth int, td model.TypeNode) semtypes.SemType {
+	switch td := td.(type) {
+	case *BLangValueType:
+		return r.resolveValueType(td.TypeKind)
+	case *BLangBuiltInRefTypeNode:
+		return r.resolveBuiltInRefType(td)
+	case *BLangUserDefinedType:
+		return r.resolveUserDefinedType(td, depth)
+	case *BLangFiniteTypeNode:
+		return resolveFiniteType(td)
+	case *BLangUnionTypeNode:
+		var result semtypes.SemType = &semtypes.NEVER
+		for _, member := range td.MemberTypeNodes {
+			result = semtypes.Union(result, r.resolveTypeDesc(defn, depth, member))
+		}
+		return result
+	case *BLangIntersectionTypeNode:
+		result := r.resolveTypeDesc(defn, depth, td.ConstituentTypeNodes[0])
+		for _, constituent := range td.ConstituentTypeNodes[1:] {
+			result = semtypes.Intersect(result, r.resolveTypeDesc(defn, depth, constituent))
+		}
+		return result
+	case *BLangArrayType:
+		return r.resolveArrayType(defn, depth, td)
+	case *BLangTupleTypeNode:
+		return r.resolveTupleType(defn, depth, td)
+	case *BLangConstrainedType:
+		return r.resolveConstrainedType(defn, depth, td)
+	case *BLangRecordTypeNode:
+		return r.resolveRecordType(defn, depth, td)
+	case *BLangTableTypeNode:
+		return r.resolveTableType(defn, depth, td)
+	case *BLangStreamType:
+		return r.resolveStreamType(defn, depth, td)
+	default:
+		panic(fmt.Sprintf("unsupported type descriptor: %T", td))
+	}
+}
+
+func (r *SemTypeResolver) resolveValueType(typeKind model.TypeKind) semtypes.SemType {
+	switch typeKind {
+	case model.TypeKind_INT:
+		return &semtypes.INT
+	case model.TypeKind_BYTE:
+		return semtypes.BYTE
+	case model.TypeKind_FLOAT:
+		return &semtypes.FLOAT
+	case model.TypeKind_DECIMAL:
+		return &semtypes.DECIMAL
+	case model.TypeKind_STRING:
+		return &semtypes.STRING
+	case model.TypeKind_BOOLEAN:
+		return &semtypes.BOOLEAN
+	case model.TypeKind_NIL:
+		return &semtypes.NIL
+	case model.TypeKind_ANY:
+		return &semtypes.ANY
+	case model.TypeKind_ANYDATA:
+		return semtypes.CreateAnydata(r.cx)
+	case model.TypeKind_JSON:
+		return semtypes.CreateJson(r.cx)
+	case model.TypeKind_READONLY:
+		return semtypes.VAL_READONLY
+	case model.TypeKind_HANDLE:
+		return &semtypes.HANDLE
+	case model.TypeKind_NEVER:
+		return &semtypes.NEVER
+	default:
+		panic(fmt.Sprintf("unsupported type: %s", typeKind))
+	}
+}
+
+func (r *SemTypeResolver) resolveBuiltInRefType(td *BLangBuiltInRefTypeNode) semtypes.SemType {
+	switch td.TypeKind {
+	case model.TypeKind_ERROR:
+		if td.FlagSet.Contains(model.Flag_DISTINCT) {
+			return r.distinctErrorType(&semtypes.ERROR)
+		}
+		return &semtypes.ERROR
+	case model.TypeKind_XML:
+		return &semtypes.XML
+	case model.TypeKind_MAP:
+		return &semtypes.MAPPING
+	case model.TypeKind_TYPEDESC:
+		return &semtypes.TYPEDESC
+	case model.TypeKind_FUTURE:
+		return &semtypes.FUTURE
+	default:
+		return r.resolveValueType(td.TypeKind)
+	}
+}
+
+func (r *SemTypeResolver) distinctErrorType(innerType semtypes.SemType) semtypes.SemType {
+	return semtypes.Intersect(semtypes.ErrorDistinct(semtypes.NextDistinctId(r.env)), innerType)
+}
+
+func (r *SemTypeResolver) resolveUserDefinedType(td *BLangUserDefinedType, depth int) semtypes.SemType {
+	var result semtypes.SemType
+	if td.PkgAlias.Value != "" {
+		if lookup := r.lookupImport(td.PkgAlias.Value); lookup != nil {
+			result = lookup(td.TypeName.Value)
+		} else {
+			result = resolveLangLibType(td)
+		}
+	} else {
+		switch defn := r.lookup(td.TypeName.Value).(type) {
+		case *BLangTypeDefinition:
+			result = r.resolveTypeDefn(defn, depth)
+		case *BLangConstant:
+			if defn.AssociatedTypeDefinition == nil {
+				panic(fmt.Sprintf("unsupported constant type reference: '%s'", td.TypeName.Value))
+			}
+			result = r.resolveTypeDefn(defn.AssociatedTypeDefinition, depth)
+		default:
+			panic(fmt.Sprintf("unknown type '%s'", td.TypeName.Value))
+		}
+	}
+	if td.FlagSet.Contains(model.Flag_DISTINCT) {
+		if !semtypes.IsSubtype(r.cx, result, &semtypes.ERROR) {
+			panic(fmt.Sprintf("unsupported distinct type: '%s'", td.TypeName.Value))
+		}
+		return r.distinctErrorType(result)
+	}
+	return result
+}
+
+func resolveLangLibType(td *BLangUserDefinedType) semtypes.SemType {
+	if td.PkgAlias.Value == "xml" {
+		switch td.TypeName.Value {
+		case "Element":
+			return semtypes.XML_ELEMENT
+		case "Comment":
+			return semtypes.XML_COMMENT
+		case "Text":
+			return semtypes.XML_TEXT
+		case "ProcessingInstruction":
+			return semtypes.XML_PI
+		}
+	}
+	panic(fmt.Sprintf("unsupported type reference: '%s:%s'", td.PkgAlias.Value, td.TypeName.Value))
+}
+
+func (r *SemTypeResolver) resolveArrayType(defn *BLangTypeDefinition, depth int, td *BLangArrayType) semtypes.SemType {
+	if td.defn != nil {
+		return td.defn.GetSemType(r.env)
+	}
+	ld := semtypes.NewListDefinition()
+	td.defn = &ld
+	accum := r.resolveTypeDesc(defn, depth+1, td.Elemtype)
+	for i := 0; i < td.Dimensions; i++ {
+		size := arraySize(td.Sizes[i])
+		if i == td.Dimensions-1 {
+			accum = resolveListInner(r.env, td.defn, size, accum)
+		} else {
+			innerDefn := semtypes.NewListDefinition()
+			accum = resolveListInner(r.env, &innerDefn, size, accum)
+		}
+	}
+	return accum
+}
+
+func arraySize(size BLangExpression) int {
+	literal, ok := size.(*BLangLiteral)
+	if !ok {
+		panic("unsupported array length")
+	}
+	switch value := literal.Value.(type) {
+	case int:
+		return value
+	case int64:
+		return int(value)
+	default:
+		panic("unsupported array length")
+	}
+}
+
+func resolveListInner(env semtypes.Env, ld *semtypes.ListDefinition, size int, elementType semtypes.SemType) semtypes.SemType {
+	if size != OPEN_ARRAY_INDICATOR {
+		return ld.DefineListTypeWrapped(env, []semtypes.SemType{elementType}, size, &semtypes.NEVER,
+			semtypes.CellMutability_CELL_MUT_LIMITED)
+	}
+	return ld.DefineListTypeWrapped(env, []semtypes.SemType{}, 0, elementType, semtypes.CellMutability_CELL_MUT_LIMITED)
+}
+
+func (r *SemTypeResolver) resolveTupleType(defn *BLangTypeDefinition, depth int, td *BLangTupleTypeNode) semtypes.SemType {
+	if td.defn != nil {
+		return td.defn.GetSemType(r.env)
+	}
+	ld := semtypes.NewListDefinition()
+	td.defn = &ld
+	members := make([]semtypes.SemType, len(td.MemberTypeNodes))
+	for i, member := range td.MemberTypeNodes {
+		members[i] = r.resolveTypeDesc(defn, depth+1, member)
+	}
+	var rest semtypes.SemType = &semtypes.NEVER
+	if td.RestParamType != nil {
+		rest = r.resolveTypeDesc(defn, depth+1, td.RestParamType)
+	}
+	return ld.DefineListTypeWrapped(r.env, members, len(members), rest, semtypes.CellMutability_CELL_MUT_LIMITED)
+}
+
+func (r *SemTypeResolver) resolveRecordType(defn *BLangTypeDefinition, depth int, td *BLangRecordTypeNode) semtypes.SemType {
+	if td.defn != nil {
+		return td.defn.GetSemType(r.env)
+	}
+	md := semtypes.NewMappingDefinition()
+	td.defn = &md
+	fields := make([]semtypes.Field, len(td.Fields))
+	names := make(map[string]bool)
+	for i := range td.Fields {
+		field := &td.Fields[i]
+		name := field.Name.Value
+		if names[name] {
+			panic(fmt.Sprintf("redeclared symbol '%s'", name))
+		}
+		names[name] = true
+		ty := r.resolveTypeDesc(defn, depth+1, field.TypeNode)
+		fields[i] = semtypes.FieldFrom(name, ty, field.FlagSet.Contains(model.Flag_READONLY),
+			field.FlagSet.Contains(model.Flag_OPTIONAL))
+	}
+	var rest semtypes.SemType
+	switch {
+	case td.RestFieldType != nil:
+		rest = r.resolveTypeDesc(defn, depth+1, td.RestFieldType)
+	case td.Sealed:
+		rest = &semtypes.NEVER
+	default:
+		rest = semtypes.CreateAnydata(r.cx)
+	}
+	return md.DefineMappingTypeWrapped(r.env, fields, rest)
+}
+
+func (r *SemTypeResolver) resolveConstrainedType(defn *BLangTypeDefinition, depth int, td *BLangConstrainedType) semtypes.SemType {
+	refType, ok := td.Type.(*BLangBuiltInRefTypeNode)
+	if !ok {
+		panic(fmt.Sprintf("unsupported constrained type: %T", td.Type))
+	}
+	switch refType.TypeKind {
+	case model.TypeKind_MAP:
+		if td.defn != nil {
+			return td.defn.GetSemType(r.env)
+		}
+		md := semtypes.NewMappingDefinition()
+		td.defn = &md
+		rest := r.resolveTypeDesc(defn, depth+1, td.Constraint)
+		return md.DefineMappingTypeWrapped(r.env, nil, rest)
+	case model.TypeKind_XML:
+		return semtypes.XmlSequence(r.resolveTypeDesc(defn, depth, td.Constraint))
+	case model.TypeKind_ERROR:
+		return semtypes.ErrorDetail(r.resolveTypeDesc(defn, depth, td.Constraint))
+	case model.TypeKind_FUTURE:
+		return semtypes.FutureContaining(r.env, r.resolveTypeDesc(defn, depth+1, td.Constraint))
+	default:
+		panic(fmt.Sprintf("unsupported constrained type: %s", refType.TypeKind))
+	}
+}
+
+// resolveTableType resolves a table type. A table type is not a list or mapping constructor, so a reference from the
+// row type to the table type is an invalid cycle, unless it goes through the fields of a record.
+func (r *SemTypeResolver) resolveTableType(defn *BLangTypeDefinition, depth int, td *BLangTableTypeNode) semtypes.SemType {
+	constraint := r.resolveTypeDesc(defn, depth, td.Constraint)
+	if !semtypes.IsSubtypeSimple(constraint, semtypes.MAPPING) {
+		panic(fmt.Sprintf("invalid constraint type. expected subtype of 'map<any|error>' but found '%s'",
+			r.typeName(td.Constraint, constraint)))
+	}
+	switch {
+	case td.TableKeySpecifier != nil:
+		fieldNames := td.KeyFieldNames()
+		r.validateKeyFields(td.Constraint, constraint, fieldNames)
+		return semtypes.TableContainingKeySpecifier(r.cx, constraint, fieldNames)
+	case td.TableKeyTypeConstraint != nil:
+		keyType := r.resolveTypeDesc(defn, depth, td.TableKeyTypeConstraint.KeyType)
+		if !semtypes.IsSubtype(r.cx, keyType, semtypes.CreateAnydata(r.cx)) {
+			panic(fmt.Sprintf("invalid key constraint type. expected subtype of 'anydata' but found '%s'",
+				r.typeName(td.TableKeyTypeConstraint.KeyType, keyType)))
+		}
+		return semtypes.TableContainingKeyConstraint(r.cx, constraint, keyType)
+	default:
+		return semtypes.TableContaining(r.env, constraint)
+	}
+}
+
+// resolveStreamType resolves a stream type. A stream type descriptor without type parameters is the type of all
+// streams.
+func (r *SemTypeResolver) resolveStreamType(defn *BLangTypeDefinition, depth int, td *BLangStreamType) semtypes.SemType {
+	if td.Constraint == nil {
+		return &semtypes.STREAM
+	}
+	if td.defn != nil {
+		return td.defn.GetSemType(r.env)
+	}
+	sd := semtypes.NewStreamDefinition()
+	td.defn = &sd
+	valueType := r.resolveTypeDesc(defn, depth+1, td.Constraint)
+	var completionType semtypes.SemType = &semtypes.NIL
+	if td.Error != nil {
+		completionType = r.resolveTypeDesc(defn, depth+1, td.Error)
+		if !semtypes.IsSubtype(r.cx, completionType, semtypes.Union(&semtypes.ERROR, &semtypes.NIL)) {
+			panic(fmt.Sprintf("invalid completion type. expected subtype of 'error?' but found '%s'",
+				r.typeName(td.Error, completionType)))
+		}
+	}
+	return sd.Define(r.env, valueType, completionType)
+}
+
+// validateKeyFields checks that the fields of a key specifier are required, readonly fields of the row type, whose
+// types are subtypes of anydata.
+func (r *SemTypeResolver) validateKeyFields(rowTypeNode model.TypeNode, rowType semtypes.SemType, fieldNames []string) {
+	record, readonlyRow := r.rowRecordType(rowTypeNode)
+	for _, name := range fieldNames {
+		var field *BLangSimpleVariable
+		if record != nil {
+			for i := range record.Fields {
+				if record.Fields[i].Name.Value == name {
+					field = &record.Fields[i]
+					break
+				}
+			}
+		}
+		if field == nil {
+			panic(fmt.Sprintf("field name '%s' used in key specifier is not found in table constraint type '%s'", name,
+				r.typeName(rowTypeNode, rowType)))
+		}
+		if field.FlagSet.Contains(model.Flag_OPTIONAL) {
+			panic(fmt.Sprintf("field '%s' used in key specifier must be a required field", name))
+		}
+		if !readonlyRow && !field.FlagSet.Contains(model.Flag_READONLY) {
+			panic(fmt.Sprintf("field '%s' used in key specifier must be a readonly field", name))
+		}
+		fieldType := semtypes.MappingMemberTypeInnerVal(r.cx, rowType, semtypes.StringConst(name))
+		if !semtypes.IsSubtype(r.cx, fieldType, semtypes.CreateAnydata(r.cx)) {
+			panic(fmt.Sprintf("type of the field '%s' used in key specifier must be a subtype of anydata", name))
+		}
+	}
+}
+
+// rowRecordType returns the record type descriptor of a row type, following references to the type definitions in
+// scope, and whether the row type is intersected with readonly. It returns nil if the row type is not a record type.
+func (r *SemTypeResolver) rowRecordType(td model.TypeNode) (*BLangRecordTypeNode, bool) {
+	switch td := td.(type) {
+	case *BLangRecordTypeNode:
+		return td, false
+	case *BLangUserDefinedType:
+		if td.PkgAlias.Value != "" {
+			return nil, false
+		}
+		if defn, ok := r.lookup(td.TypeName.Value).(*BLangTypeDefinition); ok {
+			return r.rowRecordType(defn.typeNode)
+		}
+	case *BLangIntersectionTypeNode:
+		var record *BLangRecordTypeNode
+		readonly := false
+		for _, constituent := range td.ConstituentTypeNodes {
+			if valueType, ok := constituent.(*BLangValueType); ok && valueType.TypeKind == model.TypeKind_READONLY {
+				readonly = true
+				continue
+			}
+			if constituentRecord, constituentReadonly := r.rowRecordType(constituent); constituentRecord != nil {
+				record = constituentRecord
+				readonly = readonly || constituentReadonly
+			}
+		}
+		return record, readonly
+	}
+	return nil, false
+}
+
+// typeName returns the name of a type for use in diagnostics: the name of the referenced type for a reference to a
+// type definition, and the type written as a type descriptor otherwise.
+func (r *SemTypeResolver) typeName(td model.TypeNode, t semtypes.SemType) string {
+	if userDefinedType, ok := td.(*BLangUserDefinedType); ok {
+		if userDefinedType.PkgAlias.Value != "" {
+			return userDefinedType.PkgAlias.Value + ":" + userDefinedType.TypeName.Value
+		}
+		return userDefinedType.TypeName.Value
+	}
+	return semtypes.ToTypeString(r.cx, t)
+}
+
+func resolveFiniteType(td *BLangFiniteTypeNode) semtypes.SemType {
+	var result semtypes.SemType = &semtypes.NEVER
+	for _, value := range td.ValueSpace {
+		shape, ok := literalShape(value)
+		if !ok {
+			panic(fmt.Sprintf("unsupported value in finite type: %T", value))
+		}
+		result = semtypes.Union(result, shape)
+	}
+	return result
+}
+
+// literalShape returns the singleton type of a (possibly signed) literal, as typed by its type tag.
+func literalShape(expr BLangExpression) (semtypes.SemType, bool) {
+	literal, negative, ok := unwrapLiteral(expr)
+	if !ok {
+		return nil, false
+	}
+	switch literal.GetBType().(BType).bTypeGetTag() {
+	case model.TypeTags_INT, model.TypeTags_BYTE:
+		return semtypes.IntConst(signedInt(literal, negative)), true
+	case model.TypeTags_FLOAT:
+		return semtypes.FloatConst(floatValue(literal, negative)), true
+	case model.TypeTags_DECIMAL:
+		return semtypes.DecimalConstFromStringValue(decimalValue(literal, negative)), true
+	case model.TypeTags_STRING:
+		return semtypes.StringConst(literal.Value.(string)), true
+	case model.TypeTags_BOOLEAN:
+		return semtypes.BooleanConst(literal.Value.(bool)), true
+	case model.TypeTags_NIL:
+		return &semtypes.NIL, true
+	default:
+		return nil, false
+	}
+}
+
+// LiteralShapes returns the singleton types a literal expression may have, or nil if expr is not a literal. A
+// numeric literal without a type suffix may belong to any numeric type that can represent its value, depending on
+// the type expected by its context.
+func LiteralShapes(expr BLangExpression) []semtypes.SemType {
+	shape, ok := literalShape(expr)
+	if !ok {
+		return nil
+	}
+	shapes := []semtypes.SemType{shape}
+	literal, negative, _ := unwrapLiteral(expr)
+	text := strings.ToLower(strings.TrimLeft(literal.OriginalValue, "+-"))
+	if strings.HasPrefix(text, "0x") {
+		return shapes
+	}
+	switch literal.GetBType().(BType).bTypeGetTag() {
+	case model.TypeTags_INT:
+		shapes = append(shapes, semtypes.FloatConst(float64(signedInt(literal, negative))),
+			semtypes.DecimalConstFromStringValue(decimalValue(literal, negative)))
+	case model.TypeTags_FLOAT:
+		if !strings.HasSuffix(text, "f") {
+			shapes = append(shapes, semtypes.DecimalConstFromStringValue(decimalValue(literal, negative)))
+		}
+	}
+	return shapes
+}
+
+func unwrapLiteral(expr BLangExpression) (*BLangLiteral, bool, bool) {
+	switch expr := expr.(type) {
+	case *BLangLiteral:
+		return expr, false, true
+	case *BLangNumericLiteral:
+		return &expr.BLangLiteral, false, true
+	case *BLangUnaryExpr:
+		if expr.Operator != model.OperatorKind_SUB && expr.Operator != model.OperatorKind_ADD {
+			return nil, false, false
+		}
+		literal, negative, ok := unwrapLiteral(expr.Expr)
+		if !ok {
+			return nil, false, false
+		}
+		return literal, negative != (expr.Operator == model.OperatorKind_SUB), true
+	default:
+		return nil, false, false
+	}
+}
+
+func signedInt(literal *BLangLiteral, negative bool) int64 {
+	value := literal.Value.(int64)
+	if negative {
+		return -value
+	}
+	return value
+}
+
+func floatValue(literal *BLangLiteral, negative bool) float64 {
+	text := strings.TrimRight(fmt.Sprint(literal.Value), "fF")
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		panic(fmt.Sprintf("invalid float literal: %s", literal.OriginalValue))
+	}
+	if negative {
+		return -value
+	}
+	return value
+}
+
+func decimalValue(literal *BLangLiteral, negative bool) string {
+	text := strings.TrimRight(fmt.Sprint(literal.Value), "dD")
+	if negative {
+		return "-" + text
+	}
+	return text
+}
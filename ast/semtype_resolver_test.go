@@ -0,0 +1,138 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ast
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"ballerina-lang-go/context"
+	"ballerina-lang-go/parser"
+	"ballerina-lang-go/semtypes"
+)
+
+const typeDefinitionsSource = `
+const int ONE = 1;
+type Color "red"|"green"|"blue";
+type Signed -1|0|ONE;
+type OptInt int?;
+type Pair [int, string];
+type Ints [int, int...];
+type IntArray int[];
+type RoIntArray int[] & readonly;
+type E1 distinct error;
+type E2 distinct error;
+type J ()|boolean|int|float|decimal|string|J[]|map<J>;
+type IntList ()|[int, IntList];
+type FutureInt future<int>;
+type FutureOptInt future<int?>;
+type IntStream stream<int>;
+type IntOrStringStream stream<int|string>;
+type IntStreamWithError stream<int, error?>;
+type AnyStream stream;
+`
+
+// parseTestPackage parses source as the only file of a package.
+func parseTestPackage(t *testing.T, source string) *BLangPackage {
+	t.Helper()
+	fileName := filepath.Join(t.TempDir(), "test.bal")
+	if err := os.WriteFile(fileName, []byte(source), 0o644); err != nil {
+		t.Fatalf("failed to write source file: %v", err)
+	}
+	syntaxTree, err := parser.GetSyntaxTree(nil, fileName)
+	if err != nil {
+		t.Fatalf("failed to parse source: %v", err)
+	}
+	return ToPackage(GetCompilationUnit(context.NewCompilerContext(), syntaxTree))
+}
+
+func resolveTestTypeDefinitions(t *testing.T, source string) (map[string]semtypes.SemType, semtypes.Context) {
+	t.Helper()
+	pkg := parseTestPackage(t, source)
+	resolver := NewSemTypeResolver(semtypes.GetTypeEnv(), pkg)
+	resolver.ResolveTypeDefinitions()
+	types := make(map[string]semtypes.SemType)
+	for i := range pkg.TypeDefinitions {
+		types[pkg.TypeDefinitions[i].name.Value] = pkg.TypeDefinitions[i].GetSemType()
+	}
+	return types, resolver.Context()
+}
+
+func TestResolveTypeDefinitions(t *testing.T) {
+	types, cx := resolveTestTypeDefinitions(t, typeDefinitionsSource)
+	listOf := func(members ...semtypes.SemType) semtypes.SemType {
+		ld := semtypes.NewListDefinition()
+		return ld.TupleTypeWrapped(semtypes.GetTypeEnv(), members...)
+	}
+	tests := []struct {
+		name    string
+		t1, t2  semtypes.SemType
+		subtype bool
+	}{
+		{"singleton in finite type", semtypes.StringConst("red"), types["Color"], true},
+		{"string not in finite type", semtypes.StringConst("purple"), types["Color"], false},
+		{"finite type in string", types["Color"], &semtypes.STRING, true},
+		{"negative singleton", semtypes.IntConst(-1), types["Signed"], true},
+		{"constant singleton", semtypes.IntConst(1), types["Signed"], true},
+		{"int not in finite type", semtypes.IntConst(2), types["Signed"], false},
+		{"nil in optional type", &semtypes.NIL, types["OptInt"], true},
+		{"int in optional type", &semtypes.INT, types["OptInt"], true},
+		{"string not in optional type", &semtypes.STRING, types["OptInt"], false},
+		{"tuple", listOf(&semtypes.INT, &semtypes.STRING), types["Pair"], true},
+		{"tuple with swapped members", listOf(&semtypes.STRING, &semtypes.INT), types["Pair"], false},
+		{"tuple with rest", listOf(&semtypes.INT, &semtypes.INT, &semtypes.INT), types["Ints"], true},
+		{"tuple with missing members", listOf(), types["Ints"], false},
+		{"readonly array in array", types["RoIntArray"], types["IntArray"], true},
+		{"array not in readonly array", types["IntArray"], types["RoIntArray"], false},
+		{"distinct error in error", types["E1"], &semtypes.ERROR, true},
+		{"distinct errors are disjoint", types["E1"], types["E2"], false},
+		{"error not in distinct error", &semtypes.ERROR, types["E1"], false},
+		{"recursive list member", types["IntArray"], types["J"], true},
+		{"recursive type in anydata", types["J"], semtypes.CreateAnydata(cx), true},
+		{"recursive tuple", listOf(&semtypes.INT, &semtypes.NIL), types["IntList"], true},
+		{"recursive tuple with wrong member", listOf(&semtypes.STRING, &semtypes.NIL), types["IntList"], false},
+		{"future in future", types["FutureInt"], &semtypes.FUTURE, true},
+		{"future with narrower constraint", types["FutureInt"], types["FutureOptInt"], true},
+		{"future with wider constraint", types["FutureOptInt"], types["FutureInt"], false},
+		{"stream with narrower value type", types["IntStream"], types["IntOrStringStream"], true},
+		{"stream with wider value type", types["IntOrStringStream"], types["IntStream"], false},
+		{"stream with nil completion in stream with error completion", types["IntStream"], types["IntStreamWithError"], true},
+		{"stream with error completion not in stream with nil completion", types["IntStreamWithError"], types["IntStream"], false},
+		{"stream in stream without type parameters", types["IntStreamWithError"], types["AnyStream"], true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if semtypes.IsSubtype(cx, test.t1, test.t2) != test.subtype {
+				t.Errorf("expected IsSubtype to be %v", test.subtype)
+			}
+		})
+	}
+}
+
+func TestResolveInvalidCyclicTypeDefinition(t *testing.T) {
+	for _, source := range []string{"type A A|int;", "type A B;\ntype B A;"} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("expected a panic for invalid cyclic type definition: %s", source)
+				}
+			}()
+			resolveTestTypeDefinitions(t, source)
+		}()
+	}
+}
@@ -84,6 +84,15 @@ type (
 		OnFailClause BLangOnFailClause
 	}
 
+	BLangForeach struct {
+		BLangStatementBase
+		// the variable bound to each member of the collection
+		VariableDefinitionNode *BLangSimpleVariableDef
+		Collection             BLangExpression
+		Body                   BLangBlockStmt
+		IsDeclaredWithVar      bool
+	}
+
 	BLangSimpleVariableDef struct {
 		BLangStatementBase
 		Var      BLangSimpleVariable
@@ -95,6 +104,23 @@ type (
 		BLangStatementBase
 		Expr BLangExpression
 	}
+
+	// BLangForkJoin marks the end of a fork statement. Its workers are started by the worker definitions that
+	// precede it.
+	BLangForkJoin struct {
+		BLangStatementBase
+		Workers []*BLangSimpleVariableDef
+	}
+
+	BLangXMLNSStatement struct {
+		BLangStatementBase
+		XMLNSDecl *BLangLocalXMLNS
+	}
+
+	BLangLock struct {
+		BLangStatementBase
+		Body BLangBlockStmt
+	}
 )
 
 var (
@@ -120,9 +146,29 @@ var (
 	_ BLangNode = &BLangExpressionStmt{}
 	_ BLangNode = &BLangIf{}
 	_ BLangNode = &BLangWhile{}
+	_ BLangNode = &BLangForeach{}
 	_ BLangNode = &BLangSimpleVariableDef{}
+	_ BLangNode = &BLangXMLNSStatement{}
+	_ BLangNode = &BLangForkJoin{}
+	_ BLangNode = &BLangLock{}
 )
 
+func (this *BLangForkJoin) GetKind() model.NodeKind {
+	return model.NodeKind_FORK_JOIN
+}
+
+func (this *BLangForeach) GetKind() model.NodeKind {
+	return model.NodeKind_FOREACH
+}
+
+func (this *BLangLock) GetKind() model.NodeKind {
+	return model.NodeKind_LOCK
+}
+
+func (this *BLangXMLNSStatement) GetKind() model.NodeKind {
+	return model.NodeKind_XMLNS
+}
+
 func (this *BLangAssignment) GetVariable() model.ExpressionNode {
 	// migrated from BLangAssignment.java:48:5
 	return this.VarRef
@@ -19,6 +19,7 @@ package ast
 import (
 	"ballerina-lang-go/common"
 	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
 )
 
 type ProjectKind uint8
@@ -79,6 +80,7 @@ type (
 		Elemtype   model.TypeNode
 		Sizes      []BLangExpression
 		Dimensions int
+		defn       *semtypes.ListDefinition
 	}
 	BLangBuiltInRefTypeNode struct {
 		BLangTypeBase
@@ -122,6 +124,70 @@ type (
 		BLangTypeBase
 		ValueSpace []BLangExpression
 	}
+
+	BLangConstrainedType struct {
+		BLangTypeBase
+		Type       model.TypeNode
+		Constraint model.TypeNode
+		defn       *semtypes.MappingDefinition
+	}
+
+	BLangUnionTypeNode struct {
+		BLangTypeBase
+		MemberTypeNodes []model.TypeNode
+	}
+
+	BLangIntersectionTypeNode struct {
+		BLangTypeBase
+		ConstituentTypeNodes []model.TypeNode
+	}
+
+	BLangTupleTypeNode struct {
+		BLangTypeBase
+		MemberTypeNodes []model.TypeNode
+		RestParamType   model.TypeNode
+		defn            *semtypes.ListDefinition
+	}
+
+	BLangRecordTypeNode struct {
+		BLangTypeBase
+		Fields []BLangSimpleVariable
+		// type of the rest fields, or nil if the record has no rest descriptor
+		RestFieldType model.TypeNode
+		// whether the record is closed, i.e. written using `{|` and `|}`
+		Sealed bool
+		defn   *semtypes.MappingDefinition
+	}
+
+	BLangTableTypeNode struct {
+		BLangTypeBase
+		// the row type of the table
+		Constraint model.TypeNode
+		// the key of the table, which is nil if the table has no key or if its key is given by a key type constraint
+		TableKeySpecifier *BLangTableKeySpecifier
+		// the type of the key of the table, which is nil if the table has no key or if its key is given by a key
+		// specifier
+		TableKeyTypeConstraint *BLangTableKeyTypeConstraint
+	}
+
+	BLangTableKeySpecifier struct {
+		BLangNodeBase
+		FieldNameIdentifierList []BLangIdentifier
+	}
+
+	BLangTableKeyTypeConstraint struct {
+		BLangNodeBase
+		KeyType model.TypeNode
+	}
+
+	BLangStreamType struct {
+		BLangTypeBase
+		// the type of the members of the stream, which is nil for a stream type descriptor without type parameters
+		Constraint model.TypeNode
+		// the completion type of the stream, which is nil if the completion type is nil
+		Error model.TypeNode
+		defn  *semtypes.StreamDefinition
+	}
 )
 
 var (
@@ -132,6 +198,13 @@ var (
 	_ model.NamedNode                = &BField{}
 	_ ObjectType                     = &BObjectType{}
 	_ model.FiniteTypeNode           = &BLangFiniteTypeNode{}
+	_ model.ConstrainedTypeNode      = &BLangConstrainedType{}
+	_ model.UnionTypeNode            = &BLangUnionTypeNode{}
+	_ model.IntersectionTypeNode     = &BLangIntersectionTypeNode{}
+	_ model.TupleTypeNode            = &BLangTupleTypeNode{}
+	_ model.TypeNode                 = &BLangRecordTypeNode{}
+	_ model.TypeNode                 = &BLangTableTypeNode{}
+	_ model.TypeNode                 = &BLangStreamType{}
 )
 
 var (
@@ -147,6 +220,15 @@ var (
 	_ BLangNode      = &BLangUserDefinedType{}
 	_ BLangNode      = &BLangValueType{}
 	_ model.TypeNode = &BLangValueType{}
+	_ BLangNode      = &BLangConstrainedType{}
+	_ BLangNode      = &BLangUnionTypeNode{}
+	_ BLangNode      = &BLangIntersectionTypeNode{}
+	_ BLangNode      = &BLangTupleTypeNode{}
+	_ BLangNode      = &BLangRecordTypeNode{}
+	_ BLangNode      = &BLangTableTypeNode{}
+	_ BLangNode      = &BLangTableKeySpecifier{}
+	_ BLangNode      = &BLangTableKeyTypeConstraint{}
+	_ BLangNode      = &BLangStreamType{}
 )
 
 func (this *BLangArrayType) GetKind() model.NodeKind {
@@ -170,6 +252,20 @@ func (this *BLangArrayType) GetSizes() []model.ExpressionNode {
 	return expressionNodes
 }
 
+// typeNodeBase gives access to the state shared by all the type nodes through BLangTypeBase.
+type typeNodeBase interface {
+	setGrouped(grouped bool)
+	addFlag(flag model.Flag)
+}
+
+func (this *BLangTypeBase) setGrouped(grouped bool) {
+	this.Grouped = grouped
+}
+
+func (this *BLangTypeBase) addFlag(flag model.Flag) {
+	this.FlagSet.Add(flag)
+}
+
 func (this *BLangTypeBase) IsNullable() bool {
 	return this.Nullable
 }
@@ -220,6 +316,91 @@ func (this *BLangUserDefinedType) GetTypeKind() model.TypeKind {
 	panic("not implemented")
 }
 
+func (this *BLangConstrainedType) GetType() model.TypeNode {
+	return this.Type
+}
+
+func (this *BLangConstrainedType) GetConstraint() model.TypeNode {
+	return this.Constraint
+}
+
+func (this *BLangConstrainedType) GetKind() model.NodeKind {
+	return model.NodeKind_CONSTRAINED_TYPE
+}
+
+func (this *BLangUnionTypeNode) GetMemberTypeNodes() []model.TypeNode {
+	return this.MemberTypeNodes
+}
+
+func (this *BLangUnionTypeNode) AddMemberTypeNode(typeNode model.TypeNode) {
+	this.MemberTypeNodes = append(this.MemberTypeNodes, typeNode)
+}
+
+func (this *BLangUnionTypeNode) GetKind() model.NodeKind {
+	return model.NodeKind_UNION_TYPE_NODE
+}
+
+func (this *BLangIntersectionTypeNode) GetConstituentTypeNodes() []model.TypeNode {
+	return this.ConstituentTypeNodes
+}
+
+func (this *BLangIntersectionTypeNode) AddConstituentTypeNode(typeNode model.TypeNode) {
+	this.ConstituentTypeNodes = append(this.ConstituentTypeNodes, typeNode)
+}
+
+func (this *BLangIntersectionTypeNode) GetKind() model.NodeKind {
+	return model.NodeKind_INTERSECTION_TYPE_NODE
+}
+
+func (this *BLangTupleTypeNode) GetMemberTypeNodes() []model.TypeNode {
+	return this.MemberTypeNodes
+}
+
+func (this *BLangTupleTypeNode) GetRestParamType() model.TypeNode {
+	return this.RestParamType
+}
+
+func (this *BLangTupleTypeNode) GetKind() model.NodeKind {
+	return model.NodeKind_TUPLE_TYPE_NODE
+}
+
+func (this *BLangRecordTypeNode) GetKind() model.NodeKind {
+	return model.NodeKind_RECORD_TYPE
+}
+
+func (this *BLangTableTypeNode) GetKind() model.NodeKind {
+	return model.NodeKind_TABLE_TYPE
+}
+
+// KeyFieldNames returns the names of the fields of the key specifier, or nil if the table type has no key specifier.
+func (this *BLangTableTypeNode) KeyFieldNames() []string {
+	if this.TableKeySpecifier == nil {
+		return nil
+	}
+	return this.TableKeySpecifier.FieldNames()
+}
+
+func (this *BLangTableKeySpecifier) GetKind() model.NodeKind {
+	return model.NodeKind_TABLE_KEY_SPECIFIER
+}
+
+// FieldNames returns the names of the key fields, in the order they are specified.
+func (this *BLangTableKeySpecifier) FieldNames() []string {
+	names := make([]string, len(this.FieldNameIdentifierList))
+	for i, identifier := range this.FieldNameIdentifierList {
+		names[i] = identifier.Value
+	}
+	return names
+}
+
+func (this *BLangTableKeyTypeConstraint) GetKind() model.NodeKind {
+	return model.NodeKind_TABLE_KEY_TYPE_CONSTRAINT
+}
+
+func (this *BLangStreamType) GetKind() model.NodeKind {
+	return model.NodeKind_STREAM_TYPE
+}
+
 func (this *BField) GetName() model.Name {
 	return this.Name
 }
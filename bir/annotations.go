@@ -0,0 +1,422 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bir
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+	"fmt"
+	"strings"
+)
+
+// Annotation values are evaluated at compile time. A value is checked against the type descriptor of its annotation
+// as it is evaluated, so that mapping and list constructors are checked field by field and member by member.
+
+// TransformAnnotation checks the type of an annotation declaration and makes the annotation visible to the
+// annotation attachments of the module.
+func TransformAnnotation(ctx *Context, annotation *ast.BLangAnnotation) *BIRAnnotation {
+	name := annotation.Name.Value
+	if _, ok := ctx.annotations[name]; ok {
+		panic(fmt.Sprintf("redeclared symbol '%s'", name))
+	}
+	birAnnotation := &BIRAnnotation{}
+	birAnnotation.Pos = annotation.GetPosition()
+	birAnnotation.Name = model.Name(name)
+	birAnnotation.Flags = int64(ast.AsMask(annotation.GetFlags()))
+	birAnnotation.AttachPoints = annotation.GetAttachPoints()
+	birAnnotation.Type = annotation.TypeNode
+	if annotation.TypeNode != nil {
+		birAnnotation.SemType = ctx.typeResolver.ResolveTypeNode(annotation.TypeNode)
+		if !isAnnotationType(ctx, birAnnotation.SemType) {
+			panic(fmt.Sprintf("annotation declaration requires a subtype of 'true', 'map<anydata|readonly>' or "+
+				"'map<anydata|readonly>[]', but found '%s'", typeNodeName(annotation.TypeNode)))
+		}
+	}
+	if annotation.MarkdownDocumentationAttachment != nil {
+		birAnnotation.MarkdownDocAttachment = annotation.MarkdownDocumentationAttachment.GetDocAttachment()
+	}
+	ctx.annotations[name] = birAnnotation
+	return birAnnotation
+}
+
+// annotationValueTypes returns `map<anydata|readonly>` and `map<anydata|readonly>[]`, which together with `true`
+// bound the types an annotation value may have.
+func annotationValueTypes(ctx *Context) (semtypes.SemType, semtypes.SemType) {
+	if ctx.annotationMappingType == nil {
+		env := ctx.CompilerContext.TypeEnv()
+		cx := ctx.typeResolver.Context()
+		md := semtypes.NewMappingDefinition()
+		ctx.annotationMappingType = md.DefineMappingTypeWrapped(env, nil,
+			semtypes.Union(semtypes.CreateAnydata(cx), semtypes.VAL_READONLY))
+		ld := semtypes.NewListDefinition()
+		ctx.annotationListType = ld.DefineListTypeWrapped(env, []semtypes.SemType{}, 0, ctx.annotationMappingType,
+			semtypes.CellMutability_CELL_MUT_LIMITED)
+	}
+	return ctx.annotationMappingType, ctx.annotationListType
+}
+
+func isAnnotationType(ctx *Context, ty semtypes.SemType) bool {
+	cx := ctx.typeResolver.Context()
+	mappingType, listType := annotationValueTypes(ctx)
+	return semtypes.IsSubtype(cx, ty, semtypes.BooleanConst(true)) || semtypes.IsSubtype(cx, ty, mappingType) ||
+		semtypes.IsSubtype(cx, ty, listType)
+}
+
+// isListAnnotation returns whether the annotation can be attached more than once to the same construct, which is
+// the case when its type is an array of mappings.
+func isListAnnotation(ctx *Context, annotation *BIRAnnotation) bool {
+	if annotation.SemType == nil {
+		return false
+	}
+	_, listType := annotationValueTypes(ctx)
+	return semtypes.IsSubtype(ctx.typeResolver.Context(), annotation.SemType, listType)
+}
+
+// transformAnnotationAttachments evaluates the annotations attached to a construct at the given attach point. The
+// values of annotations that are only available at compile time, because they are attached at a source attach point,
+// are checked but not kept.
+func transformAnnotationAttachments(ctx *Context, attachments []model.AnnotationAttachmentNode, point model.Point) []BIRAnnotationAttachment {
+	var result []BIRAnnotationAttachment
+	attached := make(map[string]bool)
+	for _, node := range attachments {
+		attachment := node.(*ast.BLangAnnotationAttachment)
+		if attachment.PkgAlias != nil && attachment.PkgAlias.Value != "" {
+			panic("annotations of other modules not yet supported")
+		}
+		name := attachment.AnnotationName.Value
+		annotation, ok := ctx.annotations[name]
+		if !ok {
+			panic(fmt.Sprintf("undefined annotation '%s'", name))
+		}
+		attachPoint, ok := annotationAttachPoint(annotation, point)
+		if !ok {
+			panic(fmt.Sprintf("annotation '%s' is not allowed on %s", name, attachPointName(point)))
+		}
+		typeNode := annotation.Type
+		if isListAnnotation(ctx, annotation) {
+			_, typeNode = listTypeDescriptor(ctx, typeNode)
+		} else if attached[name] {
+			panic(fmt.Sprintf("cannot specify more than one annotation value for annotation '%s'", name))
+		}
+		attached[name] = true
+		value := annotationAttachmentValue(ctx, annotation, typeNode, attachment.Expr)
+		if attachPoint.Source {
+			continue
+		}
+		birAttachment := BIRAnnotationAttachment{AnnotTagRef: model.Name(name), Value: value}
+		birAttachment.Pos = attachment.GetPosition()
+		result = append(result, birAttachment)
+	}
+	return result
+}
+
+// annotationAttachPoint returns the attach point of the annotation that allows it to be attached at point. The
+// `field` attach point allows both record and object fields.
+func annotationAttachPoint(annotation *BIRAnnotation, point model.Point) (model.AttachPoint, bool) {
+	for _, attachPoint := range annotation.AttachPoints {
+		if attachPoint.Point == point {
+			return attachPoint, true
+		}
+		if attachPoint.Point == model.Point_FIELD && (point == model.Point_RECORD_FIELD || point == model.Point_OBJECT_FIELD) {
+			return attachPoint, true
+		}
+		// methods are functions, and remote methods of services are object methods
+		if attachPoint.Point == model.Point_FUNCTION && (point == model.Point_OBJECT_METHOD || point == model.Point_SERVICE_REMOTE) {
+			return attachPoint, true
+		}
+		if attachPoint.Point == model.Point_OBJECT_METHOD && point == model.Point_SERVICE_REMOTE {
+			return attachPoint, true
+		}
+	}
+	return model.AttachPoint{}, false
+}
+
+func attachPointName(point model.Point) string {
+	switch point {
+	case model.Point_RECORD_FIELD, model.Point_OBJECT_FIELD:
+		return "field"
+	case model.Point_OBJECT_METHOD:
+		return "object function"
+	case model.Point_SERVICE_REMOTE:
+		return "service remote function"
+	default:
+		return string(point)
+	}
+}
+
+// annotationAttachmentValue evaluates the value of an attachment, which is either absent or a mapping constructor.
+// An absent value is `true` for an annotation whose type allows it, and an empty mapping otherwise.
+func annotationAttachmentValue(ctx *Context, annotation *BIRAnnotation, typeNode model.TypeNode, expr ast.BLangExpression) ConstValue {
+	name := annotation.Name.Value()
+	if annotation.Type == nil {
+		if expr != nil {
+			panic(fmt.Sprintf("no annotation value expected for annotation '%s'", name))
+		}
+		return ConstValue{Value: true}
+	}
+	if expr == nil {
+		expectedType := ctx.typeResolver.ResolveTypeNode(typeNode)
+		if semtypes.IsSubtype(ctx.typeResolver.Context(), semtypes.BooleanConst(true), expectedType) {
+			return ConstValue{Value: true}
+		}
+		expr = &ast.BLangRecordLiteral{}
+	}
+	return annotationValue(ctx, annotation, typeNode, expr)
+}
+
+// annotationValue evaluates expr, which must be a constant expression of the type described by typeNode.
+func annotationValue(ctx *Context, annotation *BIRAnnotation, typeNode model.TypeNode, expr ast.BLangExpression) ConstValue {
+	switch expr := expr.(type) {
+	case *ast.BLangRecordLiteral:
+		return mappingAnnotationValue(ctx, annotation, typeNode, expr)
+	case *ast.BLangListConstructorExpr:
+		return listAnnotationValue(ctx, annotation, typeNode, expr)
+	case *ast.BLangGroupExpr:
+		return annotationValue(ctx, annotation, typeNode, expr.Expression)
+	case *ast.BLangSimpleVarRef:
+		if expr.PkgAlias != nil && expr.PkgAlias.Value != "" {
+			nonConstantAnnotationValue(annotation)
+		}
+		constant := ctx.typeResolver.LookupConstant(expr.VariableName.Value)
+		if constant == nil {
+			nonConstantAnnotationValue(annotation)
+		}
+		return annotationValue(ctx, annotation, typeNode, constant.Expr.(ast.BLangExpression))
+	}
+	shapes := ast.LiteralShapes(expr)
+	if shapes == nil {
+		nonConstantAnnotationValue(annotation)
+	}
+	cx := ctx.typeResolver.Context()
+	expectedType := ctx.typeResolver.ResolveTypeNode(typeNode)
+	for _, shape := range shapes {
+		if semtypes.IsSubtype(cx, shape, expectedType) {
+			return literalConstValue(expr)
+		}
+	}
+	panic(fmt.Sprintf("incompatible types: expected '%s', found '%s'", typeNodeName(typeNode),
+		basicTypeName(cx, shapes[0])))
+}
+
+func nonConstantAnnotationValue(annotation *BIRAnnotation) {
+	if annotation.Flags&int64(ast.Flags_CONSTANT) != 0 {
+		panic("expression is not a constant expression")
+	}
+	panic("non-constant annotation values not yet supported")
+}
+
+func mappingAnnotationValue(ctx *Context, annotation *BIRAnnotation, typeNode model.TypeNode, expr *ast.BLangRecordLiteral) ConstValue {
+	recordType, constraint := mappingTypeDescriptor(ctx, typeNode)
+	if recordType == nil && constraint == nil {
+		panic(fmt.Sprintf("incompatible mapping constructor expression for type '%s'", typeNodeName(typeNode)))
+	}
+	var fields []ConstMappingField
+	seen := make(map[string]bool)
+	for _, field := range expr.Fields {
+		key := field.Key.Name()
+		if seen[key] {
+			panic(fmt.Sprintf("invalid usage of map literal: duplicate key '%s'", key))
+		}
+		seen[key] = true
+		fieldType := constraint
+		if recordType != nil {
+			fieldType = recordFieldTypeNode(recordType, key)
+			if fieldType == nil {
+				panic(fmt.Sprintf("undefined field '%s' in record '%s'", key, typeNodeName(typeNode)))
+			}
+		}
+		fields = append(fields, ConstMappingField{Key: key,
+			Value: annotationValue(ctx, annotation, fieldType, field.ValueExpr)})
+	}
+	if recordType != nil {
+		for _, field := range recordType.Fields {
+			if !field.FlagSet.Contains(model.Flag_OPTIONAL) && !seen[field.Name.Value] {
+				panic(fmt.Sprintf("missing non-defaultable required record field '%s'", field.Name.Value))
+			}
+		}
+	}
+	return ConstValue{Value: fields}
+}
+
+func listAnnotationValue(ctx *Context, annotation *BIRAnnotation, typeNode model.TypeNode, expr *ast.BLangListConstructorExpr) ConstValue {
+	members, rest := listTypeDescriptor(ctx, typeNode)
+	if len(expr.Exprs) < len(members) || (members == nil && rest == nil) {
+		panic(fmt.Sprintf("incompatible list constructor expression for type '%s'", typeNodeName(typeNode)))
+	}
+	values := make([]ConstValue, len(expr.Exprs))
+	for i, member := range expr.Exprs {
+		memberType := rest
+		if i < len(members) {
+			memberType = members[i]
+		}
+		if memberType == nil {
+			panic(fmt.Sprintf("incompatible list constructor expression for type '%s'", typeNodeName(typeNode)))
+		}
+		values[i] = annotationValue(ctx, annotation, memberType, member)
+	}
+	return ConstValue{Value: values}
+}
+
+// mappingTypeDescriptor finds the mapping type that a mapping constructor of type typeNode constructs. It is either a
+// record type, or the constraint of a map type. For a union, the first member that is a mapping type is used.
+func mappingTypeDescriptor(ctx *Context, typeNode model.TypeNode) (*ast.BLangRecordTypeNode, model.TypeNode) {
+	switch typeNode := typeNode.(type) {
+	case *ast.BLangRecordTypeNode:
+		return typeNode, nil
+	case *ast.BLangConstrainedType:
+		if refType, ok := typeNode.Type.(*ast.BLangBuiltInRefTypeNode); ok && refType.TypeKind == model.TypeKind_MAP {
+			return nil, typeNode.Constraint
+		}
+	case *ast.BLangUserDefinedType:
+		if typeDefinition := lookupTypeDefinition(ctx, typeNode); typeDefinition != nil {
+			return mappingTypeDescriptor(ctx, typeDefinition.GetTypeNode())
+		}
+	case *ast.BLangUnionTypeNode:
+		for _, member := range typeNode.MemberTypeNodes {
+			if recordType, constraint := mappingTypeDescriptor(ctx, member); recordType != nil || constraint != nil {
+				return recordType, constraint
+			}
+		}
+	case *ast.BLangIntersectionTypeNode:
+		for _, constituent := range typeNode.ConstituentTypeNodes {
+			if recordType, constraint := mappingTypeDescriptor(ctx, constituent); recordType != nil || constraint != nil {
+				return recordType, constraint
+			}
+		}
+	case *ast.BLangValueType:
+		if isStructuredValueTypeKind(typeNode.TypeKind) {
+			return nil, typeNode
+		}
+	case *ast.BLangBuiltInRefTypeNode:
+		if isStructuredValueTypeKind(typeNode.TypeKind) {
+			return nil, typeNode
+		}
+	}
+	return nil, nil
+}
+
+// listTypeDescriptor finds the list type that a list constructor of type typeNode constructs, as its member types
+// and rest type.
+func listTypeDescriptor(ctx *Context, typeNode model.TypeNode) ([]model.TypeNode, model.TypeNode) {
+	switch typeNode := typeNode.(type) {
+	case *ast.BLangArrayType:
+		if typeNode.Dimensions != 1 {
+			panic("multidimensional arrays in annotation values not yet supported")
+		}
+		if len(typeNode.Sizes) > 0 {
+			if literal, ok := typeNode.Sizes[0].(*ast.BLangLiteral); !ok || literal.Value != ast.OPEN_ARRAY_INDICATOR {
+				panic("fixed length arrays in annotation values not yet supported")
+			}
+		}
+		return nil, typeNode.Elemtype
+	case *ast.BLangTupleTypeNode:
+		return typeNode.MemberTypeNodes, typeNode.RestParamType
+	case *ast.BLangUserDefinedType:
+		if typeDefinition := lookupTypeDefinition(ctx, typeNode); typeDefinition != nil {
+			return listTypeDescriptor(ctx, typeDefinition.GetTypeNode())
+		}
+	case *ast.BLangUnionTypeNode:
+		for _, member := range typeNode.MemberTypeNodes {
+			if members, rest := listTypeDescriptor(ctx, member); members != nil || rest != nil {
+				return members, rest
+			}
+		}
+	case *ast.BLangIntersectionTypeNode:
+		for _, constituent := range typeNode.ConstituentTypeNodes {
+			if members, rest := listTypeDescriptor(ctx, constituent); members != nil || rest != nil {
+				return members, rest
+			}
+		}
+	case *ast.BLangValueType:
+		if isStructuredValueTypeKind(typeNode.TypeKind) {
+			return nil, typeNode
+		}
+	case *ast.BLangBuiltInRefTypeNode:
+		if isStructuredValueTypeKind(typeNode.TypeKind) {
+			return nil, typeNode
+		}
+	}
+	return nil, nil
+}
+
+// isStructuredValueTypeKind returns whether a value of the type kind may be a mapping or a list, whose members then
+// belong to the same type.
+func isStructuredValueTypeKind(typeKind model.TypeKind) bool {
+	switch typeKind {
+	case model.TypeKind_ANYDATA, model.TypeKind_JSON, model.TypeKind_ANY, model.TypeKind_READONLY:
+		return true
+	default:
+		return false
+	}
+}
+
+func lookupTypeDefinition(ctx *Context, typeNode *ast.BLangUserDefinedType) *ast.BLangTypeDefinition {
+	if typeNode.PkgAlias.Value != "" {
+		return nil
+	}
+	return ctx.typeResolver.LookupTypeDefinition(typeNode.TypeName.Value)
+}
+
+// recordFieldTypeNode returns the type of the field of a record type, the rest type if there is no such field, or
+// nil if the record type does not allow the field.
+func recordFieldTypeNode(recordType *ast.BLangRecordTypeNode, name string) model.TypeNode {
+	for _, field := range recordType.Fields {
+		if field.Name.Value == name {
+			return field.TypeNode
+		}
+	}
+	if recordType.RestFieldType != nil {
+		return recordType.RestFieldType
+	}
+	if recordType.Sealed {
+		return nil
+	}
+	return &ast.BLangValueType{TypeKind: model.TypeKind_ANYDATA}
+}
+
+// literalConstValue returns the value of a literal, with the sign of a negated numeric literal applied.
+func literalConstValue(expr ast.BLangExpression) ConstValue {
+	switch expr := expr.(type) {
+	case *ast.BLangLiteral:
+		return ConstValue{Type: expr.GetBType().(model.ValueType), Value: expr.Value}
+	case *ast.BLangNumericLiteral:
+		return literalConstValue(&expr.BLangLiteral)
+	case *ast.BLangUnaryExpr:
+		value := literalConstValue(expr.Expr)
+		if expr.Operator == model.OperatorKind_SUB {
+			value.Value = negatedValue(value.Value)
+		}
+		return value
+	default:
+		panic(fmt.Sprintf("unexpected literal: %T", expr))
+	}
+}
+
+func negatedValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case int64:
+		return -value
+	case string:
+		if rest, ok := strings.CutPrefix(value, "-"); ok {
+			return rest
+		}
+		return "-" + value
+	default:
+		panic(fmt.Sprintf("unexpected numeric value: %v", value))
+	}
+}
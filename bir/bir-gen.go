@@ -21,7 +21,10 @@ import (
 	"ballerina-lang-go/common"
 	"ballerina-lang-go/context"
 	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
 	"fmt"
+	"sort"
+	"strings"
 )
 
 // Since BLangNodeVisitor is anyway deprecated in jBallerina, we'll try to do this more cleanly
@@ -29,7 +32,35 @@ import (
 
 type Context struct {
 	CompilerContext *context.CompilerContext
-	constantMap     map[string]*BIRConstant
+	// package ID of the module being generated
+	packageID   *model.PackageID
+	constantMap map[string]*BIRConstant
+	// namespace URIs of the module level xmlns declarations, keyed by prefix
+	xmlnsMap     map[string]string
+	typeResolver *ast.SemTypeResolver
+	// functions lifted from the named workers of the function being transformed
+	liftedFunctions []BIRFunction
+	// module level variables, keyed by name
+	globalVarMap map[string]*BIROperand
+	// declared types of the module level variables, nil if not known without a type checker
+	globalVarTypes map[string]semtypes.SemType
+	// functions of the module, keyed by name
+	functions map[string]*ast.BLangFunction
+	// whether the functions of the package are isolated, and why not
+	isolation *ast.IsolationInfo
+	// annotations declared in the module, keyed by name
+	annotations map[string]*BIRAnnotation
+	// names of the module level variables declared as listeners
+	listeners map[string]bool
+	// package IDs of the imported modules, keyed by prefix
+	importedPackageIDs map[string]*model.PackageID
+	// imported modules of the current package, keyed by prefix
+	importedModules map[string]*BIRPackage
+	// `map<anydata|readonly>` and `map<anydata|readonly>[]`, created when first needed
+	annotationMappingType semtypes.SemType
+	annotationListType    semtypes.SemType
+	// `stream<any|error, ()>`, created when first needed
+	nilCompletionStreamType semtypes.SemType
 }
 
 type stmtContext struct {
@@ -40,14 +71,31 @@ type stmtContext struct {
 	scope       *BIRScope
 	nextScopeId int
 	// TODO: do better
-	varMap  map[string]*BIROperand
-	loopCtx *loopContext
+	varMap map[string]*BIROperand
+	// types of the variables in varMap, nil if not known without a type checker
+	varTypes map[string]semtypes.SemType
+	xmlnsMap map[string]string
+	loopCtx  *loopContext
+	// resolves the types in the current block scope
+	typeResolver *ast.SemTypeResolver
+	// name of the BIR function being generated, used to name the functions lifted from its workers
+	funcName model.Name
+	// name of the worker whose body is being generated
+	workerName string
+	// next event index of each channel of the worker, keyed by worker pair id
+	channelIndices map[string]int
+	// worker pair ids of the channels the worker sent messages to, in the order of the first send
+	sentChannels []string
+	// number of enclosing lock statements of the statement being generated
+	lockDepth int
 }
 
 type loopContext struct {
 	onBreakBB    *BIRBasicBlock
 	onContinueBB *BIRBasicBlock
 	enclosing    *loopContext
+	// number of locks held when entering the loop, which must still be held when leaving it
+	lockDepth int
 }
 
 func (cx *stmtContext) addLoopCtx(onBreakBB *BIRBasicBlock, onContinueBB *BIRBasicBlock) *loopContext {
@@ -55,6 +103,7 @@ func (cx *stmtContext) addLoopCtx(onBreakBB *BIRBasicBlock, onContinueBB *BIRBas
 		onBreakBB:    onBreakBB,
 		onContinueBB: onContinueBB,
 		enclosing:    cx.loopCtx,
+		lockDepth:    cx.lockDepth,
 	}
 	cx.loopCtx = newCtx
 	return newCtx
@@ -88,39 +137,113 @@ func (cx *stmtContext) addBB() *BIRBasicBlock {
 	return &bb
 }
 
-func GenBir(ctx *context.CompilerContext, ast *ast.BLangPackage) *BIRPackage {
+func GenBir(ctx *context.CompilerContext, astPkg *ast.BLangPackage) *BIRPackage {
+	return GenBirWithDependencies(ctx, astPkg, nil)
+}
+
+// GenBirWithDependencies generates the BIR of a module of a package. dependencies are the already generated modules of
+// the same package that it imports, against which references to their symbols are resolved.
+func GenBirWithDependencies(ctx *context.CompilerContext, astPkg *ast.BLangPackage, dependencies []*BIRPackage) *BIRPackage {
 	birPkg := &BIRPackage{}
-	birPkg.PackageID = ast.PackageID
+	birPkg.PackageID = astPkg.PackageID
 	genCtx := &Context{
-		CompilerContext: ctx,
-		constantMap:     make(map[string]*BIRConstant),
+		CompilerContext:    ctx,
+		packageID:          astPkg.PackageID,
+		constantMap:        make(map[string]*BIRConstant),
+		xmlnsMap:           make(map[string]string),
+		typeResolver:       ast.NewSemTypeResolver(ctx.TypeEnv(), astPkg),
+		globalVarMap:       make(map[string]*BIROperand),
+		globalVarTypes:     make(map[string]semtypes.SemType),
+		functions:          make(map[string]*ast.BLangFunction),
+		annotations:        make(map[string]*BIRAnnotation),
+		listeners:          make(map[string]bool),
+		importedPackageIDs: make(map[string]*model.PackageID),
+		importedModules:    make(map[string]*BIRPackage),
+	}
+	for i := range astPkg.Imports {
+		importModule := TransformImportModule(genCtx, astPkg.Imports[i])
+		registerImport(genCtx, &astPkg.Imports[i], importModule, dependencies)
+		birPkg.ImportModules = appendIfNotNil(birPkg.ImportModules, importModule)
+	}
+	genCtx.typeResolver.ResolveTypeDefinitions()
+	for i := range astPkg.Functions {
+		genCtx.functions[astPkg.Functions[i].Name.Value] = &astPkg.Functions[i]
 	}
-	for _, importPkg := range ast.Imports {
-		birPkg.ImportModules = appendIfNotNil(birPkg.ImportModules, TransformImportModule(genCtx, importPkg))
+	genCtx.isolation = ast.AnalyzeIsolation(astPkg, genCtx.typeResolver)
+	for _, xmlns := range astPkg.XmlnsList {
+		genCtx.xmlnsMap[xmlns.GetPrefix().GetValue()] = xmlnsURI(&xmlns)
 	}
-	for _, typeDef := range ast.TypeDefinitions {
-		birPkg.TypeDefs = appendIfNotNil(birPkg.TypeDefs, TransformTypeDefinition(genCtx, &typeDef))
+	for i := range astPkg.Annotations {
+		birPkg.Annotations = append(birPkg.Annotations, *TransformAnnotation(genCtx, &astPkg.Annotations[i]))
 	}
-	for _, globalVar := range ast.GlobalVars {
-		birPkg.GlobalVars = appendIfNotNil(birPkg.GlobalVars, TransformGlobalVariableDcl(genCtx, &globalVar))
+	for i := range astPkg.TypeDefinitions {
+		birPkg.TypeDefs = appendIfNotNil(birPkg.TypeDefs, TransformTypeDefinition(genCtx, &astPkg.TypeDefinitions[i]))
+	}
+	for i := range astPkg.GlobalVars {
+		globalVar := TransformGlobalVariableDcl(genCtx, &astPkg.GlobalVars[i])
+		genCtx.globalVarMap[globalVar.Name.Value()] = &BIROperand{VariableDcl: &globalVar.BIRVariableDcl}
+		if astPkg.GlobalVars[i].FlagSet.Contains(model.Flag_LISTENER) {
+			genCtx.listeners[globalVar.Name.Value()] = true
+		}
+		birPkg.GlobalVars = append(birPkg.GlobalVars, *globalVar)
+	}
+	for i := range astPkg.Services {
+		serviceDecl, globalVars := TransformServiceDeclaration(genCtx, &astPkg.Services[i])
+		for j := range globalVars {
+			globalVar := &globalVars[j]
+			genCtx.globalVarMap[globalVar.Name.Value()] = &BIROperand{VariableDcl: &globalVar.BIRVariableDcl}
+			birPkg.GlobalVars = append(birPkg.GlobalVars, *globalVar)
+		}
+		birPkg.ServiceDecls = append(birPkg.ServiceDecls, *serviceDecl)
 	}
-	for _, constant := range ast.Constants {
+	for _, constant := range astPkg.Constants {
 		c := TransformConstant(genCtx, &constant)
 		genCtx.constantMap[c.Name.Value()] = c
 		birPkg.Constants = appendIfNotNil(birPkg.Constants, c)
 	}
-	for _, function := range ast.Functions {
+	if len(astPkg.GlobalVars) > 0 || len(astPkg.Services) > 0 {
+		birPkg.Functions = append(birPkg.Functions, *TransformModuleInit(genCtx, astPkg.GlobalVars, astPkg.Services))
+	}
+	var listeners []model.Name
+	for _, globalVar := range birPkg.GlobalVars {
+		if globalVar.Flags&int64(ast.Flags_LISTENER) != 0 {
+			listeners = append(listeners, globalVar.Name)
+		}
+	}
+	if len(listeners) > 0 {
+		birPkg.Functions = append(birPkg.Functions, *TransformModuleStart(genCtx, listeners),
+			*TransformModuleStop(genCtx, listeners))
+	}
+	for _, function := range astPkg.Functions {
 		birPkg.Functions = appendIfNotNil(birPkg.Functions, TransformFunction(genCtx, &function))
+		birPkg.Functions = append(birPkg.Functions, genCtx.liftedFunctions...)
+		genCtx.liftedFunctions = nil
+	}
+	for i := range astPkg.Services {
+		birPkg.TypeDefs = append(birPkg.TypeDefs, *TransformServiceClass(genCtx, &astPkg.Services[i]))
+		birPkg.Functions = append(birPkg.Functions, genCtx.liftedFunctions...)
+		genCtx.liftedFunctions = nil
 	}
 	return birPkg
 }
 
 func TransformImportModule(ctx *Context, ast ast.BLangImportPackage) *BIRImportModule {
 	common.Assert(ast.Symbol == nil)
-	// FIXME: fix this when we have symbol resolution, given only import we support is io we are going to hardcode it
+	// FIXME: fix this when we have symbol resolution, imports of the modules of the current package get their
+	// organization from the project
 	orgName := model.Name("ballerina")
-	pkgName := model.Name("io")
-	version := model.Name("0.0.0")
+	if ast.OrgName != nil && ast.OrgName.Value != "" {
+		orgName = model.Name(ast.OrgName.Value)
+	}
+	nameComps := make([]string, len(ast.PkgNameComps))
+	for i, comp := range ast.PkgNameComps {
+		nameComps[i] = comp.Value
+	}
+	pkgName := model.Name(strings.Join(nameComps, "."))
+	version := model.DEFAULT_VERSION
+	if ast.Version != nil && ast.Version.Value != "" {
+		version = model.Name(ast.Version.Value)
+	}
 	return &BIRImportModule{
 		PackageID: &model.PackageID{
 			OrgName: &orgName,
@@ -130,66 +253,161 @@ func TransformImportModule(ctx *Context, ast ast.BLangImportPackage) *BIRImportM
 	}
 }
 
-func TransformTypeDefinition(ctx *Context, ast *ast.BLangTypeDefinition) *BIRTypeDefinition {
-	panic("unimplemented")
+func TransformTypeDefinition(ctx *Context, astTypeDef *ast.BLangTypeDefinition) *BIRTypeDefinition {
+	name := model.Name(astTypeDef.GetName().GetValue())
+	birTypeDef := &BIRTypeDefinition{}
+	birTypeDef.Pos = astTypeDef.GetPosition()
+	birTypeDef.Name = name
+	birTypeDef.OriginalName = name
+	birTypeDef.Flags = int64(ast.AsMask(astTypeDef.GetFlags()))
+	birTypeDef.Type = astTypeDef.GetTypeNode()
+	birTypeDef.SemType = ctx.typeResolver.ResolveTypeDefinition(astTypeDef)
+	if doc, ok := astTypeDef.GetMarkdownDocumentationAttachment().(*ast.BLangMarkdownDocumentation); ok && doc != nil {
+		birTypeDef.MarkdownDocAttachment = doc.GetDocAttachment()
+	}
+	birTypeDef.AnnotAttachments = transformAnnotationAttachments(ctx, astTypeDef.GetAnnotationAttachments(), model.Point_TYPE)
+	if recordType, ok := astTypeDef.GetTypeNode().(*ast.BLangRecordTypeNode); ok {
+		for i := range recordType.Fields {
+			field := &recordType.Fields[i]
+			attachments := transformAnnotationAttachments(ctx, field.AnnAttachments, model.Point_RECORD_FIELD)
+			if len(attachments) > 0 {
+				birTypeDef.FieldAnnotAttachments = append(birTypeDef.FieldAnnotAttachments,
+					BIRFieldAnnotations{FieldName: model.Name(field.Name.Value), AnnotAttachments: attachments})
+			}
+		}
+	}
+	return birTypeDef
 }
 
-func TransformGlobalVariableDcl(ctx *Context, ast *ast.BLangSimpleVariable) *BIRGlobalVariableDcl {
+func TransformGlobalVariableDcl(ctx *Context, globalVar *ast.BLangSimpleVariable) *BIRGlobalVariableDcl {
 	var name, originalName model.Name
-	common.Assert(ast.Symbol == nil)
-	name = model.Name(ast.GetName().GetValue())
+	common.Assert(globalVar.Symbol == nil)
+	name = model.Name(globalVar.GetName().GetValue())
 	originalName = name
 	birVarDcl := &BIRGlobalVariableDcl{}
-	birVarDcl.Pos = ast.GetPosition()
+	birVarDcl.Pos = globalVar.GetPosition()
 	birVarDcl.Name = name
 	birVarDcl.OriginalName = originalName
+	birVarDcl.Flags = int64(ast.AsMask(globalVar.GetFlags()))
+	birVarDcl.PkgId = ctx.packageID
 	birVarDcl.Scope = VAR_SCOPE_GLOBAL
 	birVarDcl.Kind = VAR_KIND_GLOBAL
 	birVarDcl.MetaVarName = name.Value()
 	return birVarDcl
 }
 
+// moduleInitFunctionName is the name of the function that initializes the module level variables
+const moduleInitFunctionName = "<init>"
+
+// TransformModuleInit generates the function that initializes the module level variables in declaration order, and
+// then attaches the services to their listeners.
+func TransformModuleInit(ctx *Context, globalVars []ast.BLangSimpleVariable, services []ast.BLangService) *BIRFunction {
+	funcName := model.Name(moduleInitFunctionName)
+	stmtCx := newStmtContext(ctx, ctx.typeResolver.NewScope(), ctx.xmlnsMap, funcName, defaultWorkerName)
+	stmtCx.retVar = stmtCx.addLocalVar(model.Name("%0"), nil, VAR_KIND_RETURN)
+	curBB := stmtCx.addBB()
+	for i := range globalVars {
+		globalVar := &globalVars[i]
+		initExpr := globalVar.Expr.(ast.BLangExpression)
+		validateXMLVariableType(stmtCx, globalVar.TypeNode, initExpr)
+		validateLiteralVariableType(stmtCx, globalVar.TypeNode, initExpr)
+		validateTableVariableType(stmtCx, globalVar.TypeNode, initExpr)
+		ctx.globalVarTypes[globalVar.GetName().GetValue()] = variableType(stmtCx, globalVar.TypeNode, initExpr)
+		exprResult := variableInitializer(stmtCx, curBB, globalVar.TypeNode, initExpr)
+		curBB = exprResult.block
+		move := &Move{}
+		move.LhsOp = ctx.globalVarMap[globalVar.GetName().GetValue()]
+		move.RhsOp = exprResult.result
+		curBB.Instructions = append(curBB.Instructions, move)
+	}
+	for i := range services {
+		curBB = attachService(stmtCx, curBB, &services[i])
+	}
+	curBB.Terminator = &Return{}
+	birFunc := &BIRFunction{}
+	birFunc.Name = funcName
+	birFunc.OriginalName = funcName
+	stmtCx.populateFunction(birFunc)
+	return birFunc
+}
+
 func TransformFunction(ctx *Context, astFunc *ast.BLangFunction) *BIRFunction {
+	return transformFunction(ctx, astFunc, model.Point_FUNCTION)
+}
+
+// transformFunction transforms a function or a method, whose annotations are attached at point.
+func transformFunction(ctx *Context, astFunc *ast.BLangFunction, point model.Point) *BIRFunction {
 	common.Assert(astFunc.Symbol == nil)
 	funcName := model.Name(astFunc.GetName().GetValue())
 	birFunc := &BIRFunction{}
 	birFunc.Pos = astFunc.GetPosition()
 	birFunc.Name = funcName
 	birFunc.OriginalName = funcName
+	birFunc.Flags = int64(ast.AsMask(&astFunc.FlagSet))
+	if astFunc.MarkdownDocumentationAttachment != nil {
+		birFunc.MarkdownDocAttachment = astFunc.MarkdownDocumentationAttachment.GetDocAttachment()
+	}
 	common.Assert(astFunc.Receiver == nil)
-	stmtCx := &stmtContext{birCx: ctx, varMap: make(map[string]*BIROperand)}
+	stmtCx := newStmtContext(ctx, ctx.typeResolver.NewScope(), ctx.xmlnsMap, funcName, defaultWorkerName)
 	stmtCx.retVar = stmtCx.addLocalVar(model.Name("%0"), nil, VAR_KIND_RETURN)
+	birFunc.AnnotAttachments = transformAnnotationAttachments(ctx, astFunc.AnnAttachments, point)
+	birFunc.ReturnTypeAnnots = transformAnnotationAttachments(ctx, astFunc.ReturnTypeAnnAttachments, model.Point_RETURN)
 	for _, param := range astFunc.RequiredParams {
-		paramOperand := stmtCx.addLocalVar(model.Name(param.GetName().GetValue()), nil, VAR_KIND_ARG)
+		paramName := model.Name(param.GetName().GetValue())
+		paramOperand := stmtCx.addLocalVar(paramName, nil, VAR_KIND_ARG)
 		stmtCx.varMap[param.GetName().GetValue()] = paramOperand
+		stmtCx.varTypes[param.GetName().GetValue()] = variableType(stmtCx, param.TypeNode, nil)
+		birParam := BIRParameter{Name: paramName,
+			AnnotAttachments: transformAnnotationAttachments(ctx, param.AnnAttachments, model.Point_PARAMETER)}
+		birParam.Pos = param.GetPosition()
+		birFunc.RequiredParams = append(birFunc.RequiredParams, birParam)
 	}
 	switch body := astFunc.Body.(type) {
 	case *ast.BLangBlockFunctionBody:
+		validateFunctionWorkerInteractions(body)
 		handleBlockFunctionBody(stmtCx, body)
 	case *ast.BLangExprFunctionBody:
 		handleExprFunctionBody(stmtCx, body)
 	default:
 		panic("unexpected function body type")
 	}
-	for _, bbPtr := range stmtCx.bbs {
+	stmtCx.populateFunction(birFunc)
+	return birFunc
+}
+
+func newStmtContext(birCx *Context, typeResolver *ast.SemTypeResolver, xmlnsMap map[string]string, funcName model.Name, workerName string) *stmtContext {
+	stmtCx := &stmtContext{birCx: birCx, varMap: make(map[string]*BIROperand), varTypes: make(map[string]semtypes.SemType),
+		xmlnsMap: make(map[string]string), typeResolver: typeResolver, funcName: funcName, workerName: workerName, channelIndices: make(map[string]int)}
+	for prefix, uri := range xmlnsMap {
+		stmtCx.xmlnsMap[prefix] = uri
+	}
+	return stmtCx
+}
+
+func (cx *stmtContext) populateFunction(birFunc *BIRFunction) {
+	for _, bbPtr := range cx.bbs {
 		birFunc.BasicBlocks = append(birFunc.BasicBlocks, *bbPtr)
 	}
-	for _, varPtr := range stmtCx.localVars {
+	for _, varPtr := range cx.localVars {
 		birFunc.LocalVars = append(birFunc.LocalVars, *varPtr)
 	}
-	return birFunc
 }
 
 func TransformConstant(ctx *Context, c *ast.BLangConstant) *BIRConstant {
 	valueExpr := c.Expr
 	if literal, ok := valueExpr.(*ast.BLangLiteral); ok {
 		// FIXME: once we have constant propagation these should be propagated and no longer needed
-		return &BIRConstant{
-			Name: model.Name(c.GetName().GetValue()),
+		birConst := &BIRConstant{
+			Name:  model.Name(c.GetName().GetValue()),
+			Flags: int64(ast.AsMask(c.GetFlags())),
 			ConstValue: ConstValue{
 				Value: literal.Value,
 			},
 		}
+		if doc, ok := c.MarkdownDocumentationAttachment.(*ast.BLangMarkdownDocumentation); ok {
+			birConst.MarkdownDocAttachment = doc.GetDocAttachment()
+		}
+		return birConst
 	}
 	// TODO: need this think how to actually implement constant value initialization. May be we add these to init function?
 	panic("unexpected constant value type")
@@ -227,10 +445,20 @@ func handleStatement(ctx *stmtContext, curBB *BIRBasicBlock, stmt ast.BLangState
 		return assignmentStatement(ctx, curBB, stmt)
 	case *ast.BLangWhile:
 		return whileStatement(ctx, curBB, stmt)
+	case *ast.BLangForeach:
+		return foreachStatement(ctx, curBB, stmt)
 	case *ast.BLangBreak:
 		return breakStatement(ctx, curBB, stmt)
 	case *ast.BLangContinue:
 		return continueStatement(ctx, curBB, stmt)
+	case *ast.BLangXMLNSStatement:
+		return xmlnsStatement(ctx, curBB, stmt)
+	case *ast.BLangTypeDefinition:
+		return localTypeDefinition(ctx, curBB, stmt)
+	case *ast.BLangForkJoin:
+		return forkJoin(ctx, curBB, stmt)
+	case *ast.BLangLock:
+		return lockStatement(ctx, curBB, stmt)
 	default:
 		panic("unexpected statement type")
 	}
@@ -238,6 +466,7 @@ func handleStatement(ctx *stmtContext, curBB *BIRBasicBlock, stmt ast.BLangState
 
 func continueStatement(ctx *stmtContext, curBB *BIRBasicBlock, stmt *ast.BLangContinue) statementEffect {
 	onContinueBB := ctx.loopCtx.onContinueBB
+	curBB = releaseLocks(ctx, curBB, ctx.lockDepth-ctx.loopCtx.lockDepth)
 	curBB.Terminator = &Goto{BIRTerminatorBase: BIRTerminatorBase{ThenBB: onContinueBB}}
 	return statementEffect{
 		// We don't know where to add the next statement so we return nil
@@ -247,6 +476,7 @@ func continueStatement(ctx *stmtContext, curBB *BIRBasicBlock, stmt *ast.BLangCo
 
 func breakStatement(ctx *stmtContext, curBB *BIRBasicBlock, stmt *ast.BLangBreak) statementEffect {
 	onBreakBB := ctx.loopCtx.onBreakBB
+	curBB = releaseLocks(ctx, curBB, ctx.lockDepth-ctx.loopCtx.lockDepth)
 	curBB.Terminator = &Goto{BIRTerminatorBase: BIRTerminatorBase{ThenBB: onBreakBB}}
 	return statementEffect{
 		// We don't know where to add the next statement so we return nil
@@ -282,10 +512,120 @@ func whileStatement(ctx *stmtContext, bb *BIRBasicBlock, stmt *ast.BLangWhile) s
 	}
 }
 
+func foreachStatement(ctx *stmtContext, bb *BIRBasicBlock, stmt *ast.BLangForeach) statementEffect {
+	// FIXME: this should be part of the type checker
+	if streamType := streamTypeOf(ctx, stmt.Collection); streamType != nil && mayCompleteWithError(ctx, streamType) {
+		panic(fmt.Sprintf("invalid iterable type '%s' in foreach statement: the completion type must be nil",
+			semtypes.ToTypeString(ctx.typeResolver.Context(), streamType)))
+	}
+	it := iterate(ctx, bb, stmt.Collection, false)
+	variable := stmt.VariableDefinitionNode.Var
+	varName := variable.Name.Value
+	member := memberOf(ctx, it.loopBody, it, varName)
+	ctx.varMap[varName] = member
+	ctx.varTypes[varName] = variableType(ctx, variable.TypeNode, nil)
+
+	ctx.addLoopCtx(it.loopEnd, it.loopHead)
+	bodyEffect := blockStatement(ctx, it.loopBody, &stmt.Body)
+	// This could happen if the foreach block always ends return, break or continue
+	if bodyEffect.block != nil {
+		bodyEffect.block.Terminator = &Goto{BIRTerminatorBase: BIRTerminatorBase{ThenBB: it.loopHead}}
+	}
+	ctx.popLoopCtx()
+	return statementEffect{
+		block: it.loopEnd,
+	}
+}
+
+// iteration is a loop over the members of an iterable value, using the `iterator` method of the value and the `next`
+// method of its iterator.
+type iteration struct {
+	// block that gets the next member, which continues the loop
+	loopHead *BIRBasicBlock
+	// block that is reached for each member
+	loopBody *BIRBasicBlock
+	// block that is reached once the iterator is exhausted
+	loopEnd *BIRBasicBlock
+	// block that is reached if the iterator completes with an error, which is nil unless the error is checked
+	errorBB *BIRBasicBlock
+	// result of the `next` call, which is a record holding the member in its value field in the loop body
+	next *BIROperand
+}
+
+// iterate creates the blocks of a loop over the members of collection. If checkError is true, a completion of the
+// iterator with an error leaves the loop through the error block instead of the loop end.
+func iterate(ctx *stmtContext, bb *BIRBasicBlock, collection ast.BLangExpression, checkError bool) iteration {
+	collectionEffect := handleExpression(ctx, bb, collection)
+	iteratorEffect := methodCall(ctx, collectionEffect.block, collectionEffect.result, "iterator", nil)
+	loopHead := ctx.addBB()
+	iteratorEffect.block.Terminator = &Goto{BIRTerminatorBase: BIRTerminatorBase{ThenBB: loopHead}}
+
+	// next returns nil once the iterator is exhausted
+	nextEffect := methodCall(ctx, loopHead, iteratorEffect.result, "next", nil)
+	curBB := nextEffect.block
+	nilOperand := ctx.addTempVar(nil)
+	nilLoad := &ConstantLoad{}
+	nilLoad.LhsOp = nilOperand
+	curBB.Instructions = append(curBB.Instructions, nilLoad)
+	isDone := &BinaryOp{}
+	isDone.Kind = INSTRUCTION_KIND_REF_EQUAL
+	isDone.LhsOp = ctx.addTempVar(nil)
+	isDone.RhsOp1 = *nextEffect.result
+	isDone.RhsOp2 = *nilOperand
+	curBB.Instructions = append(curBB.Instructions, isDone)
+	it := iteration{
+		loopHead: loopHead,
+		loopBody: ctx.addBB(),
+		loopEnd:  ctx.addBB(),
+		next:     nextEffect.result,
+	}
+	branch := &Branch{}
+	branch.Op = isDone.LhsOp
+	branch.TrueBB = it.loopEnd
+	branch.FalseBB = it.loopBody
+	curBB.Terminator = branch
+	if !checkError {
+		return it
+	}
+
+	// an iterator of a stream completes with an error by returning it instead of nil
+	errorCheckBB := it.loopBody
+	isError := &TypeTest{}
+	isError.TypeName = model.Name("error")
+	isError.Type = &semtypes.ERROR
+	isError.RhsOp = it.next
+	isError.LhsOp = ctx.addTempVar(nil)
+	errorCheckBB.Instructions = append(errorCheckBB.Instructions, isError)
+	it.loopBody = ctx.addBB()
+	it.errorBB = ctx.addBB()
+	errorBranch := &Branch{}
+	errorBranch.Op = isError.LhsOp
+	errorBranch.TrueBB = it.errorBB
+	errorBranch.FalseBB = it.loopBody
+	errorCheckBB.Terminator = errorBranch
+	return it
+}
+
+// memberOf stores the member the iterator returned in the loop body of it in a new local variable.
+func memberOf(ctx *stmtContext, bb *BIRBasicBlock, it iteration, varName string) *BIROperand {
+	memberAccess := &FieldAccess{}
+	memberAccess.Kind = INSTRUCTION_KIND_MAP_LOAD
+	memberAccess.LhsOp = ctx.addLocalVar(model.Name(varName), nil, VAR_KIND_LOCAL)
+	memberAccess.KeyOp = stringConstant(ctx, bb, "value")
+	memberAccess.RhsOp = it.next
+	bb.Instructions = append(bb.Instructions, memberAccess)
+	return memberAccess.LhsOp
+}
+
 func assignmentStatement(ctx *stmtContext, bb *BIRBasicBlock, stmt *ast.BLangAssignment) statementEffect {
 	switch varRef := stmt.VarRef.(type) {
 	case *ast.BLangIndexBasedAccess:
+		if isTableValued(ctx, varRef.Expr) {
+			return assignToTableMember(ctx, bb, varRef, stmt.Expr)
+		}
 		return assignToMemberStatement(ctx, bb, varRef, stmt.Expr)
+	case *ast.BLangFieldBaseAccess:
+		return assignToField(ctx, bb, varRef, stmt.Expr)
 	case *ast.BLangWildCardBindingPattern:
 		return assignToWildcardBindingPattern(ctx, bb, varRef, stmt.Expr)
 	case *ast.BLangSimpleVarRef:
@@ -340,13 +680,79 @@ func assignToMemberStatement(ctx *stmtContext, bb *BIRBasicBlock, varRef *ast.BL
 	}
 }
 
+// assignToField lowers `x.f = v` to a store of v in the field f of the mapping x.
+func assignToField(ctx *stmtContext, bb *BIRBasicBlock, varRef *ast.BLangFieldBaseAccess, value ast.BLangExpression) statementEffect {
+	valueEffect := handleExpression(ctx, bb, value)
+	containerRefEffect := handleExpression(ctx, valueEffect.block, varRef.Expr)
+	currBB := containerRefEffect.block
+	fieldAccess := &FieldAccess{}
+	fieldAccess.Kind = INSTRUCTION_KIND_MAP_STORE
+	fieldAccess.LhsOp = containerRefEffect.result
+	fieldAccess.KeyOp = stringConstant(ctx, currBB, varRef.Field.Value)
+	fieldAccess.RhsOp = valueEffect.result
+	currBB.Instructions = append(currBB.Instructions, fieldAccess)
+	return statementEffect{
+		block: currBB,
+	}
+}
+
+func xmlnsStatement(ctx *stmtContext, bb *BIRBasicBlock, stmt *ast.BLangXMLNSStatement) statementEffect {
+	// TODO: namespaces are function scoped, like the variables in varMap
+	ctx.xmlnsMap[stmt.XMLNSDecl.GetPrefix().GetValue()] = xmlnsURI(&stmt.XMLNSDecl.BLangXMLNS)
+	return statementEffect{
+		block: bb,
+	}
+}
+
+// localTypeDefinition makes a type definition visible to the rest of the enclosing block. It doesn't produce any
+// instructions.
+func localTypeDefinition(ctx *stmtContext, bb *BIRBasicBlock, stmt *ast.BLangTypeDefinition) statementEffect {
+	ctx.typeResolver.AddTypeDefinition(stmt)
+	ctx.typeResolver.ResolveTypeDefinition(stmt)
+	return statementEffect{
+		block: bb,
+	}
+}
+
+// variableInitializer lowers the initializer of a variable declared with typeNode, which is nil for a variable
+// declared with var. A table constructor without a key specifier gets its key from the declared table type, and an
+// implicit new expression or a `stream` query gets its type from the declared stream type.
+func variableInitializer(ctx *stmtContext, bb *BIRBasicBlock, typeNode model.TypeNode, expr ast.BLangExpression) expressionEffect {
+	if typeNode == nil {
+		return handleExpression(ctx, bb, expr)
+	}
+	switch expr := expr.(type) {
+	case *ast.BLangTableConstructorExpr:
+		if tableType := tableTypeNode(ctx.typeResolver, typeNode); tableType != nil {
+			return tableConstructorExpression(ctx, bb, expr, tableType.KeyFieldNames())
+		}
+	case *ast.BLangTypeInit:
+		if expr.UserDefinedType == nil && streamTypeNode(ctx.typeResolver, typeNode) != nil {
+			return newStreamExpression(ctx, bb, expr, typeNode)
+		}
+	case *ast.BLangQueryExpr:
+		if expr.IsStream && streamTypeNode(ctx.typeResolver, typeNode) != nil {
+			return queryExpression(ctx, bb, expr, typeNode)
+		}
+	}
+	return handleExpression(ctx, bb, expr)
+}
+
 func simpleVariableDefinition(ctx *stmtContext, bb *BIRBasicBlock, stmt *ast.BLangSimpleVariableDef) statementEffect {
-	exprResult := handleExpression(ctx, bb, stmt.Var.Expr.(ast.BLangExpression))
+	if stmt.IsWorker {
+		return workerDefinition(ctx, bb, stmt)
+	}
+	initExpr := stmt.Var.Expr.(ast.BLangExpression)
+	validateXMLVariableType(ctx, stmt.Var.TypeNode, initExpr)
+	validateLiteralVariableType(ctx, stmt.Var.TypeNode, initExpr)
+	validateTableVariableType(ctx, stmt.Var.TypeNode, initExpr)
+	exprResult := variableInitializer(ctx, bb, stmt.Var.TypeNode, initExpr)
 	curBB := exprResult.block
 	move := &Move{}
 	varName := model.Name(stmt.Var.GetName().GetValue())
 	move.LhsOp = ctx.addLocalVar(varName, nil, VAR_KIND_LOCAL)
 	ctx.varMap[varName.Value()] = move.LhsOp
+	ctx.varTypes[varName.Value()] = variableType(ctx, stmt.Var.TypeNode, initExpr)
 	move.RhsOp = exprResult.result
 	curBB.Instructions = append(curBB.Instructions, move)
 	return statementEffect{
@@ -364,10 +770,39 @@ func returnStatement(ctx *stmtContext, bb *BIRBasicBlock, stmt *ast.BLangReturn)
 		mov.RhsOp = valueEffect.result
 		curBB.Instructions = append(curBB.Instructions, mov)
 	}
+	curBB = releaseLocks(ctx, curBB, ctx.lockDepth)
 	curBB.Terminator = &Return{}
 	return statementEffect{}
 }
 
+// lockStatement generates the body of the lock statement between a lock and an unlock. Control flow that leaves the
+// body early releases the lock on its own (see releaseLocks).
+func lockStatement(ctx *stmtContext, bb *BIRBasicBlock, stmt *ast.BLangLock) statementEffect {
+	lockedBB := ctx.addBB()
+	bb.Terminator = &Lock{BIRTerminatorBase: BIRTerminatorBase{ThenBB: lockedBB}}
+	ctx.lockDepth++
+	bodyEffect := blockStatement(ctx, lockedBB, &stmt.Body)
+	ctx.lockDepth--
+	if bodyEffect.block == nil {
+		return statementEffect{}
+	}
+	unlockedBB := ctx.addBB()
+	bodyEffect.block.Terminator = &Unlock{BIRTerminatorBase: BIRTerminatorBase{ThenBB: unlockedBB}}
+	return statementEffect{
+		block: unlockedBB,
+	}
+}
+
+// releaseLocks releases the innermost count locks held at bb, and returns the block in which to continue.
+func releaseLocks(ctx *stmtContext, bb *BIRBasicBlock, count int) *BIRBasicBlock {
+	for i := 0; i < count; i++ {
+		unlockedBB := ctx.addBB()
+		bb.Terminator = &Unlock{BIRTerminatorBase: BIRTerminatorBase{ThenBB: unlockedBB}}
+		bb = unlockedBB
+	}
+	return bb
+}
+
 func expressionStatement(ctx *stmtContext, curBB *BIRBasicBlock, stmt *ast.BLangExpressionStmt) statementEffect {
 	result := handleExpression(ctx, curBB, stmt.Expr)
 	// We are ignoring the expression result (We can have one for things like call)
@@ -414,11 +849,14 @@ func ifStatement(ctx *stmtContext, curBB *BIRBasicBlock, stmt *ast.BLangIf) stat
 }
 
 func blockStatement(ctx *stmtContext, bb *BIRBasicBlock, stmt *ast.BLangBlockStmt) statementEffect {
+	enclosingTypeResolver := ctx.typeResolver
+	ctx.typeResolver = enclosingTypeResolver.NewScope()
 	curBB := bb
 	for _, stmt := range stmt.Stmts {
 		effect := handleStatement(ctx, curBB, stmt)
 		curBB = effect.block
 	}
+	ctx.typeResolver = enclosingTypeResolver
 	return statementEffect{
 		block: curBB,
 	}
@@ -449,10 +887,83 @@ func handleExpression(ctx *stmtContext, curBB *BIRBasicBlock, expr ast.BLangExpr
 		return wildcardBindingPattern(ctx, curBB, expr)
 	case *ast.BLangGroupExpr:
 		return groupExpression(ctx, curBB, expr)
+	case *ast.BLangTernaryExpr:
+		return ternaryExpression(ctx, curBB, expr)
 	case *ast.BLangIndexBasedAccess:
+		if isTableValued(ctx, expr.Expr) {
+			return tableMemberAccess(ctx, curBB, expr)
+		}
 		return indexBasedAccess(ctx, curBB, expr)
+	case *ast.BLangFieldBaseAccess:
+		return fieldBasedAccess(ctx, curBB, expr)
+	case *ast.BLangTableConstructorExpr:
+		return tableConstructorExpression(ctx, curBB, expr, nil)
+	case *ast.BLangQueryExpr:
+		return queryExpression(ctx, curBB, expr, nil)
 	case *ast.BLangListConstructorExpr:
 		return listConstructorExpression(ctx, curBB, expr)
+	case *ast.BLangRecordLiteral:
+		return mappingConstructorExpression(ctx, curBB, expr)
+	case *ast.BLangAnnotAccessExpr:
+		return annotAccessExpression(ctx, curBB, expr)
+	case *ast.BLangTypeInit:
+		return typeInitExpression(ctx, curBB, expr)
+	case *ast.BLangStringTemplateLiteral:
+		return stringTemplateLiteral(ctx, curBB, expr)
+	case *ast.BLangRawTemplateLiteral:
+		return rawTemplateLiteral(ctx, curBB, expr)
+	case *ast.BLangXMLElementLiteral:
+		return xmlElementLiteral(ctx, curBB, expr)
+	case *ast.BLangXMLTextLiteral:
+		return xmlTextLiteral(ctx, curBB, expr)
+	case *ast.BLangXMLCommentLiteral:
+		return xmlCommentLiteral(ctx, curBB, expr)
+	case *ast.BLangXMLProcInsLiteral:
+		return xmlProcInsLiteral(ctx, curBB, expr)
+	case *ast.BLangXMLSequenceLiteral:
+		return xmlSequenceLiteral(ctx, curBB, expr)
+	case *ast.BLangRegExpTemplateLiteral:
+		return regExpTemplateLiteral(ctx, curBB, expr)
+	case *ast.BLangReDisjunction:
+		return reDisjunction(ctx, curBB, expr)
+	case *ast.BLangReSequence:
+		return reSequence(ctx, curBB, expr)
+	case *ast.BLangReAssertion:
+		return reAssertion(ctx, curBB, expr)
+	case *ast.BLangReAtomQuantifier:
+		return reAtomQuantifier(ctx, curBB, expr)
+	case *ast.BLangReAtomCharOrEscape:
+		return reAtomCharOrEscape(ctx, curBB, expr)
+	case *ast.BLangReCharacterClass:
+		return reCharacterClass(ctx, curBB, expr)
+	case *ast.BLangReCharSet:
+		return reCharSet(ctx, curBB, expr)
+	case *ast.BLangReCharSetRange:
+		return reCharSetRange(ctx, curBB, expr)
+	case *ast.BLangReCapturingGroups:
+		return reCapturingGroups(ctx, curBB, expr)
+	case *ast.BLangReFlagExpression:
+		return reFlagExpression(ctx, curBB, expr)
+	case *ast.BLangReFlagsOnOff:
+		return reFlagsOnOff(ctx, curBB, expr)
+	case *ast.BLangReQuantifier:
+		return reQuantifier(ctx, curBB, expr)
+	case *ast.BLangWorkerAsyncSendExpr:
+		return workerSend(ctx, curBB, &expr.BLangWorkerSendExprBase, false)
+	case *ast.BLangWorkerSyncSendExpr:
+		return workerSend(ctx, curBB, &expr.BLangWorkerSendExprBase, true)
+	case *ast.BLangWorkerReceive:
+		return workerReceive(ctx, curBB, expr)
+	case *ast.BLangAlternateWorkerReceive:
+		return alternateWorkerReceive(ctx, curBB, expr)
+	case *ast.BLangMultipleWorkerReceive:
+		return multipleWorkerReceive(ctx, curBB, expr)
+	case *ast.BLangWorkerFlushExpr:
+		return workerFlush(ctx, curBB, expr)
+	case *ast.BLangWaitExpr:
+		return waitExpression(ctx, curBB, expr)
+	case *ast.BLangWaitForAllExpr:
+		return waitForAllExpression(ctx, curBB, expr)
 	default:
 		panic("unexpected expression type")
 	}
@@ -477,119 +988,1155 @@ func listConstructorExpression(ctx *stmtContext, bb *BIRBasicBlock, _ *ast.BLang
 	}
 }
 
-func indexBasedAccess(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangIndexBasedAccess) expressionEffect {
-	// Assignment is handled in assignmentStatement to this is always a load
-	resultOperand := ctx.addTempVar(nil)
-	fieldAccess := &FieldAccess{}
-	fieldAccess.Kind = INSTRUCTION_KIND_ARRAY_LOAD
-	fieldAccess.LhsOp = resultOperand
-	indexEffect := handleExpression(ctx, bb, expr.IndexExpr)
-	fieldAccess.KeyOp = indexEffect.result
-	containerRefEffect := handleExpression(ctx, indexEffect.block, expr.Expr)
-	fieldAccess.RhsOp = containerRefEffect.result
-	bb.Instructions = append(bb.Instructions, fieldAccess)
+// mappingConstructorExpression lowers a mapping constructor to a new structure initialized with its fields in order.
+func mappingConstructorExpression(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangRecordLiteral) expressionEffect {
+	curBB := bb
+	newStructure := &NewStructure{}
+	for _, field := range expr.Fields {
+		keyOperand := stringConstant(ctx, curBB, field.Key.Name())
+		valueEffect := handleExpression(ctx, curBB, field.ValueExpr)
+		curBB = valueEffect.block
+		newStructure.InitialValues = append(newStructure.InitialValues,
+			MappingConstructorEntry{KeyOp: keyOperand, ValueOp: valueEffect.result})
+	}
+	newStructure.LhsOp = ctx.addTempVar(nil)
+	curBB.Instructions = append(curBB.Instructions, newStructure)
 	return expressionEffect{
-		result: resultOperand,
+		result: newStructure.LhsOp,
+		block:  curBB,
 	}
 }
 
-func groupExpression(ctx *stmtContext, curBB *BIRBasicBlock, expr *ast.BLangGroupExpr) expressionEffect {
-	return handleExpression(ctx, curBB, expr.Expression)
+// annotAccessExpression lowers `T.@a` to a lookup of the annotation in the annotations of the typedesc. A reference
+// to a type definition is lowered to a new typedesc for the type.
+func annotAccessExpression(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangAnnotAccessExpr) expressionEffect {
+	if expr.PkgAlias != nil && expr.PkgAlias.Value != "" {
+		panic("annotations of other modules not yet supported")
+	}
+	name := expr.AnnotationName.Value
+	if _, ok := ctx.birCx.annotations[name]; !ok {
+		panic(fmt.Sprintf("undefined annotation '%s'", name))
+	}
+	curBB := bb
+	var typedescOperand *BIROperand
+	if typeDefinition := typeDefinitionReference(ctx, expr.Expr); typeDefinition != nil {
+		newTypeDesc := &NewTypeDesc{}
+		newTypeDesc.TypeName = model.Name(typeDefinition.GetName().GetValue())
+		newTypeDesc.Type = ctx.typeResolver.ResolveTypeDefinition(typeDefinition)
+		newTypeDesc.LhsOp = ctx.addTempVar(nil)
+		curBB.Instructions = append(curBB.Instructions, newTypeDesc)
+		typedescOperand = newTypeDesc.LhsOp
+	} else {
+		typedescEffect := handleExpression(ctx, curBB, expr.Expr)
+		curBB = typedescEffect.block
+		typedescOperand = typedescEffect.result
+	}
+	access := &FieldAccess{}
+	access.Kind = INSTRUCTION_KIND_ANNOT_ACCESS
+	access.KeyOp = stringConstant(ctx, curBB, name)
+	access.RhsOp = typedescOperand
+	access.LhsOp = ctx.addTempVar(nil)
+	curBB.Instructions = append(curBB.Instructions, access)
+	return expressionEffect{
+		result: access.LhsOp,
+		block:  curBB,
+	}
 }
 
-func wildcardBindingPattern(ctx *stmtContext, curBB *BIRBasicBlock, expr *ast.BLangWildCardBindingPattern) expressionEffect {
+// typeInitExpression lowers `new T(args)` to a new instance of the class, on which the `init` method is called.
+func typeInitExpression(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangTypeInit) expressionEffect {
+	if expr.UserDefinedType == nil {
+		panic("cannot infer the type of the object to create")
+	}
+	if streamTypeNode(ctx.typeResolver, expr.UserDefinedType) != nil {
+		return newStreamExpression(ctx, bb, expr, expr.UserDefinedType)
+	}
+	if typeNode, ok := expr.UserDefinedType.(*ast.BLangUserDefinedType); !ok || typeNode.PkgAlias.Value == "" {
+		// FIXME: classes of the current module once we have class definitions
+		panic(fmt.Sprintf("invalid type in new expression: '%s' is not a class", typeNodeName(expr.UserDefinedType)))
+	}
+	curBB := bb
+	var args []BIROperand
+	for _, arg := range expr.ArgsExpr {
+		argEffect := handleExpression(ctx, curBB, arg)
+		curBB = argEffect.block
+		args = append(args, *argEffect.result)
+	}
+	newInstance := &NewInstance{}
+	newInstance.TypeName = model.Name(typeNodeName(expr.UserDefinedType))
+	newInstance.LhsOp = ctx.addTempVar(nil)
+	curBB.Instructions = append(curBB.Instructions, newInstance)
+	initEffect := methodCall(ctx, curBB, newInstance.LhsOp, "init", args)
 	return expressionEffect{
-		result: ctx.addTempVar(nil),
+		result: newInstance.LhsOp,
+		block:  initEffect.block,
+	}
+}
+
+// typeDefinitionReference returns the type definition expr refers to, or nil if expr is not a reference to a type
+// definition.
+func typeDefinitionReference(ctx *stmtContext, expr ast.BLangExpression) *ast.BLangTypeDefinition {
+	varRef, ok := expr.(*ast.BLangSimpleVarRef)
+	if !ok || (varRef.PkgAlias != nil && varRef.PkgAlias.Value != "") {
+		return nil
+	}
+	name := varRef.VariableName.Value
+	if _, ok := ctx.varMap[name]; ok {
+		return nil
+	}
+	if _, ok := ctx.birCx.globalVarMap[name]; ok {
+		return nil
+	}
+	return ctx.typeResolver.LookupTypeDefinition(name)
+}
+
+// stringTemplateLiteral lowers a string template to a chain of string concatenations. Insertions are converted to
+// strings with `value:toString` before they are concatenated.
+func stringTemplateLiteral(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangStringTemplateLiteral) expressionEffect {
+	for _, member := range expr.Exprs {
+		if !isStringValued(member) {
+			validateStringTemplateInsertion(ctx, member)
+		}
+	}
+	return stringConcatenation(ctx, bb, expr.Exprs)
+}
+
+// stringConcatenation concatenates the string values of the given expressions
+func stringConcatenation(ctx *stmtContext, bb *BIRBasicBlock, exprs []ast.BLangExpression) expressionEffect {
+	curBB := bb
+	var result *BIROperand
+	for _, member := range exprs {
+		var memberOperand *BIROperand
+		if isStringValued(member) {
+			memberEffect := handleExpression(ctx, curBB, member)
+			curBB = memberEffect.block
+			memberOperand = memberEffect.result
+		} else {
+			memberEffect := handleExpression(ctx, curBB, member)
+			convertEffect := toStringConversion(ctx, memberEffect.block, memberEffect.result)
+			curBB = convertEffect.block
+			memberOperand = convertEffect.result
+		}
+		if result == nil {
+			result = memberOperand
+			continue
+		}
+		concatOperand := ctx.addTempVar(nil)
+		concat := &BinaryOp{}
+		concat.Kind = INSTRUCTION_KIND_ADD
+		concat.LhsOp = concatOperand
+		concat.RhsOp1 = *result
+		concat.RhsOp2 = *memberOperand
+		curBB.Instructions = append(curBB.Instructions, concat)
+		result = concatOperand
+	}
+	return expressionEffect{
+		result: result,
 		block:  curBB,
 	}
 }
 
-func unaryExpression(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangUnaryExpr) expressionEffect {
-	var kind InstructionKind
-	switch expr.Operator {
-	case model.OperatorKind_NOT:
-		kind = INSTRUCTION_KIND_NOT
-	case model.OperatorKind_SUB:
-		kind = INSTRUCTION_KIND_NEGATE
-	default:
-		panic("unexpected unary operator kind")
+// rawTemplateLiteral lowers a raw template to a structure holding the `strings` and `insertions` arrays of the
+// `object:RawTemplate` value.
+func rawTemplateLiteral(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangRawTemplateLiteral) expressionEffect {
+	curBB := bb
+	var strs []*BIROperand
+	for _, str := range expr.Strings {
+		strEffect := literal(ctx, curBB, str)
+		curBB = strEffect.block
+		strs = append(strs, strEffect.result)
 	}
-	opEffect := handleExpression(ctx, bb, expr.Expr)
+	var insertions []*BIROperand
+	for _, insertion := range expr.Insertions {
+		insertionEffect := handleExpression(ctx, curBB, insertion)
+		curBB = insertionEffect.block
+		insertions = append(insertions, insertionEffect.result)
+	}
+	stringsOperand := newArrayWithValues(ctx, curBB, strs)
+	insertionsOperand := newArrayWithValues(ctx, curBB, insertions)
+
+	stringsKey := stringConstant(ctx, curBB, "strings")
+	insertionsKey := stringConstant(ctx, curBB, "insertions")
 
 	resultOperand := ctx.addTempVar(nil)
-	unaryOp := &UnaryOp{}
-	unaryOp.Kind = kind
-	unaryOp.LhsOp = resultOperand
-	curBB := opEffect.block
-	unaryOp.RhsOp = opEffect.result
-	curBB.Instructions = append(curBB.Instructions, unaryOp)
+	newStructure := &NewStructure{}
+	newStructure.LhsOp = resultOperand
+	newStructure.InitialValues = []MappingConstructorEntry{
+		{KeyOp: stringsKey, ValueOp: stringsOperand},
+		{KeyOp: insertionsKey, ValueOp: insertionsOperand},
+	}
+	curBB.Instructions = append(curBB.Instructions, newStructure)
 	return expressionEffect{
 		result: resultOperand,
-		block:  bb,
+		block:  curBB,
 	}
 }
 
-func invocation(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangInvocation) expressionEffect {
-	curBB := bb
-	var args []BIROperand
-	for _, arg := range expr.ArgExprs {
-		argEffect := handleExpression(ctx, curBB, arg)
-		curBB = argEffect.block
-		args = append(args, *argEffect.result)
+// newArrayWithValues creates an array of the given size and stores each of the values in it
+func newArrayWithValues(ctx *stmtContext, bb *BIRBasicBlock, values []*BIROperand) *BIROperand {
+	sizeOperand := ctx.addTempVar(nil)
+	sizeLoad := &ConstantLoad{}
+	sizeLoad.Value = int64(len(values))
+	sizeLoad.LhsOp = sizeOperand
+	bb.Instructions = append(bb.Instructions, sizeLoad)
+
+	arrayOperand := ctx.addTempVar(nil)
+	newArray := &NewArray{}
+	newArray.LhsOp = arrayOperand
+	newArray.SizeOp = sizeOperand
+	bb.Instructions = append(bb.Instructions, newArray)
+
+	for i, value := range values {
+		indexOperand := ctx.addTempVar(nil)
+		indexLoad := &ConstantLoad{}
+		indexLoad.Value = int64(i)
+		indexLoad.LhsOp = indexOperand
+		bb.Instructions = append(bb.Instructions, indexLoad)
+
+		store := &FieldAccess{}
+		store.Kind = INSTRUCTION_KIND_ARRAY_STORE
+		store.LhsOp = arrayOperand
+		store.KeyOp = indexOperand
+		store.RhsOp = value
+		bb.Instructions = append(bb.Instructions, store)
 	}
+	return arrayOperand
+}
+
+func stringConstant(ctx *stmtContext, bb *BIRBasicBlock, value string) *BIROperand {
+	resultOperand := ctx.addTempVar(nil)
+	constantLoad := &ConstantLoad{}
+	constantLoad.Value = value
+	constantLoad.LhsOp = resultOperand
+	bb.Instructions = append(bb.Instructions, constantLoad)
+	return resultOperand
+}
+
+// toStringConversion converts the operand to a string by calling `value:toString`
+func toStringConversion(ctx *stmtContext, bb *BIRBasicBlock, operand *BIROperand) expressionEffect {
 	thenBB := ctx.addBB()
-	// TODO: deal with type
 	resultOperand := ctx.addTempVar(nil)
 	call := &Call{}
 	call.Kind = INSTRUCTION_KIND_CALL
-	call.Args = args
-	call.Name = model.Name(expr.GetName().GetValue())
+	call.Args = []BIROperand{*operand}
+	call.Name = model.Name("toString")
+	call.CalleePkg = model.VALUE_PKG
 	call.ThenBB = thenBB
 	call.LhsOp = resultOperand
-
-	curBB.Terminator = call
+	bb.Terminator = call
 	return expressionEffect{
 		result: resultOperand,
 		block:  thenBB,
 	}
 }
 
-func literal(ctx *stmtContext, curBB *BIRBasicBlock, expr *ast.BLangLiteral) expressionEffect {
+// isStringValued reports whether the expression is known to produce a string without a type checker
+func isStringValued(expr ast.BLangExpression) bool {
+	switch expr := expr.(type) {
+	case *ast.BLangStringTemplateLiteral:
+		return true
+	case *ast.BLangLiteral:
+		ty, ok := expr.GetBType().(model.Type)
+		return ok && ty.GetTypeKind() == model.TypeKind_STRING
+	default:
+		return false
+	}
+}
+
+// validateStringTemplateInsertion checks that an insertion of a string template is a subtype of
+// `boolean|int|float|decimal|string`.
+// FIXME: only expressions whose type is known without a type checker are validated
+func validateStringTemplateInsertion(ctx *stmtContext, expr ast.BLangExpression) {
+	var found string
+	switch expr := expr.(type) {
+	case *ast.BLangListConstructorExpr:
+		found = "array"
+	case *ast.BLangRawTemplateLiteral:
+		found = "object:RawTemplate"
+	default:
+		ty := expressionType(ctx, expr)
+		if ty == nil {
+			return
+		}
+		cx := ctx.typeResolver.Context()
+		insertionType := semtypes.Union(semtypes.Union(&semtypes.BOOLEAN, &semtypes.INT),
+			semtypes.Union(semtypes.Union(&semtypes.FLOAT, &semtypes.DECIMAL), &semtypes.STRING))
+		if semtypes.IsSubtype(cx, ty, insertionType) {
+			return
+		}
+		found = semtypes.ToTypeString(cx, ty)
+	}
+	panic(fmt.Sprintf("incompatible types: expected 'boolean|int|float|decimal|string', found '%s'", found))
+}
+
+const (
+	XMLNS_PREFIX        = "xmlns"
+	XMLNS_NAMESPACE_URI = "http://www.w3.org/2000/xmlns/"
+)
+
+// xmlElementLiteral lowers an element to a new element whose attributes and children are added one by one. The
+// namespaces declared in the start tag are visible within the element.
+func xmlElementLiteral(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangXMLElementLiteral) expressionEffect {
+	if expr.EndTagName != nil && (expr.StartTagName.Prefix.Value != expr.EndTagName.Prefix.Value ||
+		expr.StartTagName.LocalName.Value != expr.EndTagName.LocalName.Value) {
+		panic("mismatching start and end tags found in xml element")
+	}
+	enclosingXMLNSMap := ctx.xmlnsMap
+	ctx.xmlnsMap = make(map[string]string)
+	for prefix, uri := range enclosingXMLNSMap {
+		ctx.xmlnsMap[prefix] = uri
+	}
+	for _, xmlns := range expr.InlineNamespaces {
+		ctx.xmlnsMap[xmlns.GetPrefix().GetValue()] = xmlnsURI(xmlns)
+	}
+	defer func() {
+		ctx.xmlnsMap = enclosingXMLNSMap
+	}()
+
+	curBB := bb
+	startTagOperand := xmlQName(ctx, curBB, expr.StartTagName, true)
+	defaultNsURIOperand := stringConstant(ctx, curBB, ctx.xmlnsMap[""])
 	resultOperand := ctx.addTempVar(nil)
-	constantLoad := &ConstantLoad{}
-	constantLoad.Value = expr.Value
-	constantLoad.LhsOp = resultOperand
-	curBB.Instructions = append(curBB.Instructions, constantLoad)
+	newElement := &NewXMLElement{}
+	newElement.LhsOp = resultOperand
+	newElement.StartTagOp = startTagOperand
+	newElement.DefaultNsURIOp = defaultNsURIOperand
+	curBB.Instructions = append(curBB.Instructions, newElement)
+
+	for _, attribute := range expr.Attributes {
+		nameOperand := xmlQName(ctx, curBB, attribute.Name, false)
+		valueEffect := stringConcatenation(ctx, curBB, attribute.Value.TextFragments)
+		curBB = valueEffect.block
+		store := &FieldAccess{}
+		store.Kind = INSTRUCTION_KIND_XML_ATTRIBUTE_STORE
+		store.LhsOp = resultOperand
+		store.KeyOp = nameOperand
+		store.RhsOp = valueEffect.result
+		curBB.Instructions = append(curBB.Instructions, store)
+	}
+	curBB = addXMLItems(ctx, curBB, resultOperand, expr.Children)
 	return expressionEffect{
 		result: resultOperand,
 		block:  curBB,
 	}
 }
 
-func binaryExpression(ctx *stmtContext, curBB *BIRBasicBlock, expr *ast.BLangBinaryExpr) expressionEffect {
-	var kind InstructionKind
-	switch expr.OpKind {
-	case model.OperatorKind_ADD:
-		kind = INSTRUCTION_KIND_ADD
-	case model.OperatorKind_SUB:
-		kind = INSTRUCTION_KIND_SUB
-	case model.OperatorKind_MUL:
-		kind = INSTRUCTION_KIND_MUL
-	case model.OperatorKind_DIV:
-		kind = INSTRUCTION_KIND_DIV
-	case model.OperatorKind_MOD:
-		kind = INSTRUCTION_KIND_MOD
-	case model.OperatorKind_AND:
-		kind = INSTRUCTION_KIND_AND
-	case model.OperatorKind_OR:
-		kind = INSTRUCTION_KIND_OR
-	case model.OperatorKind_EQUAL:
-		kind = INSTRUCTION_KIND_EQUAL
-	case model.OperatorKind_NOT_EQUAL:
-		kind = INSTRUCTION_KIND_NOT_EQUAL
-	case model.OperatorKind_GREATER_THAN:
-		kind = INSTRUCTION_KIND_GREATER_THAN
-	case model.OperatorKind_GREATER_EQUAL:
+// xmlQName creates the qualified name of an element or an attribute. Unprefixed element names belong to the default
+// namespace while unprefixed attribute names don't belong to any namespace.
+func xmlQName(ctx *stmtContext, bb *BIRBasicBlock, qname *ast.BLangXMLQName, isElementName bool) *BIROperand {
+	prefix := qname.Prefix.Value
+	var nsURI string
+	switch {
+	case prefix == XMLNS_PREFIX || (!isElementName && prefix == "" && qname.LocalName.Value == XMLNS_PREFIX):
+		nsURI = XMLNS_NAMESPACE_URI
+	case prefix != "":
+		uri, ok := ctx.xmlnsMap[prefix]
+		if !ok {
+			panic(fmt.Sprintf("undefined symbol '%s'", prefix))
+		}
+		nsURI = uri
+	case isElementName:
+		nsURI = ctx.xmlnsMap[""]
+	}
+	localnameOperand := stringConstant(ctx, bb, qname.LocalName.Value)
+	nsURIOperand := stringConstant(ctx, bb, nsURI)
+	prefixOperand := stringConstant(ctx, bb, prefix)
+	resultOperand := ctx.addTempVar(nil)
+	newQName := &NewXMLQName{}
+	newQName.LhsOp = resultOperand
+	newQName.LocalnameOp = localnameOperand
+	newQName.NsURIOp = nsURIOperand
+	newQName.PrefixOp = prefixOperand
+	bb.Instructions = append(bb.Instructions, newQName)
+	return resultOperand
+}
+
+// xmlnsURI returns the namespace URI of a namespace declaration, which must be a string literal
+func xmlnsURI(xmlns model.XMLNSDeclarationNode) string {
+	switch uri := xmlns.GetNamespaceURI().(type) {
+	case *ast.BLangLiteral:
+		return uri.Value.(string)
+	case *ast.BLangXMLQuotedString:
+		var value string
+		for _, fragment := range uri.TextFragments {
+			literal, ok := fragment.(*ast.BLangLiteral)
+			if !ok {
+				panic("xml namespace URI must be a constant string")
+			}
+			value += literal.Value.(string)
+		}
+		return value
+	default:
+		panic("xml namespace URI must be a constant string")
+	}
+}
+
+// xmlTextLiteral lowers a text item to the concatenation of its fragments.
+// FIXME: interpolated xml values should be added as items rather than converted to text, once we have types
+func xmlTextLiteral(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangXMLTextLiteral) expressionEffect {
+	textEffect := stringConcatenation(ctx, bb, expr.TextFragments)
+	curBB := textEffect.block
+	resultOperand := ctx.addTempVar(nil)
+	newText := &NewXMLText{}
+	newText.LhsOp = resultOperand
+	newText.TextOp = textEffect.result
+	curBB.Instructions = append(curBB.Instructions, newText)
+	return expressionEffect{
+		result: resultOperand,
+		block:  curBB,
+	}
+}
+
+func xmlCommentLiteral(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangXMLCommentLiteral) expressionEffect {
+	textEffect := stringConcatenation(ctx, bb, expr.TextFragments)
+	curBB := textEffect.block
+	resultOperand := ctx.addTempVar(nil)
+	newComment := &NewXMLComment{}
+	newComment.LhsOp = resultOperand
+	newComment.TextOp = textEffect.result
+	curBB.Instructions = append(curBB.Instructions, newComment)
+	return expressionEffect{
+		result: resultOperand,
+		block:  curBB,
+	}
+}
+
+func xmlProcInsLiteral(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangXMLProcInsLiteral) expressionEffect {
+	targetEffect := literal(ctx, bb, expr.Target)
+	dataEffect := stringConcatenation(ctx, targetEffect.block, expr.DataFragments)
+	curBB := dataEffect.block
+	resultOperand := ctx.addTempVar(nil)
+	newProcIns := &NewXMLProcIns{}
+	newProcIns.LhsOp = resultOperand
+	newProcIns.TargetOp = targetEffect.result
+	newProcIns.DataOp = dataEffect.result
+	curBB.Instructions = append(curBB.Instructions, newProcIns)
+	return expressionEffect{
+		result: resultOperand,
+		block:  curBB,
+	}
+}
+
+func xmlSequenceLiteral(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangXMLSequenceLiteral) expressionEffect {
+	resultOperand := ctx.addTempVar(nil)
+	newSequence := &NewXMLSequence{}
+	newSequence.LhsOp = resultOperand
+	bb.Instructions = append(bb.Instructions, newSequence)
+	curBB := addXMLItems(ctx, bb, resultOperand, expr.XMLItems)
+	return expressionEffect{
+		result: resultOperand,
+		block:  curBB,
+	}
+}
+
+// addXMLItems appends each of the items to the given element or sequence
+func addXMLItems(ctx *stmtContext, bb *BIRBasicBlock, xmlOperand *BIROperand, items []ast.BLangExpression) *BIRBasicBlock {
+	curBB := bb
+	for _, item := range items {
+		itemEffect := handleExpression(ctx, curBB, item)
+		curBB = itemEffect.block
+		store := &XMLAccess{}
+		store.Kind = INSTRUCTION_KIND_XML_SEQ_STORE
+		store.LhsOp = xmlOperand
+		store.RhsOp = itemEffect.result
+		curBB.Instructions = append(curBB.Instructions, store)
+	}
+	return curBB
+}
+
+// validateXMLVariableType checks that an xml literal assigned to a variable declared with an xml type belongs to that
+// type.
+// FIXME: this should be part of the type checker
+func validateXMLVariableType(ctx *stmtContext, typeNode model.TypeNode, expr ast.BLangExpression) {
+	if typeNode == nil {
+		return
+	}
+	expectedType := xmlTypeDescriptorType(typeNode)
+	actualType := xmlLiteralType(expr)
+	if expectedType == nil || actualType == nil {
+		return
+	}
+	cx := ctx.typeResolver.Context()
+	if !semtypes.IsSubtype(cx, actualType, expectedType) {
+		panic(fmt.Sprintf("incompatible types: expected '%s', found '%s'", xmlTypeDescriptorName(typeNode),
+			xmlLiteralTypeName(expr)))
+	}
+}
+
+// validateLiteralVariableType checks that a literal assigned to a variable belongs to the declared type of the
+// variable.
+// FIXME: this should be part of the type checker
+func validateLiteralVariableType(ctx *stmtContext, typeNode model.TypeNode, expr ast.BLangExpression) {
+	if typeNode == nil {
+		return
+	}
+	shapes := ast.LiteralShapes(expr)
+	if shapes == nil {
+		return
+	}
+	expectedType := ctx.typeResolver.ResolveTypeNode(typeNode)
+	cx := ctx.typeResolver.Context()
+	for _, shape := range shapes {
+		if semtypes.IsSubtype(cx, shape, expectedType) {
+			return
+		}
+	}
+	panic(fmt.Sprintf("incompatible types: expected '%s', found '%s'", typeNodeName(typeNode),
+		basicTypeName(cx, shapes[0])))
+}
+
+// typeNodeName returns the name of a type descriptor, as it would be written in the source.
+func typeNodeName(typeNode model.TypeNode) string {
+	var name string
+	switch typeNode := typeNode.(type) {
+	case *ast.BLangValueType:
+		name = typeKindName(typeNode.TypeKind)
+	case *ast.BLangBuiltInRefTypeNode:
+		name = typeKindName(typeNode.TypeKind)
+	case *ast.BLangUserDefinedType:
+		name = typeNode.TypeName.Value
+		if typeNode.PkgAlias.Value != "" {
+			name = typeNode.PkgAlias.Value + ":" + name
+		}
+	case *ast.BLangArrayType:
+		name = typeNodeName(typeNode.Elemtype) + strings.Repeat("[]", typeNode.Dimensions)
+	case *ast.BLangConstrainedType:
+		name = typeNodeName(typeNode.Type) + "<" + typeNodeName(typeNode.Constraint) + ">"
+	case *ast.BLangUnionTypeNode:
+		if typeNode.Nullable {
+			name = typeNodeName(typeNode.MemberTypeNodes[0]) + "?"
+		} else {
+			name = joinTypeNodeNames(typeNode.MemberTypeNodes, "|")
+		}
+	case *ast.BLangIntersectionTypeNode:
+		name = joinTypeNodeNames(typeNode.ConstituentTypeNodes, " & ")
+	case *ast.BLangTupleTypeNode:
+		members := make([]string, 0, len(typeNode.MemberTypeNodes)+1)
+		for _, member := range typeNode.MemberTypeNodes {
+			members = append(members, typeNodeName(member))
+		}
+		if typeNode.RestParamType != nil {
+			members = append(members, typeNodeName(typeNode.RestParamType)+"...")
+		}
+		name = "[" + strings.Join(members, ", ") + "]"
+	case *ast.BLangRecordTypeNode:
+		open, close := "{", "}"
+		if typeNode.Sealed {
+			open, close = "{|", "|}"
+		}
+		parts := []string{"record", open}
+		for _, field := range typeNode.Fields {
+			fieldName := field.Name.Value
+			if field.FlagSet.Contains(model.Flag_OPTIONAL) {
+				fieldName += "?"
+			}
+			parts = append(parts, typeNodeName(field.TypeNode)+" "+fieldName+";")
+		}
+		if typeNode.RestFieldType != nil {
+			parts = append(parts, typeNodeName(typeNode.RestFieldType)+"...;")
+		}
+		name = strings.Join(append(parts, close), " ")
+	case *ast.BLangTableTypeNode:
+		name = "table<" + typeNodeName(typeNode.Constraint) + ">"
+		if typeNode.TableKeySpecifier != nil {
+			name += " key(" + strings.Join(typeNode.KeyFieldNames(), ", ") + ")"
+		}
+		if typeNode.TableKeyTypeConstraint != nil {
+			name += " key<" + typeNodeName(typeNode.TableKeyTypeConstraint.KeyType) + ">"
+		}
+	case *ast.BLangStreamType:
+		name = "stream"
+		if typeNode.Constraint != nil {
+			name += "<" + typeNodeName(typeNode.Constraint)
+			if typeNode.Error != nil {
+				name += ", " + typeNodeName(typeNode.Error)
+			}
+			name += ">"
+		}
+	case *ast.BLangFiniteTypeNode:
+		values := make([]string, len(typeNode.ValueSpace))
+		for i, value := range typeNode.ValueSpace {
+			values[i] = finiteValueName(value)
+		}
+		name = strings.Join(values, "|")
+	default:
+		panic(fmt.Sprintf("unexpected type node: %T", typeNode))
+	}
+	if typeNode.IsGrouped() {
+		return "(" + name + ")"
+	}
+	return name
+}
+
+func joinTypeNodeNames(typeNodes []model.TypeNode, separator string) string {
+	names := make([]string, len(typeNodes))
+	for i, typeNode := range typeNodes {
+		names[i] = typeNodeName(typeNode)
+	}
+	return strings.Join(names, separator)
+}
+
+func typeKindName(typeKind model.TypeKind) string {
+	if typeKind == model.TypeKind_NIL {
+		return "()"
+	}
+	return string(typeKind)
+}
+
+func finiteValueName(value ast.BLangExpression) string {
+	switch value := value.(type) {
+	case *ast.BLangLiteral:
+		return value.OriginalValue
+	case *ast.BLangNumericLiteral:
+		return value.OriginalValue
+	case *ast.BLangUnaryExpr:
+		return string(value.Operator) + finiteValueName(value.Expr)
+	default:
+		panic(fmt.Sprintf("unexpected value in finite type: %T", value))
+	}
+}
+
+// basicTypeName returns the name of the basic type of a literal's shape.
+func basicTypeName(cx semtypes.Context, shape semtypes.SemType) string {
+	basicTypes := []struct {
+		name string
+		ty   semtypes.SemType
+	}{
+		{"int", &semtypes.INT},
+		{"float", &semtypes.FLOAT},
+		{"decimal", &semtypes.DECIMAL},
+		{"string", &semtypes.STRING},
+		{"boolean", &semtypes.BOOLEAN},
+		{"()", &semtypes.NIL},
+	}
+	for _, basicType := range basicTypes {
+		if semtypes.IsSubtype(cx, shape, basicType.ty) {
+			return basicType.name
+		}
+	}
+	panic("unexpected literal shape")
+}
+
+// xmlTypeDescriptorType returns the semtype of an `xml` or `xml<T>` type descriptor, or nil for any other type
+func xmlTypeDescriptorType(typeNode model.TypeNode) semtypes.SemType {
+	switch typeNode := typeNode.(type) {
+	case *ast.BLangBuiltInRefTypeNode:
+		if typeNode.TypeKind == model.TypeKind_XML {
+			return &semtypes.XML
+		}
+	case *ast.BLangConstrainedType:
+		if xmlTypeDescriptorType(typeNode.Type) == nil {
+			return nil
+		}
+		constraint := xmlConstraintType(typeNode.Constraint)
+		if constraint == nil {
+			return nil
+		}
+		return semtypes.XmlSequence(constraint)
+	}
+	return nil
+}
+
+func xmlConstraintType(typeNode model.TypeNode) semtypes.SemType {
+	switch typeNode := typeNode.(type) {
+	case *ast.BLangUserDefinedType:
+		if typeNode.PkgAlias.Value != "xml" {
+			return nil
+		}
+		switch typeNode.TypeName.Value {
+		case "Element":
+			return semtypes.XML_ELEMENT
+		case "Comment":
+			return semtypes.XML_COMMENT
+		case "Text":
+			return semtypes.XML_TEXT
+		case "ProcessingInstruction":
+			return semtypes.XML_PI
+		}
+	case *ast.BLangValueType:
+		if typeNode.TypeKind == model.TypeKind_NEVER {
+			return &semtypes.NEVER
+		}
+	default:
+		return xmlTypeDescriptorType(typeNode)
+	}
+	return nil
+}
+
+func xmlTypeDescriptorName(typeNode model.TypeNode) string {
+	constrainedType, ok := typeNode.(*ast.BLangConstrainedType)
+	if !ok {
+		return "xml"
+	}
+	switch constraint := constrainedType.Constraint.(type) {
+	case *ast.BLangUserDefinedType:
+		return "xml<" + constraint.PkgAlias.Value + ":" + constraint.TypeName.Value + ">"
+	case *ast.BLangValueType:
+		return "xml<" + string(constraint.TypeKind) + ">"
+	default:
+		return "xml<xml>"
+	}
+}
+
+// xmlLiteralType returns the semtype of an xml literal, or nil if the expression is not an xml literal
+func xmlLiteralType(expr ast.BLangExpression) semtypes.SemType {
+	switch expr := expr.(type) {
+	case *ast.BLangXMLElementLiteral:
+		return semtypes.XML_ELEMENT
+	case *ast.BLangXMLTextLiteral:
+		return semtypes.XML_TEXT
+	case *ast.BLangXMLCommentLiteral:
+		return semtypes.XML_COMMENT
+	case *ast.BLangXMLProcInsLiteral:
+		return semtypes.XML_PI
+	case *ast.BLangXMLSequenceLiteral:
+		var itemType semtypes.SemType = &semtypes.NEVER
+		for _, item := range expr.XMLItems {
+			ty := xmlLiteralType(item)
+			if ty == nil {
+				return &semtypes.XML
+			}
+			itemType = semtypes.Union(itemType, ty)
+		}
+		return semtypes.XmlSequence(itemType)
+	default:
+		return nil
+	}
+}
+
+func xmlLiteralTypeName(expr ast.BLangExpression) string {
+	switch expr.(type) {
+	case *ast.BLangXMLElementLiteral:
+		return "xml:Element"
+	case *ast.BLangXMLTextLiteral:
+		return "xml:Text"
+	case *ast.BLangXMLCommentLiteral:
+		return "xml:Comment"
+	case *ast.BLangXMLProcInsLiteral:
+		return "xml:ProcessingInstruction"
+	default:
+		return "xml"
+	}
+}
+
+func regExpTemplateLiteral(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangRegExpTemplateLiteral) expressionEffect {
+	disjunctionEffect := reDisjunction(ctx, bb, expr.ReDisjunction)
+	curBB := disjunctionEffect.block
+	resultOperand := ctx.addTempVar(nil)
+	newRegExp := &NewRegExp{}
+	newRegExp.LhsOp = resultOperand
+	newRegExp.ReDisjunctionOp = disjunctionEffect.result
+	curBB.Instructions = append(curBB.Instructions, newRegExp)
+	return expressionEffect{
+		result: resultOperand,
+		block:  curBB,
+	}
+}
+
+func reDisjunction(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangReDisjunction) expressionEffect {
+	curBB := bb
+	var sequenceOps []*BIROperand
+	for _, sequence := range expr.SequenceList {
+		sequenceEffect := reSequence(ctx, curBB, sequence)
+		curBB = sequenceEffect.block
+		sequenceOps = append(sequenceOps, sequenceEffect.result)
+	}
+	resultOperand := ctx.addTempVar(nil)
+	newDisjunction := &NewReDisjunction{}
+	newDisjunction.LhsOp = resultOperand
+	newDisjunction.SequenceOps = sequenceOps
+	curBB.Instructions = append(curBB.Instructions, newDisjunction)
+	return expressionEffect{
+		result: resultOperand,
+		block:  curBB,
+	}
+}
+
+func reSequence(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangReSequence) expressionEffect {
+	curBB := bb
+	var termOps []*BIROperand
+	for _, term := range expr.TermList {
+		termEffect := handleExpression(ctx, curBB, term)
+		curBB = termEffect.block
+		termOps = append(termOps, termEffect.result)
+	}
+	resultOperand := ctx.addTempVar(nil)
+	newSequence := &NewReSequence{}
+	newSequence.LhsOp = resultOperand
+	newSequence.TermOps = termOps
+	curBB.Instructions = append(curBB.Instructions, newSequence)
+	return expressionEffect{
+		result: resultOperand,
+		block:  curBB,
+	}
+}
+
+func reAssertion(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangReAssertion) expressionEffect {
+	assertionOperand := stringConstant(ctx, bb, expr.Assertion.Value.(string))
+	resultOperand := ctx.addTempVar(nil)
+	newAssertion := &NewReAssertion{}
+	newAssertion.LhsOp = resultOperand
+	newAssertion.AssertionOp = assertionOperand
+	bb.Instructions = append(bb.Instructions, newAssertion)
+	return expressionEffect{
+		result: resultOperand,
+		block:  bb,
+	}
+}
+
+// reAtomQuantifier lowers an atom and its quantifier. An atom without a quantifier gets an empty quantifier.
+func reAtomQuantifier(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangReAtomQuantifier) expressionEffect {
+	atomEffect := handleExpression(ctx, bb, expr.Atom)
+	curBB := atomEffect.block
+	var quantifierEffect expressionEffect
+	if expr.Quantifier != nil {
+		quantifierEffect = reQuantifier(ctx, curBB, expr.Quantifier)
+	} else {
+		quantifierEffect = newReQuantifier(ctx, curBB, "", "")
+	}
+	curBB = quantifierEffect.block
+	resultOperand := ctx.addTempVar(nil)
+	newAtomQuantifier := &NewReAtomQuantifier{}
+	newAtomQuantifier.LhsOp = resultOperand
+	newAtomQuantifier.AtomOp = atomEffect.result
+	newAtomQuantifier.QuantifierOp = quantifierEffect.result
+	curBB.Instructions = append(curBB.Instructions, newAtomQuantifier)
+	return expressionEffect{
+		result: resultOperand,
+		block:  curBB,
+	}
+}
+
+// reAtomCharOrEscape lowers a character or an escape of a regexp. Interpolated values are converted to strings.
+func reAtomCharOrEscape(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangReAtomCharOrEscape) expressionEffect {
+	charEffect := stringConcatenation(ctx, bb, []ast.BLangExpression{expr.CharOrEscape})
+	curBB := charEffect.block
+	resultOperand := ctx.addTempVar(nil)
+	newCharOrEscape := &NewReLiteralCharOrEscape{}
+	newCharOrEscape.LhsOp = resultOperand
+	newCharOrEscape.CharOrEscapeOp = charEffect.result
+	curBB.Instructions = append(curBB.Instructions, newCharOrEscape)
+	return expressionEffect{
+		result: resultOperand,
+		block:  curBB,
+	}
+}
+
+func reCharacterClass(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangReCharacterClass) expressionEffect {
+	classStartOperand := stringConstant(ctx, bb, expr.CharClassStart.Value.(string))
+	negation := ""
+	if expr.Negation != nil {
+		negation = expr.Negation.Value.(string)
+	}
+	negationOperand := stringConstant(ctx, bb, negation)
+	charSetEffect := reCharSet(ctx, bb, expr.CharSet)
+	curBB := charSetEffect.block
+	classEndOperand := stringConstant(ctx, curBB, expr.CharClassEnd.Value.(string))
+	resultOperand := ctx.addTempVar(nil)
+	newCharClass := &NewReCharClass{}
+	newCharClass.LhsOp = resultOperand
+	newCharClass.ClassStartOp = classStartOperand
+	newCharClass.NegationOp = negationOperand
+	newCharClass.CharSetOp = charSetEffect.result
+	newCharClass.ClassEndOp = classEndOperand
+	curBB.Instructions = append(curBB.Instructions, newCharClass)
+	return expressionEffect{
+		result: resultOperand,
+		block:  curBB,
+	}
+}
+
+func reCharSet(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangReCharSet) expressionEffect {
+	curBB := bb
+	var charSetAtomOps []*BIROperand
+	for _, atom := range expr.CharSetAtoms {
+		atomEffect := handleExpression(ctx, curBB, atom)
+		curBB = atomEffect.block
+		charSetAtomOps = append(charSetAtomOps, atomEffect.result)
+	}
+	resultOperand := ctx.addTempVar(nil)
+	newCharSet := &NewReCharSet{}
+	newCharSet.LhsOp = resultOperand
+	newCharSet.CharSetAtomOps = charSetAtomOps
+	curBB.Instructions = append(curBB.Instructions, newCharSet)
+	return expressionEffect{
+		result: resultOperand,
+		block:  curBB,
+	}
+}
+
+func reCharSetRange(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangReCharSetRange) expressionEffect {
+	lhsEffect := handleExpression(ctx, bb, expr.LhsCharSetAtom)
+	dashOperand := stringConstant(ctx, lhsEffect.block, expr.Dash.Value.(string))
+	rhsEffect := handleExpression(ctx, lhsEffect.block, expr.RhsCharSetAtom)
+	curBB := rhsEffect.block
+	resultOperand := ctx.addTempVar(nil)
+	newCharSetRange := &NewReCharSetRange{}
+	newCharSetRange.LhsOp = resultOperand
+	newCharSetRange.LhsCharSetAtomOp = lhsEffect.result
+	newCharSetRange.DashOp = dashOperand
+	newCharSetRange.RhsCharSetAtomOp = rhsEffect.result
+	curBB.Instructions = append(curBB.Instructions, newCharSetRange)
+	return expressionEffect{
+		result: resultOperand,
+		block:  curBB,
+	}
+}
+
+// reCapturingGroups lowers a capturing group. A group without a flag expression gets an empty flag expression.
+func reCapturingGroups(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangReCapturingGroups) expressionEffect {
+	openParenOperand := stringConstant(ctx, bb, expr.OpenParen.Value.(string))
+	var flagExprEffect expressionEffect
+	if expr.FlagExpr != nil {
+		flagExprEffect = reFlagExpression(ctx, bb, expr.FlagExpr)
+	} else {
+		flagExprEffect = newReFlagExpr(ctx, bb, "", newReFlagOnOff(ctx, bb, "").result, "")
+	}
+	disjunctionEffect := reDisjunction(ctx, flagExprEffect.block, expr.Disjunction)
+	curBB := disjunctionEffect.block
+	closeParenOperand := stringConstant(ctx, curBB, expr.CloseParen.Value.(string))
+	resultOperand := ctx.addTempVar(nil)
+	newCapturingGroup := &NewReCapturingGroup{}
+	newCapturingGroup.LhsOp = resultOperand
+	newCapturingGroup.OpenParenOp = openParenOperand
+	newCapturingGroup.FlagExprOp = flagExprEffect.result
+	newCapturingGroup.ReDisjunctionOp = disjunctionEffect.result
+	newCapturingGroup.CloseParenOp = closeParenOperand
+	curBB.Instructions = append(curBB.Instructions, newCapturingGroup)
+	return expressionEffect{
+		result: resultOperand,
+		block:  curBB,
+	}
+}
+
+func reFlagExpression(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangReFlagExpression) expressionEffect {
+	flagsOnOffEffect := reFlagsOnOff(ctx, bb, expr.FlagsOnOff)
+	return newReFlagExpr(ctx, flagsOnOffEffect.block, expr.QuestionMark.Value.(string), flagsOnOffEffect.result,
+		expr.Colon.Value.(string))
+}
+
+func newReFlagExpr(ctx *stmtContext, bb *BIRBasicBlock, questionMark string, flagsOnOffOperand *BIROperand, colon string) expressionEffect {
+	questionMarkOperand := stringConstant(ctx, bb, questionMark)
+	colonOperand := stringConstant(ctx, bb, colon)
+	resultOperand := ctx.addTempVar(nil)
+	newFlagExpr := &NewReFlagExpr{}
+	newFlagExpr.LhsOp = resultOperand
+	newFlagExpr.QuestionMarkOp = questionMarkOperand
+	newFlagExpr.FlagsOnOffOp = flagsOnOffOperand
+	newFlagExpr.ColonOp = colonOperand
+	bb.Instructions = append(bb.Instructions, newFlagExpr)
+	return expressionEffect{
+		result: resultOperand,
+		block:  bb,
+	}
+}
+
+func reFlagsOnOff(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangReFlagsOnOff) expressionEffect {
+	return newReFlagOnOff(ctx, bb, expr.Flags.Value.(string))
+}
+
+func newReFlagOnOff(ctx *stmtContext, bb *BIRBasicBlock, flags string) expressionEffect {
+	flagsOperand := stringConstant(ctx, bb, flags)
+	resultOperand := ctx.addTempVar(nil)
+	newFlagOnOff := &NewReFlagOnOff{}
+	newFlagOnOff.LhsOp = resultOperand
+	newFlagOnOff.FlagsOp = flagsOperand
+	bb.Instructions = append(bb.Instructions, newFlagOnOff)
+	return expressionEffect{
+		result: resultOperand,
+		block:  bb,
+	}
+}
+
+func reQuantifier(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangReQuantifier) expressionEffect {
+	nonGreedyChar := ""
+	if expr.NonGreedyChar != nil {
+		nonGreedyChar = expr.NonGreedyChar.Value.(string)
+	}
+	return newReQuantifier(ctx, bb, expr.Quantifier.Value.(string), nonGreedyChar)
+}
+
+func newReQuantifier(ctx *stmtContext, bb *BIRBasicBlock, quantifier string, nonGreedyChar string) expressionEffect {
+	quantifierOperand := stringConstant(ctx, bb, quantifier)
+	nonGreedyCharOperand := stringConstant(ctx, bb, nonGreedyChar)
+	resultOperand := ctx.addTempVar(nil)
+	newQuantifier := &NewReQuantifier{}
+	newQuantifier.LhsOp = resultOperand
+	newQuantifier.QuantifierOp = quantifierOperand
+	newQuantifier.NonGreedyCharOp = nonGreedyCharOperand
+	bb.Instructions = append(bb.Instructions, newQuantifier)
+	return expressionEffect{
+		result: resultOperand,
+		block:  bb,
+	}
+}
+
+func indexBasedAccess(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangIndexBasedAccess) expressionEffect {
+	// Assignment is handled in assignmentStatement to this is always a load
+	resultOperand := ctx.addTempVar(nil)
+	fieldAccess := &FieldAccess{}
+	fieldAccess.Kind = INSTRUCTION_KIND_ARRAY_LOAD
+	fieldAccess.LhsOp = resultOperand
+	indexEffect := handleExpression(ctx, bb, expr.IndexExpr)
+	fieldAccess.KeyOp = indexEffect.result
+	containerRefEffect := handleExpression(ctx, indexEffect.block, expr.Expr)
+	fieldAccess.RhsOp = containerRefEffect.result
+	curBB := containerRefEffect.block
+	curBB.Instructions = append(curBB.Instructions, fieldAccess)
+	return expressionEffect{
+		result: resultOperand,
+		block:  curBB,
+	}
+}
+
+// fieldBasedAccess lowers `x.f` to a load of the field f of the mapping x.
+func fieldBasedAccess(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangFieldBaseAccess) expressionEffect {
+	containerRefEffect := handleExpression(ctx, bb, expr.Expr)
+	curBB := containerRefEffect.block
+	fieldAccess := &FieldAccess{}
+	fieldAccess.Kind = INSTRUCTION_KIND_MAP_LOAD
+	fieldAccess.LhsOp = ctx.addTempVar(nil)
+	fieldAccess.KeyOp = stringConstant(ctx, curBB, expr.Field.Value)
+	fieldAccess.RhsOp = containerRefEffect.result
+	curBB.Instructions = append(curBB.Instructions, fieldAccess)
+	return expressionEffect{
+		result: fieldAccess.LhsOp,
+		block:  curBB,
+	}
+}
+
+func groupExpression(ctx *stmtContext, curBB *BIRBasicBlock, expr *ast.BLangGroupExpr) expressionEffect {
+	return handleExpression(ctx, curBB, expr.Expression)
+}
+
+func ternaryExpression(ctx *stmtContext, curBB *BIRBasicBlock, expr *ast.BLangTernaryExpr) expressionEffect {
+	cond := handleExpression(ctx, curBB, expr.Expr)
+	resultOperand := ctx.addTempVar(nil)
+	thenBB := ctx.addBB()
+	elseBB := ctx.addBB()
+	branch := &Branch{}
+	branch.Op = cond.result
+	branch.TrueBB = thenBB
+	branch.FalseBB = elseBB
+	cond.block.Terminator = branch
+	finalBB := ctx.addBB()
+	// each arm moves its value to the result and joins the other at finalBB
+	arm := func(bb *BIRBasicBlock, armExpr ast.BLangExpression) {
+		armEffect := handleExpression(ctx, bb, armExpr)
+		mov := &Move{}
+		mov.LhsOp = resultOperand
+		mov.RhsOp = armEffect.result
+		armEffect.block.Instructions = append(armEffect.block.Instructions, mov)
+		armEffect.block.Terminator = &Goto{BIRTerminatorBase: BIRTerminatorBase{ThenBB: finalBB}}
+	}
+	arm(thenBB, expr.ThenExpr)
+	arm(elseBB, expr.ElseExpr)
+	return expressionEffect{
+		result: resultOperand,
+		block:  finalBB,
+	}
+}
+
+func wildcardBindingPattern(ctx *stmtContext, curBB *BIRBasicBlock, expr *ast.BLangWildCardBindingPattern) expressionEffect {
+	return expressionEffect{
+		result: ctx.addTempVar(nil),
+		block:  curBB,
+	}
+}
+
+func unaryExpression(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangUnaryExpr) expressionEffect {
+	var kind InstructionKind
+	switch expr.Operator {
+	case model.OperatorKind_NOT:
+		kind = INSTRUCTION_KIND_NOT
+	case model.OperatorKind_SUB:
+		kind = INSTRUCTION_KIND_NEGATE
+	default:
+		panic("unexpected unary operator kind")
+	}
+	opEffect := handleExpression(ctx, bb, expr.Expr)
+
+	resultOperand := ctx.addTempVar(nil)
+	unaryOp := &UnaryOp{}
+	unaryOp.Kind = kind
+	unaryOp.LhsOp = resultOperand
+	curBB := opEffect.block
+	unaryOp.RhsOp = opEffect.result
+	curBB.Instructions = append(curBB.Instructions, unaryOp)
+	return expressionEffect{
+		result: resultOperand,
+		block:  bb,
+	}
+}
+
+func invocation(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangInvocation) expressionEffect {
+	curBB := bb
+	var args []BIROperand
+	for _, arg := range expr.ArgExprs {
+		argEffect := handleExpression(ctx, curBB, arg)
+		curBB = argEffect.block
+		args = append(args, *argEffect.result)
+	}
+	thenBB := ctx.addBB()
+	// TODO: deal with type
+	resultOperand := ctx.addTempVar(nil)
+	call := &Call{}
+	if expr.Async {
+		call.Kind = INSTRUCTION_KIND_ASYNC_CALL
+	} else {
+		call.Kind = INSTRUCTION_KIND_CALL
+	}
+	call.Args = args
+	call.Name = model.Name(expr.GetName().GetValue())
+	if expr.PkgAlias != nil && expr.PkgAlias.Value != "" {
+		call.CalleePkg = importedFunction(ctx.birCx, expr.PkgAlias.Value, call.Name.Value())
+	}
+	call.ThenBB = thenBB
+	call.LhsOp = resultOperand
+
+	curBB.Terminator = call
+	return expressionEffect{
+		result: resultOperand,
+		block:  thenBB,
+	}
+}
+
+func literal(ctx *stmtContext, curBB *BIRBasicBlock, expr *ast.BLangLiteral) expressionEffect {
+	resultOperand := ctx.addTempVar(nil)
+	constantLoad := &ConstantLoad{}
+	constantLoad.Value = expr.Value
+	constantLoad.LhsOp = resultOperand
+	curBB.Instructions = append(curBB.Instructions, constantLoad)
+	return expressionEffect{
+		result: resultOperand,
+		block:  curBB,
+	}
+}
+
+func binaryExpression(ctx *stmtContext, curBB *BIRBasicBlock, expr *ast.BLangBinaryExpr) expressionEffect {
+	var kind InstructionKind
+	switch expr.OpKind {
+	case model.OperatorKind_ADD:
+		kind = INSTRUCTION_KIND_ADD
+	case model.OperatorKind_SUB:
+		kind = INSTRUCTION_KIND_SUB
+	case model.OperatorKind_MUL:
+		kind = INSTRUCTION_KIND_MUL
+	case model.OperatorKind_DIV:
+		kind = INSTRUCTION_KIND_DIV
+	case model.OperatorKind_MOD:
+		kind = INSTRUCTION_KIND_MOD
+	case model.OperatorKind_AND:
+		kind = INSTRUCTION_KIND_AND
+	case model.OperatorKind_OR:
+		kind = INSTRUCTION_KIND_OR
+	case model.OperatorKind_EQUAL:
+		kind = INSTRUCTION_KIND_EQUAL
+	case model.OperatorKind_NOT_EQUAL:
+		kind = INSTRUCTION_KIND_NOT_EQUAL
+	case model.OperatorKind_GREATER_THAN:
+		kind = INSTRUCTION_KIND_GREATER_THAN
+	case model.OperatorKind_GREATER_EQUAL:
 		kind = INSTRUCTION_KIND_GREATER_EQUAL
 	case model.OperatorKind_LESS_THAN:
 		kind = INSTRUCTION_KIND_LESS_THAN
@@ -621,7 +2168,15 @@ func binaryExpression(ctx *stmtContext, curBB *BIRBasicBlock, expr *ast.BLangBin
 
 func simpleVariableReference(ctx *stmtContext, curBB *BIRBasicBlock, expr *ast.BLangSimpleVarRef) expressionEffect {
 	varName := expr.VariableName.GetValue()
+	if expr.PkgAlias != nil && expr.PkgAlias.Value != "" {
+		if effect, ok := importedVariableReference(ctx, curBB, expr.PkgAlias.Value, varName); ok {
+			return effect
+		}
+	}
 	operand, ok := ctx.varMap[varName]
+	if !ok {
+		operand, ok = ctx.birCx.globalVarMap[varName]
+	}
 	if !ok {
 		// FIXME: this is a hack until we have constant propagation. At which point these should be literals
 		constant, ok := ctx.birCx.constantMap[varName]
@@ -646,6 +2201,439 @@ func simpleVariableReference(ctx *stmtContext, curBB *BIRBasicBlock, expr *ast.B
 	}
 }
 
+// defaultWorkerName is the name by which the workers of a function refer to its default worker
+const defaultWorkerName = "function"
+
+// workerDefinition lifts the body of a named worker to a function of its own and starts it. The resulting future is
+// bound to the worker name. The worker gets the variables visible at its definition as arguments, so it sees their
+// values at the time it starts.
+func workerDefinition(ctx *stmtContext, bb *BIRBasicBlock, stmt *ast.BLangSimpleVariableDef) statementEffect {
+	workerName := stmt.Var.GetName().GetValue()
+	workerFunc := stmt.Var.Expr.(*ast.BLangLambdaFunction).Function
+	capturedNames := make([]string, 0, len(ctx.varMap))
+	for name := range ctx.varMap {
+		capturedNames = append(capturedNames, name)
+	}
+	sort.Strings(capturedNames)
+
+	liftedName := model.Name(ctx.funcName.Value() + "$worker$" + workerName)
+	workerCx := newStmtContext(ctx.birCx, ctx.typeResolver.NewScope(), ctx.xmlnsMap, liftedName, workerName)
+	workerCx.retVar = workerCx.addLocalVar(model.Name("%0"), nil, VAR_KIND_RETURN)
+	args := make([]BIROperand, 0, len(capturedNames))
+	for _, name := range capturedNames {
+		workerCx.varMap[name] = workerCx.addLocalVar(model.Name(name), nil, VAR_KIND_ARG)
+		workerCx.varTypes[name] = ctx.varTypes[name]
+		args = append(args, *ctx.varMap[name])
+	}
+	handleBlockFunctionBody(workerCx, workerFunc.Body.(*ast.BLangBlockFunctionBody))
+	birFunc := BIRFunction{}
+	birFunc.Pos = workerFunc.GetPosition()
+	birFunc.Name = liftedName
+	birFunc.OriginalName = liftedName
+	workerCx.populateFunction(&birFunc)
+	ctx.birCx.liftedFunctions = append(ctx.birCx.liftedFunctions, birFunc)
+
+	thenBB := ctx.addBB()
+	futureOperand := ctx.addLocalVar(model.Name(workerName), nil, VAR_KIND_LOCAL)
+	ctx.varMap[workerName] = futureOperand
+	call := &Call{}
+	call.Kind = INSTRUCTION_KIND_ASYNC_CALL
+	call.Args = args
+	call.Name = liftedName
+	call.ThenBB = thenBB
+	call.LhsOp = futureOperand
+	bb.Terminator = call
+	return statementEffect{
+		block: thenBB,
+	}
+}
+
+// forkJoin doesn't produce any instructions since the workers of the fork are started by their definitions.
+func forkJoin(ctx *stmtContext, bb *BIRBasicBlock, stmt *ast.BLangForkJoin) statementEffect {
+	var workers []workerActions
+	for _, workerDef := range stmt.Workers {
+		workers = append(workers, namedWorkerActions(workerDef))
+	}
+	validateWorkerInteractions(workers)
+	return statementEffect{
+		block: bb,
+	}
+}
+
+// nextChannel returns the channel of the next message between the given workers, as seen by the current worker
+func (cx *stmtContext) nextChannel(sender, receiver string) *ast.Channel {
+	pairId := ast.WorkerPairId(sender, receiver)
+	index := cx.channelIndices[pairId]
+	cx.channelIndices[pairId] = index + 1
+	if sender == cx.workerName && index == 0 {
+		cx.sentChannels = append(cx.sentChannels, pairId)
+	}
+	return &ast.Channel{Sender: sender, Receiver: receiver, EventIndex: index}
+}
+
+func workerSend(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangWorkerSendExprBase, isSync bool) expressionEffect {
+	dataEffect := handleExpression(ctx, bb, expr.Expr)
+	curBB := dataEffect.block
+	expr.Channel = ctx.nextChannel(ctx.workerName, expr.WorkerIdentifier.GetValue())
+	thenBB := ctx.addBB()
+	send := &WorkerSend{}
+	send.Channel = model.Name(expr.Channel.ChannelId())
+	send.Data = dataEffect.result
+	send.IsSync = isSync
+	send.ThenBB = thenBB
+	if isSync {
+		send.LhsOp = ctx.addTempVar(nil)
+	}
+	curBB.Terminator = send
+	return expressionEffect{
+		result: send.LhsOp,
+		block:  thenBB,
+	}
+}
+
+func workerReceive(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangWorkerReceive) expressionEffect {
+	expr.Channel = ctx.nextChannel(expr.WorkerIdentifier.GetValue(), ctx.workerName)
+	thenBB := ctx.addBB()
+	receive := &WorkerReceive{}
+	receive.Channel = model.Name(expr.Channel.ChannelId())
+	receive.ThenBB = thenBB
+	receive.LhsOp = ctx.addTempVar(nil)
+	bb.Terminator = receive
+	return expressionEffect{
+		result: receive.LhsOp,
+		block:  thenBB,
+	}
+}
+
+func alternateWorkerReceive(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangAlternateWorkerReceive) expressionEffect {
+	thenBB := ctx.addBB()
+	receive := &WorkerAlternateReceive{}
+	workerReceives := expr.GetWorkerReceives()
+	for i := range workerReceives {
+		workerReceive := &workerReceives[i]
+		workerReceive.Channel = ctx.nextChannel(workerReceive.WorkerIdentifier.GetValue(), ctx.workerName)
+		receive.Channels = append(receive.Channels, model.Name(workerReceive.Channel.ChannelId()))
+	}
+	receive.ThenBB = thenBB
+	receive.LhsOp = ctx.addTempVar(nil)
+	bb.Terminator = receive
+	return expressionEffect{
+		result: receive.LhsOp,
+		block:  thenBB,
+	}
+}
+
+func multipleWorkerReceive(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangMultipleWorkerReceive) expressionEffect {
+	thenBB := ctx.addBB()
+	receive := &WorkerMultipleReceive{}
+	for _, receiveField := range expr.ReceiveFields {
+		workerReceive := receiveField.WorkerReceive
+		workerReceive.Channel = ctx.nextChannel(workerReceive.WorkerIdentifier.GetValue(), ctx.workerName)
+		receive.ReceiveFields = append(receive.ReceiveFields, ReceiveField{
+			Key:     receiveField.Key.GetValue(),
+			Channel: model.Name(workerReceive.Channel.ChannelId()),
+		})
+	}
+	receive.ThenBB = thenBB
+	receive.LhsOp = ctx.addTempVar(nil)
+	bb.Terminator = receive
+	return expressionEffect{
+		result: receive.LhsOp,
+		block:  thenBB,
+	}
+}
+
+// workerFlush flushes the channels to the given worker, or all the channels the worker sent messages to so far if no
+// worker is given.
+func workerFlush(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangWorkerFlushExpr) expressionEffect {
+	thenBB := ctx.addBB()
+	flush := &Flush{}
+	for _, pairId := range ctx.sentChannels {
+		if expr.WorkerIdentifier != nil && pairId != ast.WorkerPairId(ctx.workerName, expr.WorkerIdentifier.GetValue()) {
+			continue
+		}
+		flush.Channels = append(flush.Channels, model.Name(pairId))
+		if expr.WorkerIdentifier != nil {
+			expr.WorkerIdentifierList = append(expr.WorkerIdentifierList, expr.WorkerIdentifier)
+		}
+	}
+	flush.ThenBB = thenBB
+	flush.LhsOp = ctx.addTempVar(nil)
+	bb.Terminator = flush
+	return expressionEffect{
+		result: flush.LhsOp,
+		block:  thenBB,
+	}
+}
+
+func waitExpression(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangWaitExpr) expressionEffect {
+	curBB := bb
+	wait := &Wait{}
+	for _, futureExpr := range expr.ExprList {
+		futureEffect := handleExpression(ctx, curBB, futureExpr)
+		curBB = futureEffect.block
+		wait.Exprs = append(wait.Exprs, futureEffect.result)
+	}
+	thenBB := ctx.addBB()
+	wait.ThenBB = thenBB
+	wait.LhsOp = ctx.addTempVar(nil)
+	curBB.Terminator = wait
+	return expressionEffect{
+		result: wait.LhsOp,
+		block:  thenBB,
+	}
+}
+
+func waitForAllExpression(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangWaitForAllExpr) expressionEffect {
+	curBB := bb
+	waitAll := &WaitAll{}
+	for _, keyValue := range expr.KeyValuePairs {
+		var futureExpr ast.BLangExpression = keyValue.KeyExpr
+		if keyValue.ValueExpr != nil {
+			futureExpr = keyValue.ValueExpr
+		}
+		futureEffect := handleExpression(ctx, curBB, futureExpr)
+		curBB = futureEffect.block
+		waitAll.Keys = append(waitAll.Keys, keyValue.Key.GetValue())
+		waitAll.Values = append(waitAll.Values, futureEffect.result)
+	}
+	thenBB := ctx.addBB()
+	waitAll.ThenBB = thenBB
+	waitAll.LhsOp = ctx.addTempVar(nil)
+	curBB.Terminator = waitAll
+	return expressionEffect{
+		result: waitAll.LhsOp,
+		block:  thenBB,
+	}
+}
+
+type workerActionKind uint8
+
+const (
+	workerActionSend workerActionKind = iota
+	workerActionSyncSend
+	// receives a message from each of the peers
+	workerActionReceive
+	// waits until the messages sent to the peers are received; all the peers if there are none
+	workerActionFlush
+)
+
+type workerAction struct {
+	kind  workerActionKind
+	peers []string
+	// description of the action used in errors
+	desc string
+}
+
+type workerActions struct {
+	name    string
+	actions []workerAction
+}
+
+// validateFunctionWorkerInteractions checks the interactions between the default worker and the named workers of a
+// function. The workers of a fork interact only with each other and are checked separately.
+func validateFunctionWorkerInteractions(body *ast.BLangBlockFunctionBody) {
+	workers := []workerActions{{name: defaultWorkerName, actions: collectWorkerActions(body.Stmts, nil)}}
+	for _, stmt := range body.Stmts {
+		if workerDef, ok := stmt.(*ast.BLangSimpleVariableDef); ok && workerDef.IsWorker && !workerDef.IsInFork {
+			workers = append(workers, namedWorkerActions(workerDef))
+		}
+	}
+	if len(workers) == 1 && len(workers[0].actions) == 0 {
+		return
+	}
+	validateWorkerInteractions(workers)
+}
+
+func namedWorkerActions(workerDef *ast.BLangSimpleVariableDef) workerActions {
+	body := workerDef.Var.Expr.(*ast.BLangLambdaFunction).Function.Body.(*ast.BLangBlockFunctionBody)
+	return workerActions{name: workerDef.Var.GetName().GetValue(), actions: collectWorkerActions(body.Stmts, nil)}
+}
+
+// collectWorkerActions collects the worker interactions of a worker body in the order they happen
+func collectWorkerActions(stmts []ast.BLangStatement, actions []workerAction) []workerAction {
+	for _, stmt := range stmts {
+		switch stmt := stmt.(type) {
+		case *ast.BLangExpressionStmt:
+			actions = appendWorkerAction(actions, stmt.Expr)
+		case *ast.BLangSimpleVariableDef:
+			if !stmt.IsWorker && stmt.Var.Expr != nil {
+				actions = appendWorkerAction(actions, stmt.Var.Expr.(ast.BLangExpression))
+			}
+		case *ast.BLangAssignment:
+			actions = appendWorkerAction(actions, stmt.Expr)
+		case *ast.BLangReturn:
+			if stmt.Expr != nil {
+				actions = appendWorkerAction(actions, stmt.Expr)
+			}
+		case *ast.BLangBlockStmt:
+			actions = collectWorkerActions(stmt.Stmts, actions)
+		case *ast.BLangIf:
+			nested := collectWorkerActions(stmt.Body.Stmts, nil)
+			if stmt.ElseStmt != nil {
+				nested = collectWorkerActions([]ast.BLangStatement{stmt.ElseStmt}, nested)
+			}
+			if len(nested) > 0 {
+				panic("worker send/receive in conditional statements not yet supported")
+			}
+		case *ast.BLangWhile:
+			if len(collectWorkerActions(stmt.Body.Stmts, nil)) > 0 {
+				panic("worker send/receive in loops not yet supported")
+			}
+		case *ast.BLangLock:
+			if len(collectWorkerActions(stmt.Body.Stmts, nil)) > 0 {
+				panic("worker send/receive is not allowed in a lock statement")
+			}
+		}
+	}
+	return actions
+}
+
+func appendWorkerAction(actions []workerAction, expr ast.BLangExpression) []workerAction {
+	switch expr := expr.(type) {
+	case *ast.BLangWorkerAsyncSendExpr:
+		peer := expr.WorkerIdentifier.GetValue()
+		return append(actions, workerAction{kind: workerActionSend, peers: []string{peer}, desc: "-> " + peer})
+	case *ast.BLangWorkerSyncSendExpr:
+		peer := expr.WorkerIdentifier.GetValue()
+		return append(actions, workerAction{kind: workerActionSyncSend, peers: []string{peer}, desc: "->> " + peer})
+	case *ast.BLangWorkerReceive:
+		peer := expr.WorkerIdentifier.GetValue()
+		return append(actions, workerAction{kind: workerActionReceive, peers: []string{peer}, desc: "<- " + peer})
+	case *ast.BLangAlternateWorkerReceive:
+		var peers []string
+		for _, workerReceive := range expr.GetWorkerReceives() {
+			peers = append(peers, workerReceive.WorkerIdentifier.GetValue())
+		}
+		return append(actions, workerAction{kind: workerActionReceive, peers: peers, desc: "<- " + strings.Join(peers, " | ")})
+	case *ast.BLangMultipleWorkerReceive:
+		var peers, fields []string
+		for _, receiveField := range expr.ReceiveFields {
+			peer := receiveField.WorkerReceive.WorkerIdentifier.GetValue()
+			peers = append(peers, peer)
+			fields = append(fields, receiveField.Key.GetValue()+": "+peer)
+		}
+		return append(actions, workerAction{kind: workerActionReceive, peers: peers, desc: "<- {" + strings.Join(fields, ", ") + "}"})
+	case *ast.BLangWorkerFlushExpr:
+		if expr.WorkerIdentifier == nil {
+			return append(actions, workerAction{kind: workerActionFlush, desc: "flush"})
+		}
+		peer := expr.WorkerIdentifier.GetValue()
+		return append(actions, workerAction{kind: workerActionFlush, peers: []string{peer}, desc: "flush " + peer})
+	default:
+		return actions
+	}
+}
+
+// validateWorkerInteractions checks that every message sent between the workers is received and that no worker gets
+// stuck waiting for a message or for a message to be received. Async sends don't block the sender, while a sync send
+// blocks until the message is received. An alternate receive is matched with a message from each of its peers.
+func validateWorkerInteractions(workers []workerActions) {
+	workerNames := make(map[string]bool, len(workers))
+	for _, worker := range workers {
+		workerNames[worker.name] = true
+	}
+	for _, worker := range workers {
+		for _, action := range worker.actions {
+			for _, peer := range action.peers {
+				if !workerNames[peer] {
+					panic(fmt.Sprintf("undefined worker '%s'", peer))
+				}
+			}
+		}
+	}
+
+	// number of messages sent but not yet received, keyed by worker pair id
+	pending := make(map[string]int)
+	positions := make([]int, len(workers))
+	// whether the sync send each worker is at has been sent
+	syncSent := make([]bool, len(workers))
+	for progressed := true; progressed; {
+		progressed = false
+		for i, worker := range workers {
+			for positions[i] < len(worker.actions) {
+				action := worker.actions[positions[i]]
+				wasSyncSent := syncSent[i]
+				if !advanceWorkerAction(worker.name, action, pending, &syncSent[i], workerNames) {
+					// sending the message of a sync send lets the receiver move onwards
+					progressed = progressed || syncSent[i] != wasSyncSent
+					break
+				}
+				positions[i]++
+				progressed = true
+			}
+		}
+	}
+
+	var states []string
+	stuck := false
+	for i, worker := range workers {
+		if positions[i] < len(worker.actions) {
+			stuck = true
+			states = append(states, worker.name+": "+worker.actions[positions[i]].desc)
+		} else {
+			states = append(states, worker.name+": FINISHED")
+		}
+	}
+	if stuck {
+		panic(fmt.Sprintf("worker send/receive interactions are invalid; worker(s) cannot move onwards from the state: '[%s]'",
+			strings.Join(states, ", ")))
+	}
+	for _, worker := range workers {
+		for peer := range workerNames {
+			if pending[ast.WorkerPairId(worker.name, peer)] > 0 {
+				panic(fmt.Sprintf("worker send/receive interactions are invalid; message sent from worker '%s' to worker '%s' is never received",
+					worker.name, peer))
+			}
+		}
+	}
+}
+
+// advanceWorkerAction performs the action if the worker can move past it
+func advanceWorkerAction(workerName string, action workerAction, pending map[string]int, syncSent *bool, workerNames map[string]bool) bool {
+	switch action.kind {
+	case workerActionSend:
+		pending[ast.WorkerPairId(workerName, action.peers[0])]++
+		return true
+	case workerActionSyncSend:
+		pairId := ast.WorkerPairId(workerName, action.peers[0])
+		if !*syncSent {
+			pending[pairId]++
+			*syncSent = true
+		}
+		if pending[pairId] > 0 {
+			return false
+		}
+		*syncSent = false
+		return true
+	case workerActionReceive:
+		for _, peer := range action.peers {
+			if pending[ast.WorkerPairId(peer, workerName)] == 0 {
+				return false
+			}
+		}
+		for _, peer := range action.peers {
+			pending[ast.WorkerPairId(peer, workerName)]--
+		}
+		return true
+	case workerActionFlush:
+		peers := action.peers
+		if len(peers) == 0 {
+			for peer := range workerNames {
+				peers = append(peers, peer)
+			}
+		}
+		for _, peer := range peers {
+			if pending[ast.WorkerPairId(workerName, peer)] > 0 {
+				return false
+			}
+		}
+		return true
+	default:
+		panic("unexpected worker action kind")
+	}
+}
+
 func appendIfNotNil[T any](slice []T, item *T) []T {
 	if item != nil {
 		slice = append(slice, *item)
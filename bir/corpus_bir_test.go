@@ -22,6 +22,7 @@ import (
 	"ballerina-lang-go/context"
 	"ballerina-lang-go/parser"
 	"flag"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -385,3 +386,85 @@ func getExpectedBIRText(t *testing.T, balFile string) string {
 	}
 	return expectedText
 }
+
+// corpusErrors are the errors of the `-e` corpus files that are reported while generating BIR, keyed by the path of
+// the file relative to the corpus directory of its subset.
+var corpusErrors = map[string]string{
+	"01-annotation/attach1-e.bal":    "annotation 'Tag' is not allowed on function",
+	"01-annotation/const1-e.bal":     "expression is not a constant expression",
+	"01-annotation/duplicate1-e.bal": "cannot specify more than one annotation value for annotation 'Tag'",
+	"01-annotation/field1-e.bal":     "undefined field 'size' in record 'Config'",
+	"01-annotation/required1-e.bal":  "missing non-defaultable required record field 'count'",
+	"01-annotation/type1-e.bal":      "annotation declaration requires a subtype of 'true', 'map<anydata|readonly>' or 'map<anydata|readonly>[]', but found 'string[]'",
+	"01-annotation/undefined1-e.bal": "undefined annotation 'Missing'",
+	"01-annotation/value1-e.bal":     "incompatible types: expected 'int', found 'string'",
+	"01-service/annot1-e.bal":        "annotation 'Config' is not allowed on service remote function",
+	"01-service/attach1-e.bal":       "invalid listener attachment: 'port' is not a listener",
+	"01-service/duplicate1-e.bal":    "resource 'get users/[int]' with the same accessor and path already exists",
+	"01-service/duplicate2-e.bal":    "redeclared symbol 'ping'",
+	"01-service/listener1-e.bal":     "cannot infer the type of the listener from an implicit new expression",
+	"01-service/new1-e.bal":          "invalid type in new expression: 'Point' is not a class",
+	"01-service/pathparam1-e.bal":    "only 'int', 'string', 'float', 'boolean', 'decimal' types are supported as path params, found 'json'",
+	"01-stream/completion1-e.bal":    "invalid completion type. expected subtype of 'error?' but found 'string'",
+	"01-stream/foreach1-e.bal":       "invalid iterable type 'stream<int, error?>' in foreach statement: the completion type must be nil",
+	"01-stream/new1-e.bal":           "invalid stream constructor: expected at most one argument, the iterator object, but found 2",
+	"01-stream/new2-e.bal":           "invalid stream constructor: expected an iterator object of type 'object { public function next() returns record {| int value; |}|(); }', but found 'int'",
+	"01-table/constraint1-e.bal":     "invalid constraint type. expected subtype of 'map<any|error>' but found 'int'",
+	"01-table/duplicate1-e.bal":      "duplicate key found in table row key('id') : '1'",
+	"01-table/field1-e.bal":          "field name 'code' used in key specifier is not found in table constraint type 'Employee'",
+	"01-table/mismatch1-e.bal":       "table key specifier mismatch. expected: '[id]' but found '[name]'",
+	"01-table/optional1-e.bal":       "field 'id' used in key specifier must be a required field",
+	"01-table/readonly1-e.bal":       "field 'id' used in key specifier must be a readonly field",
+	"01-template/string3-e.bal":      "incompatible types: expected 'boolean|int|float|decimal|string', found '()'",
+	"01-template/string4-e.bal":      "incompatible types: expected 'boolean|int|float|decimal|string', found 'string?'",
+	"01-typedef/cyclic1-e.bal":       "invalid cyclic type reference in 'A'",
+	"01-typedef/local2-e.bal":        "unknown type 'L'",
+	"01-typedef/singleton1-e.bal":    "incompatible types: expected 'Color', found 'string'",
+	"01-worker/deadlock1-e.bal":      "worker send/receive interactions are invalid; worker(s) cannot move onwards from the state: '[function: FINISHED, A: <- B, B: <- A]'",
+	"01-worker/undefined1-e.bal":     "undefined worker 'C'",
+	"01-worker/unmatched1-e.bal":     "worker send/receive interactions are invalid; message sent from worker 'A' to worker 'B' is never received",
+	"01-xml/comment1-e.bal":          "interpolation is not allowed within XML comments",
+	"01-xml/element2-e.bal":          "mismatching start and end tags found in xml element",
+	"01-xml/ns2-e.bal":               "undefined symbol 'p'",
+	"01-xml/type1-e.bal":             "incompatible types: expected 'xml<xml:Element>', found 'xml:Comment'",
+}
+
+// TestBIRGenerationErrors tests that generating BIR from the `-e` corpus files in corpusErrors fails with the expected
+// error.
+func TestBIRGenerationErrors(t *testing.T) {
+	for file, expectedError := range corpusErrors {
+		balFile := filepath.Join("../corpus/bal/subset1", file)
+		t.Run(balFile, func(t *testing.T) {
+			t.Parallel()
+			if err := genBirError(t, balFile); err != expectedError {
+				t.Errorf("expected error %q, got %q", expectedError, err)
+			}
+		})
+	}
+}
+
+// genBirError generates the BIR of balFile and returns the message of the panic it fails with, or "" if it doesn't.
+func genBirError(t *testing.T, balFile string) (message string) {
+	defer func() {
+		if r := recover(); r != nil {
+			message = fmt.Sprint(r)
+		}
+	}()
+	debugCtx := &debugcommon.DebugContext{
+		Channel: make(chan string),
+	}
+	go func() {
+		for range debugCtx.Channel {
+		}
+	}()
+	defer close(debugCtx.Channel)
+
+	cx := context.NewCompilerContext()
+	syntaxTree, err := parser.GetSyntaxTree(debugCtx, balFile)
+	if err != nil {
+		t.Fatalf("error getting syntax tree from %s: %v", balFile, err)
+	}
+	compilationUnit := ast.GetCompilationUnit(cx, syntaxTree)
+	GenBir(cx, ast.ToPackage(compilationUnit))
+	return ""
+}
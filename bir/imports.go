@@ -0,0 +1,117 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bir
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+	"fmt"
+)
+
+// registerImport maps the prefix of an import to the package ID of the imported module. If the imported module is
+// one of dependencies, which are the already generated modules of the current package, references to its symbols are
+// resolved against it.
+func registerImport(ctx *Context, importPkg *ast.BLangImportPackage, importModule *BIRImportModule, dependencies []*BIRPackage) {
+	alias := importPkg.Alias.Value
+	ctx.importedPackageIDs[alias] = importModule.PackageID
+	for _, dependency := range dependencies {
+		if !samePackage(dependency.PackageID, importModule.PackageID) {
+			continue
+		}
+		ctx.importedModules[alias] = dependency
+		ctx.typeResolver.AddImport(alias, func(name string) semtypes.SemType {
+			return importedType(dependency, alias, name)
+		})
+		return
+	}
+}
+
+func samePackage(a, b *model.PackageID) bool {
+	return a.OrgName.Value() == b.OrgName.Value() && a.PkgName.Value() == b.PkgName.Value()
+}
+
+// checkAccessible panics unless a symbol with flags can be referred to from another module.
+func checkAccessible(flags int64, name string) {
+	if flags&int64(ast.Flags_PUBLIC) == 0 {
+		panic(fmt.Sprintf("attempt to refer to non-accessible symbol '%s'", name))
+	}
+}
+
+func importedType(module *BIRPackage, alias, name string) semtypes.SemType {
+	for i := range module.TypeDefs {
+		typeDef := &module.TypeDefs[i]
+		if typeDef.Name.Value() == name {
+			checkAccessible(typeDef.Flags, name)
+			return typeDef.SemType
+		}
+	}
+	panic(fmt.Sprintf("unknown type '%s:%s'", alias, name))
+}
+
+// importedFunction checks that the function called through alias exists and is public, returning the package ID of
+// the module it belongs to, or nil for a prefix that is not imported, such as that of a lang library. Only the
+// functions of the modules of the current package are checked.
+func importedFunction(ctx *Context, alias, name string) *model.PackageID {
+	packageID := ctx.importedPackageIDs[alias]
+	module, ok := ctx.importedModules[alias]
+	if !ok {
+		return packageID
+	}
+	for i := range module.Functions {
+		function := &module.Functions[i]
+		if function.Name.Value() == name && function.Flags&int64(ast.Flags_ATTACHED) == 0 {
+			checkAccessible(function.Flags, name)
+			return packageID
+		}
+	}
+	panic(fmt.Sprintf("undefined function '%s:%s'", alias, name))
+}
+
+// importedVariableReference loads a module level variable or constant of another module of the current package. It
+// returns false if alias is not the prefix of such a module.
+func importedVariableReference(ctx *stmtContext, curBB *BIRBasicBlock, alias, name string) (expressionEffect, bool) {
+	module, ok := ctx.birCx.importedModules[alias]
+	if !ok {
+		return expressionEffect{}, false
+	}
+	for i := range module.GlobalVars {
+		globalVar := &module.GlobalVars[i]
+		if globalVar.Name.Value() == name {
+			checkAccessible(globalVar.Flags, name)
+			return expressionEffect{
+				result: &BIROperand{VariableDcl: &globalVar.BIRVariableDcl},
+				block:  curBB,
+			}, true
+		}
+	}
+	for i := range module.Constants {
+		constant := &module.Constants[i]
+		if constant.Name.Value() == name {
+			checkAccessible(constant.Flags, name)
+			constantLoad := &ConstantLoad{}
+			constantLoad.Value = constant.ConstValue
+			constantLoad.LhsOp = ctx.addTempVar(nil)
+			curBB.Instructions = append(curBB.Instructions, constantLoad)
+			return expressionEffect{
+				result: constantLoad.LhsOp,
+				block:  curBB,
+			}, true
+		}
+	}
+	panic(fmt.Sprintf("undefined symbol '%s:%s'", alias, name))
+}
@@ -90,7 +90,7 @@ func LoadBIRPackageFromReader(cx *context.CompilerContext, r io.Reader) (*BIRPac
 	}
 
 	// Service declarations.
-	if err := populateServices(b, *pkg); err != nil {
+	if err := populateServices(b, pkg); err != nil {
 		return nil, err
 	}
 
@@ -247,7 +247,7 @@ func populateFunctions(cx *context.CompilerContext, b *Bir, pkg *BIRPackage) err
 
 		// Markdown doc attachment
 		if f.Doc != nil {
-			fmt.Println("WARNING: markdown doc attachment not supported ignoring")
+			fn.MarkdownDocAttachment = parseMarkdown(b, f.Doc)
 		}
 
 		// Scope entries (instruction vs scope table)
@@ -343,7 +343,7 @@ func populateConstants(b *Bir, pkg *BIRPackage) error {
 
 		// Parse markdown doc attachment
 		if c.Doc != nil {
-			fmt.Println("WARNING: markdown doc attachment not supported ignoring")
+			bc.MarkdownDocAttachment = parseMarkdown(b, c.Doc)
 		}
 
 		// Parse annotation attachments
@@ -427,7 +427,7 @@ func populateGlobals(b *Bir, pkg *BIRPackage) error {
 		}
 		// Parse markdown doc attachment
 		if gv.Doc != nil {
-			fmt.Println("WARNING: markdown doc attachment not supported ignoring")
+			g.MarkdownDocAttachment = parseMarkdown(b, gv.Doc)
 		}
 
 		// Parse annotation attachments
@@ -450,12 +450,39 @@ func populateAnnotations(b *Bir, pkg *BIRPackage) error {
 	panic("annotations not supported")
 }
 
-// populateServices maps Bir_ServiceDeclaration -> BIRServiceDeclaration.
-func populateServices(b *Bir, pkg BIRPackage) error {
+// populateServices maps Bir_ServiceDeclaration -> BIRServiceDeclaration. The binary only records the types of the
+// listeners, so the listener variables are left empty.
+func populateServices(b *Bir, pkg *BIRPackage) error {
 	if b.Module.ServiceDeclsSize == 0 {
 		return nil
 	}
-	panic("services not supported")
+
+	services := make([]BIRServiceDeclaration, 0, len(b.Module.ServiceDeclarations))
+	for _, sd := range b.Module.ServiceDeclarations {
+		if sd == nil {
+			continue
+		}
+		service := BIRServiceDeclaration{
+			GeneratedName:       model.Name(cpString(b, sd.NameCpIndex)),
+			AssociatedClassName: model.Name(cpString(b, sd.AssociatedClassNameCpIndex)),
+			Flags:               sd.Flags,
+		}
+		service.Pos = positionToLocation(b, sd.Position)
+		if sd.HasAttachPoint != 0 {
+			service.AttachPoint = make([]string, 0, len(sd.AttachPoints))
+			for _, idx := range sd.AttachPoints {
+				service.AttachPoint = append(service.AttachPoint, cpString(b, idx))
+			}
+		}
+		if sd.HasAttachPointLiteral != 0 {
+			literal := cpString(b, sd.AttachPointLiteral)
+			service.AttachPointLiteral = &literal
+		}
+		services = append(services, service)
+	}
+
+	pkg.ServiceDecls = services
+	return nil
 }
 
 // --- Constant‑pool helpers ---------------------------------------------------
@@ -1098,7 +1125,37 @@ func (t *minimalBType) String() string {
 
 // parseMarkdown parses markdown documentation from Bir_Markdown.
 func parseMarkdown(b *Bir, md *Bir_Markdown) model.MarkdownDocAttachment {
-	panic("markdown not supported")
+	if md.HasDoc == 0 || md.MarkdownContent == nil {
+		return model.MarkdownDocAttachment{}
+	}
+	content := md.MarkdownContent
+	return model.MarkdownDocAttachment{
+		Description:             cpOptionalString(b, content.DescriptionCpIndex),
+		Parameters:              parseMarkdownParameters(b, content.Parameters),
+		ReturnValueDescription:  cpOptionalString(b, content.ReturnValueDescriptionCpIndex),
+		DeprecatedDocumentation: cpOptionalString(b, content.DeprecatedDocsCpIndex),
+		DeprecatedParameters:    parseMarkdownParameters(b, content.DeprecatedParams),
+	}
+}
+
+func parseMarkdownParameters(b *Bir, params []*Bir_MarkdownParameter) []model.Parameters {
+	var result []model.Parameters
+	for _, p := range params {
+		result = append(result, model.Parameters{
+			Name:        cpOptionalString(b, p.NameCpIndex),
+			Description: cpOptionalString(b, p.DescriptionCpIndex),
+		})
+	}
+	return result
+}
+
+// cpOptionalString resolves a string CP index, where a negative index stands for an absent string.
+func cpOptionalString(b *Bir, idx int32) *string {
+	if idx < 0 {
+		return nil
+	}
+	value := cpString(b, idx)
+	return &value
 }
 
 // parseConstantValue parses a constant value from Bir_ConstantValue.
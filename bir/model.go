@@ -18,6 +18,7 @@ package bir
 
 import (
 	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
 	"ballerina-lang-go/tools/diagnostics"
 	"fmt"
 )
@@ -29,6 +30,13 @@ type ConstValue struct {
 	Value interface{}
 }
 
+// ConstMappingField is a field of a constant mapping value. The value of a constant mapping is a []ConstMappingField
+// in the order the fields were given, and the value of a constant list is a []ConstValue.
+type ConstMappingField struct {
+	Key   string
+	Value ConstValue
+}
+
 type BIRInstruction interface {
 	GetKind() InstructionKind
 }
@@ -65,6 +73,8 @@ type (
 		GlobalVars    []BIRGlobalVariableDcl
 		Functions     []BIRFunction
 		Constants     []BIRConstant
+		Annotations   []BIRAnnotation
+		ServiceDecls  []BIRServiceDeclaration
 	}
 
 	BIRImportModule struct {
@@ -80,11 +90,41 @@ type (
 		AttachedFuncs   []BIRFunction
 		Flags           int64
 		Type            model.TypeNode
+		SemType         semtypes.SemType
 		IsBuiltin       bool
 		ReferencedTypes []model.TypeNode
 		ReferenceType   model.TypeNode
 		Origin          model.SymbolOrigin
 		Index           int
+		// annotations attached to the type definition
+		AnnotAttachments []BIRAnnotationAttachment
+		// annotations attached to the fields of the record type of the type definition
+		FieldAnnotAttachments []BIRFieldAnnotations
+	}
+
+	// BIRServiceDeclaration is a service declaration, whose service object is created and attached to its listeners by
+	// the module initializer.
+	BIRServiceDeclaration struct {
+		BIRDocumentableNodeBase
+		// name of the module variable holding the service object
+		GeneratedName       model.Name
+		AssociatedClassName model.Name
+		// segments of the absolute resource path, which is nil if the service has no path and empty for `/`
+		AttachPoint []string
+		// service name given as a string literal, if any
+		AttachPointLiteral *string
+		// module variables of the listeners the service is attached to
+		Listeners []model.Name
+		// Flags has ISOLATED set if all the methods of the service are isolated
+		Flags            int64
+		AnnotAttachments []BIRAnnotationAttachment
+		// causes that make the service not isolated, empty if it is isolated
+		NonIsolationCauses []string
+	}
+
+	BIRFieldAnnotations struct {
+		FieldName        model.Name
+		AnnotAttachments []BIRAnnotationAttachment
 	}
 
 	BIRVariableDcl struct {
@@ -126,6 +166,8 @@ type (
 		BasicBlocks    []BIRBasicBlock
 		// FIXME:
 		DependentGlobalVars []BIRGlobalVariableDcl
+		AnnotAttachments    []BIRAnnotationAttachment
+		ReturnTypeAnnots    []BIRAnnotationAttachment
 	}
 
 	BIRConstant struct {
@@ -147,8 +189,26 @@ type (
 
 	BIRParameter struct {
 		BIRNodeBase
-		Name  model.Name
-		Flags int64
+		Name             model.Name
+		Flags            int64
+		AnnotAttachments []BIRAnnotationAttachment
+	}
+
+	BIRAnnotation struct {
+		BIRDocumentableNodeBase
+		Name         model.Name
+		Flags        int64
+		AttachPoints []model.AttachPoint
+		// type of the annotation value, nil if the annotation has no value
+		Type    model.TypeNode
+		SemType semtypes.SemType
+	}
+
+	// BIRAnnotationAttachment is an annotation attached to a construct, with its value evaluated at compile time.
+	BIRAnnotationAttachment struct {
+		BIRNodeBase
+		AnnotTagRef model.Name
+		Value       ConstValue
 	}
 
 	BIRFunctionParameter struct {
@@ -18,6 +18,7 @@ package bir
 
 import (
 	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
 	"ballerina-lang-go/tools/diagnostics"
 )
 
@@ -46,6 +47,14 @@ type (
 		RhsOp *BIROperand
 	}
 
+	// TypeTest tests whether the value of RhsOp belongs to Type.
+	TypeTest struct {
+		BIRInstructionBase
+		TypeName model.Name
+		Type     semtypes.SemType
+		RhsOp    *BIROperand
+	}
+
 	ConstantLoad struct {
 		BIRInstructionBase
 		Value any
@@ -67,6 +76,170 @@ type (
 		SizeOp          *BIROperand
 		Type            model.ValueType
 	}
+
+	NewStructure struct {
+		BIRInstructionBase
+		// FIXME: this should be the typedesc operand of the structure once we have types
+		Type          model.ValueType
+		InitialValues []MappingConstructorEntry
+	}
+
+	// NewTable creates a table whose key is given by the names of the key fields in KeyColOp, and adds the rows in
+	// DataOp to it in order.
+	NewTable struct {
+		BIRInstructionBase
+		// FIXME: this should be the typedesc operand of the table once we have types
+		Type model.ValueType
+		// array of the names of the key fields, which is empty if the table has no key
+		KeyColOp *BIROperand
+		// array of the initial rows
+		DataOp *BIROperand
+	}
+
+	// NewStream creates a stream whose members are produced by the `next` method of the iterator object in
+	// IteratorOp.
+	NewStream struct {
+		BIRInstructionBase
+		// FIXME: this should be the typedesc operand of the stream once we have types
+		TypeName   model.Name
+		Type       semtypes.SemType
+		IteratorOp *BIROperand
+	}
+
+	// NewInstance creates an object of a class without initializing it; the `init` method is called separately.
+	NewInstance struct {
+		BIRInstructionBase
+		// FIXME: this should be the type definition of the class once we have object types
+		TypeName model.Name
+	}
+
+	NewTypeDesc struct {
+		BIRInstructionBase
+		// FIXME: this should be the type of the typedesc once we have BIR types
+		TypeName model.Name
+		Type     semtypes.SemType
+	}
+
+	MappingConstructorEntry struct {
+		KeyOp   *BIROperand
+		ValueOp *BIROperand
+	}
+
+	NewXMLElement struct {
+		BIRInstructionBase
+		StartTagOp     *BIROperand
+		DefaultNsURIOp *BIROperand
+	}
+
+	NewXMLQName struct {
+		BIRInstructionBase
+		LocalnameOp *BIROperand
+		NsURIOp     *BIROperand
+		PrefixOp    *BIROperand
+	}
+
+	NewXMLText struct {
+		BIRInstructionBase
+		TextOp *BIROperand
+	}
+
+	NewXMLComment struct {
+		BIRInstructionBase
+		TextOp *BIROperand
+	}
+
+	NewXMLProcIns struct {
+		BIRInstructionBase
+		TargetOp *BIROperand
+		DataOp   *BIROperand
+	}
+
+	NewXMLSequence struct {
+		BIRInstructionBase
+	}
+
+	XMLAccess struct {
+		BIRInstructionBase
+		Kind  InstructionKind
+		RhsOp *BIROperand
+	}
+
+	NewRegExp struct {
+		BIRInstructionBase
+		ReDisjunctionOp *BIROperand
+	}
+
+	NewReDisjunction struct {
+		BIRInstructionBase
+		SequenceOps []*BIROperand
+	}
+
+	NewReSequence struct {
+		BIRInstructionBase
+		TermOps []*BIROperand
+	}
+
+	NewReAssertion struct {
+		BIRInstructionBase
+		AssertionOp *BIROperand
+	}
+
+	NewReAtomQuantifier struct {
+		BIRInstructionBase
+		AtomOp       *BIROperand
+		QuantifierOp *BIROperand
+	}
+
+	NewReLiteralCharOrEscape struct {
+		BIRInstructionBase
+		CharOrEscapeOp *BIROperand
+	}
+
+	NewReCharClass struct {
+		BIRInstructionBase
+		ClassStartOp *BIROperand
+		NegationOp   *BIROperand
+		CharSetOp    *BIROperand
+		ClassEndOp   *BIROperand
+	}
+
+	NewReCharSet struct {
+		BIRInstructionBase
+		CharSetAtomOps []*BIROperand
+	}
+
+	NewReCharSetRange struct {
+		BIRInstructionBase
+		LhsCharSetAtomOp *BIROperand
+		DashOp           *BIROperand
+		RhsCharSetAtomOp *BIROperand
+	}
+
+	NewReCapturingGroup struct {
+		BIRInstructionBase
+		OpenParenOp     *BIROperand
+		FlagExprOp      *BIROperand
+		ReDisjunctionOp *BIROperand
+		CloseParenOp    *BIROperand
+	}
+
+	NewReFlagExpr struct {
+		BIRInstructionBase
+		QuestionMarkOp *BIROperand
+		FlagsOnOffOp   *BIROperand
+		ColonOp        *BIROperand
+	}
+
+	NewReFlagOnOff struct {
+		BIRInstructionBase
+		FlagsOp *BIROperand
+	}
+
+	NewReQuantifier struct {
+		BIRInstructionBase
+		QuantifierOp    *BIROperand
+		NonGreedyCharOp *BIROperand
+	}
 )
 
 var (
@@ -74,8 +247,34 @@ var (
 	_ BIRAssignInstruction = &BinaryOp{}
 	_ BIRAssignInstruction = &UnaryOp{}
 	_ BIRAssignInstruction = &ConstantLoad{}
+	_ BIRAssignInstruction = &TypeTest{}
 	_ BIRInstruction       = &FieldAccess{}
 	_ BIRInstruction       = &NewArray{}
+	_ BIRAssignInstruction = &NewStructure{}
+	_ BIRAssignInstruction = &NewTable{}
+	_ BIRAssignInstruction = &NewStream{}
+	_ BIRAssignInstruction = &NewTypeDesc{}
+	_ BIRAssignInstruction = &NewInstance{}
+	_ BIRAssignInstruction = &NewXMLElement{}
+	_ BIRAssignInstruction = &NewXMLQName{}
+	_ BIRAssignInstruction = &NewXMLText{}
+	_ BIRAssignInstruction = &NewXMLComment{}
+	_ BIRAssignInstruction = &NewXMLProcIns{}
+	_ BIRAssignInstruction = &NewXMLSequence{}
+	_ BIRAssignInstruction = &XMLAccess{}
+	_ BIRAssignInstruction = &NewRegExp{}
+	_ BIRAssignInstruction = &NewReDisjunction{}
+	_ BIRAssignInstruction = &NewReSequence{}
+	_ BIRAssignInstruction = &NewReAssertion{}
+	_ BIRAssignInstruction = &NewReAtomQuantifier{}
+	_ BIRAssignInstruction = &NewReLiteralCharOrEscape{}
+	_ BIRAssignInstruction = &NewReCharClass{}
+	_ BIRAssignInstruction = &NewReCharSet{}
+	_ BIRAssignInstruction = &NewReCharSetRange{}
+	_ BIRAssignInstruction = &NewReCapturingGroup{}
+	_ BIRAssignInstruction = &NewReFlagExpr{}
+	_ BIRAssignInstruction = &NewReFlagOnOff{}
+	_ BIRAssignInstruction = &NewReQuantifier{}
 )
 
 func (m *Move) GetLhsOperand() *BIROperand {
@@ -129,6 +328,14 @@ func (u *UnaryOp) GetKind() InstructionKind {
 	return u.Kind
 }
 
+func (t *TypeTest) GetLhsOperand() *BIROperand {
+	return t.LhsOp
+}
+
+func (t *TypeTest) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_TYPE_TEST
+}
+
 func NewUnaryOp(pos diagnostics.Location, kind InstructionKind, lhsOp, rhsOp *BIROperand) *UnaryOp {
 	return &UnaryOp{
 		BIRInstructionBase: BIRInstructionBase{
@@ -165,3 +372,203 @@ func (n *NewArray) GetLhsOperand() *BIROperand {
 func (n *NewArray) GetKind() InstructionKind {
 	return INSTRUCTION_KIND_NEW_ARRAY
 }
+
+func (n *NewStructure) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewStructure) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_STRUCTURE
+}
+
+func (n *NewTable) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewTable) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_TABLE
+}
+
+func (n *NewStream) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewStream) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_STREAM
+}
+
+func (n *NewInstance) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewInstance) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_INSTANCE
+}
+
+func (n *NewTypeDesc) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewTypeDesc) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_TYPEDESC
+}
+
+func (n *NewXMLElement) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewXMLElement) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_XML_ELEMENT
+}
+
+func (n *NewXMLQName) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewXMLQName) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_XML_QNAME
+}
+
+func (n *NewXMLText) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewXMLText) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_XML_TEXT
+}
+
+func (n *NewXMLComment) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewXMLComment) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_XML_COMMENT
+}
+
+func (n *NewXMLProcIns) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewXMLProcIns) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_XML_PI
+}
+
+func (n *NewXMLSequence) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewXMLSequence) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_XML_SEQUENCE
+}
+
+func (x *XMLAccess) GetLhsOperand() *BIROperand {
+	return x.LhsOp
+}
+
+func (x *XMLAccess) GetKind() InstructionKind {
+	return x.Kind
+}
+
+func (n *NewRegExp) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewRegExp) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_REG_EXP
+}
+
+func (n *NewReDisjunction) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewReDisjunction) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_RE_DISJUNCTION
+}
+
+func (n *NewReSequence) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewReSequence) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_RE_SEQUENCE
+}
+
+func (n *NewReAssertion) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewReAssertion) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_RE_ASSERTION
+}
+
+func (n *NewReAtomQuantifier) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewReAtomQuantifier) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_RE_ATOM_QUANTIFIER
+}
+
+func (n *NewReLiteralCharOrEscape) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewReLiteralCharOrEscape) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_RE_LITERAL_CHAR_ESCAPE
+}
+
+func (n *NewReCharClass) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewReCharClass) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_RE_CHAR_CLASS
+}
+
+func (n *NewReCharSet) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewReCharSet) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_RE_CHAR_SET
+}
+
+func (n *NewReCharSetRange) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewReCharSetRange) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_RE_CHAR_SET_RANGE
+}
+
+func (n *NewReCapturingGroup) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewReCapturingGroup) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_RE_CAPTURING_GROUP
+}
+
+func (n *NewReFlagExpr) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewReFlagExpr) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_RE_FLAG_EXPR
+}
+
+func (n *NewReFlagOnOff) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewReFlagOnOff) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_RE_FLAG_ON_OFF
+}
+
+func (n *NewReQuantifier) GetLhsOperand() *BIROperand {
+	return n.LhsOp
+}
+
+func (n *NewReQuantifier) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_NEW_RE_QUANTIFIER
+}
@@ -17,6 +17,7 @@
 package bir
 
 import (
+	"ballerina-lang-go/ast"
 	"ballerina-lang-go/model"
 	"fmt"
 	"strings"
@@ -66,6 +67,21 @@ func (p *PrettyPrinter) Print(node BIRPackage) string {
 		p.write(p.PrintGlobalVar(globalVar))
 		p.write(";\n")
 	}
+	for _, annotation := range node.Annotations {
+		p.write(p.PrintAnnotation(annotation))
+		p.write(";\n")
+	}
+	for _, serviceDecl := range node.ServiceDecls {
+		p.PrintServiceDeclaration(serviceDecl)
+	}
+	for _, typeDef := range node.TypeDefs {
+		p.PrintTypeDefAnnotations(typeDef)
+		for _, function := range typeDef.AttachedFuncs {
+			function.Name = model.Name(typeDef.Name.Value() + "." + function.Name.Value())
+			p.PrintFunction(function)
+			p.write("\n")
+		}
+	}
 	for _, function := range node.Functions {
 		p.PrintFunction(function)
 		p.write("\n")
@@ -74,6 +90,11 @@ func (p *PrettyPrinter) Print(node BIRPackage) string {
 }
 
 func (p *PrettyPrinter) PrintFunction(function BIRFunction) {
+	p.printAnnotationAttachments(function.AnnotAttachments, "")
+	for _, param := range function.RequiredParams {
+		p.printAnnotationAttachments(param.AnnotAttachments, " param "+param.Name.Value())
+	}
+	p.printAnnotationAttachments(function.ReturnTypeAnnots, " returns")
 	p.write(function.Name.Value())
 	ty := function.Type
 	if ty != nil {
@@ -122,6 +143,8 @@ func (p *PrettyPrinter) PrintInstruction(instruction BIRInstruction) string {
 		return p.PrintBinaryOp(instruction.(*BinaryOp))
 	case *UnaryOp:
 		return p.PrintUnaryOp(instruction.(*UnaryOp))
+	case *TypeTest:
+		return p.PrintTypeTest(instruction.(*TypeTest))
 	case *ConstantLoad:
 		return p.PrintConstantLoad(instruction.(*ConstantLoad))
 	case *Goto:
@@ -132,10 +155,78 @@ func (p *PrettyPrinter) PrintInstruction(instruction BIRInstruction) string {
 		return p.PrintReturn(instruction.(*Return))
 	case *Branch:
 		return p.PrintBranch(instruction.(*Branch))
+	case *WorkerSend:
+		return p.PrintWorkerSend(instruction.(*WorkerSend))
+	case *WorkerReceive:
+		return p.PrintWorkerReceive(instruction.(*WorkerReceive))
+	case *WorkerAlternateReceive:
+		return p.PrintWorkerAlternateReceive(instruction.(*WorkerAlternateReceive))
+	case *WorkerMultipleReceive:
+		return p.PrintWorkerMultipleReceive(instruction.(*WorkerMultipleReceive))
+	case *Flush:
+		return p.PrintFlush(instruction.(*Flush))
+	case *Wait:
+		return p.PrintWait(instruction.(*Wait))
+	case *WaitAll:
+		return p.PrintWaitAll(instruction.(*WaitAll))
+	case *Lock:
+		return p.PrintLock(instruction.(*Lock))
+	case *Unlock:
+		return p.PrintUnlock(instruction.(*Unlock))
 	case *FieldAccess:
 		return p.PrintFieldAccess(instruction.(*FieldAccess))
 	case *NewArray:
 		return p.PrintNewArray(instruction.(*NewArray))
+	case *NewStructure:
+		return p.PrintNewStructure(instruction.(*NewStructure))
+	case *NewTable:
+		return p.PrintNewTable(instruction.(*NewTable))
+	case *NewStream:
+		return p.PrintNewStream(instruction.(*NewStream))
+	case *NewTypeDesc:
+		return p.PrintNewTypeDesc(instruction.(*NewTypeDesc))
+	case *NewInstance:
+		return p.PrintNewInstance(instruction.(*NewInstance))
+	case *NewXMLElement:
+		return p.PrintNewXMLElement(instruction.(*NewXMLElement))
+	case *NewXMLQName:
+		return p.PrintNewXMLQName(instruction.(*NewXMLQName))
+	case *NewXMLText:
+		return p.PrintNewXMLText(instruction.(*NewXMLText))
+	case *NewXMLComment:
+		return p.PrintNewXMLComment(instruction.(*NewXMLComment))
+	case *NewXMLProcIns:
+		return p.PrintNewXMLProcIns(instruction.(*NewXMLProcIns))
+	case *NewXMLSequence:
+		return p.PrintNewXMLSequence(instruction.(*NewXMLSequence))
+	case *XMLAccess:
+		return p.PrintXMLAccess(instruction.(*XMLAccess))
+	case *NewRegExp:
+		return p.PrintNewRegExp(instruction.(*NewRegExp))
+	case *NewReDisjunction:
+		return p.PrintNewReDisjunction(instruction.(*NewReDisjunction))
+	case *NewReSequence:
+		return p.PrintNewReSequence(instruction.(*NewReSequence))
+	case *NewReAssertion:
+		return p.PrintNewReAssertion(instruction.(*NewReAssertion))
+	case *NewReAtomQuantifier:
+		return p.PrintNewReAtomQuantifier(instruction.(*NewReAtomQuantifier))
+	case *NewReLiteralCharOrEscape:
+		return p.PrintNewReLiteralCharOrEscape(instruction.(*NewReLiteralCharOrEscape))
+	case *NewReCharClass:
+		return p.PrintNewReCharClass(instruction.(*NewReCharClass))
+	case *NewReCharSet:
+		return p.PrintNewReCharSet(instruction.(*NewReCharSet))
+	case *NewReCharSetRange:
+		return p.PrintNewReCharSetRange(instruction.(*NewReCharSetRange))
+	case *NewReCapturingGroup:
+		return p.PrintNewReCapturingGroup(instruction.(*NewReCapturingGroup))
+	case *NewReFlagExpr:
+		return p.PrintNewReFlagExpr(instruction.(*NewReFlagExpr))
+	case *NewReFlagOnOff:
+		return p.PrintNewReFlagOnOff(instruction.(*NewReFlagOnOff))
+	case *NewReQuantifier:
+		return p.PrintNewReQuantifier(instruction.(*NewReQuantifier))
 	default:
 		panic(fmt.Sprintf("unknown instruction type: %T", instruction))
 	}
@@ -145,12 +236,143 @@ func (p *PrettyPrinter) PrintNewArray(array *NewArray) string {
 	return fmt.Sprintf("%s = newArray %s[%s]", p.PrintOperand(*array.LhsOp), p.PrintType(array.Type), p.PrintOperand(*array.SizeOp))
 }
 
+func (p *PrettyPrinter) PrintNewStructure(structure *NewStructure) string {
+	entries := strings.Builder{}
+	for i, entry := range structure.InitialValues {
+		if i > 0 {
+			entries.WriteString(",")
+		}
+		entries.WriteString(fmt.Sprintf("%s:%s", p.PrintOperand(*entry.KeyOp), p.PrintOperand(*entry.ValueOp)))
+	}
+	return fmt.Sprintf("%s = newStructure %s{%s};", p.PrintOperand(*structure.LhsOp), p.PrintType(structure.Type), entries.String())
+}
+
+func (p *PrettyPrinter) PrintNewTable(table *NewTable) string {
+	return fmt.Sprintf("%s = newTable %s %s %s;", p.PrintOperand(*table.LhsOp), p.PrintType(table.Type), p.PrintOperand(*table.KeyColOp), p.PrintOperand(*table.DataOp))
+}
+
+func (p *PrettyPrinter) PrintNewStream(stream *NewStream) string {
+	return fmt.Sprintf("%s = newStream %s %s;", p.PrintOperand(*stream.LhsOp), stream.TypeName.Value(), p.PrintOperand(*stream.IteratorOp))
+}
+
+func (p *PrettyPrinter) PrintNewInstance(instance *NewInstance) string {
+	return fmt.Sprintf("%s = new %s;", p.PrintOperand(*instance.LhsOp), instance.TypeName.Value())
+}
+
+func (p *PrettyPrinter) PrintNewTypeDesc(typeDesc *NewTypeDesc) string {
+	return fmt.Sprintf("%s = newTypeDesc %s;", p.PrintOperand(*typeDesc.LhsOp), typeDesc.TypeName.Value())
+}
+
+func (p *PrettyPrinter) PrintNewXMLElement(element *NewXMLElement) string {
+	return fmt.Sprintf("%s = newXMLElement %s %s;", p.PrintOperand(*element.LhsOp), p.PrintOperand(*element.StartTagOp), p.PrintOperand(*element.DefaultNsURIOp))
+}
+
+func (p *PrettyPrinter) PrintNewXMLQName(qname *NewXMLQName) string {
+	return fmt.Sprintf("%s = newXMLQName %s %s %s;", p.PrintOperand(*qname.LhsOp), p.PrintOperand(*qname.NsURIOp), p.PrintOperand(*qname.LocalnameOp), p.PrintOperand(*qname.PrefixOp))
+}
+
+func (p *PrettyPrinter) PrintNewXMLText(text *NewXMLText) string {
+	return fmt.Sprintf("%s = newXMLText %s;", p.PrintOperand(*text.LhsOp), p.PrintOperand(*text.TextOp))
+}
+
+func (p *PrettyPrinter) PrintNewXMLComment(comment *NewXMLComment) string {
+	return fmt.Sprintf("%s = newXMLComment %s;", p.PrintOperand(*comment.LhsOp), p.PrintOperand(*comment.TextOp))
+}
+
+func (p *PrettyPrinter) PrintNewXMLSequence(sequence *NewXMLSequence) string {
+	return fmt.Sprintf("%s = newXMLSequence;", p.PrintOperand(*sequence.LhsOp))
+}
+
+func (p *PrettyPrinter) PrintNewXMLProcIns(procIns *NewXMLProcIns) string {
+	return fmt.Sprintf("%s = newXMLPI %s %s;", p.PrintOperand(*procIns.LhsOp), p.PrintOperand(*procIns.TargetOp), p.PrintOperand(*procIns.DataOp))
+}
+
+func (p *PrettyPrinter) PrintXMLAccess(access *XMLAccess) string {
+	switch access.Kind {
+	case INSTRUCTION_KIND_XML_SEQ_STORE:
+		return fmt.Sprintf("%s.add(%s);", p.PrintOperand(*access.LhsOp), p.PrintOperand(*access.RhsOp))
+	default:
+		panic(fmt.Sprintf("unknown xml access kind: %d", access.Kind))
+	}
+}
+
+func (p *PrettyPrinter) PrintNewRegExp(regExp *NewRegExp) string {
+	return fmt.Sprintf("%s = newRegExp %s;", p.PrintOperand(*regExp.LhsOp), p.PrintOperand(*regExp.ReDisjunctionOp))
+}
+
+func (p *PrettyPrinter) PrintNewReDisjunction(disjunction *NewReDisjunction) string {
+	return fmt.Sprintf("%s = newReDisjunction [%s];", p.PrintOperand(*disjunction.LhsOp), p.printOperandList(disjunction.SequenceOps))
+}
+
+func (p *PrettyPrinter) PrintNewReSequence(sequence *NewReSequence) string {
+	return fmt.Sprintf("%s = newReSequence [%s];", p.PrintOperand(*sequence.LhsOp), p.printOperandList(sequence.TermOps))
+}
+
+func (p *PrettyPrinter) PrintNewReAssertion(assertion *NewReAssertion) string {
+	return fmt.Sprintf("%s = newReAssertion %s;", p.PrintOperand(*assertion.LhsOp), p.PrintOperand(*assertion.AssertionOp))
+}
+
+func (p *PrettyPrinter) PrintNewReAtomQuantifier(atomQuantifier *NewReAtomQuantifier) string {
+	return fmt.Sprintf("%s = newReAtomQuantifier %s %s;", p.PrintOperand(*atomQuantifier.LhsOp), p.PrintOperand(*atomQuantifier.AtomOp), p.PrintOperand(*atomQuantifier.QuantifierOp))
+}
+
+func (p *PrettyPrinter) PrintNewReLiteralCharOrEscape(charOrEscape *NewReLiteralCharOrEscape) string {
+	return fmt.Sprintf("%s = newReLiteralCharOrEscape %s;", p.PrintOperand(*charOrEscape.LhsOp), p.PrintOperand(*charOrEscape.CharOrEscapeOp))
+}
+
+func (p *PrettyPrinter) PrintNewReCharClass(charClass *NewReCharClass) string {
+	return fmt.Sprintf("%s = newReCharClass %s %s %s %s;", p.PrintOperand(*charClass.LhsOp), p.PrintOperand(*charClass.ClassStartOp), p.PrintOperand(*charClass.NegationOp), p.PrintOperand(*charClass.CharSetOp), p.PrintOperand(*charClass.ClassEndOp))
+}
+
+func (p *PrettyPrinter) PrintNewReCharSet(charSet *NewReCharSet) string {
+	return fmt.Sprintf("%s = newReCharSet [%s];", p.PrintOperand(*charSet.LhsOp), p.printOperandList(charSet.CharSetAtomOps))
+}
+
+func (p *PrettyPrinter) PrintNewReCharSetRange(charSetRange *NewReCharSetRange) string {
+	return fmt.Sprintf("%s = newReCharSetRange %s %s %s;", p.PrintOperand(*charSetRange.LhsOp), p.PrintOperand(*charSetRange.LhsCharSetAtomOp), p.PrintOperand(*charSetRange.DashOp), p.PrintOperand(*charSetRange.RhsCharSetAtomOp))
+}
+
+func (p *PrettyPrinter) PrintNewReCapturingGroup(capturingGroup *NewReCapturingGroup) string {
+	return fmt.Sprintf("%s = newReCapturingGroup %s %s %s %s;", p.PrintOperand(*capturingGroup.LhsOp), p.PrintOperand(*capturingGroup.OpenParenOp), p.PrintOperand(*capturingGroup.FlagExprOp), p.PrintOperand(*capturingGroup.ReDisjunctionOp), p.PrintOperand(*capturingGroup.CloseParenOp))
+}
+
+func (p *PrettyPrinter) PrintNewReFlagExpr(flagExpr *NewReFlagExpr) string {
+	return fmt.Sprintf("%s = newReFlagExpr %s %s %s;", p.PrintOperand(*flagExpr.LhsOp), p.PrintOperand(*flagExpr.QuestionMarkOp), p.PrintOperand(*flagExpr.FlagsOnOffOp), p.PrintOperand(*flagExpr.ColonOp))
+}
+
+func (p *PrettyPrinter) PrintNewReFlagOnOff(flagOnOff *NewReFlagOnOff) string {
+	return fmt.Sprintf("%s = newReFlagOnOff %s;", p.PrintOperand(*flagOnOff.LhsOp), p.PrintOperand(*flagOnOff.FlagsOp))
+}
+
+func (p *PrettyPrinter) PrintNewReQuantifier(quantifier *NewReQuantifier) string {
+	return fmt.Sprintf("%s = newReQuantifier %s %s;", p.PrintOperand(*quantifier.LhsOp), p.PrintOperand(*quantifier.QuantifierOp), p.PrintOperand(*quantifier.NonGreedyCharOp))
+}
+
+func (p *PrettyPrinter) printOperandList(operands []*BIROperand) string {
+	result := strings.Builder{}
+	for i, operand := range operands {
+		if i > 0 {
+			result.WriteString(",")
+		}
+		result.WriteString(p.PrintOperand(*operand))
+	}
+	return result.String()
+}
+
 func (p *PrettyPrinter) PrintFieldAccess(access *FieldAccess) string {
 	switch access.Kind {
+	case INSTRUCTION_KIND_XML_ATTRIBUTE_STORE:
+		return fmt.Sprintf("%s@%s = %s;", p.PrintOperand(*access.LhsOp), p.PrintOperand(*access.KeyOp), p.PrintOperand(*access.RhsOp))
 	case INSTRUCTION_KIND_ARRAY_STORE:
 		return fmt.Sprintf("%s[%s] = %s;", p.PrintOperand(*access.LhsOp), p.PrintOperand(*access.KeyOp), p.PrintOperand(*access.RhsOp))
 	case INSTRUCTION_KIND_ARRAY_LOAD:
 		return fmt.Sprintf("%s = %s[%s];", p.PrintOperand(*access.LhsOp), p.PrintOperand(*access.RhsOp), p.PrintOperand(*access.KeyOp))
+	case INSTRUCTION_KIND_MAP_LOAD, INSTRUCTION_KIND_TABLE_LOAD:
+		return fmt.Sprintf("%s = %s[%s];", p.PrintOperand(*access.LhsOp), p.PrintOperand(*access.RhsOp), p.PrintOperand(*access.KeyOp))
+	case INSTRUCTION_KIND_MAP_STORE, INSTRUCTION_KIND_TABLE_STORE:
+		return fmt.Sprintf("%s[%s] = %s;", p.PrintOperand(*access.LhsOp), p.PrintOperand(*access.KeyOp), p.PrintOperand(*access.RhsOp))
+	case INSTRUCTION_KIND_ANNOT_ACCESS:
+		return fmt.Sprintf("%s = %s.@%s;", p.PrintOperand(*access.LhsOp), p.PrintOperand(*access.RhsOp), p.PrintOperand(*access.KeyOp))
 	default:
 		panic(fmt.Sprintf("unknown field access kind: %d", access.Kind))
 	}
@@ -176,14 +398,97 @@ func (p *PrettyPrinter) PrintCall(call *Call) string {
 		}
 		args.WriteString(p.PrintOperand(arg))
 	}
+	if call.IsVirtual {
+		// the first argument is the object whose method is called
+		methodArgs := make([]string, len(call.Args)-1)
+		for i, arg := range call.Args[1:] {
+			methodArgs[i] = p.PrintOperand(arg)
+		}
+		return fmt.Sprintf("%s = %s.%s(%s) -> %s;", p.PrintOperand(*call.LhsOp), p.PrintOperand(call.Args[0]),
+			call.Name.Value(), strings.Join(methodArgs, ","), call.ThenBB.Id.Value())
+	}
+	if call.Kind == INSTRUCTION_KIND_ASYNC_CALL {
+		return fmt.Sprintf("%s = start %s(%s) -> %s;", p.PrintOperand(*call.LhsOp), call.Name.Value(), args.String(), call.ThenBB.Id.Value())
+	}
 	return fmt.Sprintf("%s = %s(%s) -> %s;", p.PrintOperand(*call.LhsOp), call.Name.Value(), args.String(), call.ThenBB.Id.Value())
 }
 
+func (p *PrettyPrinter) PrintWorkerSend(send *WorkerSend) string {
+	if send.IsSync {
+		return fmt.Sprintf("%s = %s ->> %s -> %s;", p.PrintOperand(*send.LhsOp), p.PrintOperand(*send.Data), send.Channel.Value(), send.ThenBB.Id.Value())
+	}
+	return fmt.Sprintf("%s -> %s -> %s;", p.PrintOperand(*send.Data), send.Channel.Value(), send.ThenBB.Id.Value())
+}
+
+func (p *PrettyPrinter) PrintWorkerReceive(receive *WorkerReceive) string {
+	return fmt.Sprintf("%s = <- %s -> %s;", p.PrintOperand(*receive.LhsOp), receive.Channel.Value(), receive.ThenBB.Id.Value())
+}
+
+func (p *PrettyPrinter) PrintWorkerAlternateReceive(receive *WorkerAlternateReceive) string {
+	return fmt.Sprintf("%s = <- %s -> %s;", p.PrintOperand(*receive.LhsOp), joinNames(receive.Channels, " | "), receive.ThenBB.Id.Value())
+}
+
+func (p *PrettyPrinter) PrintWorkerMultipleReceive(receive *WorkerMultipleReceive) string {
+	fields := strings.Builder{}
+	for i, field := range receive.ReceiveFields {
+		if i > 0 {
+			fields.WriteString(",")
+		}
+		fields.WriteString(fmt.Sprintf("%s:%s", field.Key, field.Channel.Value()))
+	}
+	return fmt.Sprintf("%s = <- {%s} -> %s;", p.PrintOperand(*receive.LhsOp), fields.String(), receive.ThenBB.Id.Value())
+}
+
+func (p *PrettyPrinter) PrintLock(lock *Lock) string {
+	return fmt.Sprintf("lock -> %s;", lock.ThenBB.Id.Value())
+}
+
+func (p *PrettyPrinter) PrintUnlock(unlock *Unlock) string {
+	return fmt.Sprintf("unlock -> %s;", unlock.ThenBB.Id.Value())
+}
+
+func (p *PrettyPrinter) PrintFlush(flush *Flush) string {
+	return fmt.Sprintf("%s = flush [%s] -> %s;", p.PrintOperand(*flush.LhsOp), joinNames(flush.Channels, ","), flush.ThenBB.Id.Value())
+}
+
+func (p *PrettyPrinter) PrintWait(wait *Wait) string {
+	exprs := strings.Builder{}
+	for i, expr := range wait.Exprs {
+		if i > 0 {
+			exprs.WriteString(" | ")
+		}
+		exprs.WriteString(p.PrintOperand(*expr))
+	}
+	return fmt.Sprintf("%s = wait %s -> %s;", p.PrintOperand(*wait.LhsOp), exprs.String(), wait.ThenBB.Id.Value())
+}
+
+func (p *PrettyPrinter) PrintWaitAll(waitAll *WaitAll) string {
+	entries := strings.Builder{}
+	for i, key := range waitAll.Keys {
+		if i > 0 {
+			entries.WriteString(",")
+		}
+		entries.WriteString(fmt.Sprintf("%s:%s", key, p.PrintOperand(*waitAll.Values[i])))
+	}
+	return fmt.Sprintf("%s = wait {%s} -> %s;", p.PrintOperand(*waitAll.LhsOp), entries.String(), waitAll.ThenBB.Id.Value())
+}
+
+func joinNames(names []model.Name, separator string) string {
+	values := make([]string, len(names))
+	for i, name := range names {
+		values[i] = name.Value()
+	}
+	return strings.Join(values, separator)
+}
+
 func (p *PrettyPrinter) PrintOperand(operand BIROperand) string {
 	return operand.VariableDcl.Name.Value()
 }
 
 func (p *PrettyPrinter) PrintConstantLoad(load *ConstantLoad) string {
+	if load.Value == nil {
+		return fmt.Sprintf("%s = ConstantLoad ()", p.PrintOperand(*load.LhsOp))
+	}
 	return fmt.Sprintf("%s = ConstantLoad %s", p.PrintOperand(*load.LhsOp), load.Value)
 }
 
@@ -191,6 +496,10 @@ func (p *PrettyPrinter) PrintUnaryOp(op *UnaryOp) string {
 	return fmt.Sprintf("%s = %s %s;", p.PrintOperand(*op.LhsOp), p.PrintInstructionKind(op.Kind), p.PrintOperand(*op.RhsOp))
 }
 
+func (p *PrettyPrinter) PrintTypeTest(test *TypeTest) string {
+	return fmt.Sprintf("%s = %s is %s;", p.PrintOperand(*test.LhsOp), p.PrintOperand(*test.RhsOp), test.TypeName.Value())
+}
+
 func (p *PrettyPrinter) PrintBinaryOp(op *BinaryOp) string {
 	return fmt.Sprintf("%s = %s %s %s;", p.PrintOperand(*op.LhsOp), p.PrintInstructionKind(op.Kind), p.PrintOperand(op.RhsOp1), p.PrintOperand(op.RhsOp2))
 }
@@ -241,6 +550,97 @@ func (p *PrettyPrinter) PrintGlobalVar(globalVar BIRGlobalVariableDcl) string {
 	return sb.String()
 }
 
+func (p *PrettyPrinter) PrintAnnotation(annotation BIRAnnotation) string {
+	sb := strings.Builder{}
+	sb.WriteString("annotation ")
+	if annotation.Flags&int64(ast.Flags_CONSTANT) != 0 {
+		sb.WriteString("const ")
+	}
+	sb.WriteString(annotation.Name.Value())
+	points := make([]string, len(annotation.AttachPoints))
+	for i, attachPoint := range annotation.AttachPoints {
+		points[i] = string(attachPoint.Point)
+		if attachPoint.Source {
+			points[i] = "source " + points[i]
+		}
+	}
+	if len(points) > 0 {
+		sb.WriteString(" on ")
+		sb.WriteString(strings.Join(points, ", "))
+	}
+	return sb.String()
+}
+
+// PrintServiceDeclaration writes a service declaration as `service name /path on listeners;`, preceded by the
+// annotations attached to it. The declaration starts with `isolated` if the service is isolated, and is otherwise
+// followed by the causes that make it not isolated, one per line.
+func (p *PrettyPrinter) PrintServiceDeclaration(serviceDecl BIRServiceDeclaration) {
+	name := serviceDecl.GeneratedName.Value()
+	p.printAnnotationAttachments(serviceDecl.AnnotAttachments, " service "+name)
+	if serviceDecl.Flags&int64(ast.Flags_ISOLATED) != 0 {
+		p.write("isolated ")
+	}
+	p.write("service " + name + " " + serviceDecl.AssociatedClassName.Value())
+	if serviceDecl.AttachPointLiteral != nil {
+		p.write(fmt.Sprintf(" %q", *serviceDecl.AttachPointLiteral))
+	} else if serviceDecl.AttachPoint != nil {
+		p.write(" /" + strings.Join(serviceDecl.AttachPoint, "/"))
+	}
+	p.write(" on " + joinNames(serviceDecl.Listeners, ", ") + ";\n")
+	for _, cause := range serviceDecl.NonIsolationCauses {
+		p.write("    not isolated: " + cause + ";\n")
+	}
+}
+
+// PrintTypeDefAnnotations writes the annotations attached to a type definition and to the fields of its record type.
+func (p *PrettyPrinter) PrintTypeDefAnnotations(typeDef BIRTypeDefinition) {
+	for _, attachment := range typeDef.AnnotAttachments {
+		p.write(p.PrintAnnotationAttachment(attachment))
+		p.write(" type " + typeDef.Name.Value() + ";\n")
+	}
+	for _, field := range typeDef.FieldAnnotAttachments {
+		for _, attachment := range field.AnnotAttachments {
+			p.write(p.PrintAnnotationAttachment(attachment))
+			p.write(" field " + typeDef.Name.Value() + "." + field.FieldName.Value() + ";\n")
+		}
+	}
+}
+
+// printAnnotationAttachments writes each attachment on a line of its own, followed by what it is attached to.
+func (p *PrettyPrinter) printAnnotationAttachments(attachments []BIRAnnotationAttachment, target string) {
+	for _, attachment := range attachments {
+		p.write(p.PrintAnnotationAttachment(attachment))
+		p.write(target + "\n")
+	}
+}
+
+func (p *PrettyPrinter) PrintAnnotationAttachment(attachment BIRAnnotationAttachment) string {
+	return "@" + attachment.AnnotTagRef.Value() + " " + p.PrintConstValue(attachment.Value)
+}
+
+func (p *PrettyPrinter) PrintConstValue(value ConstValue) string {
+	switch v := value.Value.(type) {
+	case nil:
+		return "()"
+	case []ConstValue:
+		members := make([]string, len(v))
+		for i, member := range v {
+			members[i] = p.PrintConstValue(member)
+		}
+		return "[" + strings.Join(members, ", ") + "]"
+	case []ConstMappingField:
+		fields := make([]string, len(v))
+		for i, field := range v {
+			fields[i] = field.Key + ": " + p.PrintConstValue(field.Value)
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	}
+	if value.Type != nil && value.Type.GetTypeKind() == model.TypeKind_STRING {
+		return fmt.Sprintf("%q", value.Value)
+	}
+	return fmt.Sprint(value.Value)
+}
+
 func (p *PrettyPrinter) PrintType(typeNode model.ValueType) string {
 	if typeNode == nil {
 		return "<UNKNOWN>"
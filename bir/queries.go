@@ -0,0 +1,136 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bir
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+)
+
+// queryExpression lowers a query to a loop over an iterator of the collection of its from clause. The value of the
+// select clause for each member that satisfies the where clauses is added to the result, which is a new table for a
+// `table` query, a stream for a `stream` query and a new list otherwise. streamType is the type of the stream
+// expected by the context of a `stream` query, or nil if it is not known.
+func queryExpression(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangQueryExpr, streamType model.TypeNode) expressionEffect {
+	curBB := bb
+	var resultOperand *BIROperand
+	addFunction, addPkg := "push", model.ARRAY_PKG
+	if expr.IsTable {
+		var keyFieldNames []string
+		if expr.TableKeySpecifier != nil {
+			keyFieldNames = expr.TableKeySpecifier.FieldNames()
+		}
+		resultOperand = newTable(ctx, curBB, keyFieldNames, nil).result
+		addFunction, addPkg = "add", model.TABLE_PKG
+	} else {
+		resultOperand = newArrayWithValues(ctx, curBB, nil)
+	}
+
+	// the variables bound by the clauses are only visible within the query
+	type shadowedVar struct {
+		operand *BIROperand
+		ty      semtypes.SemType
+	}
+	shadowed := make(map[string]shadowedVar)
+	bind := func(name string, operand *BIROperand, ty semtypes.SemType) {
+		if _, ok := shadowed[name]; !ok {
+			shadowed[name] = shadowedVar{ctx.varMap[name], ctx.varTypes[name]}
+		}
+		ctx.varMap[name] = operand
+		ctx.varTypes[name] = ty
+	}
+
+	fromClause := expr.QueryClauseList[0].(*ast.BLangFromClause)
+	// the result of a query over a stream that completes with an error is the error
+	collectionType := streamTypeOf(ctx, fromClause.Collection)
+	checkError := collectionType != nil && mayCompleteWithError(ctx, collectionType)
+	if checkError && expr.IsStream {
+		panic("stream queries over streams that may complete with an error not yet supported")
+	}
+	it := iterate(ctx, curBB, fromClause.Collection, checkError)
+	if it.errorBB != nil {
+		move := &Move{}
+		move.LhsOp = resultOperand
+		move.RhsOp = it.next
+		it.errorBB.Instructions = append(it.errorBB.Instructions, move)
+		it.errorBB.Terminator = &Goto{BIRTerminatorBase: BIRTerminatorBase{ThenBB: it.loopEnd}}
+	}
+	curBB = it.loopBody
+	fromVar := fromClause.VariableDefinitionNode.Var
+	bind(fromVar.Name.Value, memberOf(ctx, curBB, it, fromVar.Name.Value), variableType(ctx, fromVar.TypeNode, nil))
+
+	for _, clause := range expr.QueryClauseList[1:] {
+		switch clause := clause.(type) {
+		case *ast.BLangWhereClause:
+			condEffect := handleExpression(ctx, curBB, clause.Expression)
+			// skip the member if the condition is false
+			whereBranch := &Branch{}
+			whereBranch.Op = condEffect.result
+			whereBranch.TrueBB = ctx.addBB()
+			whereBranch.FalseBB = it.loopHead
+			condEffect.block.Terminator = whereBranch
+			curBB = whereBranch.TrueBB
+		case *ast.BLangLetClause:
+			for _, letVarDeclaration := range clause.LetVarDeclarations {
+				letExpr := letVarDeclaration.Var.Expr.(ast.BLangExpression)
+				valueEffect := handleExpression(ctx, curBB, letExpr)
+				curBB = valueEffect.block
+				letVarName := letVarDeclaration.Var.Name.Value
+				move := &Move{}
+				move.LhsOp = ctx.addLocalVar(model.Name(letVarName), nil, VAR_KIND_LOCAL)
+				move.RhsOp = valueEffect.result
+				curBB.Instructions = append(curBB.Instructions, move)
+				bind(letVarName, move.LhsOp, variableType(ctx, letVarDeclaration.Var.TypeNode, letExpr))
+			}
+		case *ast.BLangSelectClause:
+			valueEffect := handleExpression(ctx, curBB, clause.Expression)
+			curBB = valueEffect.block
+			addCall := &Call{}
+			addCall.Kind = INSTRUCTION_KIND_CALL
+			addCall.Args = []BIROperand{*resultOperand, *valueEffect.result}
+			addCall.Name = model.Name(addFunction)
+			addCall.CalleePkg = addPkg
+			addCall.ThenBB = ctx.addBB()
+			addCall.LhsOp = ctx.addTempVar(nil)
+			curBB.Terminator = addCall
+			curBB = addCall.ThenBB
+		default:
+			panic("unexpected query clause type")
+		}
+	}
+	curBB.Terminator = &Goto{BIRTerminatorBase: BIRTerminatorBase{ThenBB: it.loopHead}}
+
+	for name, prev := range shadowed {
+		if prev.operand == nil {
+			delete(ctx.varMap, name)
+			delete(ctx.varTypes, name)
+		} else {
+			ctx.varMap[name] = prev.operand
+			ctx.varTypes[name] = prev.ty
+		}
+	}
+	if !expr.IsStream {
+		return expressionEffect{
+			result: resultOperand,
+			block:  it.loopEnd,
+		}
+	}
+	// FIXME: stream queries should be evaluated lazily, as the stream is consumed
+	iteratorEffect := methodCall(ctx, it.loopEnd, resultOperand, "iterator", nil)
+	return newStream(ctx, iteratorEffect.block, streamType, iteratorEffect.result)
+}
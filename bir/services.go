@@ -0,0 +1,304 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bir
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"fmt"
+	"strings"
+)
+
+const (
+	// moduleStartFunctionName is the name of the function that starts the module listeners in the listening phase
+	moduleStartFunctionName = "<start>"
+	// moduleStopFunctionName is the name of the function that gracefully stops the module listeners
+	moduleStopFunctionName = "<stop>"
+)
+
+// TransformServiceDeclaration transforms a service declaration, returning the module variables it needs: one for each
+// listener given as a new expression, followed by the one holding the service object.
+func TransformServiceDeclaration(ctx *Context, service *ast.BLangService) (*BIRServiceDeclaration, []BIRGlobalVariableDcl) {
+	name := model.Name(service.Name.Value)
+	serviceDecl := &BIRServiceDeclaration{}
+	serviceDecl.Pos = service.GetPosition()
+	serviceDecl.GeneratedName = name
+	serviceDecl.AssociatedClassName = model.Name(service.ServiceClass.Name.Value)
+	serviceDecl.Flags = int64(ast.AsMask(service.GetFlags()) | ast.Flags_SERVICE)
+	causes := ctx.isolation.ServiceNonIsolationCauses(serviceDecl.AssociatedClassName.Value())
+	if len(causes) == 0 {
+		serviceDecl.Flags |= int64(ast.Flags_ISOLATED)
+	}
+	for _, cause := range causes {
+		serviceDecl.NonIsolationCauses = append(serviceDecl.NonIsolationCauses, cause.String())
+	}
+	if service.ServiceNameLiteral != nil {
+		literal := service.ServiceNameLiteral.Value.(string)
+		serviceDecl.AttachPointLiteral = &literal
+	} else if service.AbsoluteResourcePath != nil {
+		serviceDecl.AttachPoint = make([]string, len(service.AbsoluteResourcePath))
+		for i, segment := range service.AbsoluteResourcePath {
+			serviceDecl.AttachPoint[i] = segment.GetValue()
+		}
+	}
+	if service.MarkdownDocumentationAttachment != nil {
+		serviceDecl.MarkdownDocAttachment = service.MarkdownDocumentationAttachment.GetDocAttachment()
+	}
+	serviceDecl.AnnotAttachments = transformAnnotationAttachments(ctx, service.GetAnnotationAttachments(), model.Point_SERVICE)
+
+	var globalVars []BIRGlobalVariableDcl
+	for i := range service.AttachedExprs {
+		listenerName, synthetic := serviceListenerName(ctx, service, i)
+		if synthetic {
+			globalVars = append(globalVars, *newGlobalVariableDcl(ctx, listenerName, ast.Flags_LISTENER|ast.Flags_FINAL))
+		}
+		serviceDecl.Listeners = append(serviceDecl.Listeners, listenerName)
+	}
+	globalVars = append(globalVars, *newGlobalVariableDcl(ctx, name, ast.Flags_SERVICE|ast.Flags_FINAL))
+	return serviceDecl, globalVars
+}
+
+func newGlobalVariableDcl(ctx *Context, name model.Name, flags ast.Flags) *BIRGlobalVariableDcl {
+	globalVar := &BIRGlobalVariableDcl{}
+	globalVar.Name = name
+	globalVar.OriginalName = name
+	globalVar.Flags = int64(flags)
+	globalVar.PkgId = ctx.packageID
+	globalVar.Scope = VAR_SCOPE_GLOBAL
+	globalVar.Kind = VAR_KIND_GLOBAL
+	globalVar.MetaVarName = name.Value()
+	return globalVar
+}
+
+// serviceListenerName returns the module variable holding the listener the service is attached to by its index-th
+// listener expression. A new expression is kept in a synthetic variable named after the service, while any other
+// expression must refer to a listener declaration.
+func serviceListenerName(ctx *Context, service *ast.BLangService, index int) (model.Name, bool) {
+	switch expr := service.AttachedExprs[index].(type) {
+	case *ast.BLangSimpleVarRef:
+		if expr.PkgAlias != nil && expr.PkgAlias.Value != "" {
+			panic("listeners of other modules not yet supported")
+		}
+		name := expr.VariableName.Value
+		if _, ok := ctx.globalVarMap[name]; !ok {
+			panic(fmt.Sprintf("undefined symbol '%s'", name))
+		}
+		if !ctx.listeners[name] {
+			panic(fmt.Sprintf("invalid listener attachment: '%s' is not a listener", name))
+		}
+		return model.Name(name), false
+	case *ast.BLangTypeInit:
+		return model.Name(fmt.Sprintf("%s$listener$%d", service.Name.Value, index)), true
+	default:
+		panic("invalid listener attachment: expected a listener declaration or a new expression")
+	}
+}
+
+// attachService creates the service object and attaches it to each of its listeners, after creating the listeners
+// given as new expressions.
+func attachService(ctx *stmtContext, bb *BIRBasicBlock, service *ast.BLangService) *BIRBasicBlock {
+	curBB := bb
+	listeners := make([]*BIROperand, len(service.AttachedExprs))
+	for i, expr := range service.AttachedExprs {
+		listenerName, synthetic := serviceListenerName(ctx.birCx, service, i)
+		listeners[i] = ctx.birCx.globalVarMap[listenerName.Value()]
+		if synthetic {
+			exprResult := handleExpression(ctx, curBB, expr)
+			curBB = exprResult.block
+			move := &Move{}
+			move.LhsOp = listeners[i]
+			move.RhsOp = exprResult.result
+			curBB.Instructions = append(curBB.Instructions, move)
+		}
+	}
+
+	newInstance := &NewInstance{}
+	newInstance.TypeName = model.Name(service.ServiceClass.Name.Value)
+	newInstance.LhsOp = ctx.addTempVar(nil)
+	curBB.Instructions = append(curBB.Instructions, newInstance)
+	serviceOperand := ctx.birCx.globalVarMap[service.Name.Value]
+	move := &Move{}
+	move.LhsOp = serviceOperand
+	move.RhsOp = newInstance.LhsOp
+	curBB.Instructions = append(curBB.Instructions, move)
+
+	attachPoint := serviceAttachPoint(ctx, curBB, service)
+	for _, listener := range listeners {
+		curBB = methodCall(ctx, curBB, listener, "attach", []BIROperand{*serviceOperand, *attachPoint}).block
+	}
+	return curBB
+}
+
+// serviceAttachPoint loads the name the service is attached with, which is the string literal name of the service,
+// the segments of its absolute resource path, or nil.
+func serviceAttachPoint(ctx *stmtContext, bb *BIRBasicBlock, service *ast.BLangService) *BIROperand {
+	if service.ServiceNameLiteral != nil {
+		return stringConstant(ctx, bb, service.ServiceNameLiteral.Value.(string))
+	}
+	if service.AbsoluteResourcePath == nil {
+		constantLoad := &ConstantLoad{}
+		constantLoad.LhsOp = ctx.addTempVar(nil)
+		bb.Instructions = append(bb.Instructions, constantLoad)
+		return constantLoad.LhsOp
+	}
+	sizeLoad := &ConstantLoad{}
+	sizeLoad.Value = int64(len(service.AbsoluteResourcePath))
+	sizeLoad.LhsOp = ctx.addTempVar(nil)
+	bb.Instructions = append(bb.Instructions, sizeLoad)
+	newArray := &NewArray{}
+	newArray.SizeOp = sizeLoad.LhsOp
+	newArray.LhsOp = ctx.addTempVar(nil)
+	bb.Instructions = append(bb.Instructions, newArray)
+	for i, segment := range service.AbsoluteResourcePath {
+		indexLoad := &ConstantLoad{}
+		indexLoad.Value = int64(i)
+		indexLoad.LhsOp = ctx.addTempVar(nil)
+		bb.Instructions = append(bb.Instructions, indexLoad)
+		store := &FieldAccess{}
+		store.Kind = INSTRUCTION_KIND_ARRAY_STORE
+		store.LhsOp = newArray.LhsOp
+		store.KeyOp = indexLoad.LhsOp
+		store.RhsOp = stringConstant(ctx, bb, segment.GetValue())
+		bb.Instructions = append(bb.Instructions, store)
+	}
+	return newArray.LhsOp
+}
+
+// methodCall calls a method of the object held by receiver, which is passed as the first argument of the call.
+func methodCall(ctx *stmtContext, bb *BIRBasicBlock, receiver *BIROperand, name string, args []BIROperand) expressionEffect {
+	thenBB := ctx.addBB()
+	call := &Call{}
+	call.Kind = INSTRUCTION_KIND_CALL
+	call.IsVirtual = true
+	call.Args = append([]BIROperand{*receiver}, args...)
+	call.Name = model.Name(name)
+	call.ThenBB = thenBB
+	call.LhsOp = ctx.addTempVar(nil)
+	bb.Terminator = call
+	return expressionEffect{
+		result: call.LhsOp,
+		block:  thenBB,
+	}
+}
+
+// TransformModuleStart generates the function that starts the module listeners in declaration order, which runs the
+// listening phase of the module once it is initialized.
+func TransformModuleStart(ctx *Context, listeners []model.Name) *BIRFunction {
+	return transformListenerLifecycle(ctx, moduleStartFunctionName, "start", listeners)
+}
+
+// TransformModuleStop generates the function that gracefully stops the module listeners when the program exits.
+func TransformModuleStop(ctx *Context, listeners []model.Name) *BIRFunction {
+	return transformListenerLifecycle(ctx, moduleStopFunctionName, "gracefulStop", listeners)
+}
+
+func transformListenerLifecycle(ctx *Context, name string, method string, listeners []model.Name) *BIRFunction {
+	funcName := model.Name(name)
+	stmtCx := newStmtContext(ctx, ctx.typeResolver.NewScope(), ctx.xmlnsMap, funcName, defaultWorkerName)
+	stmtCx.retVar = stmtCx.addLocalVar(model.Name("%0"), nil, VAR_KIND_RETURN)
+	curBB := stmtCx.addBB()
+	for _, listener := range listeners {
+		curBB = methodCall(stmtCx, curBB, ctx.globalVarMap[listener.Value()], method, nil).block
+	}
+	curBB.Terminator = &Return{}
+	birFunc := &BIRFunction{}
+	birFunc.Name = funcName
+	birFunc.OriginalName = funcName
+	stmtCx.populateFunction(birFunc)
+	return birFunc
+}
+
+// TransformServiceClass transforms the class of a service to a type definition whose attached functions are the
+// methods of the service. The path parameters of a resource method become its leading parameters.
+func TransformServiceClass(ctx *Context, service *ast.BLangService) *BIRTypeDefinition {
+	serviceClass := service.ServiceClass
+	name := model.Name(serviceClass.Name.Value)
+	typeDef := &BIRTypeDefinition{}
+	typeDef.Pos = serviceClass.GetPosition()
+	typeDef.Name = name
+	typeDef.OriginalName = name
+	typeDef.Flags = int64(ast.AsMask(serviceClass.GetFlags()))
+
+	methods := make(map[string]bool)
+	for i := range serviceClass.Functions {
+		method := &serviceClass.Functions[i]
+		if methods[method.Name.Value] {
+			panic(fmt.Sprintf("redeclared symbol '%s'", method.Name.Value))
+		}
+		methods[method.Name.Value] = true
+		point := model.Point(model.Point_OBJECT_METHOD)
+		if method.FlagSet.Contains(model.Flag_REMOTE) {
+			point = model.Point_SERVICE_REMOTE
+		}
+		typeDef.AttachedFuncs = append(typeDef.AttachedFuncs, *transformMethod(ctx, method, point))
+	}
+	for i := range service.ResourceFunctions {
+		resourceFunc := &service.ResourceFunctions[i]
+		if methods[resourceFunc.Name.Value] {
+			panic(fmt.Sprintf("resource '%s' with the same accessor and path already exists", resourceName(resourceFunc)))
+		}
+		methods[resourceFunc.Name.Value] = true
+		method := resourceFunc.BLangFunction
+		var params []ast.BLangSimpleVariable
+		for j := range resourceFunc.PathParams {
+			validatePathParamType(resourceFunc.PathParams[j].TypeNode)
+			params = append(params, resourceFunc.PathParams[j])
+		}
+		if resourceFunc.RestPathParam != nil {
+			validatePathParamType(resourceFunc.RestPathParam.TypeNode.(*ast.BLangArrayType).Elemtype)
+			params = append(params, *resourceFunc.RestPathParam)
+		}
+		method.RequiredParams = append(params, method.RequiredParams...)
+		typeDef.AttachedFuncs = append(typeDef.AttachedFuncs, *transformMethod(ctx, &method, model.Point_OBJECT_METHOD))
+	}
+	return typeDef
+}
+
+func transformMethod(ctx *Context, method *ast.BLangFunction, point model.Point) *BIRFunction {
+	birFunc := transformFunction(ctx, method, point)
+	birFunc.Flags = int64(ast.AsMask(&method.FlagSet) | ast.Flags_ATTACHED)
+	return birFunc
+}
+
+// resourceName returns the accessor and path of a resource method as written in the source, such as `get users/[int]`.
+func resourceName(resourceFunc *ast.BLangResourceFunction) string {
+	segments := make([]string, len(resourceFunc.ResourcePath))
+	pathParam := 0
+	for i, segment := range resourceFunc.ResourcePath {
+		switch segment.Value {
+		case "^":
+			segments[i] = "[" + typeNodeName(resourceFunc.PathParams[pathParam].TypeNode) + "]"
+			pathParam++
+		case "^^":
+			segments[i] = "[" + typeNodeName(resourceFunc.RestPathParam.TypeNode.(*ast.BLangArrayType).Elemtype) + "...]"
+		default:
+			segments[i] = segment.Value
+		}
+	}
+	return resourceFunc.MethodName.Value + " " + strings.Join(segments, "/")
+}
+
+func validatePathParamType(typeNode model.TypeNode) {
+	if valueType, ok := typeNode.(*ast.BLangValueType); ok {
+		switch valueType.TypeKind {
+		case model.TypeKind_INT, model.TypeKind_STRING, model.TypeKind_FLOAT, model.TypeKind_BOOLEAN, model.TypeKind_DECIMAL:
+			return
+		}
+	}
+	panic(fmt.Sprintf("only 'int', 'string', 'float', 'boolean', 'decimal' types are supported as path params, "+
+		"found '%s'", typeNodeName(typeNode)))
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bir
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+	"fmt"
+)
+
+// newStreamExpression lowers `new stream<T, E>(iterator)` to a new stream of the type typeNode over the iterator
+// object. A stream constructor without an iterator object creates an empty stream.
+func newStreamExpression(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangTypeInit, typeNode model.TypeNode) expressionEffect {
+	switch len(expr.ArgsExpr) {
+	case 0:
+		iteratorOperand := ctx.addTempVar(nil)
+		nilLoad := &ConstantLoad{}
+		nilLoad.LhsOp = iteratorOperand
+		bb.Instructions = append(bb.Instructions, nilLoad)
+		return newStream(ctx, bb, typeNode, iteratorOperand)
+	case 1:
+		validateStreamIterator(ctx, streamTypeNode(ctx.typeResolver, typeNode), expr.ArgsExpr[0])
+		iteratorEffect := handleExpression(ctx, bb, expr.ArgsExpr[0])
+		return newStream(ctx, iteratorEffect.block, typeNode, iteratorEffect.result)
+	default:
+		panic(fmt.Sprintf("invalid stream constructor: expected at most one argument, the iterator object, but found %d",
+			len(expr.ArgsExpr)))
+	}
+}
+
+// validateStreamIterator checks that the iterator object of a stream constructor of the type streamType is an object
+// with a method `next() returns record {| T value; |}|E`, where T and E are the type parameters of streamType.
+// FIXME: this should be part of the type checker. Until then the iterator is only checked if its type is known.
+func validateStreamIterator(ctx *stmtContext, streamType *ast.BLangStreamType, iterator ast.BLangExpression) {
+	iteratorType := expressionType(ctx, iterator)
+	if iteratorType == nil {
+		return
+	}
+	cx := ctx.typeResolver.Context()
+	// a stream type descriptor without type parameters is stream<any|error, error?>
+	var valueType semtypes.SemType = &semtypes.VAL
+	completionType := semtypes.Union(&semtypes.ERROR, &semtypes.NIL)
+	valueName, completionName := "any|error", "error?"
+	if streamType.Constraint != nil {
+		valueType = ctx.typeResolver.ResolveTypeNode(streamType.Constraint)
+		valueName = typeNodeName(streamType.Constraint)
+		completionType, completionName = &semtypes.NIL, "()"
+		if streamType.Error != nil {
+			completionType = ctx.typeResolver.ResolveTypeNode(streamType.Error)
+			completionName = typeNodeName(streamType.Error)
+		}
+	}
+	if !semtypes.IsSubtype(cx, iteratorType, streamIteratorType(ctx.birCx, valueType, completionType)) {
+		panic(fmt.Sprintf("invalid stream constructor: expected an iterator object of type "+
+			"'object { public function next() returns record {| %s value; |}|%s; }', but found '%s'",
+			valueName, completionName, semtypes.ToTypeString(cx, iteratorType)))
+	}
+}
+
+// streamIteratorType returns `object { public function next() returns record {| T value; |}|E; }`, the type of the
+// iterator objects of the streams of the value type T and completion type E.
+func streamIteratorType(ctx *Context, valueType, completionType semtypes.SemType) semtypes.SemType {
+	env := ctx.CompilerContext.TypeEnv()
+	md := semtypes.NewMappingDefinition()
+	valueField := semtypes.FieldFrom("value", valueType, false, false)
+	valueRecord := md.DefineMappingTypeWrapped(env, []semtypes.Field{valueField}, &semtypes.NEVER)
+	ld := semtypes.NewListDefinition()
+	args := ld.DefineListTypeWrapped(env, nil, 0, &semtypes.NEVER, semtypes.CellMutability_CELL_MUT_NONE)
+	fd := semtypes.NewFunctionDefinition()
+	next := fd.Define(env, args, semtypes.Union(valueRecord, completionType),
+		semtypes.FunctionQualifiersFrom(env, false, false))
+	od := semtypes.NewObjectDefinition()
+	return od.Define(env, semtypes.DefaultQualifiers(), []semtypes.Member{
+		{Name: "next", ValueTy: next, Kind: semtypes.MemberKindMethod, Visibility: semtypes.VisibilityPublic,
+			Immutable: true},
+	})
+}
+
+// newStream creates a stream of the type typeNode over the iterator object in iterator. A nil typeNode stands for
+// the type of all streams.
+func newStream(ctx *stmtContext, bb *BIRBasicBlock, typeNode model.TypeNode, iterator *BIROperand) expressionEffect {
+	stream := &NewStream{}
+	if typeNode != nil {
+		stream.TypeName = model.Name(typeNodeName(typeNode))
+		stream.Type = ctx.typeResolver.ResolveTypeNode(typeNode)
+	} else {
+		stream.TypeName = model.Name("stream")
+		stream.Type = &semtypes.STREAM
+	}
+	stream.IteratorOp = iterator
+	stream.LhsOp = ctx.addTempVar(nil)
+	bb.Instructions = append(bb.Instructions, stream)
+	return expressionEffect{
+		result: stream.LhsOp,
+		block:  bb,
+	}
+}
+
+// streamTypeNode returns the stream type descriptor typeNode refers to, following references to the type
+// definitions in scope, or nil if typeNode is not a stream type.
+func streamTypeNode(typeResolver *ast.SemTypeResolver, typeNode model.TypeNode) *ast.BLangStreamType {
+	switch typeNode := typeNode.(type) {
+	case *ast.BLangStreamType:
+		return typeNode
+	case *ast.BLangUserDefinedType:
+		if typeNode.PkgAlias.Value != "" {
+			return nil
+		}
+		if typeDefinition := typeResolver.LookupTypeDefinition(typeNode.TypeName.Value); typeDefinition != nil {
+			return streamTypeNode(typeResolver, typeDefinition.GetTypeNode())
+		}
+	}
+	return nil
+}
+
+// streamTypeOf returns the static type of the expression if it is a stream type, or nil if it is not known to produce
+// a stream without a type checker.
+func streamTypeOf(ctx *stmtContext, expr ast.BLangExpression) semtypes.SemType {
+	ty := expressionType(ctx, expr)
+	if ty == nil || !semtypes.IsSubtypeSimple(ty, semtypes.STREAM) {
+		return nil
+	}
+	return ty
+}
+
+// mayCompleteWithError reports whether a stream of the type streamType may complete with an error instead of nil.
+func mayCompleteWithError(ctx *stmtContext, streamType semtypes.SemType) bool {
+	return !semtypes.IsSubtype(ctx.typeResolver.Context(), streamType, nilCompletionStreamType(ctx.birCx))
+}
+
+// nilCompletionStreamType returns `stream<any|error, ()>`, the type of the streams that complete with nil.
+func nilCompletionStreamType(ctx *Context) semtypes.SemType {
+	if ctx.nilCompletionStreamType == nil {
+		sd := semtypes.NewStreamDefinition()
+		ctx.nilCompletionStreamType = sd.Define(ctx.CompilerContext.TypeEnv(), &semtypes.VAL, &semtypes.NIL)
+	}
+	return ctx.nilCompletionStreamType
+}
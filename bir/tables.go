@@ -0,0 +1,215 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bir
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// tableConstructorExpression lowers a table constructor to a new table holding its rows in order. expectedKey is the
+// key of the table type expected by the context, which is used if the constructor has no key specifier.
+func tableConstructorExpression(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangTableConstructorExpr, expectedKey []string) expressionEffect {
+	keyFieldNames := expectedKey
+	if expr.TableKeySpecifier != nil {
+		keyFieldNames = expr.TableKeySpecifier.FieldNames()
+	}
+	validateTableConstructorKeys(ctx, expr, keyFieldNames)
+	curBB := bb
+	rows := make([]*BIROperand, len(expr.RecordLiteralList))
+	for i, row := range expr.RecordLiteralList {
+		rowEffect := mappingConstructorExpression(ctx, curBB, row)
+		curBB = rowEffect.block
+		rows[i] = rowEffect.result
+	}
+	return newTable(ctx, curBB, keyFieldNames, rows)
+}
+
+// newTable creates a table with the given key fields, holding the given rows.
+func newTable(ctx *stmtContext, bb *BIRBasicBlock, keyFieldNames []string, rows []*BIROperand) expressionEffect {
+	keyCols := make([]*BIROperand, len(keyFieldNames))
+	for i, name := range keyFieldNames {
+		keyCols[i] = stringConstant(ctx, bb, name)
+	}
+	table := &NewTable{}
+	table.KeyColOp = newArrayWithValues(ctx, bb, keyCols)
+	table.DataOp = newArrayWithValues(ctx, bb, rows)
+	table.LhsOp = ctx.addTempVar(nil)
+	bb.Instructions = append(bb.Instructions, table)
+	return expressionEffect{
+		result: table.LhsOp,
+		block:  bb,
+	}
+}
+
+// tableMemberAccess lowers `t[k]` to a load of the row with key k. The keys of a multi-key member access `t[k1, k2]`
+// are passed as a list.
+func tableMemberAccess(ctx *stmtContext, bb *BIRBasicBlock, expr *ast.BLangIndexBasedAccess) expressionEffect {
+	containerEffect := handleExpression(ctx, bb, expr.Expr)
+	keyEffect := tableKey(ctx, containerEffect.block, expr.IndexExpr)
+	curBB := keyEffect.block
+	access := &FieldAccess{}
+	access.Kind = INSTRUCTION_KIND_TABLE_LOAD
+	access.LhsOp = ctx.addTempVar(nil)
+	access.KeyOp = keyEffect.result
+	access.RhsOp = containerEffect.result
+	curBB.Instructions = append(curBB.Instructions, access)
+	return expressionEffect{
+		result: access.LhsOp,
+		block:  curBB,
+	}
+}
+
+// assignToTableMember lowers `t[k] = v` to a store of the row v with key k.
+func assignToTableMember(ctx *stmtContext, bb *BIRBasicBlock, varRef *ast.BLangIndexBasedAccess, value ast.BLangExpression) statementEffect {
+	valueEffect := handleExpression(ctx, bb, value)
+	containerEffect := handleExpression(ctx, valueEffect.block, varRef.Expr)
+	keyEffect := tableKey(ctx, containerEffect.block, varRef.IndexExpr)
+	curBB := keyEffect.block
+	store := &FieldAccess{}
+	store.Kind = INSTRUCTION_KIND_TABLE_STORE
+	store.LhsOp = containerEffect.result
+	store.KeyOp = keyEffect.result
+	store.RhsOp = valueEffect.result
+	curBB.Instructions = append(curBB.Instructions, store)
+	return statementEffect{
+		block: curBB,
+	}
+}
+
+// tableKey lowers the key of a table member access. The node builder turns the keys of a multi-key member access into
+// a list constructor, whose members are stored in a new list.
+func tableKey(ctx *stmtContext, bb *BIRBasicBlock, keyExpr ast.BLangExpression) expressionEffect {
+	keys, ok := keyExpr.(*ast.BLangListConstructorExpr)
+	if !ok {
+		return handleExpression(ctx, bb, keyExpr)
+	}
+	curBB := bb
+	members := make([]*BIROperand, len(keys.Exprs))
+	for i, member := range keys.Exprs {
+		memberEffect := handleExpression(ctx, curBB, member)
+		curBB = memberEffect.block
+		members[i] = memberEffect.result
+	}
+	return expressionEffect{
+		result: newArrayWithValues(ctx, curBB, members),
+		block:  curBB,
+	}
+}
+
+// isTableValued reports whether the static type of the expression is a table type
+func isTableValued(ctx *stmtContext, expr ast.BLangExpression) bool {
+	ty := expressionType(ctx, expr)
+	return ty != nil && semtypes.IsSubtypeSimple(ty, semtypes.TABLE)
+}
+
+// tableTypeNode returns the table type descriptor typeNode refers to, following references to the type definitions
+// in scope, or nil if typeNode is not a table type.
+func tableTypeNode(typeResolver *ast.SemTypeResolver, typeNode model.TypeNode) *ast.BLangTableTypeNode {
+	switch typeNode := typeNode.(type) {
+	case *ast.BLangTableTypeNode:
+		return typeNode
+	case *ast.BLangUserDefinedType:
+		if typeNode.PkgAlias.Value != "" {
+			return nil
+		}
+		if typeDefinition := typeResolver.LookupTypeDefinition(typeNode.TypeName.Value); typeDefinition != nil {
+			return tableTypeNode(typeResolver, typeDefinition.GetTypeNode())
+		}
+	case *ast.BLangIntersectionTypeNode:
+		for _, constituent := range typeNode.ConstituentTypeNodes {
+			if tableType := tableTypeNode(typeResolver, constituent); tableType != nil {
+				return tableType
+			}
+		}
+	}
+	return nil
+}
+
+// validateTableVariableType checks that the key specifier of a table constructor or a `table` query assigned to a
+// variable is the key specifier of the declared table type of the variable.
+// FIXME: this should be part of the type checker
+func validateTableVariableType(ctx *stmtContext, typeNode model.TypeNode, expr ast.BLangExpression) {
+	if typeNode == nil {
+		return
+	}
+	tableType := tableTypeNode(ctx.typeResolver, typeNode)
+	if tableType == nil || tableType.TableKeySpecifier == nil {
+		return
+	}
+	var keySpecifier *ast.BLangTableKeySpecifier
+	switch expr := expr.(type) {
+	case *ast.BLangTableConstructorExpr:
+		keySpecifier = expr.TableKeySpecifier
+	case *ast.BLangQueryExpr:
+		keySpecifier = expr.TableKeySpecifier
+	}
+	if keySpecifier == nil {
+		return
+	}
+	expected := tableType.KeyFieldNames()
+	found := keySpecifier.FieldNames()
+	if !slices.Equal(expected, found) {
+		panic(fmt.Sprintf("table key specifier mismatch. expected: '[%s]' but found '[%s]'",
+			strings.Join(expected, ", "), strings.Join(found, ", ")))
+	}
+}
+
+// validateTableConstructorKeys checks that no two rows of a table constructor have the same key, when the values of
+// the key fields are literals.
+// FIXME: this should be part of the type checker
+func validateTableConstructorKeys(ctx *stmtContext, expr *ast.BLangTableConstructorExpr, keyFieldNames []string) {
+	if len(keyFieldNames) == 0 {
+		return
+	}
+	cx := ctx.typeResolver.Context()
+	keys := make(map[string]bool)
+	for _, row := range expr.RecordLiteralList {
+		values := make([]string, 0, len(keyFieldNames))
+		for _, name := range keyFieldNames {
+			shapes := ast.LiteralShapes(rowFieldValue(row, name))
+			if shapes == nil {
+				break
+			}
+			values = append(values, semtypes.ToTypeString(cx, shapes[0]))
+		}
+		if len(values) < len(keyFieldNames) {
+			// the key is only known at runtime
+			continue
+		}
+		key := strings.Join(values, ", ")
+		if keys[key] {
+			panic(fmt.Sprintf("duplicate key found in table row key('%s') : '%s'", strings.Join(keyFieldNames, ", "),
+				key))
+		}
+		keys[key] = true
+	}
+}
+
+// rowFieldValue returns the value of the field named name of a table row, or nil if the row has no such field.
+func rowFieldValue(row *ast.BLangRecordLiteral, name string) ast.BLangExpression {
+	for _, field := range row.Fields {
+		if field.Key.Name() == name {
+			return field.ValueExpr
+		}
+	}
+	return nil
+}
@@ -53,6 +53,62 @@ type (
 		TrueBB  *BIRBasicBlock
 		FalseBB *BIRBasicBlock
 	}
+
+	// WorkerSend sends Data over Channel. Only a sync send has a result.
+	WorkerSend struct {
+		BIRTerminatorBase
+		Channel model.Name
+		Data    *BIROperand
+		IsSync  bool
+	}
+
+	WorkerReceive struct {
+		BIRTerminatorBase
+		Channel model.Name
+	}
+
+	WorkerAlternateReceive struct {
+		BIRTerminatorBase
+		Channels []model.Name
+	}
+
+	WorkerMultipleReceive struct {
+		BIRTerminatorBase
+		ReceiveFields []ReceiveField
+	}
+
+	ReceiveField struct {
+		Key     string
+		Channel model.Name
+	}
+
+	// Flush waits until the messages sent over Channels are received.
+	Flush struct {
+		BIRTerminatorBase
+		Channels []model.Name
+	}
+
+	// Wait waits for the first of Exprs to complete. There is more than one expression for an alternate wait.
+	Wait struct {
+		BIRTerminatorBase
+		Exprs []*BIROperand
+	}
+
+	WaitAll struct {
+		BIRTerminatorBase
+		Keys   []string
+		Values []*BIROperand
+	}
+
+	// Lock acquires the lock of a lock statement before continuing to ThenBB, which starts the body of the statement.
+	Lock struct {
+		BIRTerminatorBase
+	}
+
+	// Unlock releases the lock acquired by the innermost Lock that is still held.
+	Unlock struct {
+		BIRTerminatorBase
+	}
 )
 
 var (
@@ -60,6 +116,15 @@ var (
 	_ BIRAssignInstruction = &Call{}
 	_ BIRTerminator        = &Return{}
 	_ BIRTerminator        = &Branch{}
+	_ BIRTerminator        = &WorkerSend{}
+	_ BIRAssignInstruction = &WorkerReceive{}
+	_ BIRAssignInstruction = &WorkerAlternateReceive{}
+	_ BIRAssignInstruction = &WorkerMultipleReceive{}
+	_ BIRAssignInstruction = &Flush{}
+	_ BIRAssignInstruction = &Wait{}
+	_ BIRAssignInstruction = &WaitAll{}
+	_ BIRTerminator        = &Lock{}
+	_ BIRTerminator        = &Unlock{}
 )
 
 func (g *Goto) GetKind() InstructionKind {
@@ -81,3 +146,63 @@ func (r *Return) GetKind() InstructionKind {
 func (b *Branch) GetKind() InstructionKind {
 	return INSTRUCTION_KIND_BRANCH
 }
+
+func (w *WorkerSend) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_WK_SEND
+}
+
+func (w *WorkerReceive) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_WK_RECEIVE
+}
+
+func (w *WorkerReceive) GetLhsOperand() *BIROperand {
+	return w.LhsOp
+}
+
+func (w *WorkerAlternateReceive) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_WK_ALT_RECEIVE
+}
+
+func (w *WorkerAlternateReceive) GetLhsOperand() *BIROperand {
+	return w.LhsOp
+}
+
+func (w *WorkerMultipleReceive) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_WK_MULTIPLE_RECEIVE
+}
+
+func (w *WorkerMultipleReceive) GetLhsOperand() *BIROperand {
+	return w.LhsOp
+}
+
+func (f *Flush) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_FLUSH
+}
+
+func (f *Flush) GetLhsOperand() *BIROperand {
+	return f.LhsOp
+}
+
+func (w *Wait) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_WAIT
+}
+
+func (w *Wait) GetLhsOperand() *BIROperand {
+	return w.LhsOp
+}
+
+func (w *WaitAll) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_WAIT_ALL
+}
+
+func (w *WaitAll) GetLhsOperand() *BIROperand {
+	return w.LhsOp
+}
+
+func (l *Lock) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_LOCK
+}
+
+func (u *Unlock) GetKind() InstructionKind {
+	return INSTRUCTION_KIND_UNLOCK
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bir
+
+import (
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
+)
+
+// FIXME: the types of expressions should come from the type checker. Until then, expressionType works out the types
+// that are known from the declared types of variables and functions, and from the shapes of literals.
+
+// expressionType returns the static type of an expression, or nil if the type is not known without a type checker.
+func expressionType(ctx *stmtContext, expr ast.BLangExpression) semtypes.SemType {
+	if shapes := ast.LiteralShapes(expr); shapes != nil {
+		// the type of a literal without a contextually expected type is given by its type tag
+		return shapes[0]
+	}
+	cx := ctx.typeResolver.Context()
+	switch expr := expr.(type) {
+	case *ast.BLangSimpleVarRef:
+		return variableReferenceType(ctx, expr)
+	case *ast.BLangGroupExpr:
+		return expressionType(ctx, expr.Expression)
+	case *ast.BLangInvocation:
+		return invocationType(ctx, expr)
+	case *ast.BLangFieldBaseAccess:
+		containerType := expressionType(ctx, expr.Expr)
+		if containerType == nil || !semtypes.IsSubtypeSimple(containerType, semtypes.MAPPING) {
+			return nil
+		}
+		return semtypes.MappingMemberTypeInnerVal(cx, containerType, semtypes.StringConst(expr.Field.Value))
+	case *ast.BLangStringTemplateLiteral:
+		return &semtypes.STRING
+	case *ast.BLangTableConstructorExpr:
+		return &semtypes.TABLE
+	case *ast.BLangQueryExpr:
+		// the member type of a `table` query doesn't matter to the lowering of its member accesses
+		if expr.IsTable {
+			return &semtypes.TABLE
+		}
+		return nil
+	case *ast.BLangTypeInit:
+		if expr.UserDefinedType == nil || streamTypeNode(ctx.typeResolver, expr.UserDefinedType) == nil {
+			return nil
+		}
+		return typeNodeType(ctx.typeResolver, expr.UserDefinedType)
+	case *ast.BLangTernaryExpr:
+		thenType := expressionType(ctx, expr.ThenExpr)
+		elseType := expressionType(ctx, expr.ElseExpr)
+		if thenType == nil || elseType == nil {
+			return nil
+		}
+		return semtypes.Union(thenType, elseType)
+	default:
+		return nil
+	}
+}
+
+// variableReferenceType returns the declared type of the variable or constant a reference refers to.
+func variableReferenceType(ctx *stmtContext, expr *ast.BLangSimpleVarRef) semtypes.SemType {
+	if expr.PkgAlias != nil && expr.PkgAlias.Value != "" {
+		return nil
+	}
+	name := expr.VariableName.Value
+	if _, ok := ctx.varMap[name]; ok {
+		return ctx.varTypes[name]
+	}
+	if _, ok := ctx.birCx.globalVarMap[name]; ok {
+		return ctx.birCx.globalVarTypes[name]
+	}
+	if constant := ctx.typeResolver.LookupConstant(name); constant != nil {
+		if value, ok := constant.Expr.(ast.BLangExpression); ok {
+			if shapes := ast.LiteralShapes(value); shapes != nil {
+				return shapes[0]
+			}
+		}
+	}
+	return nil
+}
+
+// invocationType returns the declared return type of a call to a function of the module.
+func invocationType(ctx *stmtContext, expr *ast.BLangInvocation) semtypes.SemType {
+	if expr.Expr != nil || (expr.PkgAlias != nil && expr.PkgAlias.Value != "") {
+		return nil
+	}
+	if _, ok := ctx.varMap[expr.Name.Value]; ok {
+		return nil
+	}
+	function, ok := ctx.birCx.functions[expr.Name.Value]
+	if !ok {
+		return nil
+	}
+	if function.ReturnTypeNode == nil {
+		return &semtypes.NIL
+	}
+	// the return type is resolved in the module scope, which local type definitions don't shadow
+	return typeNodeType(ctx.birCx.typeResolver, function.ReturnTypeNode)
+}
+
+// variableType returns the type of a variable declared with typeNode, or with var if typeNode is nil and initialized
+// with expr, or nil if the type is not known. expr is nil for a variable whose value doesn't come from an expression.
+func variableType(ctx *stmtContext, typeNode model.TypeNode, expr ast.BLangExpression) semtypes.SemType {
+	if typeNode != nil {
+		return typeNodeType(ctx.typeResolver, typeNode)
+	}
+	if expr == nil {
+		return nil
+	}
+	return expressionType(ctx, expr)
+}
+
+// typeNodeType resolves a type descriptor, or returns nil if the type resolver doesn't support it yet.
+func typeNodeType(typeResolver *ast.SemTypeResolver, typeNode model.TypeNode) semtypes.SemType {
+	if !typeResolver.SupportsTypeNode(typeNode) {
+		return nil
+	}
+	return typeResolver.ResolveTypeNode(typeNode)
+}
@@ -38,6 +38,8 @@ type CentralAPIClient interface {
 	GetPackage(orgNamePath, packageNamePath, version, supportedPlatform, ballerinaVersion string) (*models.Package, error)
 	GetPackageVersions(orgNamePath, packageNamePath, supportedPlatform, ballerinaVersion string) ([]string, error)
 	PullPackage(org, name, version string, fsys fs.FS, packagePathInBalaCache, supportedPlatform, ballerinaVersion string, clientContext ClientContext) error
+	PushPackage(fsys fs.FS, balaPath, org, name, version, supportedPlatform, ballerinaVersion string, clientContext ClientContext) error
+	SearchPackage(query, supportedPlatform, ballerinaVersion string) (*models.PackageSearchResult, error)
 	ResolvePackageNames(request models.PackageNameResolutionRequest, supportedPlatform, ballerinaVersion string) (*models.PackageNameResolutionResponse, error)
 	ResolveDependencies(request models.PackageResolutionRequest, supportedPlatform, ballerinaVersion string) (*models.PackageResolutionResponse, error)
 	GetConnectors(params map[string]string, supportedPlatform, ballerinaVersion string) (any, error)
@@ -295,6 +297,149 @@ func (c *centralAPIClientImpl) PullPackage(org, name, version string, fsys fs.FS
 	return nil
 }
 
+// PushPackage uploads the bala at balaPath in fsys, the package org/name:version, to Central. Upload progress is
+// reported through clientContext.
+func (c *centralAPIClientImpl) PushPackage(fsys fs.FS, balaPath, org, name, version, supportedPlatform, ballerinaVersion string, clientContext ClientContext) error {
+	err := c.pushPackageInternal(fsys, balaPath, org, name, version, supportedPlatform, ballerinaVersion, clientContext)
+	if _, ok := err.(*PackageAlreadyExistsError); ok {
+		return err
+	}
+	if err != nil {
+		return wrapCentralOrConnectionError(err, clientContext.formatLog(fmt.Sprintf("%s'%s'", ErrCannotPush, getPackageSignature(org, name, version))))
+	}
+
+	return nil
+}
+
+func (c *centralAPIClientImpl) pushPackageInternal(fsys fs.FS, balaPath, org, name, version, supportedPlatform, ballerinaVersion string, clientContext ClientContext) error {
+	packageSignature := getPackageSignature(org, name, version)
+	balaContent, err := fs.ReadFile(fsys, balaPath)
+	if err != nil {
+		return NewCentralClientError(clientContext.formatLog(fmt.Sprintf("%s'%s'. reason: %s", ErrCannotPush, packageSignature, err.Error())))
+	}
+	hash, err := checkHashInternal(fsys, balaPath)
+	if err != nil {
+		return err
+	}
+
+	urlStr := fmt.Sprintf("%s%s", c.baseURL, PackagePathPrefix)
+	newBody := func() *progressReader {
+		return &progressReader{reader: bytes.NewReader(balaContent), total: int64(len(balaContent)), onProgress: clientContext.OnProgress}
+	}
+	req, err := c.newRequest(http.MethodPost, urlStr, supportedPlatform, ballerinaVersion, newBody())
+	if err != nil {
+		return err
+	}
+	// Retries upload a new body, so that progress is reported as the body is written rather than when it's buffered
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(newBody()), nil
+	}
+
+	req.ContentLength = int64(len(balaContent))
+	req.Header.Set(ContentType, ApplicationOctetStream)
+	req.Header.Set(Digest, fmt.Sprintf("%s%s", SHA256, hash))
+
+	c.logRequestInitVerbose(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return NewConnectionError(clientContext.formatLog(fmt.Sprintf("%s'%s'. reason: %s", ErrCannotPush, packageSignature, err.Error())))
+	}
+	defer resp.Body.Close()
+
+	c.logRequestConnectVerbose(req, PackagePathPrefix)
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	c.logResponseVerbose(resp, string(bodyBytes))
+
+	switch resp.StatusCode {
+	case http.StatusNoContent, http.StatusOK, http.StatusCreated:
+		return nil
+
+	case http.StatusUnauthorized:
+		return c.handleUnauthorizedResponseWithOrg(org, bodyBytes)
+
+	case http.StatusBadRequest, http.StatusConflict:
+		var errResp models.Error
+		if err := json.Unmarshal(bodyBytes, &errResp); err == nil && errResp.Message != "" {
+			if strings.Contains(errResp.Message, "package already exists") {
+				return NewPackageAlreadyExistsError(clientContext.formatLog(fmt.Sprintf("error: %s", errResp.Message)), version)
+			}
+			return NewCentralClientError(clientContext.formatLog(fmt.Sprintf("error: %s", errResp.Message)))
+		}
+	}
+
+	return c.handleResponseErrors(resp, clientContext.formatLog(fmt.Sprintf("%s'%s'", ErrCannotPush, packageSignature)), bodyBytes)
+}
+
+// progressReader reports the percentage of its total size read from reader.
+type progressReader struct {
+	reader     io.Reader
+	total      int64
+	read       int64
+	onProgress func(percentComplete int)
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 && r.total > 0 && r.onProgress != nil {
+		r.read += int64(n)
+		r.onProgress(int((r.read * 100) / r.total))
+	}
+	return n, err
+}
+
+// SearchPackage searches Central for the packages matching query.
+func (c *centralAPIClientImpl) SearchPackage(query, supportedPlatform, ballerinaVersion string) (*models.PackageSearchResult, error) {
+	result, err := c.searchPackageInternal(query, supportedPlatform, ballerinaVersion)
+	if err != nil {
+		return nil, wrapCentralOrConnectionError(err, fmt.Sprintf("%s'%s'", ErrCannotSearch, query))
+	}
+
+	return result, nil
+}
+
+func (c *centralAPIClientImpl) searchPackageInternal(query, supportedPlatform, ballerinaVersion string) (*models.PackageSearchResult, error) {
+	urlStr := fmt.Sprintf("%s%s?q=%s", c.baseURL, PackagePathPrefix, url.QueryEscape(query))
+
+	req, err := c.newRequest(http.MethodGet, urlStr, supportedPlatform, ballerinaVersion, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logRequestInitVerbose(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, NewConnectionError(fmt.Sprintf("%s'%s'. reason: %s", ErrCannotSearch, query, err.Error()))
+	}
+	defer resp.Body.Close()
+
+	c.logRequestConnectVerbose(req, PackagePathPrefix)
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponseVerbose(resp, string(bodyBytes))
+
+	contentType := resp.Header.Get(ContentType)
+	if isApplicationJSONContentType(contentType) && resp.StatusCode == http.StatusOK {
+		var result models.PackageSearchResult
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, NewCentralClientError(fmt.Sprintf("%s'%s'. reason: unexpected error", ErrCannotSearch, query))
+		}
+		return &result, nil
+	}
+
+	return nil, c.handleResponseErrors(resp, fmt.Sprintf("%s'%s'", ErrCannotSearch, query), bodyBytes)
+}
+
 func (c *centralAPIClientImpl) ResolvePackageNames(request models.PackageNameResolutionRequest, supportedPlatform, ballerinaVersion string) (*models.PackageNameResolutionResponse, error) {
 	response, err := c.resolvePackageNamesInternal(request, supportedPlatform, ballerinaVersion)
 	if err != nil {
@@ -898,16 +1043,24 @@ func (r *customRetryTransport) RoundTrip(req *http.Request) (*http.Response, err
 	var err error
 	retryCount := 0
 
+	// A request that can't recreate its body is buffered up front so that it can be retried
+	if req.Body != nil && req.GetBody == nil {
+		bodyBytes, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(bodyBytes)), nil
+		}
+	}
+
 	for retryCount <= r.maxRetries {
-		// Clone request body for potential retries
-		var bodyBytes []byte
-		if req.Body != nil {
-			bodyBytes, err = io.ReadAll(req.Body)
-			if err != nil {
+		if retryCount > 0 && req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
 				return nil, err
 			}
-			req.Body.Close()
-			req.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
 		}
 
 		resp, err = r.transport.RoundTrip(req)
@@ -931,10 +1084,6 @@ func (r *customRetryTransport) RoundTrip(req *http.Request) (*http.Response, err
 		r.logRetryVerbose(resp, bodyContent, req, retryCount+1)
 
 		retryCount = retryCount + 1
-
-		if bodyBytes != nil {
-			req.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
-		}
 	}
 
 	return resp, err
@@ -17,6 +17,10 @@
 package centralclient
 
 import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/fs"
@@ -27,6 +31,7 @@ import (
 	"strings"
 	"testing"
 
+	"ballerina-lang-go/centralclient/models"
 	"ballerina-lang-go/common/bfs"
 
 	"golang.org/x/tools/txtar"
@@ -157,6 +162,47 @@ func TestPullPackageConnectionResetRetry(t *testing.T) {
 	}
 }
 
+func TestPushPackageProgressDuringUpload(t *testing.T) {
+	var progress []int
+	var progressBeforeUpload []int
+	attempts := 0
+	transport := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		progressBeforeUpload = append(progressBeforeUpload, len(progress))
+		if _, err := io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		status := http.StatusNoContent
+		if attempts == 1 {
+			status = http.StatusServiceUnavailable
+		}
+		return &http.Response{StatusCode: status, Status: http.StatusText(status), Body: io.NopCloser(strings.NewReader("")),
+			Header: make(http.Header), Request: req}, nil
+	})
+	client := newTestCentralAPIClient(http.Client{Transport: &customRetryTransport{transport: transport, maxRetries: 2}})
+
+	memFS := bfs.NewMemFS()
+	balaPath := "target/bala/foo-bar-any-1.0.0.bala"
+	if err := bfs.WriteFile(memFS, balaPath, newTestBala("foo", "bar", "1.0.0"), 0o644); err != nil {
+		t.Fatalf("failed to write bala: %v", err)
+	}
+	clientContext := ClientContext{OnProgress: func(percentComplete int) {
+		progress = append(progress, percentComplete)
+	}}
+	if err := client.PushPackage(memFS, balaPath, "foo", "bar", "1.0.0", "any", testBalVersion, clientContext); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %d", attempts)
+	}
+	// Progress is only reported as the transport reads the body, starting over for the retry
+	if progressBeforeUpload[0] != 0 || progress[progressBeforeUpload[1]-1] != 100 ||
+		progress[len(progress)-1] != 100 {
+		t.Errorf("unexpected upload progress %v, with %v reported before each attempt", progress, progressBeforeUpload)
+	}
+}
+
 func parseTestCases(dir string) ([]TestCase, error) {
 	files, err := os.ReadDir(dir)
 	if err != nil {
@@ -228,6 +274,10 @@ func parseInput(data txtar.File) TestRunner {
 		return createGetTriggerRunner(args)
 	case "PullPackage":
 		return createPullPackageRunner(args)
+	case "PushPackage":
+		return createPushPackageRunner(args)
+	case "SearchPackage":
+		return createSearchPackageRunner(args)
 	default:
 		panic(fmt.Sprintf("unsupported test case type: %s (file: %s)", command, data.Name))
 	}
@@ -307,6 +357,57 @@ func createPullPackageRunner(args []string) TestRunner {
 	}
 }
 
+func createPushPackageRunner(args []string) TestRunner {
+	return func(client CentralAPIClient) (string, string) {
+		memFS := bfs.NewMemFS()
+		balaPath := fmt.Sprintf("target/bala/%s-%s-any-%s.bala", args[0], args[1], args[2])
+		if err := bfs.WriteFile(memFS, balaPath, newTestBala(args[0], args[1], args[2]), 0o644); err != nil {
+			return "", err.Error()
+		}
+		var progress []int
+		clientContext := ClientContext{OnProgress: func(percentComplete int) {
+			progress = append(progress, percentComplete)
+		}}
+		err := client.PushPackage(memFS, balaPath, args[0], args[1], args[2], args[3], args[4], clientContext)
+		if err != nil {
+			return "", err.Error()
+		}
+		if len(progress) == 0 || progress[len(progress)-1] != 100 {
+			return "", fmt.Sprintf("incomplete upload progress %v", progress)
+		}
+		return "pushed", ""
+	}
+}
+
+func createSearchPackageRunner(args []string) TestRunner {
+	return func(client CentralAPIClient) (string, string) {
+		result, err := client.SearchPackage(args[0], args[1], args[2])
+		if err != nil {
+			return "", err.Error()
+		}
+		var packages []string
+		for _, pkg := range result.Packages {
+			packages = append(packages, fmt.Sprintf("%s/%s:%s", pkg.Organization, pkg.Name, pkg.Version))
+		}
+		return fmt.Sprintf("count=%d packages=%v", result.Count, packages), ""
+	}
+}
+
+// newTestBala returns a bala holding only the package.json of org/name:version.
+func newTestBala(org, name, version string) []byte {
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	writer, err := zipWriter.Create("package.json")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(writer, `{"organization": %q, "name": %q, "version": %q, "platform": "any"}`, org, name, version)
+	if err := zipWriter.Close(); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
 func updateTestCase(tc TestCase, actualOutput, actualError string) error {
 	archive, err := txtar.ParseFile(tc.filepath)
 	if err != nil {
@@ -515,6 +616,13 @@ func newMockTransport(packageJSON, packageSearchJSON, balaContent []byte) http.R
 		case "/registry/packages/testorg/servererror/1.0.0":
 			return newJSONResponse(http.StatusInternalServerError, `{"message":"database connection failed"}`, req), nil
 
+		// SearchPackage and PushPackage endpoints
+		case "/registry/packages/":
+			if req.Method == http.MethodPost {
+				return handlePushPackageRequest(req)
+			}
+			return handleSearchPackageRequest(query, packageSearchJSON, req)
+
 		// GetConnectors endpoints
 		case "/registry/connectors":
 			return handleConnectorsRequest(query, packageSearchJSON, req)
@@ -574,6 +682,73 @@ func handleConnectorsRequest(query map[string][]string, packageSearchJSON []byte
 	}
 }
 
+func handleSearchPackageRequest(query map[string][]string, packageSearchJSON []byte, req *http.Request) (*http.Response, error) {
+	var q string
+	if values, ok := query["q"]; ok && len(values) > 0 {
+		q = values[0]
+	}
+
+	switch q {
+	case "badrequest":
+		return newJSONResponse(http.StatusBadRequest, `{"message":"invalid search query"}`, req), nil
+	case "servererror":
+		return newJSONResponse(http.StatusInternalServerError, `{"message":"internal server error"}`, req), nil
+	case "nomatch":
+		return newJSONResponse(http.StatusOK, `{"packages": [], "count": 0}`, req), nil
+	default:
+		return newJSONResponse(http.StatusOK, string(packageSearchJSON), req), nil
+	}
+}
+
+// handlePushPackageRequest checks the digest of the uploaded bala and responds based on the organization in its
+// package.json.
+func handlePushPackageRequest(req *http.Request) (*http.Response, error) {
+	content, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if req.Header.Get(Digest) != fmt.Sprintf("%s%x", SHA256, sha256.Sum256(content)) {
+		return newJSONResponse(http.StatusBadRequest, `{"message":"bala digest mismatch"}`, req), nil
+	}
+	if req.Header.Get("Authorization") != "Bearer "+accessToken {
+		return newJSONResponse(http.StatusUnauthorized, `{"message":"missing access token"}`, req), nil
+	}
+	zipReader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return newJSONResponse(http.StatusBadRequest, `{"message":"invalid bala file"}`, req), nil
+	}
+	packageJSON, err := fs.ReadFile(zipReader, "package.json")
+	if err != nil {
+		return newJSONResponse(http.StatusBadRequest, `{"message":"bala does not contain a package.json"}`, req), nil
+	}
+	var pkg models.Package
+	if err := json.Unmarshal(packageJSON, &pkg); err != nil {
+		return newJSONResponse(http.StatusBadRequest, `{"message":"invalid package.json"}`, req), nil
+	}
+
+	switch pkg.Organization {
+	case "unauthorized":
+		return newJSONResponse(http.StatusUnauthorized, `{"message":"unauthorized access token for organization: 'unauthorized'"}`, req), nil
+	case "existing":
+		return newJSONResponse(http.StatusBadRequest,
+			fmt.Sprintf(`{"message":"package already exists: %s/%s:%s"}`, pkg.Organization, pkg.Name, pkg.Version), req), nil
+	case "servererror":
+		return newJSONResponse(http.StatusInternalServerError, `{"message":"internal server error occurred"}`, req), nil
+	default:
+		return &http.Response{
+			StatusCode: http.StatusNoContent,
+			Status:     http.StatusText(http.StatusNoContent),
+			Body:       io.NopCloser(strings.NewReader("")),
+			Header:     http.Header{},
+			Request:    req,
+			Proto:      "HTTP/1.1",
+			ProtoMinor: 1,
+			ProtoMajor: 1,
+			Close:      true,
+		}, nil
+	}
+}
+
 func handleTriggersRequest(query map[string][]string, req *http.Request) (*http.Response, error) {
 	var q string
 	if values, ok := query["q"]; ok && len(values) > 0 {
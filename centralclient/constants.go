@@ -57,6 +57,7 @@ const (
 	ErrCannotFindPackage  = "error: could not connect to remote repository to find package: "
 	ErrCannotFindVersions = "error: could not connect to remote repository to find versions for: "
 	ErrCannotPush         = "error: failed to push the package: "
+	ErrCannotSearch       = "error: failed to search packages: "
 	ErrCannotPullPackage  = "error: failed to pull the package: "
 	ErrCannotGetConnector = "error: failed to find connector: "
 	ErrCannotGetTriggers  = "error: failed to find triggers: "
@@ -32,10 +32,17 @@ var rootCmd = &cobra.Command{
 	SilenceErrors: true,
 }
 
-func main() {
+func init() {
 	rootCmd.AddCommand(runCmd)
+	rootCmd.AddCommand(docCmd)
+	rootCmd.AddCommand(jsonSchemaCmd)
+	rootCmd.AddCommand(pullCmd)
+	rootCmd.AddCommand(pushCmd)
+	rootCmd.AddCommand(searchCmd)
 	rootCmd.AddCommand(versionCmd)
+}
 
+func main() {
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
 	}
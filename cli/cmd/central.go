@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"ballerina-lang-go/centralclient"
+	"ballerina-lang-go/projects"
+)
+
+const (
+	stageCentralURL = "https://api.staging-central.ballerina.io/2.0/registry"
+	devCentralURL   = "https://api.dev-central.ballerina.io/2.0/registry"
+	// balaPlatform is the platform of the packages that are resolved, as there is no JVM to run native code on
+	balaPlatform = "any"
+	// progressBarWidth is the number of characters of the progress bar of uploads and downloads
+	progressBarWidth = 30
+)
+
+// centralURL is the URL of the Central API. It is a variable so that tests can point it to a recorded server.
+var centralURL = "https://api.central.ballerina.io/2.0/registry"
+
+// centralBaseURL returns the URL of the Central API, which is that of the staging or development Central if selected by
+// the environment.
+func centralBaseURL() string {
+	switch {
+	case centralclient.SetBallerinaStageCentral:
+		return stageCentralURL
+	case centralclient.SetBallerinaDevCentral:
+		return devCentralURL
+	default:
+		return centralURL
+	}
+}
+
+// loadSettings reads the Settings.toml of the Ballerina home directory.
+func loadSettings(home string) (*projects.Settings, error) {
+	return projects.LoadSettings(os.DirFS(home), projects.SettingsTomlFile)
+}
+
+// newCentralClient returns a client of the Central API configured by the Settings.toml of the Ballerina home
+// directory, which gives the access token, the proxy and the timeouts.
+func newCentralClient(home string) (centralclient.CentralAPIClient, error) {
+	settings, err := loadSettings(home)
+	if err != nil {
+		return nil, err
+	}
+	central := settings.Central
+	connectTimeout := orDefault(central.ConnectTimeout, centralclient.DefaultConnectTimeout)
+	readTimeout := orDefault(central.ReadTimeout, centralclient.DefaultReadTimeout)
+	writeTimeout := orDefault(central.WriteTimeout, centralclient.DefaultWriteTimeout)
+	callTimeout := orDefault(central.CallTimeout, centralclient.DefaultCallTimeout)
+	maxRetries := orDefault(central.MaxRetries, centralclient.MaxRetry)
+	return centralclient.NewCentralAPIClientFull(centralBaseURL(), settings.Proxy.ProxyURL(), settings.Proxy.Username,
+		settings.Proxy.Password, central.AccessToken, connectTimeout, readTimeout, writeTimeout, callTimeout,
+		maxRetries), nil
+}
+
+func orDefault(value, defaultValue int) int {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// progressPrinter returns an OnProgress callback drawing a progress bar labelled with label on w, or nil if w is not a
+// terminal, so that redirected output isn't cluttered with carriage returns.
+func progressPrinter(w io.Writer, label string) func(percentComplete int) {
+	file, ok := w.(*os.File)
+	if !ok {
+		return nil
+	}
+	if info, err := file.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return nil
+	}
+	last := -1
+	return func(percentComplete int) {
+		if percentComplete == last {
+			return
+		}
+		last = percentComplete
+		done := percentComplete * progressBarWidth / 100
+		fmt.Fprintf(w, "\r%s [%s%s] %3d%%", label, strings.Repeat("=", done), strings.Repeat(" ", progressBarWidth-done),
+			percentComplete)
+		if percentComplete == 100 {
+			fmt.Fprintln(w)
+		}
+	}
+}
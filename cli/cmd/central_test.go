@@ -0,0 +1,267 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"golang.org/x/tools/txtar"
+)
+
+// centralTestData holds the recorded Central sessions of the pull, search and push commands. Each archive has
+//   - an "args" section with the command line,
+//   - a section per recorded response, named by the method and request URI it answers, holding the status code, the
+//     headers and, after a blank line, the body,
+//   - optional "bala/..." sections, which are zipped to make the bala that "{{bala}}" stands for in the arguments and
+//     the response bodies, and an optional "Settings.toml" section for the Ballerina home directory,
+//   - "stdout" and "error" sections with the expected output and error, and optional "requests" and "home" sections
+//     with the expected requests and files of the Ballerina home directory.
+//
+// "{{central}}" stands for the URL of the recorded server. Set BLESS=1 to update the expected sections.
+var centralTestData = filepath.Join("testdata", "central")
+
+type recordedResponse struct {
+	status  int
+	headers http.Header
+	body    []byte
+}
+
+type centralSession struct {
+	archive   *txtar.Archive
+	responses map[string]recordedResponse
+	bala      []byte
+	mu        sync.Mutex
+	requests  []string
+}
+
+func TestCentralCommands(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join(centralTestData, "*.txtar"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bless := os.Getenv("BLESS") == "1" || os.Getenv("BLESS") == "true"
+	for _, path := range paths {
+		t.Run(strings.TrimSuffix(filepath.Base(path), ".txtar"), func(t *testing.T) {
+			archive, err := txtar.ParseFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			actual := runCentralSession(t, archive)
+			if bless {
+				for i, file := range archive.Files {
+					if content, ok := actual[file.Name]; ok {
+						archive.Files[i].Data = []byte(content)
+					}
+				}
+				if err := os.WriteFile(path, txtar.Format(archive), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			for _, file := range archive.Files {
+				if content, ok := actual[file.Name]; ok && content != string(file.Data) {
+					t.Errorf("unexpected %s\nexpected:\n%s\nactual:\n%s", file.Name, file.Data, content)
+				}
+			}
+		})
+	}
+}
+
+// runCentralSession runs the command of archive against a server replaying its responses, and returns the actual
+// content of its expected sections.
+func runCentralSession(t *testing.T, archive *txtar.Archive) map[string]string {
+	t.Helper()
+	session := &centralSession{archive: archive, responses: map[string]recordedResponse{}}
+	session.bala = session.zipBala(t)
+	server := httptest.NewServer(session)
+	defer server.Close()
+	for _, file := range archive.Files {
+		if method, _, ok := strings.Cut(file.Name, " "); ok && method == strings.ToUpper(method) {
+			session.responses[file.Name] = parseRecordedResponse(t, file, server.URL)
+		}
+	}
+
+	home := t.TempDir()
+	t.Setenv("BALLERINA_HOME_DIR", home)
+	if settings := session.section("Settings.toml"); settings != nil {
+		if err := os.WriteFile(filepath.Join(home, "Settings.toml"), settings, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	balaPath := filepath.Join(t.TempDir(), "test.bala")
+	if err := os.WriteFile(balaPath, session.bala, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	args := strings.Fields(strings.ReplaceAll(string(session.section("args")), "{{bala}}", balaPath))
+
+	defer func(url string) { centralURL = url }(centralURL)
+	centralURL = server.URL + "/registry"
+	var stdout, stderr bytes.Buffer
+	rootCmd.SetOut(&stdout)
+	rootCmd.SetErr(&stderr)
+	rootCmd.SetArgs(args)
+	defer func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+		rootCmd.SetArgs(nil)
+	}()
+	var errMessage string
+	if err := rootCmd.Execute(); err != nil {
+		errMessage = strings.NewReplacer(server.URL, "{{central}}", balaPath, "{{bala}}", home, "{{home}}").
+			Replace(err.Error()) + "\n"
+	}
+
+	return map[string]string{
+		"stdout":   stdout.String(),
+		"error":    errMessage,
+		"requests": strings.Join(session.requests, ""),
+		"home":     listHome(t, home),
+	}
+}
+
+func (s *centralSession) section(name string) []byte {
+	for _, file := range s.archive.Files {
+		if file.Name == name {
+			return file.Data
+		}
+	}
+	return nil
+}
+
+// zipBala zips the "bala/..." sections of the archive.
+func (s *centralSession) zipBala(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for _, file := range s.archive.Files {
+		name, ok := strings.CutPrefix(file.Name, "bala/")
+		if !ok {
+			continue
+		}
+		entry, err := writer.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := entry.Write(file.Data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func parseRecordedResponse(t *testing.T, file txtar.File, serverURL string) recordedResponse {
+	t.Helper()
+	content := strings.ReplaceAll(string(file.Data), "{{central}}", serverURL)
+	head, body, _ := strings.Cut(content, "\n\n")
+	lines := strings.Split(head, "\n")
+	status, err := strconv.Atoi(strings.TrimSpace(lines[0]))
+	if err != nil {
+		t.Fatalf("invalid status of recorded response %s: %v", file.Name, err)
+	}
+	response := recordedResponse{status: status, headers: http.Header{}, body: []byte(body)}
+	for _, line := range lines[1:] {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			t.Fatalf("invalid header %q of recorded response %s", line, file.Name)
+		}
+		response.headers.Add(strings.TrimSpace(key), strings.TrimSpace(value))
+	}
+	return response
+}
+
+func (s *centralSession) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	request := r.Method + " " + r.URL.RequestURI()
+	s.mu.Lock()
+	var log strings.Builder
+	fmt.Fprintln(&log, request)
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		fmt.Fprintf(&log, "  Authorization: %s\n", authorization)
+	}
+	if digest := r.Header.Get("Digest"); digest != "" {
+		fmt.Fprintf(&log, "  Digest: %s\n", digest)
+	}
+	if len(body) > 0 {
+		fmt.Fprintf(&log, "  body: %d bytes, bala: %v\n", len(body), bytes.Equal(body, s.bala))
+	}
+	s.requests = append(s.requests, log.String())
+	s.mu.Unlock()
+
+	response, ok := s.responses[request]
+	if !ok {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"message":"no recorded response for %s"}`, request)
+		return
+	}
+	for key, values := range response.headers {
+		w.Header()[key] = values
+	}
+	responseBody := response.body
+	if strings.TrimSpace(string(responseBody)) == "{{bala}}" {
+		responseBody = s.bala
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(responseBody)))
+	w.WriteHeader(response.status)
+	w.Write(responseBody)
+}
+
+// listHome lists the files of the Ballerina home directory other than Settings.toml.
+func listHome(t *testing.T, home string) string {
+	t.Helper()
+	var files []string
+	err := filepath.WalkDir(home, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		relPath, err := filepath.Rel(home, path)
+		if err != nil {
+			return err
+		}
+		if relPath != "Settings.toml" {
+			files = append(files, filepath.ToSlash(relPath))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(files)
+	var listing strings.Builder
+	for _, file := range files {
+		fmt.Fprintln(&listing, file)
+	}
+	return listing.String()
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"ballerina-lang-go/centralclient/models"
+	debugcommon "ballerina-lang-go/common"
+	"ballerina-lang-go/projects"
+)
+
+// resolveDependencies resolves the dependencies of a package against the repositories of the Ballerina home
+// directory, and Central unless offline, updates its Dependencies.toml and links the balas of the dependencies to the
+// compilation. The lock file of a package without dependencies is only updated if it exists.
+func resolveDependencies(debugCtx *debugcommon.DebugContext, compilation *projects.Compilation, offline bool,
+	lockingMode string) error {
+	mode := models.PackageResolutionModeMedium
+	if lockingMode != "" {
+		var err error
+		if mode, err = projects.ParseLockingMode(lockingMode); err != nil {
+			return err
+		}
+	}
+	root := compilation.Project.SourceRoot
+	lock, err := projects.LoadDependencyManifest(os.DirFS(root), projects.DependenciesTomlFile)
+	if err != nil {
+		return err
+	}
+
+	home, err := projects.BallerinaHome()
+	if err != nil {
+		return err
+	}
+	homeRepositories := projects.HomeRepositories(home)
+	var repositories []projects.PackageRepository
+	for _, repository := range homeRepositories {
+		repositories = append(repositories, repository)
+	}
+	if !offline {
+		client, err := newCentralClient(home)
+		if err != nil {
+			return err
+		}
+		repositories = append(repositories, projects.NewCentralRepository(client, balaPlatform, Version))
+	}
+	resolved, err := projects.NewResolver(mode, repositories...).Resolve(compilation, lock)
+	if err != nil {
+		return err
+	}
+	resolved.DistributionVersion = Version
+	if err := writeDependenciesToml(root, resolved); err != nil {
+		return err
+	}
+
+	balas, err := projects.LoadBalas(resolved, homeRepositories, compilation.Project.Manifest.Descriptor())
+	if err != nil {
+		return err
+	}
+	return compilation.LinkDependencies(debugCtx, balas)
+}
+
+func writeDependenciesToml(root string, resolved *projects.DependencyManifest) error {
+	lockPath := filepath.Join(root, projects.DependenciesTomlFile)
+	existing, err := os.ReadFile(lockPath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		if len(resolved.Packages) <= 1 {
+			return nil
+		}
+		return os.WriteFile(lockPath, []byte(resolved.String()), 0o644)
+	}
+	// Edit the existing file rather than regenerating it, so that comments and formatting survive
+	content := []byte(resolved.Update(string(existing)))
+	if bytes.Equal(existing, content) {
+		return nil
+	}
+	return os.WriteFile(lockPath, content, 0o644)
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"ballerina-lang-go/context"
+	"ballerina-lang-go/docgen"
+
+	"github.com/spf13/cobra"
+)
+
+var docOpts struct {
+	outputDir string
+}
+
+var docCmd = &cobra.Command{
+	Use:   "doc <source-file.bal>",
+	Short: "Generate the API documentation of a Ballerina source file",
+	Long: `	Generate the API documentation of the public functions, types and
+	constants of a Ballerina source file.
+
+	The documentation is written as a static HTML page (index.html) and as
+	JSON (api-docs.json) to '<output>/<module-name>', where the module is
+	named after the source file.
+
+	A warning is printed for each public symbol without documentation, and
+	for each documented parameter that does not match the signature.`,
+	Args: validateSourceFile,
+	RunE: generateDocs,
+}
+
+func init() {
+	docCmd.Flags().StringVarP(&docOpts.outputDir, "output", "o", filepath.Join("target", "apidocs"),
+		"Directory to write the API documentation to")
+}
+
+func generateDocs(cmd *cobra.Command, args []string) error {
+	fileName := args[0]
+
+	cx := context.NewCompilerContext()
+	moduleDoc, warnings, err := docgen.GenerateFromFile(cx, fileName)
+	if err != nil {
+		printError(fmt.Errorf("generating docs failed: %s", err.Error()), "", false)
+		return err
+	}
+	for _, warning := range warnings {
+		fmt.Fprintln(os.Stderr, warning.String())
+	}
+
+	moduleDir := filepath.Join(docOpts.outputDir, moduleDoc.Name)
+	if err := os.MkdirAll(moduleDir, 0o755); err != nil {
+		printError(fmt.Errorf("error creating output directory %s: %w", moduleDir, err), "", false)
+		return err
+	}
+	if err := writeDocFile(filepath.Join(moduleDir, "index.html"), moduleDoc, docgen.WriteHTML); err != nil {
+		return err
+	}
+	if err := writeDocFile(filepath.Join(moduleDir, "api-docs.json"), moduleDoc, docgen.WriteJSON); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "API documentation generated at %s\n", moduleDir)
+	return nil
+}
+
+func writeDocFile(path string, moduleDoc *docgen.ModuleDoc, write func(w io.Writer, moduleDoc *docgen.ModuleDoc) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		printError(fmt.Errorf("error creating file %s: %w", path, err), "", false)
+		return err
+	}
+	defer file.Close()
+	if err := write(file, moduleDoc); err != nil {
+		printError(fmt.Errorf("error writing file %s: %w", path, err), "", false)
+		return err
+	}
+	return nil
+}
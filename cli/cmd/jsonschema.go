@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"ballerina-lang-go/schemaimport"
+	"ballerina-lang-go/semtypes"
+
+	"github.com/spf13/cobra"
+)
+
+var jsonSchemaOpts struct {
+	outputFile string
+	rootName   string
+}
+
+var jsonSchemaCmd = &cobra.Command{
+	Use:   "jsonschema <schema.json>",
+	Short: "Generate Ballerina types from a JSON Schema",
+	Long: `	Generate Ballerina type definitions from a JSON Schema document.
+
+	The root schema becomes a type named after the schema file, and each
+	definition in '$defs' or 'definitions', and each other schema referred
+	to with '$ref', becomes a type of its own. Objects become records,
+	enums unions of singleton types, 'oneOf' and 'anyOf' unions, and
+	integer bounds int subtypes where Ballerina has one.
+
+	The types are written to '<schema-name>.bal' unless an output file is
+	given.`,
+	Args: validateSourceFile,
+	RunE: generateJSONSchemaTypes,
+}
+
+func init() {
+	jsonSchemaCmd.Flags().StringVarP(&jsonSchemaOpts.outputFile, "output", "o", "",
+		"File to write the types to")
+	jsonSchemaCmd.Flags().StringVarP(&jsonSchemaOpts.rootName, "name", "n", "",
+		"Name of the type of the root schema")
+}
+
+func generateJSONSchemaTypes(cmd *cobra.Command, args []string) error {
+	fileName := args[0]
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		printError(fmt.Errorf("error reading schema file %s: %w", fileName, err), "", false)
+		return err
+	}
+
+	rootName := jsonSchemaOpts.rootName
+	if rootName == "" {
+		rootName = schemaimport.RootName(fileName)
+	}
+	module, err := schemaimport.Convert(semtypes.NewEnv(), content, rootName)
+	if err != nil {
+		printError(fmt.Errorf("generating types failed: %w", err), "", false)
+		return err
+	}
+
+	outputFile := jsonSchemaOpts.outputFile
+	if outputFile == "" {
+		base := filepath.Base(fileName)
+		base, _, _ = strings.Cut(base, ".")
+		outputFile = base + ".bal"
+	}
+	if err := os.WriteFile(outputFile, []byte(module.Source), 0o644); err != nil {
+		printError(fmt.Errorf("error writing file %s: %w", outputFile, err), "", false)
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Types generated at %s\n", outputFile)
+	return nil
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"ballerina-lang-go/centralclient"
+	"ballerina-lang-go/common/bfs"
+	"ballerina-lang-go/projects"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+)
+
+var pullCmd = &cobra.Command{
+	Use:   "pull <org-name>/<package-name>[:<version>]",
+	Short: "Pull a package from Ballerina Central",
+	Long: `	Download a package from Ballerina Central to the central repository of
+	the Ballerina home directory, from where builds resolve it.
+
+	If no version is given, the latest version of the package that is
+	compatible with this distribution is pulled.`,
+	Args: validatePackageArgument,
+	RunE: pullPackage,
+}
+
+// validatePackageArgument validates the <org-name>/<package-name>[:<version>] argument of the 'pull' command.
+func validatePackageArgument(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		err := fmt.Errorf("a package should be given as <org-name>/<package-name>[:<version>]")
+		printError(err, cmd.Use, true)
+		return err
+	}
+	if _, err := parsePackageArgument(args[0]); err != nil {
+		printError(err, cmd.Use, true)
+		return err
+	}
+	return nil
+}
+
+// parsePackageArgument parses a package given as <org-name>/<package-name>[:<version>].
+func parsePackageArgument(arg string) (projects.PackageDescriptor, error) {
+	var pkg projects.PackageDescriptor
+	name, version, hasVersion := strings.Cut(arg, ":")
+	org, name, ok := strings.Cut(name, "/")
+	if !ok || org == "" || name == "" || strings.Contains(name, "/") {
+		return pkg, fmt.Errorf("invalid package '%s': a package should be given as "+
+			"<org-name>/<package-name>[:<version>]", arg)
+	}
+	if hasVersion {
+		if _, err := semver.StrictNewVersion(version); err != nil {
+			return pkg, fmt.Errorf("invalid version '%s' of package '%s/%s': %s", version, org, name, err.Error())
+		}
+	}
+	return projects.PackageDescriptor{Org: org, Name: name, Version: version}, nil
+}
+
+func pullPackage(cmd *cobra.Command, args []string) error {
+	pkg, err := parsePackageArgument(args[0])
+	if err != nil {
+		return err
+	}
+	home, err := projects.BallerinaHome()
+	if err != nil {
+		printError(err, "", false)
+		return err
+	}
+	client, err := newCentralClient(home)
+	if err != nil {
+		printError(err, "", false)
+		return err
+	}
+
+	repository := bfs.NewOSFS(projects.RepositoryBalaDir(home, projects.CentralRepositoryName))
+	clientContext := centralclient.ClientContext{
+		OnProgress: progressPrinter(cmd.ErrOrStderr(), "Downloading "+pkg.String()),
+		OnWarning: func(message string) {
+			fmt.Fprintln(cmd.ErrOrStderr(), message)
+		},
+	}
+	err = client.PullPackage(pkg.Org, pkg.Name, pkg.Version, repository, path.Join(pkg.Org, pkg.Name), balaPlatform,
+		Version, clientContext)
+	var exists *centralclient.PackageAlreadyExistsError
+	if errors.As(err, &exists) {
+		fmt.Fprintln(cmd.OutOrStdout(), exists.Error())
+		return nil
+	}
+	if err != nil {
+		printError(err, "", false)
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%s pulled from central successfully\n", pkg)
+	return nil
+}
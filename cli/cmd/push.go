@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"archive/zip"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"ballerina-lang-go/centralclient"
+	"ballerina-lang-go/projects"
+
+	"github.com/spf13/cobra"
+)
+
+var pushCmd = &cobra.Command{
+	Use:   "push [<bala-file>]",
+	Short: "Push a package to Ballerina Central",
+	Long: `	Upload a bala, the distribution format of a package, to Ballerina
+	Central.
+
+	If no bala is given, the bala in 'target/bala' of the package in the
+	current directory is pushed. The organization, name and version of the
+	package are read from the 'package.json' of the bala.
+
+	Pushing requires the access token of the organization, which is read
+	from the '[central]' table of the 'Settings.toml' of the Ballerina home
+	directory.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: pushPackage,
+}
+
+func pushPackage(cmd *cobra.Command, args []string) error {
+	balaPath, err := findBala(args)
+	if err != nil {
+		printError(err, cmd.Use, false)
+		return err
+	}
+	pkg, err := readBalaDescriptor(balaPath)
+	if err != nil {
+		printError(err, "", false)
+		return err
+	}
+	home, err := projects.BallerinaHome()
+	if err != nil {
+		printError(err, "", false)
+		return err
+	}
+	settings, err := loadSettings(home)
+	if err != nil {
+		printError(err, "", false)
+		return err
+	}
+	if settings.Central.AccessToken == "" {
+		err := fmt.Errorf("access token is missing in %s; add it to the [central] table as "+
+			"'accesstoken = \"<token>\"' after getting one from https://central.ballerina.io/dashboard?tab=token",
+			filepath.Join(home, projects.SettingsTomlFile))
+		printError(err, "", false)
+		return err
+	}
+	client, err := newCentralClient(home)
+	if err != nil {
+		printError(err, "", false)
+		return err
+	}
+
+	clientContext := centralclient.ClientContext{
+		OnProgress: progressPrinter(cmd.ErrOrStderr(), "Uploading "+pkg.String()),
+	}
+	err = client.PushPackage(os.DirFS(filepath.Dir(balaPath)), filepath.Base(balaPath), pkg.Org, pkg.Name, pkg.Version,
+		balaPlatform, Version, clientContext)
+	if err != nil {
+		printError(err, "", false)
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%s pushed to central successfully\n", pkg)
+	return nil
+}
+
+// findBala returns the bala given as the argument of the 'push' command, or else the single bala in target/bala.
+func findBala(args []string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+	balas, err := filepath.Glob(filepath.Join("target", "bala", "*.bala"))
+	if err != nil {
+		return "", err
+	}
+	switch len(balas) {
+	case 0:
+		return "", fmt.Errorf("cannot find a bala in %s; give the bala to push",
+			filepath.Join("target", "bala"))
+	case 1:
+		return balas[0], nil
+	default:
+		return "", fmt.Errorf("found %d balas in %s; give the bala to push", len(balas),
+			filepath.Join("target", "bala"))
+	}
+}
+
+// readBalaDescriptor returns the package of the bala at balaPath.
+func readBalaDescriptor(balaPath string) (projects.PackageDescriptor, error) {
+	reader, err := zip.OpenReader(balaPath)
+	if err != nil {
+		return projects.PackageDescriptor{}, fmt.Errorf("cannot read bala '%s': %w", balaPath, err)
+	}
+	defer reader.Close()
+	bala, err := projects.LoadBala(reader)
+	if err != nil {
+		return projects.PackageDescriptor{}, fmt.Errorf("cannot read bala '%s': %w", balaPath, err)
+	}
+	return bala.Descriptor(), nil
+}
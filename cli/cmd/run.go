@@ -21,7 +21,6 @@ package main
 import (
 	"fmt"
 	"os"
-	"path/filepath"
 	"strings"
 	"sync"
 
@@ -29,7 +28,7 @@ import (
 	"ballerina-lang-go/bir"
 	debugcommon "ballerina-lang-go/common"
 	"ballerina-lang-go/context"
-	"ballerina-lang-go/parser"
+	"ballerina-lang-go/projects"
 
 	"github.com/spf13/cobra"
 )
@@ -41,14 +40,20 @@ var runOpts struct {
 	dumpBIR       bool
 	traceRecovery bool
 	logFile       string
+	offline       bool
+	lockingMode   string
 }
 
 var runCmd = &cobra.Command{
-	Use:   "run <source-file.bal>",
+	Use:   "run [<source-file.bal> | <package-dir>]",
 	Short: "Compile and run the current package or a Ballerina source file",
 	Long: `	Compile the current package and run it.
 
-	The 'run' command compiles and executes the given Ballerina source file.
+	The 'run' command compiles and executes the given Ballerina source file,
+	or the package in the given directory. A package is a directory with a
+	'Ballerina.toml' file, whose '.bal' files form the default module and
+	whose 'modules/<name>' directories hold its other modules. If no
+	argument is given, the package in the current directory is run.
 
 	A Ballerina program consists of one or more modules; one of these modules
 	is distinguished as the root module, which is the default module of
@@ -68,7 +73,7 @@ var runCmd = &cobra.Command{
 	A service declaration is the syntactic sugar for creating a service object
 	and attaching it to the module listener specified in the service
 	declaration.`,
-	Args: validateSourceFile,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runBallerina,
 }
 
@@ -79,10 +84,16 @@ func init() {
 	runCmd.Flags().BoolVar(&runOpts.dumpBIR, "dump-bir", false, "Dump Ballerina Intermediate Representation")
 	runCmd.Flags().BoolVar(&runOpts.traceRecovery, "trace-recovery", false, "Enable error recovery tracing")
 	runCmd.Flags().StringVar(&runOpts.logFile, "log-file", "", "Write debug output to specified file")
+	runCmd.Flags().BoolVar(&runOpts.offline, "offline", false, "Resolve dependencies from the local repositories only")
+	runCmd.Flags().StringVar(&runOpts.lockingMode, "locking-mode", "",
+		"Locking mode of dependency versions: soft, medium (default), hard or locked")
 }
 
 func runBallerina(cmd *cobra.Command, args []string) error {
-	fileName := args[0]
+	path := "."
+	if len(args) > 0 {
+		path = args[0]
+	}
 
 	var debugCtx *debugcommon.DebugContext
 	var wg sync.WaitGroup
@@ -127,13 +138,22 @@ func runBallerina(cmd *cobra.Command, args []string) error {
 		}()
 	}
 
+	cx := context.NewCompilerContext()
+	project, err := projects.Load(cx, path)
+	if err != nil {
+		if debugCtx != nil {
+			close(debugCtx.Channel)
+			wg.Wait()
+		}
+		printError(err, "", false)
+		return err
+	}
+
 	// Compile the source
 	fmt.Fprintln(os.Stderr, "Compiling source")
-	fmt.Fprintf(os.Stderr, "\t%s\n", filepath.Base(fileName))
-
-	cx := context.NewCompilerContext()
+	fmt.Fprintf(os.Stderr, "\t%s\n", project.DisplayName())
 
-	syntaxTree, err := parser.GetSyntaxTree(debugCtx, fileName)
+	compilation, err := projects.Compile(cx, debugCtx, project)
 	if err != nil {
 		if debugCtx != nil {
 			close(debugCtx.Channel)
@@ -142,21 +162,35 @@ func runBallerina(cmd *cobra.Command, args []string) error {
 		printError(fmt.Errorf("compilation failed: %s", err.Error()), "", false)
 		return err
 	}
+	if !project.IsSingleFile() {
+		if err := resolveDependencies(debugCtx, compilation, runOpts.offline, runOpts.lockingMode); err != nil {
+			if debugCtx != nil {
+				close(debugCtx.Channel)
+				wg.Wait()
+			}
+			printError(fmt.Errorf("dependency resolution failed: %s", err.Error()), "", false)
+			return err
+		}
+	}
 
-	compilationUnit := ast.GetCompilationUnit(cx, syntaxTree)
 	if runOpts.dumpAST {
 		prettyPrinter := ast.PrettyPrinter{}
-		fmt.Println(prettyPrinter.Print(compilationUnit))
+		for _, module := range compilation.Modules {
+			for _, compilationUnit := range module.CompilationUnits {
+				fmt.Println(prettyPrinter.Print(compilationUnit))
+			}
+		}
 	}
 	if runOpts.dumpBIR {
-		pkg := ast.ToPackage(compilationUnit)
-		birPkg := bir.GenBir(cx, pkg)
+		compilation.GenBir()
 		prettyPrinter := bir.PrettyPrinter{}
 
 		// Print the BIR with separators
 		fmt.Fprintln(os.Stderr)
 		fmt.Fprintln(os.Stderr, "==================BEGIN BIR==================")
-		fmt.Println(strings.TrimSpace(prettyPrinter.Print(*birPkg)))
+		for _, module := range compilation.Modules {
+			fmt.Println(strings.TrimSpace(prettyPrinter.Print(*module.BIR)))
+		}
 		fmt.Fprintln(os.Stderr, "===================END BIR===================")
 	}
 
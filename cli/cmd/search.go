@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"ballerina-lang-go/centralclient/models"
+	"ballerina-lang-go/projects"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// searchDescriptionWidth is the number of characters the descriptions of the search results are truncated to
+	searchDescriptionWidth = 60
+	searchDateLayout       = "2006-01-02-Mon"
+)
+
+var searchCmd = &cobra.Command{
+	Use:   "search <query>",
+	Short: "Search Ballerina Central for packages",
+	Long: `	Search Ballerina Central for the packages whose organization, name,
+	description or keywords match the given query.
+
+	The matching packages are listed with their description, the date they
+	were pushed and their latest version.`,
+	Args: validateSearchQuery,
+	RunE: searchPackages,
+}
+
+// validateSearchQuery validates the query argument of the 'search' command.
+func validateSearchQuery(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 || strings.TrimSpace(args[0]) == "" {
+		err := fmt.Errorf("a single search query should be given")
+		printError(err, cmd.Use, true)
+		return err
+	}
+	return nil
+}
+
+func searchPackages(cmd *cobra.Command, args []string) error {
+	home, err := projects.BallerinaHome()
+	if err != nil {
+		printError(err, "", false)
+		return err
+	}
+	client, err := newCentralClient(home)
+	if err != nil {
+		printError(err, "", false)
+		return err
+	}
+	result, err := client.SearchPackage(args[0], balaPlatform, Version)
+	if err != nil {
+		printError(err, "", false)
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintln(out, "Ballerina Central")
+	fmt.Fprintln(out, "=================")
+	fmt.Fprintln(out)
+	if len(result.Packages) == 0 {
+		fmt.Fprintln(out, "no packages found")
+		return nil
+	}
+	table := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "NAME\tDESCRIPTION\tDATE\tVERSION")
+	for _, pkg := range result.Packages {
+		date := time.UnixMilli(pkg.CreatedDate).UTC().Format(searchDateLayout)
+		fmt.Fprintf(table, "%s/%s\t%s\t%s\t%s\n", pkg.Organization, pkg.Name, packageDescription(pkg), date,
+			pkg.Version)
+	}
+	table.Flush()
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "%d packages found\n", len(result.Packages))
+	return nil
+}
+
+// packageDescription returns the summary of a package, or else the first line of its readme without heading marks, on a single line and
+// truncated to fit the search results.
+func packageDescription(pkg models.Package) string {
+	description := pkg.Summary
+	if description == "" {
+		for _, line := range strings.Split(pkg.Readme, "\n") {
+			if line = strings.TrimSpace(strings.TrimLeft(line, "#")); line != "" {
+				description = line
+				break
+			}
+		}
+	}
+	description = strings.Join(strings.Fields(description), " ")
+	if runes := []rune(description); len(runes) > searchDescriptionWidth {
+		description = string(runes[:searchDescriptionWidth-3]) + "..."
+	}
+	return description
+}
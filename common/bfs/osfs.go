@@ -0,0 +1,105 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bfs
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// osFS is a MutableFS and WritableFS over a directory of the operating system.
+type osFS struct {
+	fs.FS
+	root string
+}
+
+// NewOSFS returns the file system of the directory root of the operating system.
+func NewOSFS(root string) *osFS {
+	return &osFS{FS: os.DirFS(root), root: root}
+}
+
+func (ofs *osFS) path(name string) (string, error) {
+	if !fs.ValidPath(name) {
+		return "", &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	return filepath.Join(ofs.root, filepath.FromSlash(name)), nil
+}
+
+func (ofs *osFS) Create(name string) (fs.File, error) {
+	path, err := ofs.path(name)
+	if err != nil {
+		return nil, err
+	}
+	return os.Create(path)
+}
+
+func (ofs *osFS) MkdirAll(dirPath string, perm fs.FileMode) error {
+	path, err := ofs.path(dirPath)
+	if err != nil {
+		return err
+	}
+	return os.MkdirAll(path, perm)
+}
+
+func (ofs *osFS) OpenFile(name string, flag int, perm fs.FileMode) (fs.File, error) {
+	path, err := ofs.path(name)
+	if err != nil {
+		return nil, err
+	}
+	return os.OpenFile(path, flag, perm)
+}
+
+// Remove removes a file or a directory with its children.
+func (ofs *osFS) Remove(name string) error {
+	path, err := ofs.path(name)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Lstat(path); err != nil {
+		return err
+	}
+	return os.RemoveAll(path)
+}
+
+// Move moves a file or directory from oldpath to newpath, creating the parent directories of newpath.
+func (ofs *osFS) Move(oldpath, newpath string) error {
+	oldPath, err := ofs.path(oldpath)
+	if err != nil {
+		return err
+	}
+	newPath, err := ofs.path(newpath)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(newPath), 0o755); err != nil {
+		return err
+	}
+	return os.Rename(oldPath, newPath)
+}
+
+// WriteFile writes data to a file, creating it and its parent directories if necessary.
+func (ofs *osFS) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	path, err := ofs.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, perm)
+}
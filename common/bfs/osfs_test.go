@@ -0,0 +1,58 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bfs
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestOSFS(t *testing.T) {
+	root := t.TempDir()
+	fsys := NewOSFS(root)
+
+	if err := WriteFile(fsys, "a/b/hello.txt", []byte("hello"), 0o644); err != nil {
+		t.Fatalf("WriteFile error: %v", err)
+	}
+	data, err := fs.ReadFile(fsys, "a/b/hello.txt")
+	if err != nil || string(data) != "hello" {
+		t.Fatalf("ReadFile: got %q, %v", data, err)
+	}
+
+	if err := Move(fsys, "a/b", "c/d"); err != nil {
+		t.Fatalf("Move error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "c", "d", "hello.txt")); err != nil {
+		t.Errorf("expected the moved file to exist: %v", err)
+	}
+	if _, err := fs.Stat(fsys, "a/b"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected the old directory to be gone, got %v", err)
+	}
+
+	if err := Remove(fsys, "c"); err != nil {
+		t.Fatalf("Remove error: %v", err)
+	}
+	if err := Remove(fsys, "c"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected removing a missing file to fail with ErrNotExist, got %v", err)
+	}
+	if err := MkdirAll(fsys, "../outside", 0o755); !errors.Is(err, fs.ErrInvalid) {
+		t.Errorf("expected a path outside the root to be invalid, got %v", err)
+	}
+}
@@ -18,12 +18,15 @@ package context
 
 import (
 	"ballerina-lang-go/model"
+	"ballerina-lang-go/semtypes"
 	"strconv"
 )
 
 type CompilerContext struct {
-	anonTypeCount   map[*model.PackageID]int
-	packageInterner *model.PackageIDInterner
+	anonTypeCount    map[*model.PackageID]int
+	anonServiceCount map[*model.PackageID]int
+	packageInterner  *model.PackageIDInterner
+	typeEnv          semtypes.Env
 }
 
 func (this *CompilerContext) GetDefaultPackage() *model.PackageID {
@@ -34,10 +37,18 @@ func (this *CompilerContext) NewPackageID(orgName model.Name, nameComps []model.
 	return model.NewPackageID(this.packageInterner, orgName, nameComps, version)
 }
 
+// TypeEnv returns the semtype env of the compilation. Each compilation has its own env, so that the atoms of its types
+// are released together with it.
+func (this *CompilerContext) TypeEnv() semtypes.Env {
+	return this.typeEnv
+}
+
 func NewCompilerContext() *CompilerContext {
 	return &CompilerContext{
-		anonTypeCount:   make(map[*model.PackageID]int),
-		packageInterner: model.DefaultPackageIDInterner,
+		anonTypeCount:    make(map[*model.PackageID]int),
+		anonServiceCount: make(map[*model.PackageID]int),
+		packageInterner:  model.DefaultPackageIDInterner,
+		typeEnv:          semtypes.NewEnv(),
 	}
 }
 
@@ -45,6 +56,7 @@ const (
 	ANON_PREFIX       = "$anon"
 	BUILTIN_ANON_TYPE = ANON_PREFIX + "Type$builtin$"
 	ANON_TYPE         = ANON_PREFIX + "Type$"
+	ANON_SERVICE      = ANON_PREFIX + "Service$"
 )
 
 func (this *CompilerContext) GetNextAnonymousTypeKey(packageID *model.PackageID) string {
@@ -55,3 +67,10 @@ func (this *CompilerContext) GetNextAnonymousTypeKey(packageID *model.PackageID)
 	}
 	return ANON_TYPE + "_" + strconv.Itoa(nextValue)
 }
+
+// GetNextAnonymousServiceKey returns the name of the variable holding the next service object of the package.
+func (this *CompilerContext) GetNextAnonymousServiceKey(packageID *model.PackageID) string {
+	nextValue := this.anonServiceCount[packageID]
+	this.anonServiceCount[packageID] = nextValue + 1
+	return ANON_SERVICE + "_" + strconv.Itoa(nextValue)
+}
@@ -0,0 +1,21 @@
+// @productions annotation-decl annotation annot-access-expr mapping-constructor-expr
+import ballerina/io;
+
+type Info record {|
+    string owner;
+    float version;
+    string[] tags;
+|};
+
+annotation Info Meta on type;
+
+@Meta {owner: "team", version: -1.5, tags: ["a", "b"]}
+type Point record {
+    int x;
+    int y;
+};
+
+public function main() {
+    Info? info = Point.@Meta;
+    io:println(info); // @output {"owner":"team","version":-1.5,"tags":["a","b"]}
+}
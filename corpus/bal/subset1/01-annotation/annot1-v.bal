@@ -0,0 +1,14 @@
+// @productions annotation-decl annotation function-defn
+import ballerina/io;
+
+annotation Deprecated on function;
+annotation Positive on parameter, return;
+
+@Deprecated
+function double(@Positive int n) returns @Positive int {
+    return n * 2;
+}
+
+public function main() {
+    io:println(double(21)); // @output 42
+}
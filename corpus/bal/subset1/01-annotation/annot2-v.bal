@@ -0,0 +1,28 @@
+// @productions annotation-decl annotation record-type-desc type-defn const-defn
+import ballerina/io;
+
+type Column record {|
+    string name;
+    int width?;
+|};
+
+const DEFAULT_WIDTH = 10;
+
+annotation Column Col on field;
+annotation record {| string text; |}[] Note on type;
+const annotation Table on source type;
+
+@Note {text: "first"}
+@Note {text: "second"}
+@Table
+type Person record {|
+    @Col {name: "full name", width: DEFAULT_WIDTH}
+    string name;
+    @Col {name: "age"}
+    int age;
+|};
+
+public function main() {
+    Person p = {name: "Alice", age: 30};
+    io:println(p); // @output {"name":"Alice","age":30}
+}
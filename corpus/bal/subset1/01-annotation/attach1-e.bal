@@ -0,0 +1,6 @@
+// @productions annotation-decl annotation function-defn
+annotation Tag on parameter;
+
+@Tag // @error
+public function main() {
+}
@@ -0,0 +1,8 @@
+// @productions annotation-decl annotation module-var-decl mapping-constructor-expr
+string label = "main";
+
+const annotation map<string> Labels on source function;
+
+@Labels {name: label} // @error
+public function main() {
+}
@@ -0,0 +1,7 @@
+// @productions annotation-decl annotation function-defn
+annotation Tag on function;
+
+@Tag
+@Tag // @error
+public function main() {
+}
@@ -0,0 +1,10 @@
+// @productions annotation-decl annotation record-type-desc mapping-constructor-expr
+type Config record {|
+    string name;
+|};
+
+annotation Config Cfg on function;
+
+@Cfg {name: "main", size: 3} // @error
+public function main() {
+}
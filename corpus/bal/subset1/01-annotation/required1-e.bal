@@ -0,0 +1,11 @@
+// @productions annotation-decl annotation record-type-desc mapping-constructor-expr
+type Config record {|
+    string name;
+    int count;
+|};
+
+annotation Config Cfg on function;
+
+@Cfg {name: "main"} // @error
+public function main() {
+}
@@ -0,0 +1,5 @@
+// @productions annotation-decl array-type-desc
+annotation string[] Labels on function; // @error
+
+public function main() {
+}
@@ -0,0 +1,4 @@
+// @productions annotation function-defn
+@Missing // @error
+public function main() {
+}
@@ -0,0 +1,16 @@
+// @productions function-defn markdown-documentation
+import ballerina/io;
+
+# Negates a value. For example,
+# ```ballerina
+# int n = negate(1);
+# ```
+# + x - the value
+# + return - `-x`
+function negate(int x) returns int {
+    return -x;
+}
+
+public function main() {
+    io:println(negate(5)); // @output -5
+}
@@ -0,0 +1,9 @@
+// @productions const-decl markdown-documentation
+import ballerina/io;
+
+# The answer, as documented in `main()`.
+const int ANSWER = 42;
+
+public function main() {
+    io:println(ANSWER); // @output 42
+}
@@ -0,0 +1,19 @@
+// @productions function-defn markdown-documentation
+import ballerina/io;
+
+# Returns the double of a value.
+#
+# + x - the value
+# + return - the doubled value
+#
+# # Deprecated
+# Use `x * 2` instead.
+# # Deprecated parameters
+# + x - use an int instead
+function double(int x) returns int {
+    return x * 2;
+}
+
+public function main() {
+    io:println(double(21)); // @output 42
+}
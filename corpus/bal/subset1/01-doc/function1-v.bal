@@ -0,0 +1,16 @@
+// @productions function-defn markdown-documentation function-call-expr
+import ballerina/io;
+
+# Prints the sum of two integers.
+#
+# + a - the first operand
+# + b - the second operand
+# + return - the sum of `a` and `b`
+function add(int a, int b) returns int {
+    return a + b;
+}
+
+# The entry point.
+public function main() {
+    io:println(add(1, 2)); // @output 3
+}
@@ -0,0 +1,14 @@
+// @productions function-defn markdown-documentation
+import ballerina/io;
+
+# Documentation warnings do not make a program invalid.
+# + - missing parameter name
+# + x missing hyphen
+# See ``unterminated
+function identity(int x) returns int {
+    return x;
+}
+
+public function main() {
+    io:println(identity(7)); // @output 7
+}
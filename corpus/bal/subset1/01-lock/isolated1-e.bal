@@ -0,0 +1,6 @@
+// @productions isolated-function module-var-decl
+int count = 0;
+
+isolated function increment() {
+    count = count + 1; // @error
+}
@@ -0,0 +1,22 @@
+// @productions isolated-var-decl isolated-function lock-stmt
+import ballerina/io;
+
+final int step = 2;
+isolated int[] values = [];
+
+isolated function add(int i, int v) {
+    lock {
+        values[i] = v * step;
+    }
+}
+
+isolated function get(int i) returns int {
+    lock {
+        return values[i];
+    }
+}
+
+public function main() {
+    add(0, 5);
+    io:println(get(0)); // @output 10
+}
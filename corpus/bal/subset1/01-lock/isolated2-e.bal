@@ -0,0 +1,6 @@
+// @productions isolated-var-decl
+isolated int count = 0;
+
+public function main() {
+    count = 1; // @error
+}
@@ -0,0 +1,11 @@
+// @productions isolated-function function-call-expr
+int count = 0;
+
+function increment() {
+    count = count + 1;
+}
+
+isolated function incrementTwice() {
+    increment(); // @error
+    increment();
+}
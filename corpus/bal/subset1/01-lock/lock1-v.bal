@@ -0,0 +1,11 @@
+// @productions lock-stmt module-var-decl
+import ballerina/io;
+
+int count = 0;
+
+public function main() {
+    lock {
+        count = count + 1;
+    }
+    io:println(count); // @output 1
+}
@@ -0,0 +1,24 @@
+// @productions lock-stmt while-stmt break-stmt return-stmt
+import ballerina/io;
+
+int total = 0;
+
+function addUpTo(int n) returns int {
+    int i = 0;
+    while true {
+        lock {
+            if i == n {
+                break;
+            }
+            total = total + i;
+        }
+        i = i + 1;
+    }
+    lock {
+        return total;
+    }
+}
+
+public function main() {
+    io:println(addUpTo(4)); // @output 6
+}
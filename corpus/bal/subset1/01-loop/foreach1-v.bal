@@ -0,0 +1,17 @@
+// @productions foreach-stmt break-stmt continue-stmt if-else-stmt equality-expr list-constructor-expr local-var-decl-stmt function-call-expr additive-expr assign-stmt
+import ballerina/io;
+
+public function main() {
+    int[] xs = [1, 2, 3, 4, 5];
+    int sum = 0;
+    foreach int x in xs {
+        if x == 2 {
+            continue;
+        }
+        if x == 4 {
+            break;
+        }
+        sum = sum + x;
+    }
+    io:println(sum); // @output 4
+}
@@ -0,0 +1,8 @@
+// @productions regexp-template-expr function-call-expr
+import ballerina/io;
+
+public function main() {
+    io:println(re `a|bc*d+?`); // @output a|bc*d+?
+    io:println(re `^x.y$`); // @output ^x.y$
+    io:println(re ``); // @output
+}
@@ -0,0 +1,7 @@
+// @productions regexp-template-expr function-call-expr
+import ballerina/io;
+
+public function main() {
+    io:println(re `[a-z0-9\-_]+@[^\s]{2,4}`); // @output [a-z0-9\-_]+@[^\s]{2,4}
+    io:println(re `\p{Lu}\P{sc=Latin}\d\.\u{61}\n`); // @output \p{Lu}\P{sc=Latin}\d\.\u{61}\n
+}
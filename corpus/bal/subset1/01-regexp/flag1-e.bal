@@ -0,0 +1,4 @@
+// @productions regexp-template-expr
+public function main() {
+    var r = re `(?ii:x)`; // @error
+}
@@ -0,0 +1,7 @@
+// @productions regexp-template-expr local-var-decl-stmt function-call-expr string-literal
+import ballerina/io;
+
+public function main() {
+    string word = "ab";
+    io:println(re `(?i-m:${word})(c|d){3}`); // @output (?i-m:ab)(c|d){3}
+}
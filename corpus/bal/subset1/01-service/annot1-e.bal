@@ -0,0 +1,15 @@
+// @productions annotation-decl annotation listener-decl service-decl remote-method-defn new-expr function-defn
+import ballerina/http;
+
+annotation Config on service;
+
+listener http:Listener ep = new (9090);
+
+service / on ep {
+    @Config // @error
+    remote function ping() {
+    }
+}
+
+public function main() {
+}
@@ -0,0 +1,10 @@
+// @productions module-var-decl service-decl resource-method-defn function-defn
+int port = 9090;
+
+service / on port { // @error
+    resource function get .() {
+    }
+}
+
+public function main() {
+}
@@ -0,0 +1,15 @@
+// @productions listener-decl service-decl resource-method-defn new-expr function-defn
+import ballerina/http;
+
+listener http:Listener ep = new (9090);
+
+service / on ep {
+    resource function get users/[int id]() {
+    }
+
+    resource function get users/[int userId]() { // @error
+    }
+}
+
+public function main() {
+}
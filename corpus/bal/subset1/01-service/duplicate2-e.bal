@@ -0,0 +1,15 @@
+// @productions listener-decl service-decl remote-method-defn new-expr function-defn
+import ballerina/http;
+
+listener http:Listener ep = new (9090);
+
+service / on ep {
+    remote function ping() {
+    }
+
+    remote function ping() { // @error
+    }
+}
+
+public function main() {
+}
@@ -0,0 +1,26 @@
+// @productions module-var-decl listener-decl service-decl resource-method-defn remote-method-defn new-expr function-defn
+import ballerina/http;
+import ballerina/io;
+
+int count = 0;
+isolated int total = 0;
+
+function increment() {
+    count = count + 1;
+}
+
+service /counter on new http:Listener(9090) {
+    resource function get value() returns int {
+        lock {
+            return total;
+        }
+    }
+
+    remote function bump() {
+        increment();
+    }
+}
+
+public function main() {
+    io:println(count); // @output 0
+}
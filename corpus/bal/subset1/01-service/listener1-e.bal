@@ -0,0 +1,5 @@
+// @productions listener-decl new-expr function-defn
+listener ep = new (9090); // @error
+
+public function main() {
+}
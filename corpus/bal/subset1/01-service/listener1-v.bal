@@ -0,0 +1,9 @@
+// @productions listener-decl new-expr function-defn
+import ballerina/http;
+import ballerina/io;
+
+public listener ep = new http:Listener(9090);
+
+public function main() {
+    io:println(1); // @output 1
+}
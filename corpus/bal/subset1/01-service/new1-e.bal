@@ -0,0 +1,8 @@
+// @productions type-defn record-type-desc new-expr function-defn
+type Point record {
+    int x;
+};
+
+public function main() {
+    Point p = new Point(); // @error
+}
@@ -0,0 +1,12 @@
+// @productions listener-decl service-decl resource-method-defn new-expr function-defn
+import ballerina/http;
+
+listener http:Listener ep = new (9090);
+
+service / on ep {
+    resource function get [json j]() { // @error
+    }
+}
+
+public function main() {
+}
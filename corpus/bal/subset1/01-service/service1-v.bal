@@ -0,0 +1,27 @@
+// @productions listener-decl service-decl resource-method-defn remote-method-defn new-expr function-defn
+import ballerina/http;
+import ballerina/io;
+
+listener http:Listener ep = new (9090);
+
+service /hello/world on ep, new http:Listener(8080) {
+    resource function get greeting() returns string {
+        return "Hello";
+    }
+
+    resource function get users/[int id]/[string... rest](string name) returns string {
+        io:println(id);
+        return name;
+    }
+
+    resource function post .() {
+    }
+
+    remote function ping(int n) returns int {
+        return n + 1;
+    }
+}
+
+public function main() {
+    io:println("ready"); // @output ready
+}
@@ -0,0 +1,22 @@
+// @productions annotation-decl annotation listener-decl service-decl resource-method-defn remote-method-defn new-expr function-defn
+import ballerina/http;
+import ballerina/io;
+
+annotation Config on service;
+annotation Remote on function;
+
+@Config
+isolated service "greeter" on new http:Listener(8081) {
+    @Remote
+    remote function hello(string name) returns string {
+        return "Hello, " + name;
+    }
+
+    resource function get [string name]() returns string {
+        return name;
+    }
+}
+
+public function main() {
+    io:println("started"); // @output started
+}
@@ -0,0 +1,5 @@
+// @productions stream-type-descriptor type-definition
+type S stream<int, string>; // @error
+
+public function main() {
+}
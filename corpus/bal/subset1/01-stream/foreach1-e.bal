@@ -0,0 +1,11 @@
+// @productions foreach-stmt stream-type-descriptor function-defn
+public function main() {
+}
+
+function sum(stream<int, error?> s) returns int {
+    int total = 0;
+    foreach int n in s { // @error
+        total = total + n;
+    }
+    return total;
+}
@@ -0,0 +1,12 @@
+// @productions foreach-stmt query-expr from-clause select-clause stream-type-descriptor local-var-decl-stmt list-constructor-expr function-call-expr additive-expr assign-stmt
+import ballerina/io;
+
+public function main() {
+    int[] xs = [1, 2, 3];
+    stream<int> doubled = stream from var x in xs select x * 2;
+    int sum = 0;
+    foreach int n in doubled {
+        sum = sum + n;
+    }
+    io:println(sum); // @output 12
+}
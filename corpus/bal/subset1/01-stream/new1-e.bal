@@ -0,0 +1,6 @@
+// @productions new-expr stream-type-descriptor local-var-decl-stmt
+public function main() {
+    int a = 1;
+    int b = 2;
+    stream<int> s = new stream<int>(a, b); // @error
+}
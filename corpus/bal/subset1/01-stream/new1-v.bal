@@ -0,0 +1,10 @@
+// @productions new-expr stream-type-descriptor local-var-decl-stmt return-stmt function-defn
+public function main() {
+    stream<int> s1 = new;
+    stream<string, error?> s2 = new stream<string, error?>();
+}
+
+function empty() returns stream<int> {
+    stream<int> s = new;
+    return s;
+}
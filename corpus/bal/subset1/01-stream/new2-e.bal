@@ -0,0 +1,5 @@
+// @productions new-expr stream-type-descriptor local-var-decl-stmt
+public function main() {
+    int a = 1;
+    stream<int> s = new stream<int>(a); // @error
+}
@@ -0,0 +1,13 @@
+// @productions query-expr from-clause where-clause select-clause stream-type-descriptor type-definition local-var-decl-stmt function-call-expr
+import ballerina/io;
+
+type IntStream stream<int>;
+
+public function main() {
+    int[] xs = [1, 2, 3, 4];
+    IntStream evens = stream from var x in xs
+        where x % 2 == 0
+        select x;
+    int[] ys = from var e in evens select e * 10;
+    io:println(ys); // @output [20,40]
+}
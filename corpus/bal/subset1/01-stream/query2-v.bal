@@ -0,0 +1,7 @@
+// @productions query-expr from-clause select-clause stream-type-descriptor union-type-descriptor return-stmt function-defn
+public function main() {
+}
+
+function collect(stream<int, error?> s) returns int[]|error {
+    return from var n in s select n;
+}
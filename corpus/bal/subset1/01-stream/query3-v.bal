@@ -0,0 +1,25 @@
+// @productions query-expr from-clause select-clause foreach-stmt stream-type-descriptor union-type-descriptor return-stmt function-defn function-call-expr local-var-decl-stmt
+public function main() {
+}
+
+function numbers() returns stream<int, error?> {
+    stream<int, error?> s = new;
+    return s;
+}
+
+function evens() returns stream<int> {
+    stream<int> s = new;
+    return s;
+}
+
+function collect() returns int[]|error {
+    return from var n in numbers() select n;
+}
+
+function sum() returns int {
+    int total = 0;
+    foreach int n in evens() {
+        total = total + n;
+    }
+    return total;
+}
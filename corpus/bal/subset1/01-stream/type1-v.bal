@@ -0,0 +1,11 @@
+// @productions stream-type-descriptor type-definition function-defn
+type IntStream stream<int>;
+type IntStreamWithError stream<int, error?>;
+type AnyStream stream;
+
+public function main() {
+}
+
+function widen(IntStream s) returns stream<int|string> {
+    return s;
+}
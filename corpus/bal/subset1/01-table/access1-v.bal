@@ -0,0 +1,19 @@
+// @productions table-type-descriptor table-constructor-expr key-specifier member-access-expr field-access-expr assignment-stmt local-var-decl-stmt function-call-expr
+import ballerina/io;
+
+type Employee record {|
+    readonly int id;
+    string name;
+|};
+
+public function main() {
+    table<Employee> key(id) employees = table key(id) [
+        {id: 1, name: "Alice"},
+        {id: 2, name: "Bob"}
+    ];
+    io:println(employees[2]); // @output {"id":2,"name":"Bob"}
+    Employee e = {id: 3, name: "Carol"};
+    e.name = "Dave";
+    employees[3] = e;
+    io:println(employees[3]); // @output {"id":3,"name":"Dave"}
+}
@@ -0,0 +1,16 @@
+// @productions table-type-descriptor table-constructor-expr key-specifier member-access-expr intersection-type-descriptor record-type-descriptor type-definition local-var-decl-stmt function-call-expr
+import ballerina/io;
+
+type Point record {
+    int x;
+    int y;
+    string label;
+};
+
+public function main() {
+    table<Point & readonly> key(x, y) points = table [
+        {x: 0, y: 0, label: "origin"},
+        {x: 1, y: 0, label: "unit"}
+    ];
+    io:println(points[1, 0]); // @output {"x":1,"y":0,"label":"unit"}
+}
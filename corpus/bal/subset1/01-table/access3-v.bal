@@ -0,0 +1,29 @@
+// @productions table-type-descriptor table-constructor-expr key-specifier member-access-expr field-access-expr conditional-expr record-type-descriptor type-definition function-call-expr local-var-decl-stmt
+import ballerina/io;
+
+type Employee record {|
+    readonly int id;
+    string name;
+|};
+
+type Team record {|
+    string name;
+    table<Employee> key(id) members;
+|};
+
+function staff() returns table<Employee> key(id) {
+    return table key(id) [
+        {id: 1, name: "Alice"},
+        {id: 2, name: "Bob"}
+    ];
+}
+
+public function main() {
+    io:println(staff()[1]); // @output {"id":1,"name":"Alice"}
+    Team team = {name: "core", members: staff()};
+    io:println(team.members[2]); // @output {"id":2,"name":"Bob"}
+    boolean useTeam = true;
+    io:println((useTeam ? team.members : staff())[2]); // @output {"id":2,"name":"Bob"}
+    int id = useTeam ? 1 : 2;
+    io:println(staff()[id]); // @output {"id":1,"name":"Alice"}
+}
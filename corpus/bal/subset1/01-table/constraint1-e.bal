@@ -0,0 +1,5 @@
+// @productions table-type-descriptor type-definition
+type Numbers table<int>; // @error
+
+public function main() {
+}
@@ -0,0 +1,18 @@
+// @productions table-type-descriptor table-constructor-expr key-specifier member-access-expr record-type-descriptor type-definition local-var-decl-stmt function-call-expr
+import ballerina/io;
+
+type Employee record {|
+    readonly int id;
+    string name;
+    int salary;
+|};
+
+type EmployeeTable table<Employee> key(id);
+
+public function main() {
+    EmployeeTable employees = table [
+        {id: 1, name: "Alice", salary: 100},
+        {id: 2, name: "Bob", salary: 200}
+    ];
+    io:println(employees[1]); // @output {"id":1,"name":"Alice","salary":100}
+}
@@ -0,0 +1,12 @@
+// @productions table-constructor-expr key-specifier record-type-descriptor type-definition local-var-decl-stmt
+type Employee record {|
+    readonly int id;
+    string name;
+|};
+
+public function main() {
+    table<Employee> key(id) employees = table [ // @error
+        {id: 1, name: "Alice"},
+        {id: 1, name: "Bob"}
+    ];
+}
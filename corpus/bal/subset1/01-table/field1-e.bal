@@ -0,0 +1,10 @@
+// @productions table-type-descriptor key-specifier record-type-descriptor type-definition
+type Employee record {|
+    readonly int id;
+    string name;
+|};
+
+type EmployeeTable table<Employee> key(code); // @error
+
+public function main() {
+}
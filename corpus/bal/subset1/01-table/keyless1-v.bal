@@ -0,0 +1,10 @@
+// @productions table-type-descriptor table-constructor-expr map-type-descriptor local-var-decl-stmt function-call-expr
+import ballerina/io;
+
+public function main() {
+    table<map<int>> t = table [
+        {a: 1},
+        {a: 1}
+    ];
+    io:println(t); // @output [{"a":1},{"a":1}]
+}
@@ -0,0 +1,10 @@
+// @productions table-type-descriptor key-type-constraint record-type-descriptor type-definition function-defn
+type Entry record {|
+    readonly string name;
+    int count;
+|};
+
+type Entries table<Entry> key<string>;
+
+public function main() {
+}
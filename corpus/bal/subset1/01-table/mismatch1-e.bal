@@ -0,0 +1,11 @@
+// @productions table-constructor-expr key-specifier record-type-descriptor type-definition local-var-decl-stmt
+type Employee record {|
+    readonly int id;
+    readonly string name;
+|};
+
+public function main() {
+    table<Employee> key(id) employees = table key(name) [ // @error
+        {id: 1, name: "Alice"}
+    ];
+}
@@ -0,0 +1,19 @@
+// @productions query-expr from-clause where-clause select-clause table-constructor-expr key-specifier field-access-expr local-var-decl-stmt function-call-expr
+import ballerina/io;
+
+type Employee record {|
+    readonly int id;
+    string name;
+    int salary;
+|};
+
+public function main() {
+    table<Employee> key(id) employees = table [
+        {id: 1, name: "Alice", salary: 100},
+        {id: 2, name: "Bob", salary: 200}
+    ];
+    table<Employee> key(id) rich = table key(id) from var emp in employees
+        where emp.salary > 150
+        select emp;
+    io:println(rich); // @output [{"id":2,"name":"Bob","salary":200}]
+}
@@ -0,0 +1,18 @@
+// @productions query-expr from-clause let-clause select-clause table-constructor-expr list-type-descriptor local-var-decl-stmt function-call-expr
+import ballerina/io;
+
+type Item record {|
+    readonly string name;
+    int price;
+|};
+
+public function main() {
+    table<Item> key(name) items = table [
+        {name: "pen", price: 2},
+        {name: "book", price: 10}
+    ];
+    int[] doubled = from var item in items
+        let int price = item.price * 2
+        select price;
+    io:println(doubled); // @output [4,20]
+}
@@ -0,0 +1,10 @@
+// @productions table-type-descriptor key-specifier record-type-descriptor type-definition
+type Employee record {|
+    int id;
+    string name;
+|};
+
+type EmployeeTable table<Employee> key(id); // @error
+
+public function main() {
+}
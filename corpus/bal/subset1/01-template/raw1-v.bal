@@ -0,0 +1,15 @@
+// @productions raw-template-expr function-call-expr local-var-decl-stmt int-literal
+import ballerina/io;
+
+public function main() {
+    int x = 1;
+    printTemplate(`a${x}b${x + 1}`);
+    printTemplate(`${x}${x}`);
+    printTemplate(``);
+}
+
+function printTemplate(object:RawTemplate t) {
+    io:println("template"); // @output template
+                            // @output template
+                            // @output template
+}
@@ -0,0 +1,8 @@
+// @productions string-template-expr local-var-decl-stmt function-call-expr string-literal int-literal
+import ballerina/io;
+
+public function main() {
+    string name = "World";
+    int count = 3;
+    io:println(string `Hello ${name}, count=${count}!`); // @output Hello World, count=3!
+}
@@ -0,0 +1,9 @@
+// @productions string-template-expr equality boolean-literal int-literal
+import ballerina/io;
+
+public function main() {
+    io:println(string `` == ""); // @output true
+    io:println(string `plain`); // @output plain
+    boolean b = true;
+    io:println(string `${b}${1}${string `x${2}`}`); // @output true1x2
+}
@@ -0,0 +1,4 @@
+// @productions string-template-expr nil-literal
+public function main() {
+    string s = string `value: ${()}`; // @error
+}
@@ -0,0 +1,8 @@
+// @productions string-template-expr function-call-expr
+function name() returns string? {
+    return ();
+}
+
+public function main() {
+    string s = string `hello ${name()}`; // @error
+}
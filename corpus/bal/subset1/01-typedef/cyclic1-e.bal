@@ -0,0 +1,6 @@
+// @productions type-defn type-reference
+type A B; // @error
+type B A;
+
+public function main() {
+}
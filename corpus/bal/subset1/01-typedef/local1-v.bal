@@ -0,0 +1,8 @@
+// @productions local-type-defn-stmt union-type-desc singleton-type-desc
+import ballerina/io;
+
+public function main() {
+    type Dir "left"|"right";
+    Dir d = "left";
+    io:println(d); // @output left
+}
@@ -0,0 +1,7 @@
+// @productions local-type-defn-stmt if-else-stmt
+public function main() {
+    if true {
+        type L int;
+    }
+    L x = 1; // @error
+}
@@ -0,0 +1,10 @@
+// @productions type-defn union-type-desc array-type-desc map-type-desc
+import ballerina/io;
+
+type J ()|boolean|int|float|decimal|string|J[]|map<J>;
+type IntList ()|[int, IntList];
+
+public function main() {
+    J j = 1;
+    io:println(j); // @output 1
+}
@@ -0,0 +1,6 @@
+// @productions type-defn union-type-desc singleton-type-desc
+type Color "red"|"green"|"blue";
+
+public function main() {
+    Color c = "purple"; // @error
+}
@@ -0,0 +1,11 @@
+// @productions type-defn tuple-type-desc intersection-type-desc distinct-type-desc
+import ballerina/io;
+
+type Pair [int, string];
+type Ints [int, int...];
+type RoInts int[] & readonly;
+type MyErr distinct error;
+
+public function main() {
+    io:println("tuples"); // @output tuples
+}
@@ -0,0 +1,18 @@
+// @productions type-defn union-type-desc singleton-type-desc optional-type-desc nil-type-desc function-call-expr
+import ballerina/io;
+
+type Color "red"|"green"|"blue";
+public type Id int|string;
+type OptInt int?;
+type Signed -1|0|1;
+type Grouped (int|string)[];
+
+public function main() {
+    Color c = "green";
+    Id id = 42;
+    OptInt n = ();
+    Signed s = -1;
+    io:println(c); // @output green
+    io:println(id); // @output 42
+    io:println(s); // @output -1
+}
@@ -0,0 +1,11 @@
+// @productions named-worker-decl receive-action
+public function main() {
+    worker A {
+        int v = <- B; // @error
+        v -> B;
+    }
+    worker B {
+        int v = <- A;
+        v -> A;
+    }
+}
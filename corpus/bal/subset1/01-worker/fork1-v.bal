@@ -0,0 +1,18 @@
+// @productions fork-stmt named-worker-decl sync-send-action alternate-wait-action multiple-wait-action
+import ballerina/io;
+
+public function main() {
+    fork {
+        worker W1 returns int {
+            int v = <- W2;
+            return v + 1;
+        }
+        worker W2 returns int {
+            error? e = 2 ->> W1;
+            return 2;
+        }
+    }
+    int first = wait W1 | W2;
+    map<int> all = wait {W1, w2: W2};
+    io:println(all["W1"]); // @output 3
+}
@@ -0,0 +1,23 @@
+// @productions named-worker-decl alternate-receive-action multiple-receive-action flush-action
+import ballerina/io;
+
+public function main() {
+    worker A {
+        1 -> B;
+        2 -> C;
+        error? e = flush B;
+        3 -> function;
+        error? e2 = flush;
+    }
+    worker B {
+        int a = <- A;
+        a -> function;
+    }
+    worker C {
+        int c = <- A;
+        c -> function;
+    }
+    int|error r = <- A | B;
+    map<int> m = <- {c: C};
+    io:println(m["c"]); // @output 2
+}
@@ -0,0 +1,12 @@
+// @productions start-action future-type-desc wait-action
+import ballerina/io;
+
+function add(int a, int b) returns int {
+    return a + b;
+}
+
+public function main() {
+    future<int> f = start add(1, 2);
+    int y = wait f;
+    io:println(y); // @output 3
+}
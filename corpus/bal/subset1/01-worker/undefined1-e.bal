@@ -0,0 +1,6 @@
+// @productions named-worker-decl async-send-action
+public function main() {
+    worker A {
+        1 -> C; // @error
+    }
+}
@@ -0,0 +1,8 @@
+// @productions named-worker-decl async-send-action
+public function main() {
+    worker A {
+        1 -> B; // @error
+    }
+    worker B {
+    }
+}
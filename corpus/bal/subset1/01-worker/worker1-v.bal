@@ -0,0 +1,18 @@
+// @productions named-worker-decl async-send-action receive-action wait-action
+import ballerina/io;
+
+public function main() {
+    int x = 10;
+    worker A returns int {
+        int v = x + 1;
+        v -> B;
+        int r = <- B;
+        return r;
+    }
+    worker B {
+        int v = <- A;
+        v * 2 -> A;
+    }
+    int r = wait A;
+    io:println(r); // @output 22
+}
@@ -0,0 +1,5 @@
+// @productions xml-template-expr xml-comment
+public function main() {
+    string v = "v";
+    xml x = xml `<!-- ${v} -->`; // @error
+}
@@ -0,0 +1,8 @@
+// @productions xml-template-expr xml-element local-var-decl-stmt function-call-expr string-literal
+import ballerina/io;
+
+public function main() {
+    string name = "Ann";
+    xml x = xml `<book id="1" title='${name}'>Hello ${name}!</book>`;
+    io:println(x); // @output <book id="1" title="Ann">Hello Ann!</book>
+}
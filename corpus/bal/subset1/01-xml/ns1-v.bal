@@ -0,0 +1,8 @@
+// @productions xml-template-expr xml-namespace-decl xml-element function-call-expr
+import ballerina/io;
+
+xmlns "http://example.com/books" as bk;
+
+public function main() {
+    io:println(xml `<bk:book xmlns:p="urn:p"><p:title>T</p:title></bk:book>`); // @output <bk:book xmlns:p="urn:p" xmlns:bk="http://example.com/books"><p:title>T</p:title></bk:book>
+}
@@ -0,0 +1,4 @@
+// @productions xml-template-expr xml-element
+public function main() {
+    xml x = xml `<p:a/>`; // @error
+}
@@ -0,0 +1,7 @@
+// @productions xml-template-expr xml-comment xml-pi xml-cdata xml-empty-element function-call-expr
+import ballerina/io;
+
+public function main() {
+    io:println(xml `<!-- c --><?pi data?><e/><![CDATA[<raw>]]>text`); // @output <!-- c --><?pi data?><e/>&lt;raw&gt;text
+    io:println(xml ``); // @output
+}
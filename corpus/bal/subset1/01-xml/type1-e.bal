@@ -0,0 +1,4 @@
+// @productions xml-template-expr xml-type-desc
+public function main() {
+    xml<xml:Element> x = xml `<!-- not an element -->`; // @error
+}
@@ -0,0 +1,10 @@
+// @productions xml-template-expr xml-type-desc function-call-expr
+import ballerina/io;
+
+public function main() {
+    xml<xml:Element> e = xml `<a/>`;
+    xml<xml:Comment> c = xml `<!--c-->`;
+    xml<xml:Text> t = xml `text`;
+    xml<xml:ProcessingInstruction> p = xml `<?p d?>`;
+    io:println(e); // @output <a/>
+}
@@ -0,0 +1,314 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package docgen extracts the API documentation of the public symbols of a module and renders it as JSON and HTML.
+//
+// The documentation is read off the syntax tree rather than the BLangPackage, so that the API of a module can be
+// documented even if some of its constructs are not yet supported by the later phases of the compiler.
+package docgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/context"
+	"ballerina-lang-go/model"
+	"ballerina-lang-go/parser"
+	"ballerina-lang-go/parser/common"
+	"ballerina-lang-go/parser/tree"
+)
+
+// ModuleDoc is the documentation of the public API of a module.
+type ModuleDoc struct {
+	Name      string        `json:"name"`
+	Functions []FunctionDoc `json:"functions"`
+	Types     []TypeDoc     `json:"types"`
+	Constants []ConstantDoc `json:"constants"`
+}
+
+// SymbolDoc is the documentation common to all public symbols.
+type SymbolDoc struct {
+	Name                   string `json:"name"`
+	Description            string `json:"description,omitempty"`
+	Deprecated             bool   `json:"deprecated,omitempty"`
+	DeprecationDescription string `json:"deprecationDescription,omitempty"`
+}
+
+type FunctionDoc struct {
+	SymbolDoc
+	Parameters        []ParameterDoc `json:"parameters"`
+	ReturnType        string         `json:"returnType,omitempty"`
+	ReturnDescription string         `json:"returnDescription,omitempty"`
+}
+
+type ParameterDoc struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	DefaultValue string `json:"defaultValue,omitempty"`
+	Description  string `json:"description,omitempty"`
+	Deprecated   bool   `json:"deprecated,omitempty"`
+}
+
+type TypeDoc struct {
+	SymbolDoc
+	Descriptor string `json:"descriptor"`
+}
+
+type ConstantDoc struct {
+	SymbolDoc
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value"`
+}
+
+// Warning is a documentation problem found while generating the docs. Warnings do not stop the generation.
+type Warning struct {
+	FileName string
+	Line     int
+	Message  string
+}
+
+func (w Warning) String() string {
+	return fmt.Sprintf("WARNING [%s:%d] %s", w.FileName, w.Line, w.Message)
+}
+
+// GenerateFromFile generates the documentation of a single file module. The module is named after the file.
+func GenerateFromFile(cx *context.CompilerContext, fileName string) (*ModuleDoc, []Warning, error) {
+	syntaxTree, err := parser.GetSyntaxTree(nil, fileName)
+	if err != nil {
+		return nil, nil, err
+	}
+	moduleName := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+	moduleDoc, warnings := Generate(cx, moduleName, filepath.Base(fileName), syntaxTree)
+	return moduleDoc, warnings, nil
+}
+
+// Generate generates the documentation of the public functions, types and constants in the syntax tree.
+func Generate(cx *context.CompilerContext, moduleName string, fileName string, syntaxTree *tree.SyntaxTree) (*ModuleDoc, []Warning) {
+	modulePart := syntaxTree.RootNode.(*tree.ModulePart)
+	g := &generator{
+		nodeBuilder: ast.NewNodeBuilder(cx),
+		fileName:    fileName,
+		source:      tree.ToSourceCode(modulePart.InternalNode()),
+	}
+	moduleDoc := &ModuleDoc{
+		Name:      moduleName,
+		Functions: []FunctionDoc{},
+		Types:     []TypeDoc{},
+		Constants: []ConstantDoc{},
+	}
+	members := modulePart.Members()
+	for member := range members.Iterator() {
+		switch member := member.(type) {
+		case *tree.FunctionDefinition:
+			resourcePath := member.RelativeResourcePath()
+			if resourcePath.Size() == 0 && hasPublicQualifier(member.QualifierList()) {
+				moduleDoc.Functions = append(moduleDoc.Functions, g.createFunctionDoc(member))
+			}
+		case *tree.TypeDefinitionNode:
+			if isPublic(member.VisibilityQualifier()) {
+				moduleDoc.Types = append(moduleDoc.Types, g.createTypeDoc(member))
+			}
+		case *tree.ConstantDeclarationNode:
+			if isPublic(member.VisibilityQualifier()) {
+				moduleDoc.Constants = append(moduleDoc.Constants, g.createConstantDoc(member))
+			}
+		}
+	}
+	return moduleDoc, g.warnings
+}
+
+// WriteJSON writes the documentation as indented JSON.
+func WriteJSON(w io.Writer, moduleDoc *ModuleDoc) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(moduleDoc)
+}
+
+type generator struct {
+	nodeBuilder *ast.NodeBuilder
+	fileName    string
+	source      string
+	warnings    []Warning
+}
+
+func (g *generator) createFunctionDoc(function *tree.FunctionDefinition) FunctionDoc {
+	name := function.FunctionName().Text()
+	docAttachment, documented := g.getDocAttachment(function.Metadata())
+	functionDoc := FunctionDoc{SymbolDoc: g.createSymbolDoc(name, "function", docAttachment, documented, function.FunctionName())}
+
+	signature := function.FunctionSignature()
+	paramDescriptions := make(map[string]string)
+	for _, param := range docAttachment.Parameters {
+		paramDescriptions[*param.Name] = *param.Description
+	}
+	deprecatedParamDescriptions := make(map[string]string)
+	for _, param := range docAttachment.DeprecatedParameters {
+		deprecatedParamDescriptions[*param.Name] = *param.Description
+	}
+
+	paramNames := make(map[string]bool)
+	parameters := signature.Parameters()
+	for param := range parameters.Iterator() {
+		paramDoc, ok := createParameterDoc(param)
+		if !ok {
+			continue
+		}
+		paramNames[paramDoc.Name] = true
+		if description, deprecated := deprecatedParamDescriptions[paramDoc.Name]; deprecated {
+			paramDoc.Description = description
+			paramDoc.Deprecated = true
+		} else if description, ok := paramDescriptions[paramDoc.Name]; ok {
+			paramDoc.Description = description
+		} else if documented {
+			g.addWarning(param, "undocumented parameter '%s' in function '%s'", paramDoc.Name, name)
+		}
+		functionDoc.Parameters = append(functionDoc.Parameters, paramDoc)
+	}
+	if functionDoc.Parameters == nil {
+		functionDoc.Parameters = []ParameterDoc{}
+	}
+	for _, params := range [][]model.Parameters{docAttachment.Parameters, docAttachment.DeprecatedParameters} {
+		for _, param := range params {
+			if !paramNames[*param.Name] {
+				g.addWarning(function.FunctionName(), "no such documentable parameter '%s' in function '%s'",
+					*param.Name, name)
+			}
+		}
+	}
+
+	if returnTypeDesc := signature.ReturnTypeDesc(); returnTypeDesc != nil {
+		functionDoc.ReturnType = sourceText(returnTypeDesc.Type())
+		if docAttachment.ReturnValueDescription != nil {
+			functionDoc.ReturnDescription = *docAttachment.ReturnValueDescription
+		} else if documented {
+			g.addWarning(returnTypeDesc, "undocumented return value in function '%s'", name)
+		}
+	} else if docAttachment.ReturnValueDescription != nil {
+		g.addWarning(function.FunctionName(), "no documentable return value in function '%s'", name)
+	}
+	return functionDoc
+}
+
+func createParameterDoc(param tree.Node) (ParameterDoc, bool) {
+	switch param := param.(type) {
+	case *tree.RequiredParameterNode:
+		return ParameterDoc{Name: tokenText(param.ParamName()), Type: sourceText(param.TypeName())}, true
+	case *tree.DefaultableParameterNode:
+		return ParameterDoc{
+			Name:         tokenText(param.ParamName()),
+			Type:         sourceText(param.TypeName()),
+			DefaultValue: sourceText(param.Expression()),
+		}, true
+	case *tree.IncludedRecordParameterNode:
+		return ParameterDoc{Name: tokenText(param.ParamName()), Type: "*" + sourceText(param.TypeName())}, true
+	case *tree.RestParameterNode:
+		return ParameterDoc{Name: tokenText(param.ParamName()), Type: sourceText(param.TypeName()) + "..."}, true
+	default:
+		// Separators
+		return ParameterDoc{}, false
+	}
+}
+
+func (g *generator) createTypeDoc(typeDefinition *tree.TypeDefinitionNode) TypeDoc {
+	name := typeDefinition.TypeName().Text()
+	docAttachment, documented := g.getDocAttachment(typeDefinition.Metadata())
+	return TypeDoc{
+		SymbolDoc:  g.createSymbolDoc(name, "type", docAttachment, documented, typeDefinition.TypeName()),
+		Descriptor: sourceText(typeDefinition.TypeDescriptor()),
+	}
+}
+
+func (g *generator) createConstantDoc(constant *tree.ConstantDeclarationNode) ConstantDoc {
+	name := constant.VariableName().Text()
+	docAttachment, documented := g.getDocAttachment(constant.Metadata())
+	constantDoc := ConstantDoc{
+		SymbolDoc: g.createSymbolDoc(name, "constant", docAttachment, documented, constant.VariableName()),
+		Value:     sourceText(constant.Initializer()),
+	}
+	if typeDescriptor := constant.TypeDescriptor(); typeDescriptor != nil {
+		constantDoc.Type = sourceText(typeDescriptor)
+	}
+	return constantDoc
+}
+
+func (g *generator) createSymbolDoc(name string, symbolKind string, docAttachment model.MarkdownDocAttachment, documented bool, nameNode tree.Node) SymbolDoc {
+	symbolDoc := SymbolDoc{Name: name}
+	if docAttachment.Description != nil {
+		symbolDoc.Description = strings.TrimSpace(*docAttachment.Description)
+	}
+	if !documented || symbolDoc.Description == "" {
+		g.addWarning(nameNode, "undocumented public %s '%s'", symbolKind, name)
+	}
+	if docAttachment.DeprecatedDocumentation != nil {
+		symbolDoc.Deprecated = true
+		symbolDoc.DeprecationDescription = strings.TrimSpace(*docAttachment.DeprecatedDocumentation)
+	}
+	return symbolDoc
+}
+
+// getDocAttachment returns the documentation in the metadata, and whether there was any.
+func (g *generator) getDocAttachment(metadata *tree.MetadataNode) (model.MarkdownDocAttachment, bool) {
+	if metadata == nil {
+		return model.MarkdownDocAttachment{}, false
+	}
+	documentationString, ok := metadata.DocumentationString().(*tree.MarkdownDocumentationNode)
+	if !ok {
+		return model.MarkdownDocAttachment{}, false
+	}
+	doc := g.nodeBuilder.TransformMarkdownDocumentation(documentationString).(*ast.BLangMarkdownDocumentation)
+	return doc.GetDocAttachment(), true
+}
+
+func (g *generator) addWarning(node tree.Node, format string, args ...any) {
+	leadingMinutiaeWidth := int(node.InternalNode().WidthWithLeadingMinutiae() - node.InternalNode().Width())
+	offset := min(node.Position()+leadingMinutiaeWidth, len(g.source))
+	g.warnings = append(g.warnings, Warning{
+		FileName: g.fileName,
+		Line:     strings.Count(g.source[:offset], "\n") + 1,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func hasPublicQualifier(qualifiers tree.NodeList[tree.Token]) bool {
+	for qualifier := range qualifiers.Iterator() {
+		if isPublic(qualifier) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPublic(qualifier tree.Token) bool {
+	return qualifier != nil && qualifier.Kind() == common.PUBLIC_KEYWORD
+}
+
+func sourceText(node tree.Node) string {
+	if node == nil {
+		return ""
+	}
+	return strings.TrimSpace(tree.ToSourceCode(node.InternalNode()))
+}
+
+func tokenText(token tree.Token) string {
+	if token == nil || token.IsMissing() {
+		return ""
+	}
+	return token.Text()
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package docgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"ballerina-lang-go/context"
+)
+
+const testSource = `# Adds two integers.
+#
+# Uses ` + "`int:sum()`" + ` internally.
+# + a - the first operand
+# + b - the second operand
+# + c - not a parameter
+# + return - the sum
+public function add(int a, int b = 2) returns int {
+    return a + b;
+}
+
+public function undocumented(int x) returns int {
+    return x;
+}
+
+# Old function.
+#
+# # Deprecated
+# Use ` + "`add()`" + ` instead.
+# # Deprecated parameters
+# + x - do not use
+public function old(int x, string y) {
+}
+
+# Maximum value.
+public const int MAX = 10;
+
+public const MIN = 0;
+
+# Not part of the API.
+function helper() {
+}
+`
+
+func generateTestDoc(t *testing.T) (*ModuleDoc, []Warning) {
+	t.Helper()
+	fileName := filepath.Join(t.TempDir(), "math.bal")
+	if err := os.WriteFile(fileName, []byte(testSource), 0o644); err != nil {
+		t.Fatalf("failed to write source file: %v", err)
+	}
+	moduleDoc, warnings, err := GenerateFromFile(context.NewCompilerContext(), fileName)
+	if err != nil {
+		t.Fatalf("failed to generate docs: %v", err)
+	}
+	return moduleDoc, warnings
+}
+
+func TestGenerate(t *testing.T) {
+	moduleDoc, _ := generateTestDoc(t)
+	if moduleDoc.Name != "math" {
+		t.Errorf("expected module name 'math', got '%s'", moduleDoc.Name)
+	}
+
+	var functionNames []string
+	for _, function := range moduleDoc.Functions {
+		functionNames = append(functionNames, function.Name)
+	}
+	if !slices.Equal(functionNames, []string{"add", "undocumented", "old"}) {
+		t.Fatalf("unexpected public functions %v", functionNames)
+	}
+
+	add := moduleDoc.Functions[0]
+	if add.Description != "Adds two integers.\n\nUses `int:sum()` internally." {
+		t.Errorf("unexpected description %q", add.Description)
+	}
+	expectedParams := []ParameterDoc{
+		{Name: "a", Type: "int", Description: "the first operand"},
+		{Name: "b", Type: "int", DefaultValue: "2", Description: "the second operand"},
+	}
+	if !slices.Equal(add.Parameters, expectedParams) {
+		t.Errorf("expected parameters %v, got %v", expectedParams, add.Parameters)
+	}
+	if add.ReturnType != "int" || add.ReturnDescription != "the sum" {
+		t.Errorf("unexpected return type '%s' with description '%s'", add.ReturnType, add.ReturnDescription)
+	}
+
+	old := moduleDoc.Functions[2]
+	if !old.Deprecated || old.DeprecationDescription != "Use `add()` instead." {
+		t.Errorf("expected 'old' to be deprecated, got %v with description %q", old.Deprecated,
+			old.DeprecationDescription)
+	}
+	if !old.Parameters[0].Deprecated || old.Parameters[0].Description != "do not use" {
+		t.Errorf("expected parameter 'x' to be deprecated, got %v", old.Parameters[0])
+	}
+
+	expectedConstants := []ConstantDoc{
+		{SymbolDoc: SymbolDoc{Name: "MAX", Description: "Maximum value."}, Type: "int", Value: "10"},
+		{SymbolDoc: SymbolDoc{Name: "MIN"}, Value: "0"},
+	}
+	if !slices.Equal(moduleDoc.Constants, expectedConstants) {
+		t.Errorf("expected constants %v, got %v", expectedConstants, moduleDoc.Constants)
+	}
+}
+
+func TestGenerateWarnings(t *testing.T) {
+	_, warnings := generateTestDoc(t)
+	expected := []Warning{
+		{FileName: "math.bal", Line: 8, Message: "no such documentable parameter 'c' in function 'add'"},
+		{FileName: "math.bal", Line: 12, Message: "undocumented public function 'undocumented'"},
+		{FileName: "math.bal", Line: 22, Message: "undocumented parameter 'y' in function 'old'"},
+		{FileName: "math.bal", Line: 28, Message: "undocumented public constant 'MIN'"},
+	}
+	if !slices.Equal(warnings, expected) {
+		t.Errorf("expected warnings %v, got %v", expected, warnings)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	moduleDoc, _ := generateTestDoc(t)
+
+	var jsonOut bytes.Buffer
+	if err := WriteJSON(&jsonOut, moduleDoc); err != nil {
+		t.Fatalf("failed to write JSON: %v", err)
+	}
+	var decoded ModuleDoc
+	if err := json.Unmarshal(jsonOut.Bytes(), &decoded); err != nil {
+		t.Fatalf("failed to read back JSON: %v", err)
+	}
+	if len(decoded.Functions) != len(moduleDoc.Functions) || decoded.Functions[0].Parameters[1].DefaultValue != "2" {
+		t.Errorf("JSON does not round trip: %s", jsonOut.String())
+	}
+
+	var htmlOut bytes.Buffer
+	if err := WriteHTML(&htmlOut, moduleDoc); err != nil {
+		t.Fatalf("failed to write HTML: %v", err)
+	}
+	for _, expected := range []string{"<h1>Module math</h1>", `id="function-add"`, "add(int a, int b) returns int",
+		"Use `add()` instead.", `id="constant-MAX"`} {
+		if !strings.Contains(htmlOut.String(), expected) {
+			t.Errorf("expected HTML to contain %q", expected)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package docgen
+
+import (
+	"html/template"
+	"io"
+)
+
+// Descriptions are rendered as preformatted text since the markdown in them is not interpreted yet.
+const moduleTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Name}} - API Documentation</title>
+<style>
+body { font-family: sans-serif; margin: 2em auto; max-width: 60em; color: #222; }
+code, .signature { font-family: monospace; }
+.description { white-space: pre-wrap; }
+.deprecated { color: #a33; }
+.symbol { border-top: 1px solid #ddd; padding: 0.5em 0; }
+table { border-collapse: collapse; }
+td, th { text-align: left; padding: 0.2em 1em 0.2em 0; vertical-align: top; }
+</style>
+</head>
+<body>
+<h1>Module {{.Name}}</h1>
+{{- if .Functions}}
+<h2>Functions</h2>
+{{- range .Functions}}
+<div class="symbol" id="function-{{.Name}}">
+<h3 class="signature">{{.Name}}({{range $i, $p := .Parameters}}{{if $i}}, {{end}}{{$p.Type}} {{$p.Name}}{{end}}){{if .ReturnType}} returns {{.ReturnType}}{{end}}</h3>
+{{- template "symbol" .SymbolDoc}}
+{{- if .Parameters}}
+<h4>Parameters</h4>
+<table>
+{{- range .Parameters}}
+<tr><td><code>{{.Name}}</code></td><td><code>{{.Type}}</code>{{if .DefaultValue}} (default <code>{{.DefaultValue}}</code>){{end}}</td><td class="description{{if .Deprecated}} deprecated{{end}}">{{.Description}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if .ReturnType}}
+<h4>Return Type</h4>
+<p><code>{{.ReturnType}}</code></p>
+{{- if .ReturnDescription}}
+<p class="description">{{.ReturnDescription}}</p>
+{{- end}}
+{{- end}}
+</div>
+{{- end}}
+{{- end}}
+{{- if .Types}}
+<h2>Types</h2>
+{{- range .Types}}
+<div class="symbol" id="type-{{.Name}}">
+<h3 class="signature">{{.Name}}</h3>
+{{- template "symbol" .SymbolDoc}}
+<pre><code>{{.Descriptor}}</code></pre>
+</div>
+{{- end}}
+{{- end}}
+{{- if .Constants}}
+<h2>Constants</h2>
+{{- range .Constants}}
+<div class="symbol" id="constant-{{.Name}}">
+<h3 class="signature">{{if .Type}}{{.Type}} {{end}}{{.Name}} = {{.Value}}</h3>
+{{- template "symbol" .SymbolDoc}}
+</div>
+{{- end}}
+{{- end}}
+</body>
+</html>
+{{define "symbol"}}
+{{- if .Deprecated}}
+<p class="deprecated"><strong>Deprecated</strong></p>
+{{- if .DeprecationDescription}}
+<p class="description deprecated">{{.DeprecationDescription}}</p>
+{{- end}}
+{{- end}}
+{{- if .Description}}
+<p class="description">{{.Description}}</p>
+{{- end}}
+{{- end}}
+`
+
+var moduleHTMLTemplate = template.Must(template.New("module").Parse(moduleTemplate))
+
+// WriteHTML writes the documentation as a single static HTML page.
+func WriteHTML(w io.Writer, moduleDoc *ModuleDoc) error {
+	return moduleHTMLTemplate.Execute(w, moduleDoc)
+}
@@ -14,7 +14,9 @@ require (
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/sergi/go-diff v1.4.0 // indirect
 	github.com/spf13/pflag v1.0.9 // indirect
-	golang.org/x/text v0.28.0 // indirect
 )
 
-require github.com/kaitai-io/kaitai_struct_go_runtime v0.11.0
+require (
+	github.com/kaitai-io/kaitai_struct_go_runtime v0.11.0
+	golang.org/x/text v0.28.0
+)
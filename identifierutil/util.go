@@ -19,6 +19,7 @@
 package identifierutil
 
 import (
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -257,3 +258,57 @@ func EncodeNonFunctionIdentifier(identifierString string) string {
 	identifierString = encodeIdentifier(identifierString)
 	return encodeGeneratedName(identifierString).name
 }
+
+// reservedWords are the keywords that can't be used as identifiers without a quote
+var reservedWords = map[string]bool{
+	"public": true, "private": true, "function": true, "return": true, "returns": true, "external": true,
+	"type": true, "record": true, "object": true, "remote": true, "abstract": true, "client": true, "if": true,
+	"else": true, "while": true, "panic": true, "true": true, "false": true, "check": true, "fail": true,
+	"checkpanic": true, "continue": true, "break": true, "import": true, "as": true, "on": true, "resource": true,
+	"listener": true, "const": true, "final": true, "typeof": true, "is": true, "null": true, "lock": true,
+	"annotation": true, "source": true, "worker": true, "parameter": true, "field": true, "isolated": true,
+	"xmlns": true, "fork": true, "trap": true, "in": true, "foreach": true, "table": true, "key": true,
+	"error": true, "let": true, "stream": true, "new": true, "readonly": true, "distinct": true, "from": true,
+	"where": true, "select": true, "start": true, "flush": true, "default": true, "wait": true, "do": true,
+	"transaction": true, "transactional": true, "commit": true, "retry": true, "rollback": true, "enum": true,
+	"base16": true, "base64": true, "match": true, "conflict": true, "limit": true, "join": true, "outer": true,
+	"equals": true, "order": true, "by": true, "ascending": true, "descending": true, "class": true,
+	"configurable": true, "natural": true, "int": true, "float": true, "string": true, "boolean": true,
+	"decimal": true, "xml": true, "json": true, "handle": true, "any": true, "anydata": true, "service": true,
+	"var": true, "never": true, "map": true, "future": true, "typedesc": true, "byte": true,
+}
+
+// IsPlainIdentifier reports whether name can be written as an identifier without a quote.
+func IsPlainIdentifier(name string) bool {
+	if name == "" || reservedWords[name] {
+		return false
+	}
+	for i, r := range name {
+		if !(r == '_' || r < unicode.MaxASCII && unicode.IsLetter(r) || i > 0 && r < unicode.MaxASCII && unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// QuoteIdentifier returns name written as an identifier, which is quoted with a leading `'` and has its special
+// characters escaped unless it is a plain identifier.
+func QuoteIdentifier(name string) string {
+	if IsPlainIdentifier(name) {
+		return name
+	}
+	var sb strings.Builder
+	sb.WriteByte('\'')
+	for _, r := range name {
+		switch {
+		case r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r):
+			sb.WriteRune(r)
+		case r < unicode.MaxASCII && unicode.IsPrint(r):
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		default:
+			fmt.Fprintf(&sb, `\u{%X}`, r)
+		}
+	}
+	return sb.String()
+}
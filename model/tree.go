@@ -554,6 +554,19 @@ func OperatorKind_valueFrom(opValue string) OperatorKind {
 
 type DocumentationReferenceType string
 
+const (
+	DocumentationReferenceType_TYPE             DocumentationReferenceType = "type"
+	DocumentationReferenceType_SERVICE          DocumentationReferenceType = "service"
+	DocumentationReferenceType_VARIABLE         DocumentationReferenceType = "variable"
+	DocumentationReferenceType_VAR              DocumentationReferenceType = "var"
+	DocumentationReferenceType_ANNOTATION       DocumentationReferenceType = "annotation"
+	DocumentationReferenceType_MODULE           DocumentationReferenceType = "module"
+	DocumentationReferenceType_FUNCTION         DocumentationReferenceType = "function"
+	DocumentationReferenceType_PARAMETER        DocumentationReferenceType = "parameter"
+	DocumentationReferenceType_CONST            DocumentationReferenceType = "const"
+	DocumentationReferenceType_BACKTICK_CONTENT DocumentationReferenceType = "`"
+)
+
 // Core/Base Interfaces
 
 type Node interface {
@@ -760,6 +773,30 @@ type FiniteTypeNode interface {
 	AddValue(value ExpressionNode)
 }
 
+type ConstrainedTypeNode interface {
+	ReferenceTypeNode
+	GetType() TypeNode
+	GetConstraint() TypeNode
+}
+
+type UnionTypeNode interface {
+	ReferenceTypeNode
+	GetMemberTypeNodes() []TypeNode
+	AddMemberTypeNode(typeNode TypeNode)
+}
+
+type IntersectionTypeNode interface {
+	ReferenceTypeNode
+	GetConstituentTypeNodes() []TypeNode
+	AddConstituentTypeNode(typeNode TypeNode)
+}
+
+type TupleTypeNode interface {
+	ReferenceTypeNode
+	GetMemberTypeNodes() []TypeNode
+	GetRestParamType() TypeNode
+}
+
 type UserDefinedTypeNode interface {
 	ReferenceTypeNode
 	GetPackageAlias() IdentifierNode
@@ -824,6 +861,13 @@ type LiteralNode interface {
 	SetIsConstant(isConstant bool)
 }
 
+type TernaryExpressionNode interface {
+	ExpressionNode
+	GetCondition() ExpressionNode
+	GetThenExpression() ExpressionNode
+	GetElseExpression() ExpressionNode
+}
+
 type ElvisExpressionNode interface {
 	GetLeftExpression() ExpressionNode
 	GetRightExpression() ExpressionNode
@@ -929,6 +973,68 @@ type TypedescExpressionNode interface {
 
 type DynamicArgNode = ExpressionNode
 
+type StringTemplateLiteralNode interface {
+	ExpressionNode
+	GetExpressions() []ExpressionNode
+}
+
+type RawTemplateLiteralNode interface {
+	ExpressionNode
+	GetStrings() []LiteralNode
+	GetInsertions() []ExpressionNode
+}
+
+type XMLQNameNode interface {
+	ExpressionNode
+	GetPrefix() IdentifierNode
+	GetLocalname() IdentifierNode
+}
+
+type XMLAttributeNode interface {
+	ExpressionNode
+	GetName() ExpressionNode
+	GetValue() ExpressionNode
+}
+
+type XMLQuotedStringNode interface {
+	ExpressionNode
+	GetTextFragments() []ExpressionNode
+}
+
+type XMLElementLiteralNode interface {
+	ExpressionNode
+	GetStartTagName() ExpressionNode
+	GetEndTagName() ExpressionNode
+	GetAttributes() []XMLAttributeNode
+	GetContent() []ExpressionNode
+}
+
+type XMLTextLiteralNode interface {
+	ExpressionNode
+	GetTextFragments() []ExpressionNode
+}
+
+type XMLCommentLiteralNode interface {
+	ExpressionNode
+	GetTextFragments() []ExpressionNode
+}
+
+type XMLProcessingInstructionLiteralNode interface {
+	ExpressionNode
+	GetTarget() ExpressionNode
+	GetDataTextFragments() []ExpressionNode
+}
+
+type XMLSequenceLiteralNode interface {
+	ExpressionNode
+	GetXMLItems() []ExpressionNode
+}
+
+type RegExpTemplateLiteralNode interface {
+	ExpressionNode
+	GetReDisjunction() ExpressionNode
+}
+
 // Statement Interfaces
 
 type StatementNode = Node
@@ -685,7 +685,7 @@ var syntaxKind = []string{
 	"@",
 	"#",
 	"`",
-	"",
+	"\"",
 	"'",
 	"``",
 	"```",
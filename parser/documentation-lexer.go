@@ -0,0 +1,443 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package parser
+
+import (
+	"ballerina-lang-go/parser/common"
+	"ballerina-lang-go/parser/tree"
+	"ballerina-lang-go/tools/text"
+	"unicode"
+)
+
+const deprecatedDocHeader = "Deprecated"
+
+// docReferenceTypes are the keywords that can precede a backtick quoted name reference in documentation,
+// e.g. function `foo`.
+var docReferenceTypes = map[string]common.SyntaxKind{
+	"type":       common.TYPE_DOC_REFERENCE_TOKEN,
+	"service":    common.SERVICE_DOC_REFERENCE_TOKEN,
+	"variable":   common.VARIABLE_DOC_REFERENCE_TOKEN,
+	"var":        common.VAR_DOC_REFERENCE_TOKEN,
+	"annotation": common.ANNOTATION_DOC_REFERENCE_TOKEN,
+	"module":     common.MODULE_DOC_REFERENCE_TOKEN,
+	"function":   common.FUNCTION_DOC_REFERENCE_TOKEN,
+	"parameter":  common.PARAMETER_DOC_REFERENCE_TOKEN,
+	"const":      common.CONST_DOC_REFERENCE_TOKEN,
+}
+
+// NewDocumentationLexer creates a lexer that tokenizes the text of a DOCUMENTATION_STRING token. The leading trivia
+// and the diagnostics of the documentation string are carried over to the first token.
+//
+// Unlike the default mode, `//` is not a comment within documentation. Only whitespace and newlines are treated as
+// minutiae, and the whitespace within a description is part of the description.
+func NewDocumentationLexer(reader text.CharReader, leadingTriviaList []tree.STNode, diagnostics []tree.STNodeDiagnostic) *Lexer {
+	lexer := NewLexer(reader, nil)
+	lexer.StartMode(PARSER_MODE_DOC_LINE_START_HASH)
+	lexer.context.leadingTriviaList = leadingTriviaList
+	lexer.context.diagnostics = diagnostics
+	return lexer
+}
+
+func (l *Lexer) readDocumentationToken() tree.STToken {
+	switch l.context.mode {
+	case PARSER_MODE_DOC_LINE_START_HASH:
+		l.processDocLeadingTrivia()
+		return l.readDocLineStartHashToken(PARSER_MODE_DOC_LINE_DIFFERENTIATOR)
+	case PARSER_MODE_DOC_LINE_DIFFERENTIATOR:
+		return l.readDocLineDifferentiatorToken()
+	case PARSER_MODE_DOC_INTERNAL:
+		return l.readDocInternalToken()
+	case PARSER_MODE_DOC_PARAMETER:
+		l.processDocLeadingTrivia()
+		return l.readDocParameterToken()
+	case PARSER_MODE_DOC_REFERENCE_TYPE:
+		return l.readDocReferenceTypeToken()
+	case PARSER_MODE_DOC_SINGLE_BACKTICK_CONTENT:
+		return l.readDocBacktickContentToken(1)
+	case PARSER_MODE_DOC_DOUBLE_BACKTICK_CONTENT:
+		return l.readDocBacktickContentToken(2)
+	case PARSER_MODE_DOC_TRIPLE_BACKTICK_CONTENT:
+		return l.readDocBacktickContentToken(3)
+	case PARSER_MODE_DOC_CODE_REF_END:
+		l.processDocLeadingTrivia()
+		return l.readDocCodeRefEndToken()
+	case PARSER_MODE_DOC_CODE_LINE_START_HASH:
+		l.processDocLeadingTrivia()
+		return l.readDocCodeLineStartHashToken()
+	case PARSER_MODE_DOC_CODE_LINE_CONTENT:
+		return l.readDocCodeLineContentToken()
+	default:
+		panic("readDocumentationToken: not a documentation mode")
+	}
+}
+
+func (l *Lexer) readDocLineStartHashToken(nextMode ParserMode) tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+	if reader.Peek() != HASH {
+		// Documentation string always starts a line with a hash. Anything else is read as a description.
+		l.SwitchMode(PARSER_MODE_DOC_INTERNAL)
+		return l.readDocInternalToken()
+	}
+	reader.Advance()
+	l.SwitchMode(nextMode)
+	return l.getSyntaxTokenWithoutTrailingTrivia(common.HASH_TOKEN)
+}
+
+// readDocLineDifferentiatorToken reads the token following the hash of a line, which decides the kind of the line.
+func (l *Lexer) readDocLineDifferentiatorToken() tree.STToken {
+	if l.processDocLeadingTrivia() {
+		// Line with only the hash
+		return l.readDocLineStartHashToken(PARSER_MODE_DOC_LINE_DIFFERENTIATOR)
+	}
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+
+	switch reader.Peek() {
+	case PLUS:
+		reader.Advance()
+		l.SwitchMode(PARSER_MODE_DOC_PARAMETER)
+		return l.getDocSyntaxToken(common.PLUS_TOKEN, PARSER_MODE_DOC_PARAMETER)
+	case HASH:
+		if l.isDeprecationHeaderAhead() {
+			reader.Advance()
+			l.advanceDocWhitespace()
+			reader.AdvanceN(len(deprecatedDocHeader))
+			return l.getDocLiteral(common.DEPRECATION_LITERAL, PARSER_MODE_DOC_INTERNAL)
+		}
+	case BACKTICK:
+		if reader.PeekN(1) == BACKTICK && reader.PeekN(2) == BACKTICK {
+			// Start of a code block. Rest of the line, if any, is the lang attribute.
+			reader.AdvanceN(3)
+			return l.getDocSyntaxToken(common.TRIPLE_BACKTICK_TOKEN, PARSER_MODE_DOC_CODE_LINE_CONTENT)
+		}
+	}
+	l.SwitchMode(PARSER_MODE_DOC_INTERNAL)
+	return l.readDocInternalToken()
+}
+
+// isDeprecationHeaderAhead checks for `# Deprecated` followed by whitespace or the end of the line.
+func (l *Lexer) isDeprecationHeaderAhead() bool {
+	reader := l.reader
+	lookAhead := 1
+	for c := reader.PeekN(lookAhead); c == SPACE || c == TAB; c = reader.PeekN(lookAhead) {
+		lookAhead++
+	}
+	for _, c := range deprecatedDocHeader {
+		if reader.PeekN(lookAhead) != c {
+			return false
+		}
+		lookAhead++
+	}
+	switch reader.PeekN(lookAhead) {
+	case SPACE, TAB, NEWLINE, CARRIAGE_RETURN, unicode.MaxRune:
+		return true
+	default:
+		return false
+	}
+}
+
+func (l *Lexer) advanceDocWhitespace() {
+	reader := l.reader
+	for c := reader.Peek(); c == SPACE || c == TAB; c = reader.Peek() {
+		reader.Advance()
+	}
+}
+
+// readDocInternalToken reads the description of a line. The description is broken at backticks and at the reference
+// types preceding a backtick, which are read as separate tokens.
+func (l *Lexer) readDocInternalToken() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+
+	if reader.Peek() == BACKTICK {
+		return l.readDocBacktickStartToken()
+	}
+	if _, ok := l.docReferenceTypeAhead(); ok {
+		l.SwitchMode(PARSER_MODE_DOC_REFERENCE_TYPE)
+		return l.readDocReferenceTypeToken()
+	}
+
+	atWordStart := false
+	for !reader.IsEOF() {
+		c := reader.Peek()
+		if c == NEWLINE || c == CARRIAGE_RETURN || c == BACKTICK {
+			break
+		}
+		if atWordStart {
+			if _, ok := l.docReferenceTypeAhead(); ok {
+				break
+			}
+		}
+		reader.Advance()
+		atWordStart = c == SPACE || c == TAB
+	}
+	return l.getDocLiteral(common.DOCUMENTATION_DESCRIPTION, PARSER_MODE_DOC_INTERNAL)
+}
+
+// docReferenceTypeAhead checks whether the upcoming word is a reference type followed by a backtick.
+func (l *Lexer) docReferenceTypeAhead() (common.SyntaxKind, bool) {
+	reader := l.reader
+	lookAhead := 0
+	var word []rune
+	for c := reader.PeekN(lookAhead); c >= 'a' && c <= 'z'; c = reader.PeekN(lookAhead) {
+		word = append(word, c)
+		lookAhead++
+	}
+	kind, ok := docReferenceTypes[string(word)]
+	if !ok {
+		return 0, false
+	}
+	for c := reader.PeekN(lookAhead); c == SPACE || c == TAB; c = reader.PeekN(lookAhead) {
+		lookAhead++
+	}
+	if reader.PeekN(lookAhead) != BACKTICK {
+		return 0, false
+	}
+	return kind, true
+}
+
+func (l *Lexer) readDocReferenceTypeToken() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	kind, ok := l.docReferenceTypeAhead()
+	if !ok {
+		l.SwitchMode(PARSER_MODE_DOC_INTERNAL)
+		return l.readDocInternalToken()
+	}
+	for c := reader.Peek(); c >= 'a' && c <= 'z'; c = reader.Peek() {
+		reader.Advance()
+	}
+	l.SwitchMode(PARSER_MODE_DOC_INTERNAL)
+	return l.getDocSyntaxToken(kind, PARSER_MODE_DOC_INTERNAL)
+}
+
+// readDocBacktickStartToken reads the backticks starting an inline code or a name reference. Up to three backticks
+// are read as a single token, and the content is read in the matching mode.
+func (l *Lexer) readDocBacktickStartToken() tree.STToken {
+	reader := l.reader
+	reader.Advance()
+	if reader.Peek() != BACKTICK {
+		l.SwitchMode(PARSER_MODE_DOC_SINGLE_BACKTICK_CONTENT)
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.BACKTICK_TOKEN)
+	}
+	reader.Advance()
+	if reader.Peek() != BACKTICK {
+		l.SwitchMode(PARSER_MODE_DOC_DOUBLE_BACKTICK_CONTENT)
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.DOUBLE_BACKTICK_TOKEN)
+	}
+	reader.Advance()
+	l.SwitchMode(PARSER_MODE_DOC_TRIPLE_BACKTICK_CONTENT)
+	return l.getSyntaxTokenWithoutTrailingTrivia(common.TRIPLE_BACKTICK_TOKEN)
+}
+
+// readDocBacktickContentToken reads the content of an inline code till the given number of backticks or the end of
+// the line. Backticks fewer than the given count are part of the content.
+func (l *Lexer) readDocBacktickContentToken(backtickCount int) tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+	for !reader.IsEOF() {
+		c := reader.Peek()
+		if c == NEWLINE || c == CARRIAGE_RETURN {
+			// Unterminated inline code. Continue the rest of the documentation as usual.
+			return l.getDocLiteral(common.CODE_CONTENT, PARSER_MODE_DOC_INTERNAL)
+		}
+		if c == BACKTICK && l.isBacktickRunAhead(backtickCount) {
+			break
+		}
+		reader.Advance()
+	}
+	l.SwitchMode(PARSER_MODE_DOC_CODE_REF_END)
+	leadingTrivia := l.getLeadingTrivia()
+	return tree.CreateLiteralValueToken(common.CODE_CONTENT, l.getLexeme(), leadingTrivia, tree.CreateEmptyNodeList())
+}
+
+func (l *Lexer) isBacktickRunAhead(count int) bool {
+	for i := 0; i < count; i++ {
+		if l.reader.PeekN(i) != BACKTICK {
+			return false
+		}
+	}
+	return true
+}
+
+// readDocCodeRefEndToken reads the backticks ending an inline code.
+func (l *Lexer) readDocCodeRefEndToken() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+	kind := common.BACKTICK_TOKEN
+	reader.Advance()
+	if reader.Peek() == BACKTICK {
+		reader.Advance()
+		kind = common.DOUBLE_BACKTICK_TOKEN
+		if reader.Peek() == BACKTICK {
+			reader.Advance()
+			kind = common.TRIPLE_BACKTICK_TOKEN
+		}
+	}
+	l.SwitchMode(PARSER_MODE_DOC_INTERNAL)
+	return l.getDocSyntaxToken(kind, PARSER_MODE_DOC_INTERNAL)
+}
+
+// readDocParameterToken reads the parameter name and the following minus of a parameter documentation line.
+func (l *Lexer) readDocParameterToken() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+
+	c := reader.Peek()
+	if c == MINUS {
+		reader.Advance()
+		l.SwitchMode(PARSER_MODE_DOC_INTERNAL)
+		return l.getDocSyntaxToken(common.MINUS_TOKEN, PARSER_MODE_DOC_INTERNAL)
+	}
+	if c != SINGLE_QUOTE && c != BACKSLASH && !isIdentifierInitialChar(c) {
+		l.SwitchMode(PARSER_MODE_DOC_INTERNAL)
+		return l.readDocInternalToken()
+	}
+	if c == SINGLE_QUOTE {
+		reader.Advance()
+	}
+	for !reader.IsEOF() {
+		c = reader.Peek()
+		if c == BACKSLASH {
+			reader.AdvanceN(2)
+			continue
+		}
+		if !isIdentifierFollowingChar(c) {
+			break
+		}
+		reader.Advance()
+	}
+	if l.getLexeme() == "return" {
+		return l.getDocSyntaxToken(common.RETURN_KEYWORD, PARSER_MODE_DOC_PARAMETER)
+	}
+	return l.getDocLiteral(common.PARAMETER_NAME, PARSER_MODE_DOC_PARAMETER)
+}
+
+// readDocCodeLineStartHashToken reads the hash starting a line within a code block. If the line closes the code
+// block, the closing backticks are read next.
+func (l *Lexer) readDocCodeLineStartHashToken() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+	if reader.Peek() != HASH {
+		l.SwitchMode(PARSER_MODE_DOC_CODE_LINE_CONTENT)
+		return l.readDocCodeLineContentToken()
+	}
+	reader.Advance()
+	lookAhead := 0
+	for c := reader.PeekN(lookAhead); c == SPACE || c == TAB; c = reader.PeekN(lookAhead) {
+		lookAhead++
+	}
+	if reader.PeekN(lookAhead) == BACKTICK && reader.PeekN(lookAhead+1) == BACKTICK &&
+		reader.PeekN(lookAhead+2) == BACKTICK {
+		l.SwitchMode(PARSER_MODE_DOC_CODE_REF_END)
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.HASH_TOKEN)
+	}
+	l.SwitchMode(PARSER_MODE_DOC_CODE_LINE_CONTENT)
+	return l.getSyntaxTokenWithoutTrailingTrivia(common.HASH_TOKEN)
+}
+
+// readDocCodeLineContentToken reads the rest of the line within a code block, including the leading whitespace.
+func (l *Lexer) readDocCodeLineContentToken() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+	for !reader.IsEOF() {
+		c := reader.Peek()
+		if c == NEWLINE || c == CARRIAGE_RETURN {
+			break
+		}
+		reader.Advance()
+	}
+	return l.getDocLiteral(common.CODE_CONTENT, PARSER_MODE_DOC_CODE_LINE_CONTENT)
+}
+
+// getDocSyntaxToken creates a token with the trailing whitespace and newline as the trailing minutiae. The lexer
+// continues in the given mode, unless the line ends with the token, in which case it moves on to the next line.
+func (l *Lexer) getDocSyntaxToken(kind common.SyntaxKind, nextMode ParserMode) tree.STToken {
+	leadingTrivia := l.getLeadingTrivia()
+	trailingTrivia := l.processDocTrailingTrivia(nextMode)
+	return tree.CreateTokenFrom(kind, leadingTrivia, trailingTrivia)
+}
+
+func (l *Lexer) getDocLiteral(kind common.SyntaxKind, nextMode ParserMode) tree.STToken {
+	leadingTrivia := l.getLeadingTrivia()
+	lexeme := l.getLexeme()
+	trailingTrivia := l.processDocTrailingTrivia(nextMode)
+	return tree.CreateLiteralValueToken(kind, lexeme, leadingTrivia, trailingTrivia)
+}
+
+func (l *Lexer) processDocTrailingTrivia(nextMode ParserMode) tree.STNode {
+	triviaList := make([]tree.STNode, 0, INITIAL_TRIVIA_CAPACITY)
+	if !l.processDocSyntaxTrivia(&triviaList, false) {
+		l.SwitchMode(nextMode)
+	} else if nextMode == PARSER_MODE_DOC_CODE_LINE_CONTENT {
+		l.SwitchMode(PARSER_MODE_DOC_CODE_LINE_START_HASH)
+	} else {
+		l.SwitchMode(PARSER_MODE_DOC_LINE_START_HASH)
+	}
+	return tree.CreateNodeList(triviaList...)
+}
+
+// processDocLeadingTrivia reads the leading whitespace and newlines, and reports whether a newline was read.
+func (l *Lexer) processDocLeadingTrivia() bool {
+	return l.processDocSyntaxTrivia(&l.context.leadingTriviaList, true)
+}
+
+func (l *Lexer) processDocSyntaxTrivia(triviaList *[]tree.STNode, isLeading bool) bool {
+	reader := l.reader
+	hasNewline := false
+	for !reader.IsEOF() {
+		reader.Mark()
+		switch reader.Peek() {
+		case SPACE, TAB, FORM_FEED:
+			*triviaList = append(*triviaList, l.processWhitespaces())
+		case CARRIAGE_RETURN, NEWLINE:
+			*triviaList = append(*triviaList, l.processEndOfLine())
+			hasNewline = true
+			if !isLeading {
+				return hasNewline
+			}
+		default:
+			return hasNewline
+		}
+	}
+	return hasNewline
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package parser
+
+import (
+	debugcommon "ballerina-lang-go/common"
+	"ballerina-lang-go/parser/common"
+	"ballerina-lang-go/parser/tree"
+	"ballerina-lang-go/tools/diagnostics"
+	"regexp"
+	"strings"
+)
+
+// nameReferencePattern matches the content of a backtick quoted ballerina name reference, i.e. an optionally module
+// qualified identifier, optionally followed by a method name and/or a call.
+var nameReferencePattern = regexp.MustCompile(`^([\p{L}_][\p{L}\p{N}_]*:)?'?[\p{L}_][\p{L}\p{N}_]*(\.'?[\p{L}_][\p{L}\p{N}_]*)?(\(\))?$`)
+
+// DocumentationParser parses the lines of a documentation string. Since documentation is not part of the program
+// semantics, malformed documentation is recovered by inserting the missing tokens with warnings rather than errors.
+type DocumentationParser struct {
+	abstractParser
+}
+
+func NewDocumentationParserFromTokenReader(tokenReader *TokenReader, dbgCtx *debugcommon.DebugContext) DocumentationParser {
+	return DocumentationParser{
+		abstractParser: NewAbstractParserFromTokenReader(tokenReader, dbgCtx),
+	}
+}
+
+// Parse returns the list of documentation lines.
+func (this *DocumentationParser) Parse() tree.STNode {
+	var docLines []tree.STNode
+	for this.peek().Kind() != common.EOF_TOKEN {
+		if this.peek().Kind() != common.HASH_TOKEN {
+			invalidToken := this.consume()
+			if len(docLines) == 0 {
+				this.addInvalidNodeToNextToken(invalidToken, &common.WARNING_MISSING_HASH_TOKEN)
+			} else {
+				docLines[len(docLines)-1] = tree.CloneWithTrailingInvalidNodeMinutiae(docLines[len(docLines)-1],
+					invalidToken, &common.WARNING_MISSING_HASH_TOKEN)
+			}
+			continue
+		}
+		docLines = append(docLines, this.parseDocumentationLine())
+	}
+	if len(docLines) > 0 {
+		docLines[len(docLines)-1] = this.addInvalidNodeStackToTrailingMinutiae(docLines[len(docLines)-1])
+	}
+	return tree.CreateNodeList(docLines...)
+}
+
+func (this *DocumentationParser) parseDocumentationLine() tree.STNode {
+	hashToken := this.consume()
+	switch this.peek().Kind() {
+	case common.PLUS_TOKEN:
+		return this.parseParameterDocumentationLine(hashToken)
+	case common.DEPRECATION_LITERAL:
+		deprecationLiteral := this.consume()
+		docElements := append([]tree.STNode{deprecationLiteral}, this.parseDocumentationElements()...)
+		return tree.CreateMarkdownDocumentationLineNode(common.MARKDOWN_DEPRECATION_DOCUMENTATION_LINE, hashToken,
+			tree.CreateNodeList(docElements...))
+	case common.TRIPLE_BACKTICK_TOKEN:
+		return this.parseCodeBlock(hashToken)
+	}
+
+	docElements := this.parseDocumentationElements()
+	kind := common.MARKDOWN_REFERENCE_DOCUMENTATION_LINE
+	if len(docElements) == 0 || (len(docElements) == 1 && docElements[0].Kind() == common.DOCUMENTATION_DESCRIPTION) {
+		kind = common.MARKDOWN_DOCUMENTATION_LINE
+	}
+	return tree.CreateMarkdownDocumentationLineNode(kind, hashToken, tree.CreateNodeList(docElements...))
+}
+
+// parseParameterDocumentationLine parses `# + name - description`. A `return` in place of the parameter name makes it
+// the documentation of the return value.
+func (this *DocumentationParser) parseParameterDocumentationLine(hashToken tree.STNode) tree.STNode {
+	plusToken := this.consume()
+	var parameterName tree.STNode
+	kind := common.MARKDOWN_PARAMETER_DOCUMENTATION_LINE
+	switch this.peek().Kind() {
+	case common.PARAMETER_NAME:
+		parameterName = this.consume()
+	case common.RETURN_KEYWORD:
+		parameterName = this.consume()
+		kind = common.MARKDOWN_RETURN_PARAMETER_DOCUMENTATION_LINE
+	default:
+		parameterName = tree.CreateMissingTokenWithDiagnostics(common.PARAMETER_NAME,
+			&common.WARNING_MISSING_PARAMETER_NAME)
+	}
+	minusToken := this.parseExpectedToken(common.MINUS_TOKEN, &common.WARNING_MISSING_HYPHEN_TOKEN)
+	docElements := this.parseDocumentationElements()
+	return tree.CreateMarkdownParameterDocumentationLineNode(kind, hashToken, plusToken, parameterName, minusToken,
+		tree.CreateNodeList(docElements...))
+}
+
+// parseDocumentationElements parses the rest of the line as descriptions, inline codes and name references.
+func (this *DocumentationParser) parseDocumentationElements() []tree.STNode {
+	var docElements []tree.STNode
+	for {
+		switch this.peek().Kind() {
+		case common.EOF_TOKEN, common.HASH_TOKEN:
+			return docElements
+		case common.DOCUMENTATION_DESCRIPTION:
+			docElements = append(docElements, this.consume())
+		case common.BACKTICK_TOKEN, common.DOUBLE_BACKTICK_TOKEN, common.TRIPLE_BACKTICK_TOKEN:
+			docElements = append(docElements, this.parseBacktickReference(tree.CreateEmptyNode()))
+		default:
+			if isDocReferenceTypeToken(this.peek().Kind()) {
+				referenceType := this.consume()
+				docElements = append(docElements, this.parseBacktickReference(referenceType))
+				continue
+			}
+			invalidToken := this.consume()
+			this.addInvalidNodeToNextToken(invalidToken, &common.WARNING_INVALID_BALLERINA_NAME_REFERENCE,
+				invalidToken.Text())
+		}
+	}
+}
+
+func isDocReferenceTypeToken(kind common.SyntaxKind) bool {
+	return kind >= common.TYPE_DOC_REFERENCE_TOKEN && kind <= common.CONST_DOC_REFERENCE_TOKEN
+}
+
+// parseBacktickReference parses a backtick quoted content. A single backtick quoted content is a name reference if
+// it is preceded by a reference type, or if it is a function or a method call. Everything else is an inline code.
+func (this *DocumentationParser) parseBacktickReference(referenceType tree.STNode) tree.STNode {
+	startBacktick := this.peek()
+	if startBacktick.Kind() != common.BACKTICK_TOKEN && startBacktick.Kind() != common.DOUBLE_BACKTICK_TOKEN &&
+		startBacktick.Kind() != common.TRIPLE_BACKTICK_TOKEN {
+		// Reference type not followed by a backtick. This is not expected from the lexer.
+		startBacktick = tree.CreateMissingTokenWithDiagnostics(common.BACKTICK_TOKEN,
+			&common.WARNING_MISSING_SINGLE_BACKTICK_TOKEN)
+	} else {
+		startBacktick = this.consume()
+	}
+
+	var content tree.STToken
+	if this.peek().Kind() == common.CODE_CONTENT {
+		content = this.consume()
+	} else {
+		content = tree.CreateMissingTokenWithDiagnostics(common.CODE_CONTENT, &common.WARNING_MISSING_CODE_REFERENCE)
+	}
+	endBacktick := this.parseEndBacktick(startBacktick.Kind())
+
+	if startBacktick.Kind() != common.BACKTICK_TOKEN {
+		return tree.CreateInlineCodeReferenceNode(startBacktick, content, endBacktick)
+	}
+	isNameReference := nameReferencePattern.MatchString(content.Text())
+	if referenceType != nil {
+		if !isNameReference && !content.IsMissing() {
+			content = tree.AddDiagnostic(content, &common.WARNING_INVALID_BALLERINA_NAME_REFERENCE,
+				content.Text()).(tree.STToken)
+		}
+		return tree.CreateBallerinaNameReferenceNode(referenceType, startBacktick, content, endBacktick)
+	}
+	if isNameReference && strings.HasSuffix(content.Text(), "()") {
+		return tree.CreateBallerinaNameReferenceNode(referenceType, startBacktick, content, endBacktick)
+	}
+	return tree.CreateInlineCodeReferenceNode(startBacktick, content, endBacktick)
+}
+
+func (this *DocumentationParser) parseEndBacktick(startBacktickKind common.SyntaxKind) tree.STNode {
+	switch startBacktickKind {
+	case common.DOUBLE_BACKTICK_TOKEN:
+		return this.parseExpectedToken(common.DOUBLE_BACKTICK_TOKEN, &common.WARNING_MISSING_DOUBLE_BACKTICK_TOKEN)
+	case common.TRIPLE_BACKTICK_TOKEN:
+		return this.parseExpectedToken(common.TRIPLE_BACKTICK_TOKEN, &common.WARNING_MISSING_TRIPLE_BACKTICK_TOKEN)
+	default:
+		return this.parseExpectedToken(common.BACKTICK_TOKEN, &common.WARNING_MISSING_SINGLE_BACKTICK_TOKEN)
+	}
+}
+
+// parseCodeBlock parses a fenced code block, where each line of the code is a documentation line by itself.
+func (this *DocumentationParser) parseCodeBlock(startLineHashToken tree.STNode) tree.STNode {
+	startBacktick := this.consume()
+	langAttribute := tree.CreateEmptyNode()
+	if this.peek().Kind() == common.CODE_CONTENT {
+		langAttribute = this.consume()
+	}
+
+	var codeLines []tree.STNode
+	for this.peek().Kind() == common.HASH_TOKEN && this.peekN(2).Kind() != common.TRIPLE_BACKTICK_TOKEN {
+		hashToken := this.consume()
+		var codeDescription tree.STNode
+		if this.peek().Kind() == common.CODE_CONTENT {
+			codeDescription = this.consume()
+		} else {
+			codeDescription = tree.CreateLiteralValueToken(common.CODE_CONTENT, "", tree.CreateEmptyNodeList(),
+				tree.CreateEmptyNodeList())
+		}
+		codeLines = append(codeLines, tree.CreateMarkdownCodeLineNode(hashToken, codeDescription))
+	}
+
+	var endLineHashToken, endBacktick tree.STNode
+	if this.peek().Kind() == common.HASH_TOKEN {
+		endLineHashToken = this.consume()
+		endBacktick = this.consume()
+		// Anything following the closing backticks in the same line is not part of the documentation
+		for kind := this.peek().Kind(); kind != common.HASH_TOKEN && kind != common.EOF_TOKEN; kind = this.peek().Kind() {
+			endBacktick = tree.CloneWithTrailingInvalidNodeMinutiae(endBacktick, this.consume(),
+				&common.WARNING_CANNOT_HAVE_DOCUMENTATION_INLINE_WITH_A_CODE_REFERENCE_BLOCK)
+		}
+	} else {
+		endLineHashToken = tree.CreateMissingTokenWithDiagnostics(common.HASH_TOKEN, &common.WARNING_MISSING_HASH_TOKEN)
+		endBacktick = tree.CreateMissingTokenWithDiagnostics(common.TRIPLE_BACKTICK_TOKEN,
+			&common.WARNING_MISSING_TRIPLE_BACKTICK_TOKEN)
+	}
+	return tree.CreateMarkdownCodeBlockNode(startLineHashToken, startBacktick, langAttribute,
+		tree.CreateNodeList(codeLines...), endLineHashToken, endBacktick)
+}
+
+func (this *DocumentationParser) parseExpectedToken(kind common.SyntaxKind, diagnosticCode diagnostics.DiagnosticCode) tree.STToken {
+	if this.peek().Kind() == kind {
+		return this.consume()
+	}
+	return tree.CreateMissingTokenWithDiagnostics(kind, diagnosticCode)
+}
@@ -94,6 +94,44 @@ func (l *Lexer) NextToken() tree.STToken {
 	case PARSER_MODE_INTERPOLATION_BRACED_CONTENT:
 		l.processLeadingTrivia()
 		token = l.readTokenInBracedContentInInterpolation()
+	case PARSER_MODE_XML_CONTENT:
+		token = l.readTokenInXMLContent()
+	case PARSER_MODE_XML_ELEMENT_START_TAG:
+		l.processLeadingXMLTrivia()
+		token = l.readTokenInXMLElement(true)
+	case PARSER_MODE_XML_ELEMENT_END_TAG:
+		l.processLeadingXMLTrivia()
+		token = l.readTokenInXMLElement(false)
+	case PARSER_MODE_XML_COMMENT:
+		token = l.readTokenInXMLComment()
+	case PARSER_MODE_XML_PI:
+		l.processLeadingXMLTrivia()
+		token = l.readTokenInXMLPI()
+	case PARSER_MODE_XML_PI_DATA:
+		token = l.readTokenInXMLPIData()
+	case PARSER_MODE_XML_SINGLE_QUOTED_STRING:
+		token = l.readTokenInXMLQuotedString(SINGLE_QUOTE)
+	case PARSER_MODE_XML_DOUBLE_QUOTED_STRING:
+		token = l.readTokenInXMLQuotedString(DOUBLE_QUOTE)
+	case PARSER_MODE_XML_CDATA_SECTION:
+		token = l.readTokenInXMLCDATASection()
+	case PARSER_MODE_RE_DISJUNCTION:
+		token = l.readTokenInReDisjunction()
+	case PARSER_MODE_RE_ESCAPE:
+		token = l.readTokenInReEscape()
+	case PARSER_MODE_RE_UNICODE_PROP_ESCAPE:
+		token = l.readTokenInReUnicodePropertyEscape()
+	case PARSER_MODE_RE_UNICODE_PROPERTY_VALUE:
+		token = l.readTokenInReUnicodePropertyValue()
+	case PARSER_MODE_RE_CHAR_CLASS:
+		token = l.readTokenInReCharacterClass()
+	case PARSER_MODE_RE_FLAG_EXPRESSION:
+		token = l.readTokenInReFlagExpression()
+	case PARSER_MODE_DOC_LINE_START_HASH, PARSER_MODE_DOC_LINE_DIFFERENTIATOR, PARSER_MODE_DOC_INTERNAL,
+		PARSER_MODE_DOC_PARAMETER, PARSER_MODE_DOC_REFERENCE_TYPE, PARSER_MODE_DOC_SINGLE_BACKTICK_CONTENT,
+		PARSER_MODE_DOC_DOUBLE_BACKTICK_CONTENT, PARSER_MODE_DOC_TRIPLE_BACKTICK_CONTENT, PARSER_MODE_DOC_CODE_REF_END,
+		PARSER_MODE_DOC_CODE_LINE_START_HASH, PARSER_MODE_DOC_CODE_LINE_CONTENT:
+		token = l.readDocumentationToken()
 	default:
 		l.processLeadingTrivia()
 		token = l.readToken()
@@ -1513,6 +1551,7 @@ const (
 	PARSER_MODE_DOC_TRIPLE_BACKTICK_CONTENT
 	PARSER_MODE_DOC_CODE_REF_END
 	PARSER_MODE_DOC_CODE_LINE_START_HASH
+	PARSER_MODE_DOC_CODE_LINE_CONTENT
 
 	// XML Parser
 	PARSER_MODE_XML_CONTENT
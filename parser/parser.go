@@ -3649,11 +3649,10 @@ func (this *BallerinaParser) parseStatement() tree.STNode {
 	return this.parseStatementWithAnnotataions(annots)
 }
 
+// validateStatement marks statements that are not allowed in a block as invalid. Unlike jBallerina, local type
+// definitions are allowed.
 func (this *BallerinaParser) validateStatement(statement tree.STNode) bool {
 	switch statement.Kind() {
-	case common.LOCAL_TYPE_DEFINITION_STATEMENT:
-		this.addInvalidNodeToNextToken(statement, &common.ERROR_LOCAL_TYPE_DEFINITION_NOT_ALLOWED)
-		return true
 	case common.CONST_DECLARATION:
 		this.addInvalidNodeToNextToken(statement, &common.ERROR_LOCAL_CONST_DECL_NOT_ALLOWED)
 		return true
@@ -8523,11 +8522,10 @@ func (this *BallerinaParser) parseTemplateContentAsXML() tree.STNode {
 		}
 		nextToken = this.peek()
 	}
-	// charReader := text.CharReaderFromText(xmlStringBuilder.String())
-	// tokenReader := nil
-	// xmlParser := nil
-	// return this.xmlParser.parse()
-	panic("xml parser not implemented")
+	charReader := text.CharReaderFromText(xmlStringBuilder.String())
+	tokenReader := CreateTokenReader(*NewXMLLexer(charReader, nil), this.dbgContext)
+	xmlParser := NewXMLParserFromTokenReader(tokenReader, expressions, this.dbgContext)
+	return xmlParser.Parse()
 }
 
 func (this *BallerinaParser) parseRegExpTemplateExpression() tree.STNode {
@@ -8553,29 +8551,28 @@ func (this *BallerinaParser) createMissingTemplateExpressionNode(reKeyword tree.
 
 func (this *BallerinaParser) parseTemplateContentAsRegExp() tree.STNode {
 	this.tokenReader.StartMode(PARSER_MODE_REGEXP)
-	panic("Regexp parser not implemented")
-	// expressions := make([]interface{}, 0)
-	// regExpStringBuilder := nil
-	// nextToken := this.peek()
-	// for !this.isEndOfBacktickContent(nextToken.Kind()) {
-	// 	contentItem := this.parseTemplateItem()
-	// 	if contentItem.Kind() == common.TEMPLATE_STRING {
-	// 		contentToken, ok := contentItem.(STToken)
-	// 		if !ok {
-	// 			panic("parseTemplateContentAsRegExp: expected STToken")
-	// 		}
-	// 		this.regExpStringBuilder.append(contentToken.text())
-	// 	} else {
-	// 		this.regExpStringBuilder.append("${}")
-	// 		this.expressions.add(contentItem)
-	// 	}
-	// 	nextToken = this.peek()
-	// }
-	// this.this.tokenReader.endMode()
-	// charReader := this.CharReader.from(regExpStringBuilder.toString())
-	// tokenReader := nil
-	// regExpParser := nil
-	// return this.regExpParser.parse()
+	var expressions []tree.STNode
+	var regExpStringBuilder strings.Builder
+	nextToken := this.peek()
+	for !this.isEndOfBacktickContent(nextToken.Kind()) {
+		contentItem := this.parseTemplateItem()
+		if contentItem.Kind() == common.TEMPLATE_STRING {
+			contentToken, ok := contentItem.(tree.STToken)
+			if !ok {
+				panic("parseTemplateContentAsRegExp: expected STToken")
+			}
+			regExpStringBuilder.WriteString(contentToken.Text())
+		} else {
+			regExpStringBuilder.WriteString("${}")
+			expressions = append(expressions, contentItem)
+		}
+		nextToken = this.peek()
+	}
+	this.tokenReader.EndMode()
+	charReader := text.CharReaderFromText(regExpStringBuilder.String())
+	tokenReader := CreateTokenReader(*NewRegExpLexer(charReader, nil), this.dbgContext)
+	regExpParser := NewRegExpParserFromTokenReader(tokenReader, expressions, this.dbgContext)
+	return regExpParser.Parse()
 }
 
 func (this *BallerinaParser) parseInterpolation() tree.STNode {
@@ -11545,15 +11542,14 @@ func (this *BallerinaParser) parseMarkdownDocumentation() tree.STNode {
 }
 
 func (this *BallerinaParser) parseDocumentationString(documentationStringToken tree.STToken) tree.STNode {
-	// leadingTriviaList := this.getLeadingTriviaList(documentationStringToken.LeadingMinutiae())
-	// diagnostics := make([]tree.STNodeDiagnostic, len(documentationStringToken.Diagnostics()))
-	// copy(diagnostics, documentationStringToken.Diagnostics())
-	// charReader := commonCharReader.from(documentationStringToken.Text())
-	// documentationLexer := nil
-	// tokenReader := nil
-	// documentationParser := nil
-	// return this.documentationParser.parse()
-	panic("documentation parser not implemented")
+	leadingTriviaList := this.getLeadingTriviaList(documentationStringToken.LeadingMinutiae())
+	diagnostics := make([]tree.STNodeDiagnostic, len(documentationStringToken.Diagnostics()))
+	copy(diagnostics, documentationStringToken.Diagnostics())
+	charReader := text.CharReaderFromText(documentationStringToken.Text())
+	documentationLexer := NewDocumentationLexer(charReader, leadingTriviaList, diagnostics)
+	tokenReader := CreateTokenReader(*documentationLexer, this.dbgContext)
+	documentationParser := NewDocumentationParserFromTokenReader(tokenReader, this.dbgContext)
+	return documentationParser.Parse()
 }
 
 func (this *BallerinaParser) getLeadingTriviaList(leadingMinutiaeNode tree.STNode) []tree.STNode {
@@ -14792,9 +14788,13 @@ func GetSyntaxTree(debugCtx *debugcommon.DebugContext, fileName string) (*tree.S
 	if err != nil {
 		return nil, fmt.Errorf("error reading file %s: %v", fileName, err)
 	}
+	return GetSyntaxTreeFromText(debugCtx, fileName, string(content)), nil
+}
 
+// GetSyntaxTreeFromText parses content, the source of the file fileName.
+func GetSyntaxTreeFromText(debugCtx *debugcommon.DebugContext, fileName string, content string) *tree.SyntaxTree {
 	// Create CharReader from file content
-	reader := text.CharReaderFromText(string(content))
+	reader := text.CharReaderFromText(content)
 
 	// Create Lexer with DebugContext
 	lexer := NewLexer(reader, debugCtx)
@@ -14810,5 +14810,14 @@ func GetSyntaxTree(debugCtx *debugcommon.DebugContext, fileName string) (*tree.S
 
 	moduleNode := tree.CreateUnlinkedFacade[*tree.STModulePart, *tree.ModulePart](rootNode)
 	syntaxTree := tree.NewSyntaxTreeFromNodeTextDocumentStringBool(moduleNode, nil, fileName, false)
-	return &syntaxTree, nil
+	return &syntaxTree
+}
+
+// ParseTypeDescriptor parses content as a single type descriptor. Any text after the type descriptor is invalid.
+func ParseTypeDescriptor(debugCtx *debugcommon.DebugContext, content string) tree.Node {
+	reader := text.CharReaderFromText(content)
+	lexer := NewLexer(reader, debugCtx)
+	tokenReader := CreateTokenReader(*lexer, debugCtx)
+	ballerinaParser := NewBallerinaParserFromTokenReader(tokenReader, debugCtx)
+	return ballerinaParser.ParseAsTypeDescriptor().CreateFacade(0, nil)
 }
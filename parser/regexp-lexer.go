@@ -0,0 +1,363 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package parser
+
+import (
+	debugcommon "ballerina-lang-go/common"
+	"ballerina-lang-go/parser/common"
+	"ballerina-lang-go/parser/tree"
+	"ballerina-lang-go/tools/text"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	reFlags                = "imsx"
+	reUnicodeScriptStart   = "sc="
+	reGeneralCategoryStart = "gc="
+)
+
+// reGeneralCategories are the unicode general category names accepted in a unicode property escape
+var reGeneralCategories = map[string]bool{
+	"L": true, "Lu": true, "Ll": true, "Lt": true, "Lm": true, "Lo": true,
+	"M": true, "Mn": true, "Mc": true, "Me": true,
+	"N": true, "Nd": true, "Nl": true, "No": true,
+	"S": true, "Sm": true, "Sc": true, "Sk": true, "So": true,
+	"P": true, "Pc": true, "Pd": true, "Ps": true, "Pe": true, "Pi": true, "Pf": true, "Po": true,
+	"Z": true, "Zs": true, "Zl": true, "Zp": true,
+	"C": true, "Cc": true, "Cf": true, "Co": true, "Cn": true,
+}
+
+// NewRegExpLexer creates a lexer that tokenizes the content of a regexp template. The content is expected to have
+// the interpolations replaced with `${}`.
+//
+// Whitespace is significant within a regexp, hence no token has minutiae.
+func NewRegExpLexer(reader text.CharReader, debugCtx *debugcommon.DebugContext) *Lexer {
+	lexer := NewLexer(reader, debugCtx)
+	lexer.StartMode(PARSER_MODE_RE_DISJUNCTION)
+	return lexer
+}
+
+func (l *Lexer) readTokenInReDisjunction() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+
+	c := reader.Peek()
+	switch c {
+	case DOLLAR:
+		if reader.PeekN(1) == OPEN_BRACE {
+			// Switch to interpolation mode. Then the next token will be read in that mode.
+			l.StartMode(PARSER_MODE_INTERPOLATION)
+			reader.AdvanceN(2)
+			return l.getSyntaxTokenWithoutTrailingTrivia(common.INTERPOLATION_START_TOKEN)
+		}
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.DOLLAR_TOKEN)
+	case BITWISE_XOR:
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.BITWISE_XOR_TOKEN)
+	case DOT:
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.DOT_TOKEN)
+	case ASTERISK:
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.ASTERISK_TOKEN)
+	case PLUS:
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.PLUS_TOKEN)
+	case QUESTION_MARK:
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.QUESTION_MARK_TOKEN)
+	case PIPE:
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.PIPE_TOKEN)
+	case OPEN_PARANTHESIS:
+		reader.Advance()
+		if reader.Peek() == QUESTION_MARK {
+			l.StartMode(PARSER_MODE_RE_FLAG_EXPRESSION)
+		}
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.OPEN_PAREN_TOKEN)
+	case CLOSE_PARANTHESIS:
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.CLOSE_PAREN_TOKEN)
+	case OPEN_BRACKET:
+		reader.Advance()
+		l.StartMode(PARSER_MODE_RE_CHAR_CLASS)
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.OPEN_BRACKET_TOKEN)
+	case CLOSE_BRACKET:
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.CLOSE_BRACKET_TOKEN)
+	case OPEN_BRACE:
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.OPEN_BRACE_TOKEN)
+	case CLOSE_BRACE:
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.CLOSE_BRACE_TOKEN)
+	case COMMA:
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.COMMA_TOKEN)
+	case BACKSLASH:
+		return l.readReEscapeStart(false)
+	}
+
+	reader.Advance()
+	if c >= '0' && c <= '9' {
+		return l.getRegExpText(common.DIGIT)
+	}
+	return l.getRegExpText(common.RE_LITERAL_CHAR)
+}
+
+// readReEscapeStart reads the token starting with a backslash. Escapes that are not further structured in the syntax
+// tree (numeric and control escapes, and `\-` within a character class) are returned as a single token. For the
+// rest, the backslash is returned and the escaped part is read in the escape mode.
+func (l *Lexer) readReEscapeStart(inCharClass bool) tree.STToken {
+	reader := l.reader
+	reader.Advance()
+	switch reader.Peek() {
+	case 'n', 'r', 't':
+		reader.Advance()
+		return l.getRegExpText(common.RE_CONTROL_ESCAPE)
+	case 'u':
+		if reader.PeekN(1) == OPEN_BRACE {
+			return l.readReNumericEscape()
+		}
+	case MINUS:
+		if inCharClass {
+			reader.Advance()
+			return l.getRegExpText(common.ESCAPED_MINUS_TOKEN)
+		}
+	}
+	l.StartMode(PARSER_MODE_RE_ESCAPE)
+	return l.getSyntaxTokenWithoutTrailingTrivia(common.BACK_SLASH_TOKEN)
+}
+
+// readReNumericEscape reads a numeric escape of the form `\u{CodePoint}`.
+func (l *Lexer) readReNumericEscape() tree.STToken {
+	reader := l.reader
+	reader.AdvanceN(2)
+	hexDigits := 0
+	for isReHexDigit(reader.Peek()) {
+		reader.Advance()
+		hexDigits++
+	}
+	if hexDigits == 0 || reader.Peek() != CLOSE_BRACE {
+		l.reportLexerError(common.ERROR_INVALID_STRING_NUMERIC_ESCAPE_SEQUENCE)
+	} else {
+		reader.Advance()
+	}
+	return l.getRegExpText(common.RE_NUMERIC_ESCAPE)
+}
+
+func (l *Lexer) readTokenInReEscape() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	l.EndMode()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+
+	c := reader.Peek()
+	reader.Advance()
+	switch c {
+	case 'd', 'D', 's', 'S', 'w', 'W':
+		return l.getRegExpText(common.RE_SIMPLE_CHAR_CLASS_CODE)
+	case 'p', 'P':
+		l.StartMode(PARSER_MODE_RE_UNICODE_PROP_ESCAPE)
+		return l.getRegExpText(common.RE_PROPERTY)
+	}
+
+	kind, ok := reSyntaxCharKind(c)
+	if !ok {
+		l.reportLexerError(common.ERROR_INVALID_RE_SYNTAX_CHAR, string(c))
+		return l.getRegExpText(common.RE_LITERAL_CHAR)
+	}
+	return l.getSyntaxTokenWithoutTrailingTrivia(kind)
+}
+
+// reSyntaxCharKind returns the token kind of the given ReSyntaxChar, which are the characters that have to be
+// escaped to be matched literally.
+func reSyntaxCharKind(c rune) (common.SyntaxKind, bool) {
+	switch c {
+	case BITWISE_XOR:
+		return common.BITWISE_XOR_TOKEN, true
+	case DOLLAR:
+		return common.DOLLAR_TOKEN, true
+	case BACKSLASH:
+		return common.BACK_SLASH_TOKEN, true
+	case DOT:
+		return common.DOT_TOKEN, true
+	case ASTERISK:
+		return common.ASTERISK_TOKEN, true
+	case PLUS:
+		return common.PLUS_TOKEN, true
+	case QUESTION_MARK:
+		return common.QUESTION_MARK_TOKEN, true
+	case OPEN_PARANTHESIS:
+		return common.OPEN_PAREN_TOKEN, true
+	case CLOSE_PARANTHESIS:
+		return common.CLOSE_PAREN_TOKEN, true
+	case OPEN_BRACKET:
+		return common.OPEN_BRACKET_TOKEN, true
+	case CLOSE_BRACKET:
+		return common.CLOSE_BRACKET_TOKEN, true
+	case OPEN_BRACE:
+		return common.OPEN_BRACE_TOKEN, true
+	case CLOSE_BRACE:
+		return common.CLOSE_BRACE_TOKEN, true
+	case PIPE:
+		return common.PIPE_TOKEN, true
+	default:
+		return 0, false
+	}
+}
+
+func (l *Lexer) readTokenInReUnicodePropertyEscape() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		l.EndMode()
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+
+	switch reader.Peek() {
+	case OPEN_BRACE:
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.OPEN_BRACE_TOKEN)
+	case CLOSE_BRACE:
+		reader.Advance()
+		l.EndMode()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.CLOSE_BRACE_TOKEN)
+	}
+	if l.isReKeyword(reUnicodeScriptStart) {
+		reader.AdvanceN(len(reUnicodeScriptStart))
+		l.StartMode(PARSER_MODE_RE_UNICODE_PROPERTY_VALUE)
+		return l.getRegExpText(common.RE_UNICODE_SCRIPT_START)
+	}
+	if l.isReKeyword(reGeneralCategoryStart) {
+		reader.AdvanceN(len(reGeneralCategoryStart))
+		return l.getRegExpText(common.RE_UNICODE_GENERAL_CATEGORY_START)
+	}
+	if !isReUnicodePropertyChar(reader.Peek()) {
+		// Not a property. Let the enclosing mode read the token, and the parser report the incomplete escape.
+		l.EndMode()
+		return l.NextToken()
+	}
+
+	for isReUnicodePropertyChar(reader.Peek()) {
+		reader.Advance()
+	}
+	if !reGeneralCategories[l.getLexeme()] {
+		l.reportLexerError(common.ERROR_INVALID_TOKEN_IN_REG_EXP, l.getLexeme())
+	}
+	return l.getRegExpText(common.RE_UNICODE_GENERAL_CATEGORY_NAME)
+}
+
+func (l *Lexer) readTokenInReUnicodePropertyValue() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	l.EndMode()
+	if !isReUnicodePropertyChar(reader.Peek()) {
+		return l.NextToken()
+	}
+	for isReUnicodePropertyChar(reader.Peek()) {
+		reader.Advance()
+	}
+	return l.getRegExpText(common.RE_UNICODE_PROPERTY_VALUE)
+}
+
+func (l *Lexer) isReKeyword(keyword string) bool {
+	for i, c := range keyword {
+		if l.reader.PeekN(i) != c {
+			return false
+		}
+	}
+	return true
+}
+
+func isReUnicodePropertyChar(c rune) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_'
+}
+
+func isReHexDigit(c rune) bool {
+	return c < utf8.RuneSelf && isHexDigit(byte(c))
+}
+
+func (l *Lexer) readTokenInReCharacterClass() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+
+	switch reader.Peek() {
+	case CLOSE_BRACKET:
+		reader.Advance()
+		l.EndMode()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.CLOSE_BRACKET_TOKEN)
+	case BITWISE_XOR:
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.BITWISE_XOR_TOKEN)
+	case MINUS:
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.MINUS_TOKEN)
+	case BACKSLASH:
+		return l.readReEscapeStart(true)
+	}
+	reader.Advance()
+	return l.getRegExpText(common.RE_LITERAL_CHAR)
+}
+
+func (l *Lexer) readTokenInReFlagExpression() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	switch reader.Peek() {
+	case QUESTION_MARK:
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.QUESTION_MARK_TOKEN)
+	case MINUS:
+		reader.Advance()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.MINUS_TOKEN)
+	case COLON:
+		reader.Advance()
+		l.EndMode()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.COLON_TOKEN)
+	}
+	if !isReUnicodePropertyChar(reader.Peek()) {
+		// End of the flags. Let the enclosing mode read the token, and the parser report the missing colon.
+		l.EndMode()
+		return l.NextToken()
+	}
+
+	for isReUnicodePropertyChar(reader.Peek()) {
+		reader.Advance()
+	}
+	flags := l.getLexeme()
+	for i, flag := range flags {
+		if !strings.ContainsRune(reFlags, flag) || strings.ContainsRune(flags[:i], flag) {
+			l.reportLexerError(common.ERROR_INVALID_FLAG_IN_REG_EXP, string(flag))
+		}
+	}
+	return l.getRegExpText(common.RE_FLAGS_VALUE)
+}
+
+func (l *Lexer) getRegExpText(kind common.SyntaxKind) tree.STToken {
+	leadingTrivia := l.getLeadingTrivia()
+	return tree.CreateLiteralValueToken(kind, l.getLexeme(), leadingTrivia, tree.CreateEmptyNodeList())
+}
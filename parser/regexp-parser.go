@@ -0,0 +1,407 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package parser
+
+import (
+	debugcommon "ballerina-lang-go/common"
+	"ballerina-lang-go/parser/common"
+	"ballerina-lang-go/parser/tree"
+	"strconv"
+	"strings"
+)
+
+// RegExpParser parses the content of a regexp template. The content is parsed as a ReDisjunction, which is a list
+// of ReSequences separated by pipes.
+//
+// Like the XMLParser, errors are recovered by inserting the missing tokens, and by treating the unexpected tokens as
+// invalid nodes.
+type RegExpParser struct {
+	templateContentParser
+}
+
+func NewRegExpParserFromTokenReader(tokenReader *TokenReader, expressions []tree.STNode, dbgCtx *debugcommon.DebugContext) RegExpParser {
+	return RegExpParser{
+		templateContentParser: newTemplateContentParser(tokenReader, expressions, dbgCtx),
+	}
+}
+
+func (this *RegExpParser) Parse() tree.STNode {
+	reSequences := this.parseReSequences(false)
+	if this.isInvalidNodeStackEmpty() {
+		return reSequences
+	}
+	if !containsToken(reSequences) {
+		// Nothing but invalid tokens. Add a missing char to hold them.
+		missingChar := tree.CreateMissingToken(common.RE_LITERAL_CHAR, nil)
+		reSequences = tree.CreateNodeList(tree.CreateReSequenceNode(tree.CreateNodeList(
+			tree.CreateReAtomQuantifierNode(tree.CreateReAtomCharOrEscapeNode(missingChar), tree.CreateEmptyNode()))))
+	}
+	return this.addInvalidNodeStackToTrailingMinutiae(reSequences)
+}
+
+func containsToken(node tree.STNode) bool {
+	if _, ok := node.(tree.STToken); ok {
+		return true
+	}
+	for i := 0; i < node.BucketCount(); i++ {
+		child := node.ChildInBucket(i)
+		if tree.IsSTNodePresent(child) && containsToken(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseReSequences parses a ReDisjunction. A capturing group ends the disjunction with the close parenthesis,
+// whereas the top level disjunction goes on till the end of the content.
+func (this *RegExpParser) parseReSequences(inCapturingGroup bool) tree.STNode {
+	items := []tree.STNode{this.parseReSequence(inCapturingGroup)}
+	for this.peek().Kind() == common.PIPE_TOKEN {
+		items = append(items, this.consume())
+		items = append(items, this.parseReSequence(inCapturingGroup))
+	}
+	return tree.CreateNodeList(items...)
+}
+
+func (this *RegExpParser) parseReSequence(inCapturingGroup bool) tree.STNode {
+	var terms []tree.STNode
+	for !this.isEndOfReSequence(inCapturingGroup) {
+		term := this.parseReTerm()
+		if term != nil {
+			terms = append(terms, term)
+		}
+	}
+	return tree.CreateReSequenceNode(tree.CreateNodeList(terms...))
+}
+
+func (this *RegExpParser) isEndOfReSequence(inCapturingGroup bool) bool {
+	switch this.peek().Kind() {
+	case common.EOF_TOKEN, common.PIPE_TOKEN:
+		return true
+	case common.CLOSE_PAREN_TOKEN:
+		return inCapturingGroup
+	default:
+		return false
+	}
+}
+
+// parseReTerm parses either an assertion, or an atom with an optional quantifier. Returns nil if the next token
+// cannot start a term, in which case it is added as an invalid node.
+func (this *RegExpParser) parseReTerm() tree.STNode {
+	nextToken := this.peek()
+	var reAtom tree.STNode
+	switch nextToken.Kind() {
+	case common.BITWISE_XOR_TOKEN, common.DOLLAR_TOKEN:
+		return tree.CreateReAssertionNode(this.consume())
+	case common.ASTERISK_TOKEN, common.PLUS_TOKEN, common.QUESTION_MARK_TOKEN, common.OPEN_BRACE_TOKEN:
+		// Quantifier without a preceding atom
+		quantifier := this.parseReQuantifier()
+		this.addInvalidNodeToNextToken(quantifier, &common.ERROR_INVALID_QUANTIFIER_IN_REG_EXP,
+			tree.ToSourceCode(quantifier))
+		return nil
+	case common.CLOSE_PAREN_TOKEN, common.CLOSE_BRACKET_TOKEN, common.CLOSE_BRACE_TOKEN:
+		invalidToken := this.consume()
+		this.addInvalidNodeToNextToken(invalidToken, &common.ERROR_INVALID_TOKEN_IN_REG_EXP, invalidToken.Text())
+		return nil
+	case common.OPEN_BRACKET_TOKEN:
+		reAtom = this.parseReCharacterClass()
+	case common.OPEN_PAREN_TOKEN:
+		reAtom = this.parseReCapturingGroup()
+	case common.BACK_SLASH_TOKEN:
+		reAtom = tree.CreateReAtomCharOrEscapeNode(this.parseReEscape())
+	case common.INTERPOLATION_START_TOKEN:
+		reAtom = tree.CreateReAtomCharOrEscapeNode(this.parseInterpolation())
+	default:
+		reAtom = tree.CreateReAtomCharOrEscapeNode(this.consume())
+	}
+	return tree.CreateReAtomQuantifierNode(reAtom, this.parseOptionalReQuantifier())
+}
+
+// parseReEscape parses the escapes that start with a separate backslash token, i.e. the quote escapes, the simple
+// char class escapes and the unicode property escapes.
+func (this *RegExpParser) parseReEscape() tree.STNode {
+	slashToken := this.consume()
+	switch this.peek().Kind() {
+	case common.RE_SIMPLE_CHAR_CLASS_CODE:
+		return tree.CreateReSimpleCharClassEscapeNode(slashToken, this.consume())
+	case common.RE_PROPERTY:
+		return this.parseReUnicodePropertyEscape(slashToken)
+	case common.EOF_TOKEN:
+		syntaxChar := tree.CreateMissingTokenWithDiagnostics(common.RE_LITERAL_CHAR, &common.ERROR_INVALID_RE_SYNTAX_CHAR)
+		return tree.CreateReQuoteEscapeNode(slashToken, syntaxChar)
+	default:
+		// The lexer reports the escaped char if it is not a syntax char
+		return tree.CreateReQuoteEscapeNode(slashToken, this.consume())
+	}
+}
+
+func (this *RegExpParser) parseReUnicodePropertyEscape(slashToken tree.STNode) tree.STNode {
+	property := this.consume()
+	openBrace := this.parseExpectedToken(common.OPEN_BRACE_TOKEN, &common.ERROR_MISSING_OPEN_BRACE_TOKEN)
+	var reUnicodeProperty tree.STNode
+	switch this.peek().Kind() {
+	case common.RE_UNICODE_SCRIPT_START:
+		scriptStart := this.consume()
+		value := this.parseExpectedToken(common.RE_UNICODE_PROPERTY_VALUE,
+			&common.ERROR_MISSING_RE_UNICODE_PROPERTY_VALUE)
+		reUnicodeProperty = tree.CreateReUnicodeScriptNode(scriptStart, value)
+	case common.RE_UNICODE_GENERAL_CATEGORY_START:
+		categoryStart := this.consume()
+		name := this.parseExpectedToken(common.RE_UNICODE_GENERAL_CATEGORY_NAME,
+			&common.ERROR_MISSING_RE_UNICODE_PROPERTY_VALUE)
+		reUnicodeProperty = tree.CreateReUnicodeGeneralCategoryNode(categoryStart, name)
+	default:
+		name := this.parseExpectedToken(common.RE_UNICODE_GENERAL_CATEGORY_NAME,
+			&common.ERROR_MISSING_RE_UNICODE_PROPERTY_VALUE)
+		reUnicodeProperty = tree.CreateReUnicodeGeneralCategoryNode(tree.CreateEmptyNode(), name)
+	}
+	closeBrace := this.parseExpectedToken(common.CLOSE_BRACE_TOKEN, &common.ERROR_MISSING_CLOSE_BRACE_TOKEN)
+	return tree.CreateReUnicodePropertyEscapeNode(slashToken, property, openBrace, reUnicodeProperty, closeBrace)
+}
+
+func (this *RegExpParser) parseReCharacterClass() tree.STNode {
+	openBracket := this.consume()
+	negation := tree.CreateEmptyNode()
+	if this.peek().Kind() == common.BITWISE_XOR_TOKEN {
+		negation = this.consume()
+	}
+	reCharSet := tree.CreateEmptyNode()
+	if !this.isEndOfReCharSet() {
+		reCharSet = this.parseReCharSet()
+	}
+	closeBracket := this.parseExpectedToken(common.CLOSE_BRACKET_TOKEN, &common.ERROR_MISSING_CLOSE_BRACKET_TOKEN)
+	return tree.CreateReCharacterClassNode(openBracket, negation, reCharSet, closeBracket)
+}
+
+func (this *RegExpParser) isEndOfReCharSet() bool {
+	switch this.peek().Kind() {
+	case common.EOF_TOKEN, common.CLOSE_BRACKET_TOKEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// isReCharSetRangeStart checks whether the next dash is the dash of a range, rather than a dash atom at the end
+// of the char set.
+func (this *RegExpParser) isReCharSetRangeStart() bool {
+	if this.peek().Kind() != common.MINUS_TOKEN {
+		return false
+	}
+	switch this.peekN(2).Kind() {
+	case common.EOF_TOKEN, common.CLOSE_BRACKET_TOKEN:
+		return false
+	default:
+		return true
+	}
+}
+
+// parseReCharSet parses
+//
+//	ReCharSet := ReCharSetAtom | ReCharSetRange [ReCharSet] | ReCharSetAtom ReCharSetNoDash
+func (this *RegExpParser) parseReCharSet() tree.STNode {
+	reCharSetAtom := this.parseReCharSetAtom()
+	if this.isReCharSetRangeStart() {
+		reCharSetRange := this.parseReCharSetRange(reCharSetAtom, tree.CreateReCharSetRangeNode)
+		if this.isEndOfReCharSet() {
+			return reCharSetRange
+		}
+		return tree.CreateReCharSetRangeWithReCharSetNode(reCharSetRange, this.parseReCharSet())
+	}
+	if this.isEndOfReCharSet() {
+		return reCharSetAtom
+	}
+	return tree.CreateReCharSetAtomWithReCharSetNoDashNode(reCharSetAtom, this.parseReCharSetNoDash())
+}
+
+// parseReCharSetNoDash parses
+//
+//	ReCharSetNoDash := ReCharSetAtom | ReCharSetRangeNoDash [ReCharSet] | ReCharSetAtomNoDash ReCharSetNoDash
+func (this *RegExpParser) parseReCharSetNoDash() tree.STNode {
+	reCharSetAtom := this.parseReCharSetAtom()
+	if this.isReCharSetRangeStart() {
+		reCharSetRange := this.parseReCharSetRange(reCharSetAtom, tree.CreateReCharSetRangeNoDashNode)
+		if this.isEndOfReCharSet() {
+			return reCharSetRange
+		}
+		return tree.CreateReCharSetRangeNoDashWithReCharSetNode(reCharSetRange, this.parseReCharSet())
+	}
+	if this.isEndOfReCharSet() {
+		return reCharSetAtom
+	}
+	return tree.CreateReCharSetAtomNoDashWithReCharSetNoDashNode(reCharSetAtom, this.parseReCharSetNoDash())
+}
+
+func (this *RegExpParser) parseReCharSetRange(lhsAtom tree.STNode, createRange func(lhs, minus, rhs tree.STNode) tree.STNode) tree.STNode {
+	minusToken := this.consume()
+	rhsAtom := this.parseReCharSetAtom()
+	reCharSetRange := createRange(lhsAtom, minusToken, rhsAtom)
+	lhs, lhsOk := reCharSetAtomCodePoint(lhsAtom)
+	rhs, rhsOk := reCharSetAtomCodePoint(rhsAtom)
+	if lhsOk && rhsOk && lhs > rhs {
+		reCharSetRange = tree.AddDiagnostic(reCharSetRange, &common.ERROR_INVALID_TOKEN_IN_REG_EXP,
+			tree.ToSourceCode(reCharSetRange))
+	}
+	return reCharSetRange
+}
+
+// reCharSetAtomCodePoint returns the code point matched by the given char set atom, if it matches a single char.
+func reCharSetAtomCodePoint(atom tree.STNode) (rune, bool) {
+	token, ok := atom.(tree.STToken)
+	if !ok || token.IsMissing() {
+		return 0, false
+	}
+	text := token.Text()
+	switch token.Kind() {
+	case common.RE_LITERAL_CHAR, common.BITWISE_XOR_TOKEN, common.MINUS_TOKEN:
+		return []rune(text)[0], true
+	case common.ESCAPED_MINUS_TOKEN:
+		return '-', true
+	case common.RE_CONTROL_ESCAPE:
+		switch text[1] {
+		case 'n':
+			return '\n', true
+		case 'r':
+			return '\r', true
+		default:
+			return '\t', true
+		}
+	case common.RE_NUMERIC_ESCAPE:
+		hex := strings.TrimSuffix(strings.TrimPrefix(text, "\\u{"), "}")
+		codePoint, err := strconv.ParseInt(hex, 16, 32)
+		return rune(codePoint), err == nil
+	default:
+		return 0, false
+	}
+}
+
+func (this *RegExpParser) parseReCharSetAtom() tree.STNode {
+	if this.peek().Kind() == common.BACK_SLASH_TOKEN {
+		return this.parseReEscape()
+	}
+	return this.consume()
+}
+
+func (this *RegExpParser) parseReCapturingGroup() tree.STNode {
+	openParenthesis := this.consume()
+	reFlagExpression := tree.CreateEmptyNode()
+	if this.peek().Kind() == common.QUESTION_MARK_TOKEN {
+		reFlagExpression = this.parseReFlagExpression()
+	}
+	reSequences := this.parseReSequences(true)
+	closeParenthesis := this.parseExpectedToken(common.CLOSE_PAREN_TOKEN, &common.ERROR_MISSING_CLOSE_PAREN_TOKEN)
+	return tree.CreateReCapturingGroupsNode(openParenthesis, reFlagExpression, reSequences, closeParenthesis)
+}
+
+func (this *RegExpParser) parseReFlagExpression() tree.STNode {
+	questionMark := this.consume()
+	reFlagsOnOff := this.parseReFlagsOnOff()
+	colon := this.parseExpectedToken(common.COLON_TOKEN, &common.ERROR_MISSING_COLON_TOKEN)
+	return tree.CreateReFlagExpressionNode(questionMark, reFlagsOnOff, colon)
+}
+
+func (this *RegExpParser) parseReFlagsOnOff() tree.STNode {
+	lhsReFlags := tree.CreateEmptyNode()
+	lhsFlags := ""
+	if this.peek().Kind() == common.RE_FLAGS_VALUE {
+		lhsFlagsToken := this.consume()
+		lhsFlags = lhsFlagsToken.Text()
+		lhsReFlags = tree.CreateReFlagsNode(tree.CreateNodeList(lhsFlagsToken))
+	}
+	minusToken := tree.CreateEmptyNode()
+	rhsReFlags := tree.CreateEmptyNode()
+	if this.peek().Kind() == common.MINUS_TOKEN {
+		minusToken = this.consume()
+		if this.peek().Kind() == common.RE_FLAGS_VALUE {
+			rhsFlagsToken := this.consume()
+			// A flag cannot be both turned on and off
+			for _, flag := range rhsFlagsToken.Text() {
+				if strings.ContainsRune(lhsFlags, flag) {
+					rhsFlagsToken = tree.AddDiagnostic(rhsFlagsToken, &common.ERROR_INVALID_FLAG_IN_REG_EXP,
+						string(flag)).(tree.STToken)
+				}
+			}
+			rhsReFlags = tree.CreateReFlagsNode(tree.CreateNodeList(rhsFlagsToken))
+		}
+	}
+	return tree.CreateReFlagsOnOffNode(lhsReFlags, minusToken, rhsReFlags)
+}
+
+func (this *RegExpParser) parseOptionalReQuantifier() tree.STNode {
+	switch this.peek().Kind() {
+	case common.ASTERISK_TOKEN, common.PLUS_TOKEN, common.QUESTION_MARK_TOKEN, common.OPEN_BRACE_TOKEN:
+		return this.parseReQuantifier()
+	default:
+		return tree.CreateEmptyNode()
+	}
+}
+
+func (this *RegExpParser) parseReQuantifier() tree.STNode {
+	var reBaseQuantifier tree.STNode
+	if this.peek().Kind() == common.OPEN_BRACE_TOKEN {
+		reBaseQuantifier = this.parseReBracedQuantifier()
+	} else {
+		reBaseQuantifier = this.consume()
+	}
+	nonGreedyChar := tree.CreateEmptyNode()
+	if this.peek().Kind() == common.QUESTION_MARK_TOKEN {
+		nonGreedyChar = this.consume()
+	}
+	return tree.CreateReQuantifierNode(reBaseQuantifier, nonGreedyChar)
+}
+
+func (this *RegExpParser) parseReBracedQuantifier() tree.STNode {
+	openBrace := this.consume()
+	leastTimesMatchedDigits := this.parseReDigits()
+	if len(leastTimesMatchedDigits) == 0 {
+		leastTimesMatchedDigits = append(leastTimesMatchedDigits,
+			tree.CreateMissingTokenWithDiagnostics(common.DIGIT, &common.ERROR_MISSING_RE_QUANTIFIER_DIGIT))
+	}
+	commaToken := tree.CreateEmptyNode()
+	var mostTimesMatchedDigits []tree.STNode
+	if this.peek().Kind() == common.COMMA_TOKEN {
+		commaToken = this.consume()
+		mostTimesMatchedDigits = this.parseReDigits()
+	}
+	closeBrace := this.parseExpectedToken(common.CLOSE_BRACE_TOKEN, &common.ERROR_MISSING_CLOSE_BRACE_TOKEN)
+	bracedQuantifier := tree.CreateReBracedQuantifierNode(openBrace, tree.CreateNodeList(leastTimesMatchedDigits...),
+		commaToken, tree.CreateNodeList(mostTimesMatchedDigits...), closeBrace)
+
+	least, leastErr := strconv.Atoi(reDigitsText(leastTimesMatchedDigits))
+	most, mostErr := strconv.Atoi(reDigitsText(mostTimesMatchedDigits))
+	if leastErr == nil && mostErr == nil && least > most {
+		bracedQuantifier = tree.AddDiagnostic(bracedQuantifier, &common.ERROR_INVALID_QUANTIFIER_IN_REG_EXP,
+			tree.ToSourceCode(bracedQuantifier))
+	}
+	return bracedQuantifier
+}
+
+func (this *RegExpParser) parseReDigits() []tree.STNode {
+	var digits []tree.STNode
+	for this.peek().Kind() == common.DIGIT {
+		digits = append(digits, this.consume())
+	}
+	return digits
+}
+
+func reDigitsText(digits []tree.STNode) string {
+	var builder strings.Builder
+	for _, digit := range digits {
+		builder.WriteString(tree.ToSourceCode(digit))
+	}
+	return builder.String()
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package parser
+
+import (
+	debugcommon "ballerina-lang-go/common"
+	"ballerina-lang-go/parser/common"
+	"ballerina-lang-go/parser/tree"
+	"ballerina-lang-go/tools/diagnostics"
+)
+
+// templateContentParser is the base of the parsers for the content of xml and regexp templates. The content is
+// lexed with the interpolations replaced with `${}`, and the interpolations parsed by the BallerinaParser are
+// substituted back in the order they appear in the template.
+type templateContentParser struct {
+	abstractParser
+	expressions []tree.STNode
+}
+
+func newTemplateContentParser(tokenReader *TokenReader, expressions []tree.STNode, dbgCtx *debugcommon.DebugContext) templateContentParser {
+	return templateContentParser{
+		abstractParser: NewAbstractParserFromTokenReader(tokenReader, dbgCtx),
+		expressions:    expressions,
+	}
+}
+
+// parseInterpolation returns the interpolation parsed by the BallerinaParser in place of the `${}` in the content.
+func (this *templateContentParser) parseInterpolation() tree.STNode {
+	interpolationStart := this.consume()
+	this.consume()
+	if len(this.expressions) == 0 {
+		panic("parseInterpolation: no interpolation left for the template content")
+	}
+	interpolation := this.expressions[0]
+	this.expressions = this.expressions[1:]
+
+	// Preserve the minutiae the lexer attached to the `${`, so that the source text is not lost
+	leadingMinutiae := interpolationStart.LeadingMinutiae().(*tree.STNodeList)
+	if leadingMinutiae.IsEmpty() && len(interpolationStart.Diagnostics()) == 0 {
+		return interpolation
+	}
+	firstToken := tree.FirstToken(interpolation)
+	minutiae := make([]tree.STNode, leadingMinutiae.Size())
+	for i := range minutiae {
+		minutiae[i] = leadingMinutiae.Get(i)
+	}
+	newFirstToken := firstToken.ModifyWith(
+		firstToken.LeadingMinutiae().(*tree.STNodeList).AddAllAt(0, minutiae), firstToken.TrailingMinutiae())
+	newFirstToken = tree.AddSyntaxDiagnostics(newFirstToken, interpolationStart.Diagnostics())
+	return tree.Replace(interpolation, firstToken, newFirstToken)
+}
+
+func (this *templateContentParser) parseExpectedToken(kind common.SyntaxKind, diagnosticCode diagnostics.DiagnosticCode) tree.STToken {
+	if this.peek().Kind() == kind {
+		return this.consume()
+	}
+	return tree.CreateMissingTokenWithDiagnostics(kind, diagnosticCode)
+}
@@ -402,12 +402,23 @@ func (n *NonTerminalNodeBase) ChildInBucket(bucket int) Node {
 	if !IsSTNodePresent(internalChild) {
 		return nil
 	}
-	child = createFacade[Node](internalChild, n.position, n)
+	child = createFacade[Node](internalChild, n.childPosition(bucket), n)
 	n.childBuckets[bucket] = child
 	return child
 
 }
 
+// childPosition returns the offset of the child in the given bucket, including its leading minutiae.
+func (n *NonTerminalNodeBase) childPosition(bucket int) int {
+	position := n.position
+	for i := 0; i < bucket; i++ {
+		if child := n.internalNode.ChildInBucket(i); IsSTNodePresent(child) {
+			position += int(child.WidthWithMinutiae())
+		}
+	}
+	return position
+}
+
 type Token interface {
 	Node
 	Text() string
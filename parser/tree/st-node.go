@@ -541,7 +541,7 @@ func findToken(n STNode, dir direction) STToken {
 			}
 			panic("expected STToken")
 		}
-		if (!IsSTNodePresent(child) || IsSTNodeList(child)) && child.BucketCount() == 0 {
+		if !IsSTNodePresent(child) || (IsSTNodeList(child) && child.BucketCount() == 0) {
 			continue
 		}
 		var token STToken
@@ -586,10 +586,20 @@ func writeTo(n STNode, builder *strings.Builder) {
 	tok, ok := n.(STToken)
 	if ok {
 		writeTo(tok.LeadingMinutiae(), builder)
-		builder.WriteString(tok.Text())
+		if !tok.IsMissing() {
+			builder.WriteString(tok.Text())
+		}
 		writeTo(tok.TrailingMinutiae(), builder)
 		return
 	}
+	switch minutiae := n.(type) {
+	case *STMinutiae:
+		builder.WriteString(minutiae.text)
+		return
+	case *STInvalidNodeMinutiae:
+		builder.WriteString(minutiae.text)
+		return
+	}
 	for _, child := range n.ChildBuckets() {
 		if IsSTNodePresent(child) {
 			writeTo(child, builder)
@@ -968,6 +978,43 @@ func replaceAll(current []STNode, target STNode, replacement STNode) (bool, []ST
 	return modified, result
 }
 
+// HasSyntaxDiagnostic reports whether the parser reported a diagnostic with the given code on node or its descendants,
+// including the invalid nodes the parser moved into the minutiae of their tokens.
+func HasSyntaxDiagnostic(node STNode, code diagnostics.DiagnosticCode) bool {
+	if !IsSTNodePresent(node) || !node.HasDiagnostics() {
+		return false
+	}
+	for _, diagnostic := range node.Diagnostics() {
+		if diagnostic.code.DiagnosticId() == code.DiagnosticId() {
+			return true
+		}
+	}
+	if token, ok := node.(STToken); ok {
+		return minutiaeHasSyntaxDiagnostic(token.LeadingMinutiae(), code) ||
+			minutiaeHasSyntaxDiagnostic(token.TrailingMinutiae(), code)
+	}
+	for _, child := range node.ChildBuckets() {
+		if HasSyntaxDiagnostic(child, code) {
+			return true
+		}
+	}
+	return false
+}
+
+func minutiaeHasSyntaxDiagnostic(minutiaeList STNode, code diagnostics.DiagnosticCode) bool {
+	list, ok := minutiaeList.(*STNodeList)
+	if !ok {
+		return false
+	}
+	for _, minutiae := range list.children {
+		if invalidNodeMinutiae, ok := minutiae.(*STInvalidNodeMinutiae); ok &&
+			HasSyntaxDiagnostic(invalidNodeMinutiae.invalidNode, code) {
+			return true
+		}
+	}
+	return false
+}
+
 func AddSyntaxDiagnostic[T STNode](node T, diagnostic STNodeDiagnostic) T {
 	return AddSyntaxDiagnostics(node, []STNodeDiagnostic{diagnostic})
 }
@@ -3645,6 +3692,438 @@ func CreateInterpolationNode(interpolationStartToken STNode, expression STNode,
 	}, interpolationStartToken, expression, interpolationEndToken)
 }
 
+// ========== XML Item Methods ==========
+
+func CreateXMLElementNode(startTag STNode, content STNode, endTag STNode) STNode {
+	return createNodeAndAddChildren(&STXMLElementNode{
+		STXMLItemNode: &STNodeBase{
+			kind: common.XML_ELEMENT,
+		},
+		StartTag: startTag,
+		Content:  content,
+		EndTag:   endTag,
+	}, startTag, content, endTag)
+}
+
+func CreateXMLStartTagNode(ltToken STNode, name STNode, attributes STNode, getToken STNode) STNode {
+	return createNodeAndAddChildren(&STXMLStartTagNode{
+		STXMLElementTagNode: &STNodeBase{
+			kind: common.XML_ELEMENT_START_TAG,
+		},
+		LtToken:    ltToken,
+		Name:       name,
+		Attributes: attributes,
+		GetToken:   getToken,
+	}, ltToken, name, attributes, getToken)
+}
+
+func CreateXMLEndTagNode(ltToken STNode, slashToken STNode, name STNode, getToken STNode) STNode {
+	return createNodeAndAddChildren(&STXMLEndTagNode{
+		STXMLElementTagNode: &STNodeBase{
+			kind: common.XML_ELEMENT_END_TAG,
+		},
+		LtToken:    ltToken,
+		SlashToken: slashToken,
+		Name:       name,
+		GetToken:   getToken,
+	}, ltToken, slashToken, name, getToken)
+}
+
+func CreateXMLSimpleNameNode(name STNode) STNode {
+	return createNodeAndAddChildren(&STXMLSimpleNameNode{
+		STXMLNameNode: &STNodeBase{
+			kind: common.XML_SIMPLE_NAME,
+		},
+		Name: name,
+	}, name)
+}
+
+func CreateXMLQualifiedNameNode(prefix STNode, colon STNode, name STNode) STNode {
+	return createNodeAndAddChildren(&STXMLQualifiedNameNode{
+		STXMLNameNode: &STNodeBase{
+			kind: common.XML_QUALIFIED_NAME,
+		},
+		Prefix: prefix,
+		Colon:  colon,
+		Name:   name,
+	}, prefix, colon, name)
+}
+
+func CreateXMLEmptyElementNode(ltToken STNode, name STNode, attributes STNode, slashToken STNode, getToken STNode) STNode {
+	return createNodeAndAddChildren(&STXMLEmptyElementNode{
+		STXMLItemNode: &STNodeBase{
+			kind: common.XML_EMPTY_ELEMENT,
+		},
+		LtToken:    ltToken,
+		Name:       name,
+		Attributes: attributes,
+		SlashToken: slashToken,
+		GetToken:   getToken,
+	}, ltToken, name, attributes, slashToken, getToken)
+}
+
+func CreateXMLTextNode(content STNode) STNode {
+	return createNodeAndAddChildren(&STXMLTextNode{
+		STXMLItemNode: &STNodeBase{
+			kind: common.XML_TEXT,
+		},
+		Content: content,
+	}, content)
+}
+
+func CreateXMLAttributeNode(attributeName STNode, equalToken STNode, value STNode) STNode {
+	return createNodeAndAddChildren(&STXMLAttributeNode{
+		STNode: &STNodeBase{
+			kind: common.XML_ATTRIBUTE,
+		},
+		AttributeName: attributeName,
+		EqualToken:    equalToken,
+		Value:         value,
+	}, attributeName, equalToken, value)
+}
+
+func CreateXMLAttributeValue(startQuote STNode, value STNode, endQuote STNode) STNode {
+	return createNodeAndAddChildren(&STXMLAttributeValue{
+		STNode: &STNodeBase{
+			kind: common.XML_ATTRIBUTE_VALUE,
+		},
+		StartQuote: startQuote,
+		Value:      value,
+		EndQuote:   endQuote,
+	}, startQuote, value, endQuote)
+}
+
+func CreateXMLComment(commentStart STNode, content STNode, commentEnd STNode) STNode {
+	return createNodeAndAddChildren(&STXMLComment{
+		STXMLItemNode: &STNodeBase{
+			kind: common.XML_COMMENT,
+		},
+		CommentStart: commentStart,
+		Content:      content,
+		CommentEnd:   commentEnd,
+	}, commentStart, content, commentEnd)
+}
+
+func CreateXMLCDATANode(cdataStart STNode, content STNode, cdataEnd STNode) STNode {
+	return createNodeAndAddChildren(&STXMLCDATANode{
+		STXMLItemNode: &STNodeBase{
+			kind: common.XML_CDATA,
+		},
+		CdataStart: cdataStart,
+		Content:    content,
+		CdataEnd:   cdataEnd,
+	}, cdataStart, content, cdataEnd)
+}
+
+func CreateXMLProcessingInstruction(piStart STNode, target STNode, data STNode, piEnd STNode) STNode {
+	return createNodeAndAddChildren(&STXMLProcessingInstruction{
+		STXMLItemNode: &STNodeBase{
+			kind: common.XML_PI,
+		},
+		PiStart: piStart,
+		Target:  target,
+		Data:    data,
+		PiEnd:   piEnd,
+	}, piStart, target, data, piEnd)
+}
+
+// ========== RegExp Methods ==========
+
+func CreateReSequenceNode(reTerm STNode) STNode {
+	return createNodeAndAddChildren(&STReSequenceNode{
+		STNode: &STNodeBase{
+			kind: common.RE_SEQUENCE,
+		},
+		ReTerm: reTerm,
+	}, reTerm)
+}
+
+func CreateReAtomQuantifierNode(reAtom STNode, reQuantifier STNode) STNode {
+	return createNodeAndAddChildren(&STReAtomQuantifierNode{
+		STReTermNode: &STNodeBase{
+			kind: common.RE_ATOM_QUANTIFIER,
+		},
+		ReAtom:       reAtom,
+		ReQuantifier: reQuantifier,
+	}, reAtom, reQuantifier)
+}
+
+func CreateReAtomCharOrEscapeNode(reAtomCharOrEscape STNode) STNode {
+	return createNodeAndAddChildren(&STReAtomCharOrEscapeNode{
+		STNode: &STNodeBase{
+			kind: common.RE_LITERAL_CHAR_DOT_OR_ESCAPE,
+		},
+		ReAtomCharOrEscape: reAtomCharOrEscape,
+	}, reAtomCharOrEscape)
+}
+
+func CreateReQuoteEscapeNode(slashToken STNode, reSyntaxChar STNode) STNode {
+	return createNodeAndAddChildren(&STReQuoteEscapeNode{
+		STNode: &STNodeBase{
+			kind: common.RE_QUOTE_ESCAPE,
+		},
+		SlashToken:   slashToken,
+		ReSyntaxChar: reSyntaxChar,
+	}, slashToken, reSyntaxChar)
+}
+
+func CreateReSimpleCharClassEscapeNode(slashToken STNode, reSimpleCharClassCode STNode) STNode {
+	return createNodeAndAddChildren(&STReSimpleCharClassEscapeNode{
+		STNode: &STNodeBase{
+			kind: common.RE_SIMPLE_CHAR_CLASS_ESCAPE,
+		},
+		SlashToken:            slashToken,
+		ReSimpleCharClassCode: reSimpleCharClassCode,
+	}, slashToken, reSimpleCharClassCode)
+}
+
+func CreateReUnicodePropertyEscapeNode(slashToken STNode, property STNode, openBraceToken STNode, reUnicodeProperty STNode, closeBraceToken STNode) STNode {
+	return createNodeAndAddChildren(&STReUnicodePropertyEscapeNode{
+		STNode: &STNodeBase{
+			kind: common.RE_UNICODE_PROPERTY_ESCAPE,
+		},
+		SlashToken:        slashToken,
+		Property:          property,
+		OpenBraceToken:    openBraceToken,
+		ReUnicodeProperty: reUnicodeProperty,
+		CloseBraceToken:   closeBraceToken,
+	}, slashToken, property, openBraceToken, reUnicodeProperty, closeBraceToken)
+}
+
+func CreateReUnicodeScriptNode(scriptStart STNode, reUnicodePropertyValue STNode) STNode {
+	return createNodeAndAddChildren(&STReUnicodeScriptNode{
+		STReUnicodePropertyNode: &STNodeBase{
+			kind: common.RE_UNICODE_SCRIPT,
+		},
+		ScriptStart:            scriptStart,
+		ReUnicodePropertyValue: reUnicodePropertyValue,
+	}, scriptStart, reUnicodePropertyValue)
+}
+
+func CreateReUnicodeGeneralCategoryNode(categoryStart STNode, reUnicodeGeneralCategoryName STNode) STNode {
+	return createNodeAndAddChildren(&STReUnicodeGeneralCategoryNode{
+		STReUnicodePropertyNode: &STNodeBase{
+			kind: common.RE_UNICODE_GENERAL_CATEGORY,
+		},
+		CategoryStart:                categoryStart,
+		ReUnicodeGeneralCategoryName: reUnicodeGeneralCategoryName,
+	}, categoryStart, reUnicodeGeneralCategoryName)
+}
+
+func CreateReCharacterClassNode(openBracket STNode, negation STNode, reCharSet STNode, closeBracket STNode) STNode {
+	return createNodeAndAddChildren(&STReCharacterClassNode{
+		STNode: &STNodeBase{
+			kind: common.RE_CHARACTER_CLASS,
+		},
+		OpenBracket:  openBracket,
+		Negation:     negation,
+		ReCharSet:    reCharSet,
+		CloseBracket: closeBracket,
+	}, openBracket, negation, reCharSet, closeBracket)
+}
+
+func CreateReCharSetRangeWithReCharSetNode(reCharSetRange STNode, reCharSet STNode) STNode {
+	return createNodeAndAddChildren(&STReCharSetRangeWithReCharSetNode{
+		STNode: &STNodeBase{
+			kind: common.RE_CHAR_SET_RANGE_WITH_RE_CHAR_SET,
+		},
+		ReCharSetRange: reCharSetRange,
+		ReCharSet:      reCharSet,
+	}, reCharSetRange, reCharSet)
+}
+
+func CreateReCharSetRangeNode(lhsReCharSetAtom STNode, minusToken STNode, rhsReCharSetAtom STNode) STNode {
+	return createNodeAndAddChildren(&STReCharSetRangeNode{
+		STNode: &STNodeBase{
+			kind: common.RE_CHAR_SET_RANGE,
+		},
+		LhsReCharSetAtom: lhsReCharSetAtom,
+		MinusToken:       minusToken,
+		RhsReCharSetAtom: rhsReCharSetAtom,
+	}, lhsReCharSetAtom, minusToken, rhsReCharSetAtom)
+}
+
+func CreateReCharSetAtomWithReCharSetNoDashNode(reCharSetAtom STNode, reCharSetNoDash STNode) STNode {
+	return createNodeAndAddChildren(&STReCharSetAtomWithReCharSetNoDashNode{
+		STNode: &STNodeBase{
+			kind: common.RE_CHAR_SET_ATOM_WITH_RE_CHAR_SET_NO_DASH,
+		},
+		ReCharSetAtom:   reCharSetAtom,
+		ReCharSetNoDash: reCharSetNoDash,
+	}, reCharSetAtom, reCharSetNoDash)
+}
+
+func CreateReCharSetRangeNoDashWithReCharSetNode(reCharSetRangeNoDash STNode, reCharSet STNode) STNode {
+	return createNodeAndAddChildren(&STReCharSetRangeNoDashWithReCharSetNode{
+		STNode: &STNodeBase{
+			kind: common.RE_CHAR_SET_RANGE_NO_DASH_WITH_RE_CHAR_SET,
+		},
+		ReCharSetRangeNoDash: reCharSetRangeNoDash,
+		ReCharSet:            reCharSet,
+	}, reCharSetRangeNoDash, reCharSet)
+}
+
+func CreateReCharSetRangeNoDashNode(reCharSetAtomNoDash STNode, minusToken STNode, reCharSetAtom STNode) STNode {
+	return createNodeAndAddChildren(&STReCharSetRangeNoDashNode{
+		STNode: &STNodeBase{
+			kind: common.RE_CHAR_SET_RANGE_NO_DASH,
+		},
+		ReCharSetAtomNoDash: reCharSetAtomNoDash,
+		MinusToken:          minusToken,
+		ReCharSetAtom:       reCharSetAtom,
+	}, reCharSetAtomNoDash, minusToken, reCharSetAtom)
+}
+
+func CreateReCharSetAtomNoDashWithReCharSetNoDashNode(reCharSetAtomNoDash STNode, reCharSetNoDash STNode) STNode {
+	return createNodeAndAddChildren(&STReCharSetAtomNoDashWithReCharSetNoDashNode{
+		STNode: &STNodeBase{
+			kind: common.RE_CHAR_SET_ATOM_NO_DASH_WITH_RE_CHAR_SET_NO_DASH,
+		},
+		ReCharSetAtomNoDash: reCharSetAtomNoDash,
+		ReCharSetNoDash:     reCharSetNoDash,
+	}, reCharSetAtomNoDash, reCharSetNoDash)
+}
+
+func CreateReCapturingGroupsNode(openParenthesis STNode, reFlagExpression STNode, reSequences STNode, closeParenthesis STNode) STNode {
+	return createNodeAndAddChildren(&STReCapturingGroupsNode{
+		STNode: &STNodeBase{
+			kind: common.RE_CAPTURING_GROUP,
+		},
+		OpenParenthesis:  openParenthesis,
+		ReFlagExpression: reFlagExpression,
+		ReSequences:      reSequences,
+		CloseParenthesis: closeParenthesis,
+	}, openParenthesis, reFlagExpression, reSequences, closeParenthesis)
+}
+
+func CreateReFlagExpressionNode(questionMark STNode, reFlagsOnOff STNode, colon STNode) STNode {
+	return createNodeAndAddChildren(&STReFlagExpressionNode{
+		STNode: &STNodeBase{
+			kind: common.RE_FLAG_EXPR,
+		},
+		QuestionMark: questionMark,
+		ReFlagsOnOff: reFlagsOnOff,
+		Colon:        colon,
+	}, questionMark, reFlagsOnOff, colon)
+}
+
+func CreateReFlagsOnOffNode(lhsReFlags STNode, minusToken STNode, rhsReFlags STNode) STNode {
+	return createNodeAndAddChildren(&STReFlagsOnOffNode{
+		STNode: &STNodeBase{
+			kind: common.RE_FLAGS_ON_OFF,
+		},
+		LhsReFlags: lhsReFlags,
+		MinusToken: minusToken,
+		RhsReFlags: rhsReFlags,
+	}, lhsReFlags, minusToken, rhsReFlags)
+}
+
+func CreateReFlagsNode(reFlag STNode) STNode {
+	return createNodeAndAddChildren(&STReFlagsNode{
+		STNode: &STNodeBase{
+			kind: common.RE_FLAGS,
+		},
+		ReFlag: reFlag,
+	}, reFlag)
+}
+
+func CreateReAssertionNode(reAssertion STNode) STNode {
+	return createNodeAndAddChildren(&STReAssertionNode{
+		STReTermNode: &STNodeBase{
+			kind: common.RE_ASSERTION,
+		},
+		ReAssertion: reAssertion,
+	}, reAssertion)
+}
+
+func CreateReQuantifierNode(reBaseQuantifier STNode, nonGreedyChar STNode) STNode {
+	return createNodeAndAddChildren(&STReQuantifierNode{
+		STNode: &STNodeBase{
+			kind: common.RE_QUANTIFIER,
+		},
+		ReBaseQuantifier: reBaseQuantifier,
+		NonGreedyChar:    nonGreedyChar,
+	}, reBaseQuantifier, nonGreedyChar)
+}
+
+func CreateReBracedQuantifierNode(openBraceToken STNode, leastTimesMatchedDigit STNode, commaToken STNode, mostTimesMatchedDigit STNode, closeBraceToken STNode) STNode {
+	return createNodeAndAddChildren(&STReBracedQuantifierNode{
+		STNode: &STNodeBase{
+			kind: common.RE_BRACED_QUANTIFIER,
+		},
+		OpenBraceToken:         openBraceToken,
+		LeastTimesMatchedDigit: leastTimesMatchedDigit,
+		CommaToken:             commaToken,
+		MostTimesMatchedDigit:  mostTimesMatchedDigit,
+		CloseBraceToken:        closeBraceToken,
+	}, openBraceToken, leastTimesMatchedDigit, commaToken, mostTimesMatchedDigit, closeBraceToken)
+}
+
+// ========== Documentation Methods ==========
+
+func CreateMarkdownParameterDocumentationLineNode(kind common.SyntaxKind, hashToken STNode, plusToken STNode,
+	parameterName STNode, minusToken STNode, documentElements STNode) STNode {
+	return createNodeAndAddChildren(&STMarkdownParameterDocumentationLineNode{
+		STDocumentationNode: &STNodeBase{
+			kind: kind,
+		},
+		HashToken:        hashToken,
+		PlusToken:        plusToken,
+		ParameterName:    parameterName,
+		MinusToken:       minusToken,
+		DocumentElements: documentElements,
+	}, hashToken, plusToken, parameterName, minusToken, documentElements)
+}
+
+func CreateBallerinaNameReferenceNode(referenceType STNode, startBacktick STNode, nameReference STNode,
+	endBacktick STNode) STNode {
+	return createNodeAndAddChildren(&STBallerinaNameReferenceNode{
+		STDocumentationNode: &STNodeBase{
+			kind: common.BALLERINA_NAME_REFERENCE,
+		},
+		ReferenceType: referenceType,
+		StartBacktick: startBacktick,
+		NameReference: nameReference,
+		EndBacktick:   endBacktick,
+	}, referenceType, startBacktick, nameReference, endBacktick)
+}
+
+func CreateInlineCodeReferenceNode(startBacktick STNode, codeReference STNode, endBacktick STNode) STNode {
+	return createNodeAndAddChildren(&STInlineCodeReferenceNode{
+		STDocumentationNode: &STNodeBase{
+			kind: common.INLINE_CODE_REFERENCE,
+		},
+		StartBacktick: startBacktick,
+		CodeReference: codeReference,
+		EndBacktick:   endBacktick,
+	}, startBacktick, codeReference, endBacktick)
+}
+
+func CreateMarkdownCodeBlockNode(startLineHashToken STNode, startBacktick STNode, langAttribute STNode,
+	codeLines STNode, endLineHashToken STNode, endBacktick STNode) STNode {
+	return createNodeAndAddChildren(&STMarkdownCodeBlockNode{
+		STDocumentationNode: &STNodeBase{
+			kind: common.MARKDOWN_CODE_BLOCK,
+		},
+		StartLineHashToken: startLineHashToken,
+		StartBacktick:      startBacktick,
+		LangAttribute:      langAttribute,
+		CodeLines:          codeLines,
+		EndLineHashToken:   endLineHashToken,
+		EndBacktick:        endBacktick,
+	}, startLineHashToken, startBacktick, langAttribute, codeLines, endLineHashToken, endBacktick)
+}
+
+func CreateMarkdownCodeLineNode(hashToken STNode, codeDescription STNode) STNode {
+	return createNodeAndAddChildren(&STMarkdownCodeLineNode{
+		STDocumentationNode: &STNodeBase{
+			kind: common.MARKDOWN_CODE_LINE,
+		},
+		HashToken:       hashToken,
+		CodeDescription: codeDescription,
+	}, hashToken, codeDescription)
+}
+
 // ========== Binding Pattern Methods (STNodeFactory.java:1705-1710) ==========
 
 // From STNodeFactory.java:1705-1710
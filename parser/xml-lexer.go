@@ -0,0 +1,421 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package parser
+
+import (
+	debugcommon "ballerina-lang-go/common"
+	"ballerina-lang-go/parser/common"
+	"ballerina-lang-go/parser/tree"
+	"ballerina-lang-go/tools/text"
+	"unicode"
+)
+
+// NewXMLLexer creates a lexer that tokenizes the content of an xml template. The content is expected to have
+// the interpolations replaced with `${}`.
+func NewXMLLexer(reader text.CharReader, debugCtx *debugcommon.DebugContext) *Lexer {
+	lexer := NewLexer(reader, debugCtx)
+	lexer.StartMode(PARSER_MODE_XML_CONTENT)
+	return lexer
+}
+
+func (l *Lexer) readTokenInXMLContent() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+
+	switch reader.Peek() {
+	case LT:
+		reader.Advance()
+		return l.processXMLTokenStartWithLt()
+	case DOLLAR:
+		if reader.PeekN(1) == OPEN_BRACE {
+			// Switch to interpolation mode. Then the next token will be read in that mode.
+			l.StartMode(PARSER_MODE_INTERPOLATION)
+			reader.AdvanceN(2)
+			return l.getSyntaxTokenWithoutTrailingTrivia(common.INTERPOLATION_START_TOKEN)
+		}
+	}
+
+	// Everything else is character data
+	return l.readXMLText()
+}
+
+func (l *Lexer) processXMLTokenStartWithLt() tree.STToken {
+	reader := l.reader
+	switch reader.Peek() {
+	case EXCLAMATION_MARK:
+		if reader.PeekN(1) == MINUS && reader.PeekN(2) == MINUS {
+			reader.AdvanceN(3)
+			l.StartMode(PARSER_MODE_XML_COMMENT)
+			return l.getSyntaxTokenWithoutTrailingTrivia(common.XML_COMMENT_START_TOKEN)
+		}
+		if l.isXMLCDATAStart() {
+			reader.AdvanceN(len("![CDATA["))
+			l.StartMode(PARSER_MODE_XML_CDATA_SECTION)
+			return l.getSyntaxTokenWithoutTrailingTrivia(common.XML_CDATA_START_TOKEN)
+		}
+	case QUESTION_MARK:
+		reader.Advance()
+		l.StartMode(PARSER_MODE_XML_PI)
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.XML_PI_START_TOKEN)
+	case SLASH:
+		// The end tag closes the content of the current element. The root content is never closed, since the
+		// parser is expected to report the unmatched end tag.
+		if len(l.context.modeStack) > 1 {
+			l.EndMode()
+		}
+		l.StartMode(PARSER_MODE_XML_ELEMENT_END_TAG)
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.LT_TOKEN)
+	}
+	l.StartMode(PARSER_MODE_XML_ELEMENT_START_TAG)
+	return l.getSyntaxTokenWithoutTrailingTrivia(common.LT_TOKEN)
+}
+
+func (l *Lexer) isXMLCDATAStart() bool {
+	for i, c := range "![CDATA[" {
+		if l.reader.PeekN(i) != c {
+			return false
+		}
+	}
+	return true
+}
+
+func (l *Lexer) readXMLText() tree.STToken {
+	reader := l.reader
+	for !reader.IsEOF() {
+		switch reader.Peek() {
+		case LT:
+			return l.getXMLText()
+		case DOLLAR:
+			if reader.PeekN(1) == OPEN_BRACE {
+				return l.getXMLText()
+			}
+			reader.Advance()
+		case BITWISE_AND:
+			l.processXMLReference()
+		default:
+			reader.Advance()
+		}
+	}
+	return l.getXMLText()
+}
+
+// processXMLReference consumes an entity or a character reference such as `&amp;` or `&#x3C;`
+func (l *Lexer) processXMLReference() {
+	reader := l.reader
+	reader.Advance()
+	if reader.Peek() == HASH {
+		reader.Advance()
+		if reader.Peek() == 'x' {
+			reader.Advance()
+		}
+	}
+	for !reader.IsEOF() && isXMLNameChar(reader.Peek()) {
+		reader.Advance()
+	}
+	if reader.Peek() == SEMICOLON {
+		reader.Advance()
+		return
+	}
+	l.reportLexerError(common.ERROR_MISSING_SEMICOLON_IN_XML_REFERENCE)
+}
+
+func (l *Lexer) getXMLText() tree.STToken {
+	leadingTrivia := l.getLeadingTrivia()
+	return tree.CreateLiteralValueToken(common.XML_TEXT_CONTENT, l.getLexeme(), leadingTrivia,
+		tree.CreateEmptyNodeList())
+}
+
+func (l *Lexer) readTokenInXMLElement(isStartTag bool) tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+
+	c := reader.Peek()
+	switch c {
+	case GT:
+		reader.Advance()
+		l.EndMode()
+		if isStartTag {
+			l.StartMode(PARSER_MODE_XML_CONTENT)
+		}
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.GT_TOKEN)
+	case SLASH:
+		reader.Advance()
+		if isStartTag {
+			// Only the closing `>` of the empty element can follow.
+			l.SwitchMode(PARSER_MODE_XML_ELEMENT_END_TAG)
+		}
+		return l.getXMLSyntaxToken(common.SLASH_TOKEN)
+	case COLON:
+		reader.Advance()
+		return l.getXMLSyntaxToken(common.COLON_TOKEN)
+	case EQUAL:
+		reader.Advance()
+		return l.getXMLSyntaxToken(common.EQUAL_TOKEN)
+	case DOUBLE_QUOTE:
+		reader.Advance()
+		l.StartMode(PARSER_MODE_XML_DOUBLE_QUOTED_STRING)
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.DOUBLE_QUOTE_TOKEN)
+	case SINGLE_QUOTE:
+		reader.Advance()
+		l.StartMode(PARSER_MODE_XML_SINGLE_QUOTED_STRING)
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.SINGLE_QUOTE_TOKEN)
+	case DOLLAR:
+		if reader.PeekN(1) == OPEN_BRACE {
+			l.StartMode(PARSER_MODE_INTERPOLATION)
+			reader.AdvanceN(2)
+			return l.getSyntaxTokenWithoutTrailingTrivia(common.INTERPOLATION_START_TOKEN)
+		}
+	}
+
+	if isXMLNameStartChar(c) {
+		return l.processXMLName()
+	}
+
+	reader.Advance()
+	invalidToken := tree.CreateInvalidToken(l.getLexeme())
+	l.context.leadingTriviaList = append(l.context.leadingTriviaList, tree.CreateInvalidNodeMinutiae(invalidToken))
+	token := l.NextToken()
+	return tree.AddSyntaxDiagnostic(token, tree.CreateDiagnostic(&common.ERROR_INVALID_TOKEN, invalidToken))
+}
+
+func (l *Lexer) processXMLName() tree.STToken {
+	reader := l.reader
+	for !reader.IsEOF() && isXMLNameChar(reader.Peek()) {
+		reader.Advance()
+	}
+	leadingTrivia := l.getLeadingTrivia()
+	lexeme := l.getLexeme()
+	trailingTrivia := l.processTrailingXMLTrivia()
+	return tree.CreateIdentifierToken(lexeme, leadingTrivia, trailingTrivia)
+}
+
+func isXMLNameStartChar(c rune) bool {
+	return c == '_' || unicode.IsLetter(c)
+}
+
+func isXMLNameChar(c rune) bool {
+	return isXMLNameStartChar(c) || unicode.IsDigit(c) || c == '.' || c == '-'
+}
+
+func (l *Lexer) readTokenInXMLQuotedString(quote rune) tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+
+	c := reader.Peek()
+	if c == quote {
+		reader.Advance()
+		l.EndMode()
+		if quote == DOUBLE_QUOTE {
+			return l.getXMLSyntaxToken(common.DOUBLE_QUOTE_TOKEN)
+		}
+		return l.getXMLSyntaxToken(common.SINGLE_QUOTE_TOKEN)
+	}
+	if c == DOLLAR && reader.PeekN(1) == OPEN_BRACE {
+		l.StartMode(PARSER_MODE_INTERPOLATION)
+		reader.AdvanceN(2)
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.INTERPOLATION_START_TOKEN)
+	}
+
+	for !reader.IsEOF() {
+		c = reader.Peek()
+		if c == quote || (c == DOLLAR && reader.PeekN(1) == OPEN_BRACE) {
+			break
+		}
+		switch c {
+		case LT:
+			reader.Advance()
+			l.reportLexerError(common.ERROR_INVALID_CHARACTER_IN_XML_ATTRIBUTE_VALUE)
+		case BITWISE_AND:
+			l.processXMLReference()
+		default:
+			reader.Advance()
+		}
+	}
+	return l.getXMLText()
+}
+
+func (l *Lexer) readTokenInXMLComment() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+
+	if l.isXMLCommentEnd() {
+		reader.AdvanceN(3)
+		l.EndMode()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.XML_COMMENT_END_TOKEN)
+	}
+	if reader.Peek() == DOLLAR && reader.PeekN(1) == OPEN_BRACE {
+		l.StartMode(PARSER_MODE_INTERPOLATION)
+		reader.AdvanceN(2)
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.INTERPOLATION_START_TOKEN)
+	}
+
+	for !reader.IsEOF() && !l.isXMLCommentEnd() {
+		c := reader.Peek()
+		if c == DOLLAR && reader.PeekN(1) == OPEN_BRACE {
+			break
+		}
+		if c == MINUS && reader.PeekN(1) == MINUS {
+			reader.AdvanceN(2)
+			l.reportLexerError(common.ERROR_DOUBLE_HYPHEN_NOT_ALLOWED_WITHIN_XML_COMMENT)
+			continue
+		}
+		reader.Advance()
+	}
+	return l.getXMLText()
+}
+
+func (l *Lexer) isXMLCommentEnd() bool {
+	reader := l.reader
+	return reader.Peek() == MINUS && reader.PeekN(1) == MINUS && reader.PeekN(2) == GT
+}
+
+func (l *Lexer) readTokenInXMLPI() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+
+	if l.isXMLPIEnd() {
+		reader.AdvanceN(2)
+		l.EndMode()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.XML_PI_END_TOKEN)
+	}
+
+	// Whatever follows the target is the data of the processing instruction.
+	l.SwitchMode(PARSER_MODE_XML_PI_DATA)
+	c := reader.Peek()
+	if c == DOLLAR && reader.PeekN(1) == OPEN_BRACE {
+		l.StartMode(PARSER_MODE_INTERPOLATION)
+		reader.AdvanceN(2)
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.INTERPOLATION_START_TOKEN)
+	}
+	if isXMLNameStartChar(c) {
+		return l.processXMLName()
+	}
+	return l.readTokenInXMLPIData()
+}
+
+func (l *Lexer) readTokenInXMLPIData() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+
+	if l.isXMLPIEnd() {
+		reader.AdvanceN(2)
+		l.EndMode()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.XML_PI_END_TOKEN)
+	}
+	if reader.Peek() == DOLLAR && reader.PeekN(1) == OPEN_BRACE {
+		l.StartMode(PARSER_MODE_INTERPOLATION)
+		reader.AdvanceN(2)
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.INTERPOLATION_START_TOKEN)
+	}
+
+	for !reader.IsEOF() && !l.isXMLPIEnd() {
+		if reader.Peek() == DOLLAR && reader.PeekN(1) == OPEN_BRACE {
+			break
+		}
+		reader.Advance()
+	}
+	return l.getXMLText()
+}
+
+func (l *Lexer) isXMLPIEnd() bool {
+	return l.reader.Peek() == QUESTION_MARK && l.reader.PeekN(1) == GT
+}
+
+func (l *Lexer) readTokenInXMLCDATASection() tree.STToken {
+	reader := l.reader
+	reader.Mark()
+	if reader.IsEOF() {
+		return l.getSyntaxToken(common.EOF_TOKEN)
+	}
+
+	if l.isXMLCDATAEnd() {
+		reader.AdvanceN(3)
+		l.EndMode()
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.XML_CDATA_END_TOKEN)
+	}
+	if reader.Peek() == DOLLAR && reader.PeekN(1) == OPEN_BRACE {
+		l.StartMode(PARSER_MODE_INTERPOLATION)
+		reader.AdvanceN(2)
+		return l.getSyntaxTokenWithoutTrailingTrivia(common.INTERPOLATION_START_TOKEN)
+	}
+
+	for !reader.IsEOF() && !l.isXMLCDATAEnd() {
+		if reader.Peek() == DOLLAR && reader.PeekN(1) == OPEN_BRACE {
+			break
+		}
+		reader.Advance()
+	}
+	return l.getXMLText()
+}
+
+func (l *Lexer) isXMLCDATAEnd() bool {
+	reader := l.reader
+	return reader.Peek() == CLOSE_BRACKET && reader.PeekN(1) == CLOSE_BRACKET && reader.PeekN(2) == GT
+}
+
+func (l *Lexer) getXMLSyntaxToken(kind common.SyntaxKind) tree.STToken {
+	leadingTrivia := l.getLeadingTrivia()
+	trailingTrivia := l.processTrailingXMLTrivia()
+	return tree.CreateTokenFrom(kind, leadingTrivia, trailingTrivia)
+}
+
+func (l *Lexer) processLeadingXMLTrivia() {
+	l.processXMLTrivia(&l.context.leadingTriviaList, true)
+}
+
+func (l *Lexer) processTrailingXMLTrivia() tree.STNode {
+	triviaList := make([]tree.STNode, 0, INITIAL_TRIVIA_CAPACITY)
+	l.processXMLTrivia(&triviaList, false)
+	return tree.CreateNodeList(triviaList...)
+}
+
+// processXMLTrivia is similar to processSyntaxTrivia, except that comments are not treated as trivia within xml.
+func (l *Lexer) processXMLTrivia(triviaList *[]tree.STNode, isLeading bool) {
+	reader := l.reader
+	for !reader.IsEOF() {
+		reader.Mark()
+		switch reader.Peek() {
+		case SPACE, TAB, FORM_FEED:
+			*triviaList = append(*triviaList, l.processWhitespaces())
+		case CARRIAGE_RETURN, NEWLINE:
+			*triviaList = append(*triviaList, l.processEndOfLine())
+			if !isLeading {
+				return
+			}
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package parser
+
+import (
+	debugcommon "ballerina-lang-go/common"
+	"ballerina-lang-go/parser/common"
+	"ballerina-lang-go/parser/tree"
+	"ballerina-lang-go/tools/diagnostics"
+)
+
+// XMLParser parses the content of an xml template. Interpolations are already parsed by the BallerinaParser, and
+// are expected to be given in the order they appear in the template.
+//
+// Unlike the BallerinaParser, errors are recovered by inserting the missing tokens, and by treating the unexpected
+// tokens as invalid nodes.
+type XMLParser struct {
+	templateContentParser
+}
+
+func NewXMLParserFromTokenReader(tokenReader *TokenReader, expressions []tree.STNode, dbgCtx *debugcommon.DebugContext) XMLParser {
+	return XMLParser{
+		templateContentParser: newTemplateContentParser(tokenReader, expressions, dbgCtx),
+	}
+}
+
+func (this *XMLParser) Parse() tree.STNode {
+	var items []tree.STNode
+	for this.peek().Kind() != common.EOF_TOKEN {
+		if this.isEndTagStart() {
+			// End tag without a matching start tag
+			endTag := this.parseXMLEndTag()
+			this.addInvalidNodeToNextToken(endTag, &common.ERROR_INVALID_TOKEN, tree.ToSourceCode(endTag))
+			continue
+		}
+		item := this.parseXMLContentItem()
+		if item != nil {
+			items = append(items, item)
+		}
+	}
+	if !this.isInvalidNodeStackEmpty() {
+		if len(items) == 0 {
+			items = append(items, tree.CreateXMLTextNode(tree.CreateMissingToken(common.XML_TEXT_CONTENT, nil)))
+		}
+		items[len(items)-1] = this.addInvalidNodeStackToTrailingMinutiae(items[len(items)-1])
+	}
+	return tree.CreateNodeList(items...)
+}
+
+func (this *XMLParser) parseXMLContentItem() tree.STNode {
+	nextToken := this.peek()
+	switch nextToken.Kind() {
+	case common.LT_TOKEN:
+		return this.parseXMLElement()
+	case common.XML_COMMENT_START_TOKEN:
+		return this.parseXMLComment()
+	case common.XML_PI_START_TOKEN:
+		return this.parseXMLPI()
+	case common.XML_CDATA_START_TOKEN:
+		return this.parseXMLCDATA()
+	case common.INTERPOLATION_START_TOKEN:
+		return this.parseInterpolation()
+	case common.XML_TEXT_CONTENT:
+		return tree.CreateXMLTextNode(this.consume())
+	default:
+		this.addInvalidTokenToNextToken(this.consume())
+		return nil
+	}
+}
+
+func (this *XMLParser) isEndTagStart() bool {
+	return this.peek().Kind() == common.LT_TOKEN && this.peekN(2).Kind() == common.SLASH_TOKEN
+}
+
+func (this *XMLParser) parseXMLElement() tree.STNode {
+	ltToken := this.consume()
+	name := this.parseXMLName()
+	attributes := this.parseXMLAttributes()
+	if this.peek().Kind() == common.SLASH_TOKEN {
+		slashToken := this.consume()
+		gtToken := this.parseExpectedToken(common.GT_TOKEN, &common.ERROR_MISSING_GT_TOKEN)
+		return tree.CreateXMLEmptyElementNode(ltToken, name, attributes, slashToken, gtToken)
+	}
+	gtToken := this.parseExpectedToken(common.GT_TOKEN, &common.ERROR_MISSING_GT_TOKEN)
+	startTag := tree.CreateXMLStartTagNode(ltToken, name, attributes, gtToken)
+
+	var items []tree.STNode
+	for this.peek().Kind() != common.EOF_TOKEN && !this.isEndTagStart() {
+		item := this.parseXMLContentItem()
+		if item != nil {
+			items = append(items, item)
+		}
+	}
+	content := tree.CreateNodeList(items...)
+
+	var endTag tree.STNode
+	if this.isEndTagStart() {
+		endTag = this.parseXMLEndTag()
+	} else {
+		endTag = tree.CreateXMLEndTagNode(
+			tree.CreateMissingTokenWithDiagnostics(common.LT_TOKEN, &common.ERROR_MISSING_LT_TOKEN),
+			tree.CreateMissingTokenWithDiagnostics(common.SLASH_TOKEN, &common.ERROR_MISSING_SLASH_TOKEN),
+			tree.CreateXMLSimpleNameNode(tree.CreateMissingTokenWithDiagnostics(common.IDENTIFIER_TOKEN,
+				&common.ERROR_MISSING_IDENTIFIER)),
+			tree.CreateMissingTokenWithDiagnostics(common.GT_TOKEN, &common.ERROR_MISSING_GT_TOKEN))
+	}
+	return tree.CreateXMLElementNode(startTag, content, endTag)
+}
+
+func (this *XMLParser) parseXMLEndTag() tree.STNode {
+	ltToken := this.consume()
+	slashToken := this.consume()
+	name := this.parseXMLName()
+	gtToken := this.parseExpectedToken(common.GT_TOKEN, &common.ERROR_MISSING_GT_TOKEN)
+	return tree.CreateXMLEndTagNode(ltToken, slashToken, name, gtToken)
+}
+
+func (this *XMLParser) parseXMLName() tree.STNode {
+	nextToken := this.peek()
+	switch nextToken.Kind() {
+	case common.IDENTIFIER_TOKEN:
+		name := this.consume()
+		if this.peek().Kind() != common.COLON_TOKEN {
+			return tree.CreateXMLSimpleNameNode(name)
+		}
+		colon := this.consume()
+		localName := this.parseExpectedToken(common.IDENTIFIER_TOKEN, &common.ERROR_MISSING_IDENTIFIER)
+		return tree.CreateXMLQualifiedNameNode(tree.CreateXMLSimpleNameNode(name), colon,
+			tree.CreateXMLSimpleNameNode(localName))
+	case common.INTERPOLATION_START_TOKEN:
+		interpolation := this.parseInterpolation()
+		name := tree.CreateMissingToken(common.IDENTIFIER_TOKEN, nil)
+		name = tree.ToToken(tree.CloneWithLeadingInvalidNodeMinutiae(name, interpolation,
+			&common.ERROR_INTERPOLATION_IS_NOT_ALLOWED_FOR_XML_TAG_NAMES))
+		return tree.CreateXMLSimpleNameNode(name)
+	default:
+		return tree.CreateXMLSimpleNameNode(
+			tree.CreateMissingTokenWithDiagnostics(common.IDENTIFIER_TOKEN, &common.ERROR_MISSING_IDENTIFIER))
+	}
+}
+
+func (this *XMLParser) parseXMLAttributes() tree.STNode {
+	var attributes []tree.STNode
+	for {
+		switch this.peek().Kind() {
+		case common.GT_TOKEN, common.SLASH_TOKEN, common.EOF_TOKEN:
+			return tree.CreateNodeList(attributes...)
+		case common.IDENTIFIER_TOKEN, common.INTERPOLATION_START_TOKEN:
+			attributes = append(attributes, this.parseXMLAttribute())
+		default:
+			this.addInvalidTokenToNextToken(this.consume())
+		}
+	}
+}
+
+func (this *XMLParser) parseXMLAttribute() tree.STNode {
+	attributeName := this.parseXMLName()
+	equalToken := this.parseExpectedToken(common.EQUAL_TOKEN, &common.ERROR_MISSING_EQUAL_TOKEN)
+	value := this.parseXMLAttributeValue()
+	return tree.CreateXMLAttributeNode(attributeName, equalToken, value)
+}
+
+func (this *XMLParser) parseXMLAttributeValue() tree.STNode {
+	var startQuote tree.STToken
+	switch this.peek().Kind() {
+	case common.DOUBLE_QUOTE_TOKEN, common.SINGLE_QUOTE_TOKEN:
+		startQuote = this.consume()
+	default:
+		missingQuote := tree.CreateMissingTokenWithDiagnostics(common.DOUBLE_QUOTE_TOKEN,
+			&common.ERROR_MISSING_DOUBLE_QUOTE_TOKEN)
+		return tree.CreateXMLAttributeValue(missingQuote, tree.CreateEmptyNodeList(),
+			tree.CreateMissingToken(common.DOUBLE_QUOTE_TOKEN, nil))
+	}
+
+	var items []tree.STNode
+	for {
+		nextToken := this.peek()
+		if nextToken.Kind() == common.XML_TEXT_CONTENT {
+			items = append(items, this.consume())
+		} else if nextToken.Kind() == common.INTERPOLATION_START_TOKEN {
+			items = append(items, this.parseInterpolation())
+		} else {
+			break
+		}
+	}
+
+	var endQuote tree.STNode
+	if this.peek().Kind() == startQuote.Kind() {
+		endQuote = this.consume()
+	} else if startQuote.Kind() == common.DOUBLE_QUOTE_TOKEN {
+		endQuote = tree.CreateMissingTokenWithDiagnostics(common.DOUBLE_QUOTE_TOKEN,
+			&common.ERROR_MISSING_DOUBLE_QUOTE_TOKEN)
+	} else {
+		endQuote = tree.CreateMissingTokenWithDiagnostics(common.SINGLE_QUOTE_TOKEN,
+			&common.ERROR_MISSING_SINGLE_QUOTE_TOKEN)
+	}
+	return tree.CreateXMLAttributeValue(startQuote, tree.CreateNodeList(items...), endQuote)
+}
+
+func (this *XMLParser) parseXMLComment() tree.STNode {
+	commentStart := this.consume()
+	content := this.parseXMLTextItems(common.XML_COMMENT_END_TOKEN,
+		&common.ERROR_INTERPOLATION_IS_NOT_ALLOWED_WITHIN_XML_COMMENTS)
+	commentEnd := this.parseExpectedToken(common.XML_COMMENT_END_TOKEN, &common.ERROR_MISSING_XML_COMMENT_END_TOKEN)
+	return tree.CreateXMLComment(commentStart, content, commentEnd)
+}
+
+func (this *XMLParser) parseXMLPI() tree.STNode {
+	piStart := this.consume()
+	var target tree.STNode
+	switch this.peek().Kind() {
+	case common.IDENTIFIER_TOKEN, common.INTERPOLATION_START_TOKEN:
+		target = this.parseXMLName()
+	default:
+		target = tree.CreateXMLSimpleNameNode(
+			tree.CreateMissingTokenWithDiagnostics(common.IDENTIFIER_TOKEN, &common.ERROR_MISSING_IDENTIFIER))
+	}
+	data := this.parseXMLTextItems(common.XML_PI_END_TOKEN, &common.ERROR_INTERPOLATION_IS_NOT_ALLOWED_WITHIN_XML_PI)
+	piEnd := this.parseExpectedToken(common.XML_PI_END_TOKEN, &common.ERROR_MISSING_XML_PI_END_TOKEN)
+	return tree.CreateXMLProcessingInstruction(piStart, target, data, piEnd)
+}
+
+func (this *XMLParser) parseXMLCDATA() tree.STNode {
+	cdataStart := this.consume()
+	content := this.parseXMLTextItems(common.XML_CDATA_END_TOKEN, nil)
+	cdataEnd := this.parseExpectedToken(common.XML_CDATA_END_TOKEN, &common.ERROR_MISSING_XML_CDATA_END_TOKEN)
+	return tree.CreateXMLCDATANode(cdataStart, content, cdataEnd)
+}
+
+// parseXMLTextItems parses the text content up to the given end token. If interpolations are not allowed in the
+// content, they are added as invalid nodes with the given diagnostic code.
+func (this *XMLParser) parseXMLTextItems(endKind common.SyntaxKind, interpolationErrorCode diagnostics.DiagnosticCode) tree.STNode {
+	var items []tree.STNode
+	for {
+		switch this.peek().Kind() {
+		case endKind, common.EOF_TOKEN:
+			return tree.CreateNodeList(items...)
+		case common.XML_TEXT_CONTENT:
+			items = append(items, this.consume())
+		case common.INTERPOLATION_START_TOKEN:
+			interpolation := this.parseInterpolation()
+			if interpolationErrorCode == nil {
+				items = append(items, interpolation)
+			} else {
+				this.addInvalidNodeToNextToken(interpolation, interpolationErrorCode)
+			}
+		default:
+			this.addInvalidTokenToNextToken(this.consume())
+		}
+	}
+}
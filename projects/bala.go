@@ -0,0 +1,351 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projects
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+const (
+	// BallerinaHomeDirEnv overrides the Ballerina home directory, which is `~/.ballerina` by default
+	BallerinaHomeDirEnv = "BALLERINA_HOME_DIR"
+	// CentralRepositoryName is the repository of the home directory caching the packages pulled from Central
+	CentralRepositoryName = "central.ballerina.io"
+	// LocalRepositoryName is the repository of the home directory holding the packages pushed locally
+	LocalRepositoryName = "local"
+
+	// BalaPackageJSON is the file of a bala describing its package
+	BalaPackageJSON = "package.json"
+	// DependencyGraphJSON is the file of a bala recording the dependencies of its package
+	DependencyGraphJSON = "dependency-graph.json"
+	// PackageMdFile is the documentation of a package
+	PackageMdFile = "Package.md"
+	// ModuleMdFile is the documentation of a module
+	ModuleMdFile = "Module.md"
+	// BalaDocsDir is the directory of a bala holding the documentation of the package and its modules
+	BalaDocsDir = "docs"
+	// BalaBIRDir is the directory of a bala in which the BIR of its modules may be cached, as `<module-name>.bir`
+	BalaBIRDir = "bir"
+	// BIRFileExtension is the extension of the files holding the BIR of a module
+	BIRFileExtension = ".bir"
+)
+
+// BallerinaHome returns the Ballerina home directory.
+func BallerinaHome() (string, error) {
+	if home := os.Getenv(BallerinaHomeDirEnv); home != "" {
+		return home, nil
+	}
+	userHome, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(userHome, ".ballerina"), nil
+}
+
+// RepositoryBalaDir returns the directory of the balas of the repository named name in the Ballerina home directory.
+func RepositoryBalaDir(home, name string) string {
+	return filepath.Join(home, "repositories", name, "bala")
+}
+
+// HomeRepositories returns the repositories of the Ballerina home directory: the packages pulled from Central,
+// followed by the packages pushed locally.
+func HomeRepositories(home string) []*BalaRepository {
+	return []*BalaRepository{
+		NewBalaRepository(os.DirFS(RepositoryBalaDir(home, CentralRepositoryName))),
+		NewBalaRepository(os.DirFS(RepositoryBalaDir(home, LocalRepositoryName))),
+	}
+}
+
+// BalaPackage is the package.json of a bala.
+type BalaPackage struct {
+	Organization        string   `json:"organization"`
+	Name                string   `json:"name"`
+	Version             string   `json:"version"`
+	Platform            string   `json:"platform"`
+	BallerinaVersion    string   `json:"ballerina_version"`
+	LanguageSpecVersion string   `json:"language_spec_version"`
+	Export              []string `json:"export"`
+	Authors             []string `json:"authors"`
+	Keywords            []string `json:"keywords"`
+	License             []string `json:"license"`
+	SourceRepository    string   `json:"source_repository"`
+	Visibility          string   `json:"visibility"`
+	Template            bool     `json:"template"`
+}
+
+// Bala is an extracted bala, the distribution format of a package.
+type Bala struct {
+	Package BalaPackage
+	// Readme is the documentation of the package, which is empty if the bala has none
+	Readme string
+	// Modules are the modules of the package sorted by name
+	Modules []*BalaModule
+	fsys    fs.FS
+}
+
+// BalaModule is a module of a bala.
+type BalaModule struct {
+	// Name is the name of the module qualified by the package name
+	Name string
+	// Readme is the documentation of the module, which is empty if the bala has none
+	Readme string
+	// SourceFiles are the paths of the source files of the module in the bala, sorted by name
+	SourceFiles []string
+	// BIRFile is the path of the cached BIR of the module in the bala, or empty if there is none
+	BIRFile string
+}
+
+// LoadBala reads the bala extracted to the root of fsys.
+func LoadBala(fsys fs.FS) (*Bala, error) {
+	content, err := fs.ReadFile(fsys, BalaPackageJSON)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bala: %w", err)
+	}
+	bala := &Bala{fsys: fsys}
+	if err := json.Unmarshal(content, &bala.Package); err != nil {
+		return nil, fmt.Errorf("invalid bala: invalid %s: %w", BalaPackageJSON, err)
+	}
+	if bala.Package.Organization == "" || bala.Package.Name == "" || bala.Package.Version == "" {
+		return nil, fmt.Errorf("invalid bala: %s must give the organization, name and version of the package",
+			BalaPackageJSON)
+	}
+	if bala.Readme, err = readOptionalFile(fsys, path.Join(BalaDocsDir, PackageMdFile)); err != nil {
+		return nil, err
+	}
+
+	entries, err := fs.ReadDir(fsys, ModulesDir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		module, err := loadBalaModule(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		bala.Modules = append(bala.Modules, module)
+	}
+	return bala, nil
+}
+
+func loadBalaModule(fsys fs.FS, name string) (*BalaModule, error) {
+	module := &BalaModule{Name: name}
+	moduleDir := path.Join(ModulesDir, name)
+	entries, err := fs.ReadDir(fsys, moduleDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() && path.Ext(entry.Name()) == SourceFileExtension {
+			module.SourceFiles = append(module.SourceFiles, path.Join(moduleDir, entry.Name()))
+		}
+	}
+	// The documentation of a module is kept with the documentation of the package, or else with its sources
+	for _, readme := range []string{path.Join(BalaDocsDir, ModulesDir, name, ModuleMdFile),
+		path.Join(moduleDir, ModuleMdFile)} {
+		if module.Readme, err = readOptionalFile(fsys, readme); err != nil || module.Readme != "" {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	birFile := path.Join(BalaBIRDir, name+BIRFileExtension)
+	if _, err := fs.Stat(fsys, birFile); err == nil {
+		module.BIRFile = birFile
+	}
+	return module, nil
+}
+
+func readOptionalFile(fsys fs.FS, name string) (string, error) {
+	content, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", nil
+		}
+		return "", err
+	}
+	return string(content), nil
+}
+
+// Descriptor returns the organization, name and version of the package of the bala.
+func (b *Bala) Descriptor() PackageDescriptor {
+	return PackageDescriptor{Org: b.Package.Organization, Name: b.Package.Name, Version: b.Package.Version}
+}
+
+// IsExported reports whether the module named moduleName may be imported by other packages. All of the modules are
+// exported if package.json doesn't list the exported modules.
+func (b *Bala) IsExported(moduleName string) bool {
+	if len(b.Package.Export) == 0 {
+		return true
+	}
+	for _, exported := range b.Package.Export {
+		if exported == moduleName {
+			return true
+		}
+	}
+	return false
+}
+
+// BalaRepository is a repository of extracted balas laid out as `<org>/<name>/<version>/<platform>`, such as the
+// repositories of the Ballerina home directory.
+type BalaRepository struct {
+	fsys fs.FS
+}
+
+// NewBalaRepository returns the repository of the balas in fsys.
+func NewBalaRepository(fsys fs.FS) *BalaRepository {
+	return &BalaRepository{fsys: fsys}
+}
+
+func (r *BalaRepository) Versions(org, name string) ([]string, error) {
+	entries, err := fs.ReadDir(r.fsys, path.Join(org, name))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var versions []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		// Directories of downloads in progress, such as `1.0.0_temp`, are not versions
+		if _, err := semver.StrictNewVersion(entry.Name()); err != nil {
+			continue
+		}
+		platform, err := r.platformDir(org, name, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		if platform != "" {
+			versions = append(versions, entry.Name())
+		}
+	}
+	return versions, nil
+}
+
+// balaDependencyGraph is the content of the dependency-graph.json of a bala. Its packages are the dependency graph of
+// the package of the bala, the direct dependencies of which are not transitive.
+type balaDependencyGraph struct {
+	Packages []struct {
+		PackageDescriptor
+		Transitive bool `json:"transitive"`
+	} `json:"packages"`
+}
+
+func (r *BalaRepository) Dependencies(org, name, version string) ([]PackageDescriptor, error) {
+	platform, err := r.platformDir(org, name, version)
+	if err != nil {
+		return nil, err
+	}
+	if platform == "" {
+		return nil, fmt.Errorf("cannot find bala of '%s/%s:%s'", org, name, version)
+	}
+	content, err := fs.ReadFile(r.fsys, path.Join(platform, DependencyGraphJSON))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var graph balaDependencyGraph
+	if err := json.Unmarshal(content, &graph); err != nil {
+		return nil, fmt.Errorf("invalid %s of '%s/%s:%s': %w", DependencyGraphJSON, org, name, version, err)
+	}
+	var dependencies []PackageDescriptor
+	for _, pkg := range graph.Packages {
+		if !pkg.Transitive {
+			dependencies = append(dependencies, pkg.PackageDescriptor)
+		}
+	}
+	return dependencies, nil
+}
+
+// Bala returns the bala of the given version of org/name, or nil if the repository doesn't have it.
+func (r *BalaRepository) Bala(org, name, version string) (*Bala, error) {
+	platform, err := r.platformDir(org, name, version)
+	if err != nil || platform == "" {
+		return nil, err
+	}
+	fsys, err := fs.Sub(r.fsys, platform)
+	if err != nil {
+		return nil, err
+	}
+	bala, err := LoadBala(fsys)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load bala of '%s/%s:%s': %w", org, name, version, err)
+	}
+	return bala, nil
+}
+
+// platformDir returns the directory of the bala of the given version of org/name, or an empty string if the
+// repository doesn't have it. A bala is extracted into a directory named after its platform, such as `any` or
+// `java21`.
+func (r *BalaRepository) platformDir(org, name, version string) (string, error) {
+	versionDir := path.Join(org, name, version)
+	entries, err := fs.ReadDir(r.fsys, versionDir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", nil
+		}
+		return "", err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			return path.Join(versionDir, entry.Name()), nil
+		}
+	}
+	return "", nil
+}
+
+// LoadBalas returns the balas of the packages in resolved other than root, searching repositories in order.
+func LoadBalas(resolved *DependencyManifest, repositories []*BalaRepository, root PackageDescriptor) ([]*Bala, error) {
+	var balas []*Bala
+	for _, pkg := range resolved.Packages {
+		if pkg.Org == root.Org && pkg.Name == root.Name {
+			continue
+		}
+		var bala *Bala
+		for _, repository := range repositories {
+			var err error
+			if bala, err = repository.Bala(pkg.Org, pkg.Name, pkg.Version); err != nil {
+				return nil, err
+			}
+			if bala != nil {
+				break
+			}
+		}
+		if bala == nil {
+			return nil, fmt.Errorf("cannot find bala of '%s/%s:%s' in the local repositories", pkg.Org, pkg.Name,
+				pkg.Version)
+		}
+		balas = append(balas, bala)
+	}
+	return balas, nil
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projects
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"ballerina-lang-go/centralclient/models"
+	"ballerina-lang-go/context"
+)
+
+const greetingPackageJSON = `{
+  "organization": "example",
+  "name": "greeting",
+  "version": "1.0.0",
+  "platform": "any",
+  "ballerina_version": "2201.12.0",
+  "export": ["greeting", "greeting.util"],
+  "keywords": ["hello"]
+}`
+
+// greetingBala returns the files of the bala of example/greeting:1.0.0, keyed by their paths relative to dir.
+func greetingBala(dir string) map[string]string {
+	return map[string]string{
+		dir + "/package.json":                         greetingPackageJSON,
+		dir + "/docs/Package.md":                      "# Greeting\n",
+		dir + "/docs/modules/greeting/Module.md":      "Greets people.\n",
+		dir + "/modules/greeting/greeting.bal":        "import greeting.util;\n\npublic function hello(string name) returns string {\n    return util:prefix() + name;\n}\n",
+		dir + "/modules/greeting.util/util.bal":       "public function prefix() returns string {\n    return \"Hello, \";\n}\n",
+		dir + "/modules/greeting.util/Module.md":      "Utilities.\n",
+		dir + "/modules/greeting.internal/secret.bal": "public function secret() returns string {\n    return \"\";\n}\n",
+	}
+}
+
+func TestLoadBala(t *testing.T) {
+	root := writePackage(t, greetingBala("."))
+	bala, err := LoadBala(os.DirFS(root))
+	if err != nil {
+		t.Fatalf("failed to load bala: %v", err)
+	}
+	if bala.Descriptor().String() != "example/greeting:1.0.0" || bala.Package.Platform != "any" ||
+		!reflect.DeepEqual(bala.Package.Keywords, []string{"hello"}) {
+		t.Errorf("unexpected package.json: %+v", bala.Package)
+	}
+	if bala.Readme != "# Greeting\n" {
+		t.Errorf("unexpected package docs %q", bala.Readme)
+	}
+	var names, readmes []string
+	for _, module := range bala.Modules {
+		names = append(names, module.Name)
+		readmes = append(readmes, module.Readme)
+	}
+	if expected := []string{"greeting", "greeting.internal", "greeting.util"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected modules %v, got %v", expected, names)
+	}
+	if expected := []string{"Greets people.\n", "", "Utilities.\n"}; !reflect.DeepEqual(readmes, expected) {
+		t.Errorf("expected module docs %q, got %q", expected, readmes)
+	}
+	if files := bala.Modules[2].SourceFiles; !reflect.DeepEqual(files, []string{"modules/greeting.util/util.bal"}) {
+		t.Errorf("unexpected source files %v", files)
+	}
+	if !bala.IsExported("greeting.util") || bala.IsExported("greeting.internal") {
+		t.Error("expected only the modules listed in package.json to be exported")
+	}
+
+	for name, files := range map[string]map[string]string{
+		"missing package.json": {"modules/greeting/greeting.bal": ""},
+		"invalid package.json": {"package.json": "{"},
+		"incomplete package":   {"package.json": `{"organization": "example", "name": "greeting"}`},
+	} {
+		if _, err := LoadBala(os.DirFS(writePackage(t, files))); err == nil || !strings.HasPrefix(err.Error(), "invalid bala") {
+			t.Errorf("%s: expected an invalid bala error, got %v", name, err)
+		}
+	}
+}
+
+func TestBalaRepository(t *testing.T) {
+	files := greetingBala("example/greeting/1.0.0/any")
+	files["example/greeting/1.1.0_temp/any/package.json"] = greetingPackageJSON
+	repository := NewBalaRepository(os.DirFS(writePackage(t, files)))
+
+	versions, err := repository.Versions("example", "greeting")
+	if err != nil || !reflect.DeepEqual(versions, []string{"1.0.0"}) {
+		t.Errorf("expected versions [1.0.0], got %v, %v", versions, err)
+	}
+	if versions, err := repository.Versions("example", "missing"); err != nil || len(versions) != 0 {
+		t.Errorf("expected no versions, got %v, %v", versions, err)
+	}
+	if bala, err := repository.Bala("example", "greeting", "1.0.0"); err != nil || bala == nil || len(bala.Modules) != 3 {
+		t.Errorf("expected the bala of example/greeting:1.0.0, got %v, %v", bala, err)
+	}
+	if bala, err := repository.Bala("example", "greeting", "2.0.0"); err != nil || bala != nil {
+		t.Errorf("expected no bala, got %v, %v", bala, err)
+	}
+
+	resolved := lockOf("example/consumer:0.1.0", "example/greeting:2.0.0")
+	_, err = LoadBalas(resolved, []*BalaRepository{repository}, PackageDescriptor{Org: "example", Name: "consumer"})
+	if err == nil || err.Error() != "cannot find bala of 'example/greeting:2.0.0' in the local repositories" {
+		t.Errorf("expected a missing bala error, got %v", err)
+	}
+}
+
+// linkConsumer compiles a package with main.bal as its default module, resolving its dependencies offline against
+// the repositories of a Ballerina home directory holding files, and links them.
+func linkConsumer(t *testing.T, main string, files map[string]string) (*Compilation, error) {
+	t.Helper()
+	home := writePackage(t, files)
+	cx := context.NewCompilerContext()
+	project, err := Load(cx, writePackage(t, map[string]string{"Ballerina.toml": consumerToml, "main.bal": main}))
+	if err != nil {
+		t.Fatalf("failed to load project: %v", err)
+	}
+	compilation, err := Compile(cx, nil, project)
+	if err != nil {
+		t.Fatalf("failed to compile project: %v", err)
+	}
+	homeRepositories := HomeRepositories(home)
+	var repositories []PackageRepository
+	for _, repository := range homeRepositories {
+		repositories = append(repositories, repository)
+	}
+	resolved, err := NewResolver(models.PackageResolutionModeMedium, repositories...).Resolve(compilation, nil)
+	if err != nil {
+		t.Fatalf("failed to resolve dependencies: %v", err)
+	}
+	balas, err := LoadBalas(resolved, homeRepositories, project.Manifest.Descriptor())
+	if err != nil {
+		t.Fatalf("failed to load balas: %v", err)
+	}
+	return compilation, compilation.LinkDependencies(nil, balas)
+}
+
+func TestLinkDependencies(t *testing.T) {
+	centralDir := filepath.ToSlash(filepath.Join("repositories", CentralRepositoryName, "bala"))
+	files := greetingBala(centralDir + "/example/greeting/1.0.0/any")
+	main := "import example/greeting;\n\npublic function main() {\n    string s = greeting:hello(\"Bob\");\n}\n"
+	compilation, err := linkConsumer(t, main, files)
+	if err != nil {
+		t.Fatalf("failed to link dependencies: %v", err)
+	}
+	var names []string
+	for _, module := range compilation.Modules {
+		names = append(names, module.Module.Name)
+	}
+	if expected := []string{"greeting.util", "greeting", "consumer"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected modules %v in dependency order, got %v", expected, names)
+	}
+	compilation.GenBir()
+	for _, module := range compilation.Modules {
+		if module.BIR == nil {
+			t.Errorf("expected BIR of module %s", module.Module.Name)
+		}
+	}
+
+	// The functions of a dependency are checked against its BIR
+	compilation, err = linkConsumer(t, strings.Replace(main, "hello", "goodbye", 1), files)
+	if err != nil {
+		t.Fatalf("failed to link dependencies: %v", err)
+	}
+	func() {
+		defer func() {
+			if r := recover(); r == nil || !strings.Contains(r.(string), "undefined function 'greeting:goodbye'") {
+				t.Errorf("expected an undefined function panic, got %v", r)
+			}
+		}()
+		compilation.GenBir()
+	}()
+
+	// Modules of the local repository are found too, and only exported modules may be imported
+	localDir := filepath.ToSlash(filepath.Join("repositories", LocalRepositoryName, "bala"))
+	_, err = linkConsumer(t, "import example/greeting.internal;\n", greetingBala(localDir+"/example/greeting/1.0.0/any"))
+	if err == nil || err.Error() != "module 'example/greeting.internal' is not exported by package 'example/greeting:1.0.0'" {
+		t.Errorf("expected a module not exported error, got %v", err)
+	}
+}
+
+func TestLinkDependencyBIR(t *testing.T) {
+	// The modules of a bala with cached BIR are loaded from it rather than compiled from their sources
+	centralDir := filepath.ToSlash(filepath.Join("repositories", CentralRepositoryName, "bala"))
+	files := greetingBala(centralDir + "/example/greeting/1.0.0/any")
+	files[centralDir+"/example/greeting/1.0.0/any/bir/greeting.bir"] = "not BIR"
+	_, err := linkConsumer(t, "import example/greeting;\n", files)
+	if err == nil || !strings.HasPrefix(err.Error(), "cannot load BIR of module 'greeting': ") {
+		t.Errorf("expected a BIR loading error, got %v", err)
+	}
+}
@@ -0,0 +1,313 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projects
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"ballerina-lang-go/ast"
+	"ballerina-lang-go/bir"
+	debugcommon "ballerina-lang-go/common"
+	"ballerina-lang-go/context"
+	"ballerina-lang-go/parser"
+	"ballerina-lang-go/parser/tree"
+)
+
+// Compilation is the result of compiling a project.
+type Compilation struct {
+	cx      *context.CompilerContext
+	Project *Project
+	// Modules are the compiled modules in dependency order: each module comes after the modules it imports
+	Modules []*CompiledModule
+	// modules are the compiled modules keyed by their organization and name
+	modules map[string]*CompiledModule
+}
+
+// CompiledModule is a module whose source files have been compiled to AST, or whose BIR has been loaded.
+type CompiledModule struct {
+	Module           *Module
+	CompilationUnits []*ast.BLangCompilationUnit
+	// Package is nil for a module loaded from BIR
+	Package *ast.BLangPackage
+	// Dependencies are the compiled modules imported by the module
+	Dependencies []*CompiledModule
+	// BIR is nil until the BIR of the compilation is generated
+	BIR *bir.BIRPackage
+}
+
+// Compile parses the source files of the modules of project and builds their AST. Imports of the modules of the
+// package are resolved, supplying their organization and version. It returns an error if a source file can't be read.
+func Compile(cx *context.CompilerContext, debugCtx *debugcommon.DebugContext, project *Project) (*Compilation, error) {
+	compilation := &Compilation{cx: cx, Project: project, modules: make(map[string]*CompiledModule)}
+	var compiledModules []*CompiledModule
+	for _, module := range project.Modules {
+		compiledModule, err := compilation.compileModule(debugCtx, module)
+		if err != nil {
+			return nil, err
+		}
+		compiledModules = append(compiledModules, compiledModule)
+	}
+	for _, compiledModule := range compiledModules {
+		resolveImports(compilation.modules, compiledModule)
+	}
+	compilation.Modules = sortModules(compiledModules)
+	return compilation, nil
+}
+
+// LinkDependencies compiles the modules of the balas that the compilation imports, directly or through the imports of
+// other such modules, and resolves the imports to them. It returns an error if a module of a bala can't be read, or if
+// a module imports a module that its package doesn't export.
+func (c *Compilation) LinkDependencies(debugCtx *debugcommon.DebugContext, balas []*Bala) error {
+	type balaModule struct {
+		bala   *Bala
+		module *Module
+	}
+	available := make(map[string]balaModule)
+	for _, bala := range balas {
+		pkg := bala.Descriptor()
+		for _, module := range bala.Modules {
+			dependency := newModule(c.cx, pkg, module.Name, module.SourceFiles)
+			dependency.FS = bala.fsys
+			dependency.BIRFile = module.BIRFile
+			available[moduleKey(pkg.Org, module.Name)] = balaModule{bala: bala, module: dependency}
+		}
+	}
+	compiledModules := c.Modules
+	pending := append([]*CompiledModule(nil), c.Modules...)
+	for len(pending) > 0 {
+		importer := pending[0]
+		pending = pending[1:]
+		for _, key := range importedModules(importer) {
+			if _, ok := c.modules[key]; ok {
+				continue
+			}
+			dependency, ok := available[key]
+			if !ok {
+				continue
+			}
+			if dependency.module.Package != importer.Module.Package && !dependency.bala.IsExported(dependency.module.Name) {
+				return fmt.Errorf("module '%s' is not exported by package '%s'", key, dependency.module.Package)
+			}
+			compiledModule, err := c.compileModule(debugCtx, dependency.module)
+			if err != nil {
+				return err
+			}
+			compiledModules = append(compiledModules, compiledModule)
+			pending = append(pending, compiledModule)
+		}
+	}
+	for _, compiledModule := range compiledModules {
+		resolveImports(c.modules, compiledModule)
+	}
+	c.Modules = sortModules(compiledModules)
+	return nil
+}
+
+// compileModule builds the AST of the source files of module, or loads its BIR if it has a BIR file.
+func (c *Compilation) compileModule(debugCtx *debugcommon.DebugContext, module *Module) (*CompiledModule, error) {
+	compiledModule := &CompiledModule{Module: module}
+	c.modules[moduleKey(module.Package.Org, module.Name)] = compiledModule
+	if module.BIRFile != "" {
+		content, err := fs.ReadFile(module.FS, module.BIRFile)
+		if err != nil {
+			return nil, err
+		}
+		if compiledModule.BIR, err = bir.LoadBIRPackageFromReader(c.cx, bytes.NewReader(content)); err != nil {
+			return nil, fmt.Errorf("cannot load BIR of module '%s': %w", module.Name, err)
+		}
+		return compiledModule, nil
+	}
+	for _, sourceFile := range module.SourceFiles {
+		var syntaxTree *tree.SyntaxTree
+		fileName := filepath.Base(sourceFile)
+		if module.FS == nil {
+			var err error
+			if syntaxTree, err = parser.GetSyntaxTree(debugCtx, sourceFile); err != nil {
+				return nil, err
+			}
+		} else {
+			content, err := fs.ReadFile(module.FS, sourceFile)
+			if err != nil {
+				return nil, err
+			}
+			syntaxTree = parser.GetSyntaxTreeFromText(debugCtx, sourceFile, string(content))
+			fileName = path.Base(sourceFile)
+		}
+		compilationUnit := ast.GetModuleCompilationUnit(c.cx, module.PackageID, fileName, syntaxTree)
+		compiledModule.CompilationUnits = append(compiledModule.CompilationUnits, compilationUnit)
+	}
+	compiledModule.Package = ast.ToPackage(compiledModule.CompilationUnits...)
+	checkRedeclaredSymbols(compiledModule.Package)
+	return compiledModule, nil
+}
+
+// GenBir generates the BIR of the modules in dependency order, so that references to the symbols of imported modules
+// are resolved against their BIR. The BIR of the modules loaded from BIR is kept.
+func (c *Compilation) GenBir() {
+	for _, module := range c.Modules {
+		if module.Package == nil {
+			continue
+		}
+		var dependencies []*bir.BIRPackage
+		for _, dependency := range module.Dependencies {
+			dependencies = append(dependencies, dependency.BIR)
+		}
+		module.BIR = bir.GenBirWithDependencies(c.cx, module.Package, dependencies)
+	}
+}
+
+func moduleKey(org, moduleName string) string {
+	return org + "/" + moduleName
+}
+
+// checkRedeclaredSymbols checks that the module level symbols of the compilation units of a module have unique names.
+func checkRedeclaredSymbols(pkg *ast.BLangPackage) {
+	declared := make(map[string]bool)
+	declare := func(name string) {
+		if declared[name] {
+			panic(fmt.Sprintf("redeclared symbol '%s'", name))
+		}
+		declared[name] = true
+	}
+	for i := range pkg.Functions {
+		declare(pkg.Functions[i].Name.Value)
+	}
+	for i := range pkg.GlobalVars {
+		declare(pkg.GlobalVars[i].Name.Value)
+	}
+	for i := range pkg.Constants {
+		declare(pkg.Constants[i].Name.Value)
+	}
+	for i := range pkg.TypeDefinitions {
+		declare(pkg.TypeDefinitions[i].GetName().GetValue())
+	}
+	for i := range pkg.Annotations {
+		declare(pkg.Annotations[i].Name.Value)
+	}
+}
+
+// resolveImports resolves the imports of module to the compiled modules, supplying the organization and version of
+// the imported modules. An import of a module of the same package either names the organization of the package or
+// omits it, and its module name is the package name, optionally followed by the name of a submodule. It panics if such
+// a module doesn't exist. Imports of other packages that are not compiled are left unresolved.
+func resolveImports(modules map[string]*CompiledModule, module *CompiledModule) {
+	pkg := module.Module.Package
+	if module.Package == nil {
+		for _, importModule := range module.BIR.ImportModules {
+			key := moduleKey(importModule.PackageID.OrgName.Value(), importModule.PackageID.Name.Value())
+			if dependency, ok := modules[key]; ok {
+				module.addDependency(dependency)
+			}
+		}
+		return
+	}
+	for i := range module.Package.Imports {
+		importPkg := &module.Package.Imports[i]
+		orgName, moduleName := importedModule(pkg, importPkg)
+		dependency, ok := modules[moduleKey(orgName, moduleName)]
+		if !ok {
+			if pkg.Name != "" && orgName == pkg.Org &&
+				(moduleName == pkg.Name || strings.HasPrefix(moduleName, pkg.Name+".")) {
+				panic(fmt.Sprintf("cannot resolve module '%s/%s'", orgName, moduleName))
+			}
+			continue
+		}
+		version := dependency.Module.Package.Version
+		importPkg.OrgName = &ast.BLangIdentifier{Value: orgName, OriginalValue: orgName}
+		importPkg.Version = &ast.BLangIdentifier{Value: version, OriginalValue: version}
+		module.addDependency(dependency)
+	}
+}
+
+// importedModule returns the organization and name of the module imported by importPkg from a module of pkg.
+func importedModule(pkg PackageDescriptor, importPkg *ast.BLangImportPackage) (string, string) {
+	orgName := pkg.Org
+	if importPkg.OrgName != nil && importPkg.OrgName.Value != "" {
+		orgName = importPkg.OrgName.Value
+	}
+	var nameComps []string
+	for _, comp := range importPkg.PkgNameComps {
+		nameComps = append(nameComps, comp.Value)
+	}
+	return orgName, strings.Join(nameComps, ".")
+}
+
+// importedModules returns the keys of the modules imported by module.
+func importedModules(module *CompiledModule) []string {
+	var keys []string
+	if module.Package == nil {
+		for _, importModule := range module.BIR.ImportModules {
+			keys = append(keys, moduleKey(importModule.PackageID.OrgName.Value(), importModule.PackageID.Name.Value()))
+		}
+		return keys
+	}
+	for i := range module.Package.Imports {
+		keys = append(keys, moduleKey(importedModule(module.Module.Package, &module.Package.Imports[i])))
+	}
+	return keys
+}
+
+func (m *CompiledModule) addDependency(dependency *CompiledModule) {
+	for _, existing := range m.Dependencies {
+		if existing == dependency {
+			return
+		}
+	}
+	m.Dependencies = append(m.Dependencies, dependency)
+}
+
+// sortModules orders modules so that each module comes after its dependencies, keeping the given order otherwise. It
+// panics if the modules import each other cyclically.
+func sortModules(modules []*CompiledModule) []*CompiledModule {
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[*CompiledModule]int)
+	var sorted []*CompiledModule
+	var path []string
+	var visit func(module *CompiledModule)
+	visit = func(module *CompiledModule) {
+		switch state[module] {
+		case visited:
+			return
+		case visiting:
+			cycle := append(path, module.Module.Name)
+			for cycle[0] != module.Module.Name {
+				cycle = cycle[1:]
+			}
+			panic(fmt.Sprintf("cyclic module imports detected: %s", strings.Join(cycle, " -> ")))
+		}
+		state[module] = visiting
+		path = append(path, module.Module.Name)
+		for _, dependency := range module.Dependencies {
+			visit(dependency)
+		}
+		path = path[:len(path)-1]
+		state[module] = visited
+		sorted = append(sorted, module)
+	}
+	for _, module := range modules {
+		visit(module)
+	}
+	return sorted
+}
@@ -0,0 +1,404 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projects
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"reflect"
+	"sort"
+	"strings"
+
+	"ballerina-lang-go/tomlparser"
+	"ballerina-lang-go/tomlparser/syntax"
+)
+
+const (
+	// DependenciesTomlFile is the name of the lock file recording the resolved dependencies of a package
+	DependenciesTomlFile = "Dependencies.toml"
+	// DependenciesTomlVersion is the version of the format of the Dependencies.toml files that are written
+	DependenciesTomlVersion = "2"
+)
+
+// PackageDescriptor identifies a version of a package.
+type PackageDescriptor struct {
+	Org     string `toml:"org" json:"org"`
+	Name    string `toml:"name" json:"name"`
+	Version string `toml:"version" json:"version"`
+}
+
+func (d PackageDescriptor) String() string {
+	if d.Version == "" {
+		return d.Org + "/" + d.Name
+	}
+	return fmt.Sprintf("%s/%s:%s", d.Org, d.Name, d.Version)
+}
+
+// DependencyManifest is the content of a Dependencies.toml: the versions of the packages of a dependency graph.
+type DependencyManifest struct {
+	// DistributionVersion is the Ballerina version that resolved the dependencies
+	DistributionVersion string
+	// Packages are sorted by organization and name
+	Packages []*LockedPackage
+}
+
+// LockedPackage is a `[[package]]` table of a Dependencies.toml.
+type LockedPackage struct {
+	Org     string `toml:"org"`
+	Name    string `toml:"name"`
+	Version string `toml:"version"`
+	// Dependencies are the packages the package depends on directly
+	Dependencies []LockedDependency `toml:"dependencies"`
+	// Modules are the modules of the package imported by the packages of the graph
+	Modules []LockedModule `toml:"modules"`
+}
+
+// LockedDependency is an element of the `dependencies` array of a `[[package]]`.
+type LockedDependency struct {
+	Org  string `toml:"org"`
+	Name string `toml:"name"`
+}
+
+// LockedModule is an element of the `modules` array of a `[[package]]`.
+type LockedModule struct {
+	Org         string `toml:"org"`
+	PackageName string `toml:"packageName"`
+	ModuleName  string `toml:"moduleName"`
+}
+
+type dependenciesToml struct {
+	Ballerina struct {
+		DependenciesTomlVersion string `toml:"dependencies-toml-version"`
+		DistributionVersion     string `toml:"distribution-version"`
+	} `toml:"ballerina"`
+	Packages []*LockedPackage `toml:"package"`
+}
+
+// LoadDependencyManifest reads the Dependencies.toml at path in fsys, validating it against the built-in schema of
+// Dependencies.toml. It returns nil without an error if the file does not exist.
+func LoadDependencyManifest(fsys fs.FS, path string) (*DependencyManifest, error) {
+	if _, err := fs.Stat(fsys, path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	toml, err := tomlparser.ReadWithSchema(fsys, path, tomlparser.DependenciesTomlSchema())
+	if err != nil {
+		if toml != nil && len(toml.Diagnostics()) > 0 {
+			messages := make([]string, len(toml.Diagnostics()))
+			for i, diagnostic := range toml.Diagnostics() {
+				messages[i] = formatDiagnostic(diagnostic)
+			}
+			return nil, fmt.Errorf("invalid %s: %s", DependenciesTomlFile, strings.Join(messages, "; "))
+		}
+		return nil, err
+	}
+	var content dependenciesToml
+	toml.To(&content)
+	if len(toml.Diagnostics()) > 0 {
+		return nil, fmt.Errorf("invalid %s: %s", DependenciesTomlFile, formatDiagnostic(toml.Diagnostics()[0]))
+	}
+	manifest := &DependencyManifest{DistributionVersion: content.Ballerina.DistributionVersion, Packages: content.Packages}
+	manifest.sort()
+	return manifest, nil
+}
+
+// Package returns the locked package org/name, or nil if the manifest doesn't have it.
+func (m *DependencyManifest) Package(org, name string) *LockedPackage {
+	if m == nil {
+		return nil
+	}
+	for _, pkg := range m.Packages {
+		if pkg.Org == org && pkg.Name == name {
+			return pkg
+		}
+	}
+	return nil
+}
+
+// ModulePackage returns the locked package having the module org/moduleName, or nil if the manifest doesn't list the
+// module.
+func (m *DependencyManifest) ModulePackage(org, moduleName string) *LockedPackage {
+	if m == nil {
+		return nil
+	}
+	for _, pkg := range m.Packages {
+		for _, module := range pkg.Modules {
+			if module.Org == org && module.ModuleName == moduleName {
+				return pkg
+			}
+		}
+	}
+	return nil
+}
+
+func (m *DependencyManifest) sort() {
+	sort.SliceStable(m.Packages, func(i, j int) bool {
+		return comparePackages(m.Packages[i].Org, m.Packages[i].Name, m.Packages[j].Org, m.Packages[j].Name) < 0
+	})
+	for _, pkg := range m.Packages {
+		sort.SliceStable(pkg.Dependencies, func(i, j int) bool {
+			return comparePackages(pkg.Dependencies[i].Org, pkg.Dependencies[i].Name, pkg.Dependencies[j].Org,
+				pkg.Dependencies[j].Name) < 0
+		})
+		sort.SliceStable(pkg.Modules, func(i, j int) bool {
+			return pkg.Modules[i].ModuleName < pkg.Modules[j].ModuleName
+		})
+	}
+}
+
+func comparePackages(org1, name1, org2, name2 string) int {
+	if c := strings.Compare(org1, org2); c != 0 {
+		return c
+	}
+	return strings.Compare(name1, name2)
+}
+
+// String formats the manifest as the content of a Dependencies.toml.
+func (m *DependencyManifest) String() string {
+	var sb strings.Builder
+	sb.WriteString("# AUTO-GENERATED FILE. DO NOT MODIFY.\n\n")
+	sb.WriteString("# This file is auto-generated by Ballerina for managing dependency versions.\n")
+	sb.WriteString("# It should not be modified by hand.\n\n")
+	sb.WriteString("[ballerina]\n")
+	fmt.Fprintf(&sb, "dependencies-toml-version = %s\n", quoteString(DependenciesTomlVersion))
+	if m.DistributionVersion != "" {
+		fmt.Fprintf(&sb, "distribution-version = %s\n", quoteString(m.DistributionVersion))
+	}
+	for _, pkg := range m.Packages {
+		writePackageTable(&sb, pkg)
+	}
+	return sb.String()
+}
+
+// Update returns content, the text of a Dependencies.toml, updated to the manifest. Unlike String, it edits the
+// syntax tree of content in place, keeping its comments, formatting and the order of its packages. Packages missing
+// from content are inserted before the first package that sorts after them. Content with syntax
+// errors or without a [ballerina] table is replaced by String.
+func (m *DependencyManifest) Update(content string) string {
+	tree := syntax.Parse(DependenciesTomlFile, content)
+	if tree.HasDiagnostics() {
+		return m.String()
+	}
+	document := tree.RootNode()
+	ballerina := findTable(document, "ballerina")
+	if ballerina == nil {
+		return m.String()
+	}
+	setStringField(&ballerina.Fields, "dependencies-toml-version", DependenciesTomlVersion)
+	if m.DistributionVersion != "" {
+		setStringField(&ballerina.Fields, "distribution-version", m.DistributionVersion)
+	}
+
+	locked := make(map[PackageDescriptor]bool)
+	for _, member := range document.Members {
+		if table, ok := member.(*syntax.TableArrayNode); ok && isKey(table.Key, "package") {
+			locked[packageOf(table)] = true
+		}
+	}
+	var added []*LockedPackage
+	for _, pkg := range m.Packages {
+		if !locked[PackageDescriptor{Org: pkg.Org, Name: pkg.Name}] {
+			added = append(added, pkg)
+		}
+	}
+	var members []syntax.Node
+	addPackages := func(before func(pkg *LockedPackage) bool) {
+		for len(added) > 0 && before(added[0]) {
+			var sb strings.Builder
+			writePackageTable(&sb, added[0])
+			members = append(members, syntax.Parse("", sb.String()).RootNode().Members...)
+			added = added[1:]
+		}
+	}
+	for _, member := range document.Members {
+		table, ok := member.(*syntax.TableArrayNode)
+		if !ok || !isKey(table.Key, "package") {
+			members = append(members, member)
+			continue
+		}
+		descriptor := packageOf(table)
+		pkg := m.Package(descriptor.Org, descriptor.Name)
+		if pkg == nil {
+			// The package is no longer a dependency
+			continue
+		}
+		addPackages(func(added *LockedPackage) bool {
+			return comparePackages(added.Org, added.Name, pkg.Org, pkg.Name) < 0
+		})
+		updatePackageTable(table, pkg)
+		members = append(members, table)
+	}
+	addPackages(func(*LockedPackage) bool { return true })
+	document.Members = members
+	return tree.ToSourceCode()
+}
+
+func updatePackageTable(table *syntax.TableArrayNode, pkg *LockedPackage) {
+	setStringField(&table.Fields, "version", pkg.Version)
+	var dependencies []map[string]string
+	for _, dependency := range pkg.Dependencies {
+		dependencies = append(dependencies, map[string]string{"org": dependency.Org, "name": dependency.Name})
+	}
+	var modules []map[string]string
+	for _, module := range pkg.Modules {
+		modules = append(modules, map[string]string{"org": module.Org, "packageName": module.PackageName,
+			"moduleName": module.ModuleName})
+	}
+	var sb strings.Builder
+	writeDependencies(&sb, pkg.Dependencies)
+	setArrayField(&table.Fields, "dependencies", dependencies, sb.String())
+	sb.Reset()
+	writeModules(&sb, pkg.Modules)
+	setArrayField(&table.Fields, "modules", modules, sb.String())
+}
+
+func packageOf(table *syntax.TableArrayNode) PackageDescriptor {
+	return PackageDescriptor{Org: stringField(table.Fields, "org"), Name: stringField(table.Fields, "name")}
+}
+
+func findTable(document *syntax.DocumentNode, name string) *syntax.TableNode {
+	for _, member := range document.Members {
+		if table, ok := member.(*syntax.TableNode); ok && isKey(table.Key, name) {
+			return table
+		}
+	}
+	return nil
+}
+
+func isKey(key *syntax.KeyNode, name string) bool {
+	names := key.Names()
+	return len(names) == 1 && names[0] == name
+}
+
+func findField(fields []*syntax.KeyValueNode, name string) int {
+	for i, field := range fields {
+		if isKey(field.Key, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func stringField(fields []*syntax.KeyValueNode, name string) string {
+	if i := findField(fields, name); i >= 0 {
+		if token, ok := fields[i].Value.(*syntax.Token); ok && token.Kind() == syntax.STRING_LITERAL_TOKEN {
+			return token.Value()
+		}
+	}
+	return ""
+}
+
+// setStringField sets the field name to value, appending the field if fields don't have it.
+func setStringField(fields *[]*syntax.KeyValueNode, name, value string) {
+	i := findField(*fields, name)
+	if i < 0 {
+		*fields = append(*fields, syntax.CreateKeyValue([]string{name}, syntax.CreateStringLiteral(value)))
+	} else if stringField(*fields, name) != value {
+		(*fields)[i].SetValue(syntax.CreateStringLiteral(value))
+	}
+}
+
+// setArrayField sets the field name, an array of inline tables, to values, replacing the field with source unless it
+// already has the values. An empty array of values removes the field.
+func setArrayField(fields *[]*syntax.KeyValueNode, name string, values []map[string]string, source string) {
+	i := findField(*fields, name)
+	if i < 0 && len(values) == 0 {
+		return
+	}
+	if i >= 0 && reflect.DeepEqual(inlineTables((*fields)[i].Value), values) {
+		return
+	}
+	if len(values) == 0 {
+		*fields = append((*fields)[:i], (*fields)[i+1:]...)
+		return
+	}
+	field := syntax.Parse("", source).RootNode().Members[0].(*syntax.KeyValueNode)
+	if i < 0 {
+		*fields = append(*fields, field)
+	} else {
+		(*fields)[i].SetValue(field.Value)
+	}
+}
+
+// inlineTables returns the string fields of the inline tables of an array value.
+func inlineTables(value syntax.Node) []map[string]string {
+	array, ok := value.(*syntax.ArrayNode)
+	if !ok {
+		return nil
+	}
+	var tables []map[string]string
+	for _, element := range array.Elements {
+		table := make(map[string]string)
+		if inlineTable, ok := element.Value.(*syntax.InlineTableNode); ok {
+			for _, field := range inlineTable.Fields {
+				if token, ok := field.KeyValue.Value.(*syntax.Token); ok {
+					table[strings.Join(field.KeyValue.Key.Names(), ".")] = token.Value()
+				}
+			}
+		}
+		tables = append(tables, table)
+	}
+	return tables
+}
+
+func writePackageTable(sb *strings.Builder, pkg *LockedPackage) {
+	sb.WriteString("\n[[package]]\n")
+	fmt.Fprintf(sb, "org = %s\n", quoteString(pkg.Org))
+	fmt.Fprintf(sb, "name = %s\n", quoteString(pkg.Name))
+	fmt.Fprintf(sb, "version = %s\n", quoteString(pkg.Version))
+	writeDependencies(sb, pkg.Dependencies)
+	writeModules(sb, pkg.Modules)
+}
+
+func writeDependencies(sb *strings.Builder, dependencies []LockedDependency) {
+	if len(dependencies) == 0 {
+		return
+	}
+	sb.WriteString("dependencies = [\n")
+	for i, dependency := range dependencies {
+		fmt.Fprintf(sb, "\t{org = %s, name = %s}", quoteString(dependency.Org), quoteString(dependency.Name))
+		writeArraySeparator(sb, i, len(dependencies))
+	}
+	sb.WriteString("]\n")
+}
+
+func writeModules(sb *strings.Builder, modules []LockedModule) {
+	if len(modules) == 0 {
+		return
+	}
+	sb.WriteString("modules = [\n")
+	for i, module := range modules {
+		fmt.Fprintf(sb, "\t{org = %s, packageName = %s, moduleName = %s}", quoteString(module.Org),
+			quoteString(module.PackageName), quoteString(module.ModuleName))
+		writeArraySeparator(sb, i, len(modules))
+	}
+	sb.WriteString("]\n")
+}
+
+func writeArraySeparator(sb *strings.Builder, i, n int) {
+	if i < n-1 {
+		sb.WriteString(",")
+	}
+	sb.WriteString("\n")
+}
+
+func quoteString(value string) string {
+	return syntax.CreateStringLiteral(value).Text()
+}
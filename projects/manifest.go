@@ -0,0 +1,92 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projects
+
+import (
+	"fmt"
+	"io/fs"
+	"regexp"
+	"strings"
+
+	"ballerina-lang-go/tomlparser"
+)
+
+const (
+	// BallerinaTomlFile is the name of the file whose presence makes a directory a package
+	BallerinaTomlFile = "Ballerina.toml"
+	// DefaultVersion is the version of a package whose Ballerina.toml does not give one
+	DefaultVersion = "0.1.0"
+)
+
+var packageNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_.]+$`)
+
+// PackageManifest is the `[package]` table of a Ballerina.toml.
+type PackageManifest struct {
+	Org     string
+	Name    string
+	Version string
+	// Dependencies are the `[[dependency]]` tables, whose versions are the minimum versions of the packages the
+	// dependencies are resolved to
+	Dependencies []PackageDescriptor
+}
+
+// LoadManifest reads the Ballerina.toml at path in fsys, validating it against the built-in schema of Ballerina.toml.
+func LoadManifest(fsys fs.FS, path string) (*PackageManifest, error) {
+	toml, err := tomlparser.ReadWithSchema(fsys, path, tomlparser.BallerinaTomlSchema())
+	if err != nil {
+		if toml != nil && len(toml.Diagnostics()) > 0 {
+			messages := make([]string, len(toml.Diagnostics()))
+			for i, diagnostic := range toml.Diagnostics() {
+				messages[i] = formatDiagnostic(diagnostic)
+			}
+			return nil, fmt.Errorf("invalid %s: %s", BallerinaTomlFile, strings.Join(messages, "; "))
+		}
+		return nil, err
+	}
+	manifest := &PackageManifest{Version: DefaultVersion}
+	var ok bool
+	if manifest.Org, ok = toml.GetString("package.org"); !ok {
+		return nil, fmt.Errorf("invalid %s: missing 'org' in [package]", BallerinaTomlFile)
+	}
+	if manifest.Name, ok = toml.GetString("package.name"); !ok {
+		return nil, fmt.Errorf("invalid %s: missing 'name' in [package]", BallerinaTomlFile)
+	}
+	if version, ok := toml.GetString("package.version"); ok {
+		manifest.Version = version
+	}
+	dependencies, _ := toml.GetTables("dependency")
+	for _, dependency := range dependencies {
+		org, _ := dependency.GetString("org")
+		name, _ := dependency.GetString("name")
+		version, _ := dependency.GetString("version")
+		manifest.Dependencies = append(manifest.Dependencies, PackageDescriptor{Org: org, Name: name, Version: version})
+	}
+	return manifest, nil
+}
+
+// Descriptor returns the organization, name and version of the package.
+func (m *PackageManifest) Descriptor() PackageDescriptor {
+	return PackageDescriptor{Org: m.Org, Name: m.Name, Version: m.Version}
+}
+
+func formatDiagnostic(diagnostic tomlparser.Diagnostic) string {
+	if diagnostic.Location == nil {
+		return diagnostic.Message
+	}
+	return fmt.Sprintf("%s at line %d, column %d", diagnostic.Message, diagnostic.Location.StartLine,
+		diagnostic.Location.StartColumn)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package projects loads Ballerina packages from the file system and compiles their modules together.
+package projects
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"ballerina-lang-go/context"
+	"ballerina-lang-go/model"
+)
+
+const (
+	// ModulesDir is the directory of a package holding its submodules, one directory per module
+	ModulesDir = "modules"
+	// SourceFileExtension is the extension of Ballerina source files
+	SourceFileExtension = ".bal"
+)
+
+// Project is a Ballerina package, or a single source file compiled as the default module of the anonymous package.
+type Project struct {
+	// SourceRoot is the package directory, or the source file of a single file project
+	SourceRoot string
+	// Manifest is nil for a single file project
+	Manifest *PackageManifest
+	// Modules are the modules of the package with source files, the default module followed by the submodules sorted
+	// by name
+	Modules []*Module
+}
+
+// Module is a module of a project.
+type Module struct {
+	// Name is the name of the module qualified by the package name, such as `hello.util`. It is empty for the module
+	// of a single file project.
+	Name      string
+	PackageID *model.PackageID
+	// Package is the package of the module, whose fields are empty for the module of a single file project
+	Package PackageDescriptor
+	// SourceFiles are the paths of the source files of the module, sorted by name
+	SourceFiles []string
+	// FS is the file system the source files and BIR file are read from, or nil if they are paths of the operating
+	// system
+	FS fs.FS
+	// BIRFile is the path of the BIR of a module of a dependency, which is loaded instead of compiling its source
+	// files if it is given
+	BIRFile string
+}
+
+// IsSingleFile reports whether the project is a standalone source file rather than a package.
+func (p *Project) IsSingleFile() bool {
+	return p.Manifest == nil
+}
+
+// DisplayName is the name by which the project is reported: the file name of a single file project, or
+// `org/name:version` for a package.
+func (p *Project) DisplayName() string {
+	if p.IsSingleFile() {
+		return filepath.Base(p.SourceRoot)
+	}
+	return fmt.Sprintf("%s/%s:%s", p.Manifest.Org, p.Manifest.Name, p.Manifest.Version)
+}
+
+// Load loads the project at path, which is either a Ballerina source file or a package directory containing a
+// Ballerina.toml.
+func Load(cx *context.CompilerContext, path string) (*Project, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		if filepath.Ext(path) != SourceFileExtension {
+			return nil, fmt.Errorf("invalid Ballerina source file '%s'", path)
+		}
+		module := &Module{PackageID: cx.GetDefaultPackage(), SourceFiles: []string{path}}
+		return &Project{SourceRoot: path, Modules: []*Module{module}}, nil
+	}
+	return loadPackage(cx, path)
+}
+
+func loadPackage(cx *context.CompilerContext, root string) (*Project, error) {
+	if _, err := os.Stat(filepath.Join(root, BallerinaTomlFile)); err != nil {
+		return nil, fmt.Errorf("'%s' is not a Ballerina package: %s not found", root, BallerinaTomlFile)
+	}
+	manifest, err := LoadManifest(os.DirFS(root), BallerinaTomlFile)
+	if err != nil {
+		return nil, err
+	}
+	project := &Project{SourceRoot: root, Manifest: manifest}
+	sourceFiles, err := sourceFilesIn(root)
+	if err != nil {
+		return nil, err
+	}
+	if len(sourceFiles) > 0 {
+		project.Modules = append(project.Modules, newModule(cx, manifest.Descriptor(), manifest.Name, sourceFiles))
+	}
+
+	entries, err := os.ReadDir(filepath.Join(root, ModulesDir))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if !packageNamePattern.MatchString(entry.Name()) || strings.Contains(entry.Name(), ".") {
+			return nil, fmt.Errorf("invalid module name '%s'", entry.Name())
+		}
+		sourceFiles, err := sourceFilesIn(filepath.Join(root, ModulesDir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if len(sourceFiles) == 0 {
+			continue
+		}
+		project.Modules = append(project.Modules, newModule(cx, manifest.Descriptor(), manifest.Name+"."+entry.Name(), sourceFiles))
+	}
+	if len(project.Modules) == 0 {
+		return nil, fmt.Errorf("package '%s' has no Ballerina source files", root)
+	}
+	return project, nil
+}
+
+func newModule(cx *context.CompilerContext, pkg PackageDescriptor, name string, sourceFiles []string) *Module {
+	var nameComps []model.Name
+	for _, comp := range strings.Split(name, ".") {
+		nameComps = append(nameComps, model.Name(comp))
+	}
+	packageID := cx.NewPackageID(model.Name(pkg.Org), nameComps, model.Name(pkg.Version))
+	return &Module{Name: name, PackageID: packageID, Package: pkg, SourceFiles: sourceFiles}
+}
+
+func sourceFilesIn(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var sourceFiles []string
+	for _, entry := range entries {
+		if !entry.IsDir() && filepath.Ext(entry.Name()) == SourceFileExtension {
+			sourceFiles = append(sourceFiles, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(sourceFiles)
+	return sourceFiles, nil
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projects
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"ballerina-lang-go/bir"
+	"ballerina-lang-go/context"
+)
+
+const helloToml = `[package]
+org = "example"
+name = "hello"
+version = "1.2.0"
+`
+
+const helloMain = `import ballerina/io;
+import hello.util;
+import example/hello.model;
+
+public function main() {
+    model:Person p = {name: "Alice", age: util:DEFAULT_AGE};
+    io:println(p);
+    io:println(util:greet("Bob"));
+    io:println(twice(util:count));
+}
+`
+
+const helloUtil = `public const DEFAULT_AGE = 30;
+
+public final int count = 3;
+
+public function greet(string name) returns string {
+    return "Hello, " + name;
+}
+
+function secret() {
+}
+`
+
+const helloModel = `public type Person record {|
+    string name;
+    int age;
+|};
+
+type Secret record {||};
+`
+
+func helloPackage() map[string]string {
+	return map[string]string{
+		"Ballerina.toml":          helloToml,
+		"main.bal":                helloMain,
+		"helpers.bal":             "function twice(int n) returns int {\n    return n * 2;\n}\n",
+		"modules/util/util.bal":   helloUtil,
+		"modules/model/model.bal": helloModel,
+	}
+}
+
+// writePackage writes files, keyed by their slash separated paths, to a temporary directory and returns it.
+func writePackage(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	for path, content := range files {
+		filePath := filepath.Join(root, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestLoadSingleFile(t *testing.T) {
+	root := writePackage(t, map[string]string{"main.bal": "public function main() {\n}\n"})
+	cx := context.NewCompilerContext()
+	project, err := Load(cx, filepath.Join(root, "main.bal"))
+	if err != nil {
+		t.Fatalf("failed to load project: %v", err)
+	}
+	if !project.IsSingleFile() {
+		t.Error("expected a single file project")
+	}
+	if project.DisplayName() != "main.bal" {
+		t.Errorf("expected display name main.bal, got %s", project.DisplayName())
+	}
+	if len(project.Modules) != 1 || project.Modules[0].PackageID != cx.GetDefaultPackage() {
+		t.Errorf("expected a single module in the default package")
+	}
+}
+
+func TestLoadPackage(t *testing.T) {
+	root := writePackage(t, helloPackage())
+	cx := context.NewCompilerContext()
+	project, err := Load(cx, root)
+	if err != nil {
+		t.Fatalf("failed to load project: %v", err)
+	}
+	if project.DisplayName() != "example/hello:1.2.0" {
+		t.Errorf("expected display name example/hello:1.2.0, got %s", project.DisplayName())
+	}
+	var names []string
+	for _, module := range project.Modules {
+		names = append(names, module.Name)
+	}
+	if strings.Join(names, ",") != "hello,hello.model,hello.util" {
+		t.Errorf("unexpected modules %v", names)
+	}
+	defaultModule := project.Modules[0]
+	if len(defaultModule.SourceFiles) != 2 || filepath.Base(defaultModule.SourceFiles[0]) != "helpers.bal" {
+		t.Errorf("expected the sorted source files of the default module, got %v", defaultModule.SourceFiles)
+	}
+	utilID := project.Modules[2].PackageID
+	if utilID != cx.NewPackageID("example", utilID.NameComps, "1.2.0") || utilID.Name.Value() != "hello.util" {
+		t.Errorf("unexpected package ID %s", utilID.Name.Value())
+	}
+}
+
+func TestLoadInvalidPackage(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected string
+	}{
+		{"no manifest", map[string]string{"main.bal": ""}, "not a Ballerina package"},
+		{"missing org", map[string]string{"Ballerina.toml": "[package]\nname = \"hello\"\n", "main.bal": ""},
+			"missing 'org'"},
+		{"invalid version", map[string]string{"Ballerina.toml": "[package]\norg = \"a\"\nname = \"b\"\nversion = \"1.x\"\n",
+			"main.bal": ""}, "'version' should be compatible with semver at line 4, column 1"},
+		{"unsupported key", map[string]string{"Ballerina.toml": helloToml + "exported = [\"hello\"]\n", "main.bal": ""},
+			"key 'exported' not supported in schema 'package' at line 5, column 1"},
+		{"invalid toml", map[string]string{"Ballerina.toml": "[package\n", "main.bal": ""}, "invalid Ballerina.toml"},
+		{"no sources", map[string]string{"Ballerina.toml": helloToml}, "has no Ballerina source files"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Load(context.NewCompilerContext(), writePackage(t, test.files))
+			if err == nil || !strings.Contains(err.Error(), test.expected) {
+				t.Errorf("expected error containing %q, got %v", test.expected, err)
+			}
+		})
+	}
+}
+
+func compilePackage(t *testing.T, files map[string]string) *Compilation {
+	t.Helper()
+	cx := context.NewCompilerContext()
+	project, err := Load(cx, writePackage(t, files))
+	if err != nil {
+		t.Fatalf("failed to load project: %v", err)
+	}
+	compilation, err := Compile(cx, nil, project)
+	if err != nil {
+		t.Fatalf("failed to compile project: %v", err)
+	}
+	compilation.GenBir()
+	return compilation
+}
+
+func TestCompilePackage(t *testing.T) {
+	compilation := compilePackage(t, helloPackage())
+	var names []string
+	for _, module := range compilation.Modules {
+		names = append(names, module.Module.Name)
+	}
+	if strings.Join(names, ",") != "hello.util,hello.model,hello" {
+		t.Errorf("expected modules in dependency order, got %v", names)
+	}
+	main := compilation.Modules[2]
+	if len(main.Dependencies) != 2 {
+		t.Errorf("expected 2 dependencies, got %d", len(main.Dependencies))
+	}
+	var imports []string
+	for _, importModule := range main.BIR.ImportModules {
+		imports = append(imports, importModule.PackageID.OrgName.Value()+"/"+importModule.PackageID.PkgName.Value()+
+			":"+importModule.PackageID.Version.Value())
+	}
+	if strings.Join(imports, ",") != "ballerina/io:0.0.0,example/hello.util:1.2.0,example/hello.model:1.2.0" {
+		t.Errorf("unexpected imports %v", imports)
+	}
+	var greetCall *bir.Call
+	for _, function := range main.BIR.Functions {
+		for _, bb := range function.BasicBlocks {
+			if call, ok := bb.Terminator.(*bir.Call); ok && call.Name.Value() == "greet" {
+				greetCall = call
+			}
+		}
+	}
+	if greetCall == nil || greetCall.CalleePkg == nil || greetCall.CalleePkg.PkgName.Value() != "hello.util" {
+		t.Errorf("expected a call to greet of hello.util")
+	}
+}
+
+func TestCompileInvalidPackage(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected string
+	}{
+		{"non-accessible function", map[string]string{"main.bal": "import hello.util;\nfunction f() {\n    util:secret();\n}\n"},
+			"attempt to refer to non-accessible symbol 'secret'"},
+		{"undefined function", map[string]string{"main.bal": "import hello.util;\nfunction f() {\n    util:nope();\n}\n"},
+			"undefined function 'util:nope'"},
+		{"non-accessible type", map[string]string{"main.bal": "import hello.model;\ntype T model:Secret;\n"},
+			"attempt to refer to non-accessible symbol 'Secret'"},
+		{"undefined variable", map[string]string{"main.bal": "import hello.util;\nfunction f() {\n    int x = util:total;\n}\n"},
+			"undefined symbol 'util:total'"},
+		{"missing module", map[string]string{"main.bal": "import hello.missing;\n"},
+			"cannot resolve module 'example/hello.missing'"},
+		{"cyclic imports", map[string]string{"modules/util/cycle.bal": "import hello;\n"},
+			"cyclic module imports detected: hello -> hello.util -> hello"},
+		{"redeclared symbol", map[string]string{"other.bal": "function twice() {\n}\n"},
+			"redeclared symbol 'twice'"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := helloPackage()
+			for path, content := range test.files {
+				files[path] = content
+			}
+			defer func() {
+				r := recover()
+				if r == nil {
+					t.Fatalf("expected panic containing %q", test.expected)
+				}
+				if message, ok := r.(string); !ok || !strings.Contains(message, test.expected) {
+					t.Errorf("expected panic containing %q, got %v", test.expected, r)
+				}
+			}()
+			compilePackage(t, files)
+		})
+	}
+}
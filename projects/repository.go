@@ -0,0 +1,74 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projects
+
+import (
+	"fmt"
+
+	"ballerina-lang-go/centralclient"
+	"ballerina-lang-go/centralclient/models"
+)
+
+// PackageRepository is a source of the packages that dependencies are resolved to.
+type PackageRepository interface {
+	// Versions returns the versions of the package org/name in the repository. They are empty if the repository
+	// doesn't have the package.
+	Versions(org, name string) ([]string, error)
+	// Dependencies returns the packages the given version of org/name directly depends on, with the versions it was
+	// built with.
+	Dependencies(org, name, version string) ([]PackageDescriptor, error)
+}
+
+// CentralRepository is the repository of the packages published to Ballerina Central.
+type CentralRepository struct {
+	client           centralclient.CentralAPIClient
+	platform         string
+	ballerinaVersion string
+}
+
+// NewCentralRepository returns the repository of the packages client finds on Central that support platform and
+// ballerinaVersion.
+func NewCentralRepository(client centralclient.CentralAPIClient, platform, ballerinaVersion string) *CentralRepository {
+	return &CentralRepository{client: client, platform: platform, ballerinaVersion: ballerinaVersion}
+}
+
+func (r *CentralRepository) Versions(org, name string) ([]string, error) {
+	return r.client.GetPackageVersions(org, name, r.platform, r.ballerinaVersion)
+}
+
+// Dependencies asks Central to resolve the given version of org/name in the hard locking mode, the top level nodes of
+// whose dependency graph are the direct dependencies of the package.
+func (r *CentralRepository) Dependencies(org, name, version string) ([]PackageDescriptor, error) {
+	var request models.PackageResolutionRequest
+	request.AddPackage(org, name, version, models.PackageResolutionModeHard)
+	response, err := r.client.ResolveDependencies(request, r.platform, r.ballerinaVersion)
+	if err != nil {
+		return nil, err
+	}
+	for _, pkg := range response.Resolved {
+		if pkg.Org != org || pkg.Name != name {
+			continue
+		}
+		var dependencies []PackageDescriptor
+		for _, dependency := range pkg.DependencyGraph {
+			dependencies = append(dependencies, PackageDescriptor{Org: dependency.Org, Name: dependency.Name,
+				Version: dependency.Version})
+		}
+		return dependencies, nil
+	}
+	return nil, fmt.Errorf("cannot resolve the dependencies of '%s/%s:%s' on Central", org, name, version)
+}
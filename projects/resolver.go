@@ -0,0 +1,371 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projects
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"ballerina-lang-go/centralclient/models"
+	"ballerina-lang-go/model"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// Resolver resolves the imports of a package to versions of the packages in its repositories, following one of the
+// locking modes of Ballerina:
+//   - soft: the latest version compatible with the locked version, sharing its major version
+//   - medium: the latest patch of the locked version, sharing its major and minor versions
+//   - hard: the locked version
+//   - locked: the locked version, failing if a package is not locked
+//
+// A package that is not locked resolves to its latest version, except in the locked mode. The versions of the
+// `[[dependency]]` tables of Ballerina.toml and the versions the dependencies were built with are minimum versions,
+// taking the place of the locked version if they are greater.
+type Resolver struct {
+	mode models.PackageResolutionMode
+	// repositories are consulted in order. The versions of a package are those of all of them, and the dependencies
+	// of a version are given by the first repository having it.
+	repositories []PackageRepository
+	versions     map[packageKey][]*semver.Version
+	sources      map[PackageDescriptor]PackageRepository
+}
+
+type packageKey struct {
+	org  string
+	name string
+}
+
+func (k packageKey) String() string {
+	return k.org + "/" + k.name
+}
+
+// NewResolver returns a resolver picking versions in mode from repositories.
+func NewResolver(mode models.PackageResolutionMode, repositories ...PackageRepository) *Resolver {
+	return &Resolver{
+		mode:         mode,
+		repositories: repositories,
+		versions:     make(map[packageKey][]*semver.Version),
+		sources:      make(map[PackageDescriptor]PackageRepository),
+	}
+}
+
+// ParseLockingMode returns the locking mode named mode.
+func ParseLockingMode(mode string) (models.PackageResolutionMode, error) {
+	switch resolutionMode := models.PackageResolutionMode(mode); resolutionMode {
+	case models.PackageResolutionModeSoft, models.PackageResolutionModeMedium, models.PackageResolutionModeHard,
+		models.PackageResolutionModeLocked:
+		return resolutionMode, nil
+	}
+	return "", fmt.Errorf("invalid locking mode '%s': expected one of soft, medium, hard or locked", mode)
+}
+
+// Resolve resolves the dependencies of the compiled modules of a project. The versions of the packages are picked
+// relative to those of lock, which may be nil. The result lists the package of the project, if it is not a single
+// file, followed by its dependencies sorted by organization and name.
+func (r *Resolver) Resolve(compilation *Compilation, lock *DependencyManifest) (*DependencyManifest, error) {
+	project := compilation.Project
+	minimums := make(map[packageKey]*semver.Version)
+	if project.Manifest != nil {
+		for _, dependency := range project.Manifest.Dependencies {
+			version, err := semver.StrictNewVersion(dependency.Version)
+			if err != nil {
+				return nil, fmt.Errorf("invalid version '%s' of dependency '%s/%s' in %s", dependency.Version,
+					dependency.Org, dependency.Name, BallerinaTomlFile)
+			}
+			raiseMinimum(minimums, packageKey{dependency.Org, dependency.Name}, version)
+		}
+	}
+
+	modules := make(map[packageKey][]LockedModule)
+	var direct []packageKey
+	for _, imp := range externalImports(compilation) {
+		key, err := r.modulePackage(imp.Org, imp.ModuleName, lock)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := modules[key]; !ok {
+			direct = append(direct, key)
+		}
+		modules[key] = append(modules[key], LockedModule{Org: imp.Org, PackageName: key.name, ModuleName: imp.ModuleName})
+	}
+
+	selected := make(map[packageKey]*semver.Version)
+	dependencies := make(map[packageKey][]PackageDescriptor)
+	worklist := append([]packageKey(nil), direct...)
+	for len(worklist) > 0 {
+		key := worklist[0]
+		worklist = worklist[1:]
+		version, err := r.selectVersion(key, lock.Package(key.org, key.name), minimums[key])
+		if err != nil {
+			return nil, err
+		}
+		if previous, ok := selected[key]; ok && previous.Equal(version) {
+			continue
+		}
+		selected[key] = version
+		packageDependencies, err := r.dependencies(PackageDescriptor{Org: key.org, Name: key.name,
+			Version: version.Original()})
+		if err != nil {
+			return nil, err
+		}
+		dependencies[key] = packageDependencies
+		for _, dependency := range packageDependencies {
+			dependencyVersion, err := semver.StrictNewVersion(dependency.Version)
+			if err != nil {
+				return nil, fmt.Errorf("invalid version '%s' of dependency '%s/%s' of '%s:%s'", dependency.Version,
+					dependency.Org, dependency.Name, key, version.Original())
+			}
+			dependencyKey := packageKey{dependency.Org, dependency.Name}
+			if raiseMinimum(minimums, dependencyKey, dependencyVersion) || selected[dependencyKey] == nil {
+				worklist = append(worklist, dependencyKey)
+			}
+		}
+	}
+
+	result := &DependencyManifest{}
+	if project.Manifest != nil {
+		root := &LockedPackage{Org: project.Manifest.Org, Name: project.Manifest.Name, Version: project.Manifest.Version}
+		for _, key := range direct {
+			root.Dependencies = append(root.Dependencies, LockedDependency{Org: key.org, Name: key.name})
+		}
+		for _, module := range project.Modules {
+			root.Modules = append(root.Modules, LockedModule{Org: project.Manifest.Org,
+				PackageName: project.Manifest.Name, ModuleName: module.Name})
+		}
+		result.Packages = append(result.Packages, root)
+	}
+	// Versions selected before a minimum version was raised may have depended on packages that the final versions
+	// don't, so only the packages reachable from the imports are kept.
+	reachable := make(map[packageKey]bool)
+	var visit func(key packageKey)
+	visit = func(key packageKey) {
+		if reachable[key] {
+			return
+		}
+		reachable[key] = true
+		pkg := &LockedPackage{Org: key.org, Name: key.name, Version: selected[key].Original(), Modules: modules[key]}
+		for _, dependency := range dependencies[key] {
+			pkg.Dependencies = append(pkg.Dependencies, LockedDependency{Org: dependency.Org, Name: dependency.Name})
+			visit(packageKey{dependency.Org, dependency.Name})
+		}
+		result.Packages = append(result.Packages, pkg)
+	}
+	for _, key := range direct {
+		visit(key)
+	}
+	result.sort()
+	return result, nil
+}
+
+func raiseMinimum(minimums map[packageKey]*semver.Version, key packageKey, version *semver.Version) bool {
+	if minimum, ok := minimums[key]; ok && !version.GreaterThan(minimum) {
+		return false
+	}
+	minimums[key] = version
+	return true
+}
+
+// selectVersion picks the version of a package according to the locking mode, given its locked version and its
+// minimum version, either of which may be absent.
+func (r *Resolver) selectVersion(key packageKey, locked *LockedPackage, minimum *semver.Version) (*semver.Version, error) {
+	versions, err := r.packageVersions(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("cannot find package '%s'", key)
+	}
+	var base *semver.Version
+	if locked != nil {
+		if base, err = semver.StrictNewVersion(locked.Version); err != nil {
+			return nil, fmt.Errorf("invalid version '%s' of '%s' in %s", locked.Version, key, DependenciesTomlFile)
+		}
+	}
+	if r.mode == models.PackageResolutionModeLocked {
+		if base == nil {
+			return nil, fmt.Errorf("package '%s' is not locked in %s, which is required in the locked mode", key,
+				DependenciesTomlFile)
+		}
+		if minimum != nil && minimum.GreaterThan(base) {
+			return nil, fmt.Errorf("package '%s' is locked at version '%s', but version '%s' is required", key,
+				base.Original(), minimum.Original())
+		}
+	}
+	if minimum != nil && (base == nil || minimum.GreaterThan(base)) {
+		base = minimum
+	}
+	if base == nil {
+		return latestVersion(versions), nil
+	}
+
+	var constraint string
+	switch r.mode {
+	case models.PackageResolutionModeHard, models.PackageResolutionModeLocked:
+		for _, version := range versions {
+			if version.Equal(base) {
+				return version, nil
+			}
+		}
+		return nil, fmt.Errorf("cannot find version '%s' of package '%s'", base.Original(), key)
+	case models.PackageResolutionModeSoft:
+		constraint = "^" + base.String()
+	default:
+		constraint = "~" + base.String()
+	}
+	constraints, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return nil, err
+	}
+	var compatible []*semver.Version
+	for _, version := range versions {
+		if constraints.Check(version) || version.Equal(base) {
+			compatible = append(compatible, version)
+		}
+	}
+	if len(compatible) == 0 {
+		return nil, fmt.Errorf("cannot find a version of package '%s' compatible with '%s' in the %s locking mode", key,
+			base.Original(), r.mode)
+	}
+	return compatible[len(compatible)-1], nil
+}
+
+// latestVersion returns the greatest stable version of versions, which are sorted, or the greatest pre-release
+// version if there is no stable version.
+func latestVersion(versions []*semver.Version) *semver.Version {
+	for i := len(versions) - 1; i >= 0; i-- {
+		if versions[i].Prerelease() == "" {
+			return versions[i]
+		}
+	}
+	return versions[len(versions)-1]
+}
+
+// packageVersions returns the sorted versions of a package in all of the repositories.
+func (r *Resolver) packageVersions(key packageKey) ([]*semver.Version, error) {
+	if versions, ok := r.versions[key]; ok {
+		return versions, nil
+	}
+	var versions []*semver.Version
+	for _, repository := range r.repositories {
+		repositoryVersions, err := repository.Versions(key.org, key.name)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range repositoryVersions {
+			version, err := semver.StrictNewVersion(v)
+			if err != nil {
+				continue
+			}
+			descriptor := PackageDescriptor{Org: key.org, Name: key.name, Version: version.Original()}
+			if _, ok := r.sources[descriptor]; ok {
+				continue
+			}
+			r.sources[descriptor] = repository
+			versions = append(versions, version)
+		}
+	}
+	sort.Sort(semver.Collection(versions))
+	r.versions[key] = versions
+	return versions, nil
+}
+
+func (r *Resolver) dependencies(pkg PackageDescriptor) ([]PackageDescriptor, error) {
+	repository, ok := r.sources[pkg]
+	if !ok {
+		return nil, fmt.Errorf("cannot find package '%s'", pkg)
+	}
+	return repository.Dependencies(pkg.Org, pkg.Name, pkg.Version)
+}
+
+// modulePackage returns the package of the module org/moduleName. It is the package locked with the module if there
+// is one, or else the package in the repositories with the longest name that is the module name or a prefix of it
+// ending at a dot.
+func (r *Resolver) modulePackage(org, moduleName string, lock *DependencyManifest) (packageKey, error) {
+	if pkg := lock.ModulePackage(org, moduleName); pkg != nil {
+		return packageKey{pkg.Org, pkg.Name}, nil
+	}
+	name := moduleName
+	for {
+		key := packageKey{org, name}
+		versions, err := r.packageVersions(key)
+		if err != nil {
+			return packageKey{}, err
+		}
+		if len(versions) > 0 {
+			return key, nil
+		}
+		i := strings.LastIndex(name, ".")
+		if i < 0 {
+			return packageKey{}, fmt.Errorf("cannot resolve module '%s/%s'", org, moduleName)
+		}
+		name = name[:i]
+	}
+}
+
+type moduleImport struct {
+	Org        string
+	ModuleName string
+}
+
+// externalImports returns the sorted imports of the compiled modules that refer to modules of other packages. Imports
+// of the modules of the package itself and of the modules built into the compiler are left out.
+func externalImports(compilation *Compilation) []moduleImport {
+	manifest := compilation.Project.Manifest
+	seen := make(map[moduleImport]bool)
+	var imports []moduleImport
+	for _, module := range compilation.Modules {
+		for i := range module.Package.Imports {
+			importPkg := &module.Package.Imports[i]
+			if importPkg.OrgName == nil || importPkg.OrgName.Value == "" {
+				continue
+			}
+			var nameComps []string
+			for _, comp := range importPkg.PkgNameComps {
+				nameComps = append(nameComps, comp.Value)
+			}
+			imp := moduleImport{Org: importPkg.OrgName.Value, ModuleName: strings.Join(nameComps, ".")}
+			if isBuiltinModule(imp.Org, imp.ModuleName) {
+				continue
+			}
+			if manifest != nil && imp.Org == manifest.Org &&
+				(imp.ModuleName == manifest.Name || strings.HasPrefix(imp.ModuleName, manifest.Name+".")) {
+				continue
+			}
+			if !seen[imp] {
+				seen[imp] = true
+				imports = append(imports, imp)
+			}
+		}
+	}
+	sort.Slice(imports, func(i, j int) bool {
+		if imports[i].Org != imports[j].Org {
+			return imports[i].Org < imports[j].Org
+		}
+		return imports[i].ModuleName < imports[j].ModuleName
+	})
+	return imports
+}
+
+// isBuiltinModule reports whether the module is provided by the compiler rather than a package repository.
+func isBuiltinModule(org, moduleName string) bool {
+	if org != string(model.BALLERINA_ORG) {
+		return false
+	}
+	return moduleName == "io" || moduleName == string(model.JAVA) || strings.HasPrefix(moduleName, string(model.LANG)+".")
+}
@@ -0,0 +1,459 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projects
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"ballerina-lang-go/centralclient"
+	"ballerina-lang-go/centralclient/models"
+	"ballerina-lang-go/context"
+)
+
+// fakeCentral serves the package versions and dependency resolution endpoints of the Central API for the packages of
+// its registry, keyed by `org/name` and then by version, each version mapping to its direct dependencies.
+type fakeCentral struct {
+	registry map[string]map[string][]PackageDescriptor
+	requests []string
+}
+
+func (c *fakeCentral) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	path := strings.TrimPrefix(r.URL.Path, "/packages/")
+	c.requests = append(c.requests, r.Method+" "+path)
+	if r.Method == http.MethodPost && path == "resolve-dependencies" {
+		var request struct {
+			Packages []PackageDescriptor `json:"packages"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message": "invalid request"}`))
+			return
+		}
+		type dependency struct {
+			PackageDescriptor
+			Dependencies []dependency `json:"dependencies"`
+		}
+		type resolved struct {
+			PackageDescriptor
+			DependencyGraph []dependency `json:"dependencyGraph"`
+		}
+		response := struct {
+			Resolved   []resolved          `json:"resolved"`
+			Unresolved []PackageDescriptor `json:"unresolved"`
+		}{Resolved: []resolved{}, Unresolved: []PackageDescriptor{}}
+		for _, pkg := range request.Packages {
+			dependencies, ok := c.registry[pkg.Org+"/"+pkg.Name][pkg.Version]
+			if !ok {
+				response.Unresolved = append(response.Unresolved, pkg)
+				continue
+			}
+			graph := []dependency{}
+			for _, d := range dependencies {
+				graph = append(graph, dependency{PackageDescriptor: d})
+			}
+			response.Resolved = append(response.Resolved, resolved{PackageDescriptor: pkg, DependencyGraph: graph})
+		}
+		_ = json.NewEncoder(w).Encode(response)
+		return
+	}
+	versions, ok := c.registry[path]
+	if r.Method != http.MethodGet || !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "package not found: ` + path + `"}`))
+		return
+	}
+	list := []string{}
+	for version := range versions {
+		list = append(list, version)
+	}
+	_ = json.NewEncoder(w).Encode(list)
+}
+
+func newFakeCentral(t *testing.T) (*fakeCentral, PackageRepository) {
+	t.Helper()
+	strings100 := []PackageDescriptor{{Org: "example", Name: "strings", Version: "1.0.0"}}
+	central := &fakeCentral{registry: map[string]map[string][]PackageDescriptor{
+		"example/greeting": {
+			"1.0.0": strings100,
+			"1.0.3": strings100,
+			"1.1.0": strings100,
+			"2.0.0": {{Org: "example", Name: "strings", Version: "1.2.0"}},
+		},
+		"example/strings": {"1.0.0": nil, "1.0.1": nil, "1.2.0": nil, "1.3.0-beta": nil},
+		"example/data":    {"0.9.0": nil},
+	}}
+	server := httptest.NewServer(central)
+	t.Cleanup(server.Close)
+	client := centralclient.NewCentralAPIClient(server.URL, "", "")
+	return central, NewCentralRepository(client, "any", "2201.12.0")
+}
+
+const consumerMain = `import example/greeting;
+import example/data.csv;
+import ballerina/io;
+
+public function main() {
+}
+`
+
+func compileConsumer(t *testing.T, ballerinaToml string) *Compilation {
+	t.Helper()
+	cx := context.NewCompilerContext()
+	project, err := Load(cx, writePackage(t, map[string]string{
+		"Ballerina.toml": ballerinaToml,
+		"main.bal":       consumerMain,
+	}))
+	if err != nil {
+		t.Fatalf("failed to load project: %v", err)
+	}
+	compilation, err := Compile(cx, nil, project)
+	if err != nil {
+		t.Fatalf("failed to compile project: %v", err)
+	}
+	return compilation
+}
+
+const consumerToml = `[package]
+org = "example"
+name = "consumer"
+version = "0.1.0"
+`
+
+// versionsOf returns the `org/name:version` of the dependencies in manifest, leaving out the package itself.
+func versionsOf(manifest *DependencyManifest) []string {
+	var versions []string
+	for _, pkg := range manifest.Packages[1:] {
+		versions = append(versions, PackageDescriptor{Org: pkg.Org, Name: pkg.Name, Version: pkg.Version}.String())
+	}
+	return versions
+}
+
+func lockOf(versions ...string) *DependencyManifest {
+	lock := &DependencyManifest{}
+	for _, v := range versions {
+		name, version, _ := strings.Cut(v, ":")
+		org, name, _ := strings.Cut(name, "/")
+		lock.Packages = append(lock.Packages, &LockedPackage{Org: org, Name: name, Version: version})
+	}
+	return lock
+}
+
+func TestResolveDependencies(t *testing.T) {
+	tests := []struct {
+		name          string
+		mode          models.PackageResolutionMode
+		ballerinaToml string
+		lock          *DependencyManifest
+		expected      []string
+		expectedError string
+	}{
+		{
+			name:     "latest versions without a lock file",
+			mode:     models.PackageResolutionModeMedium,
+			expected: []string{"example/data:0.9.0", "example/greeting:2.0.0", "example/strings:1.2.0"},
+		},
+		{
+			name:     "medium",
+			mode:     models.PackageResolutionModeMedium,
+			lock:     lockOf("example/greeting:1.0.0", "example/strings:1.0.0", "example/data:0.9.0"),
+			expected: []string{"example/data:0.9.0", "example/greeting:1.0.3", "example/strings:1.0.1"},
+		},
+		{
+			name:     "soft",
+			mode:     models.PackageResolutionModeSoft,
+			lock:     lockOf("example/greeting:1.0.0", "example/strings:1.0.0", "example/data:0.9.0"),
+			expected: []string{"example/data:0.9.0", "example/greeting:1.1.0", "example/strings:1.2.0"},
+		},
+		{
+			name:     "hard",
+			mode:     models.PackageResolutionModeHard,
+			lock:     lockOf("example/greeting:1.0.0", "example/strings:1.0.0", "example/data:0.9.0"),
+			expected: []string{"example/data:0.9.0", "example/greeting:1.0.0", "example/strings:1.0.0"},
+		},
+		{
+			name:     "locked",
+			mode:     models.PackageResolutionModeLocked,
+			lock:     lockOf("example/greeting:1.0.3", "example/strings:1.0.1", "example/data:0.9.0"),
+			expected: []string{"example/data:0.9.0", "example/greeting:1.0.3", "example/strings:1.0.1"},
+		},
+		{
+			name:          "locked without a locked package",
+			mode:          models.PackageResolutionModeLocked,
+			lock:          lockOf("example/greeting:1.0.0", "example/strings:1.0.0"),
+			expectedError: "package 'example/data' is not locked in Dependencies.toml, which is required in the locked mode",
+		},
+		{
+			name: "minimum version of Ballerina.toml",
+			mode: models.PackageResolutionModeHard,
+			ballerinaToml: consumerToml + `
+[[dependency]]
+org = "example"
+name = "greeting"
+version = "1.1.0"
+`,
+			lock:     lockOf("example/greeting:1.0.0", "example/strings:1.0.0", "example/data:0.9.0"),
+			expected: []string{"example/data:0.9.0", "example/greeting:1.1.0", "example/strings:1.0.0"},
+		},
+		{
+			name:          "locked version not in the repositories",
+			mode:          models.PackageResolutionModeHard,
+			lock:          lockOf("example/greeting:1.0.1", "example/data:0.9.0"),
+			expectedError: "cannot find version '1.0.1' of package 'example/greeting'",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ballerinaToml := test.ballerinaToml
+			if ballerinaToml == "" {
+				ballerinaToml = consumerToml
+			}
+			compilation := compileConsumer(t, ballerinaToml)
+			_, central := newFakeCentral(t)
+			resolved, err := NewResolver(test.mode, central).Resolve(compilation, test.lock)
+			if test.expectedError != "" {
+				if err == nil || err.Error() != test.expectedError {
+					t.Fatalf("expected error %q, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to resolve dependencies: %v", err)
+			}
+			if root := resolved.Packages[0]; root.Name != "consumer" || len(root.Dependencies) != 2 {
+				t.Errorf("expected the package itself with 2 dependencies first, got %+v", root)
+			}
+			if versions := versionsOf(resolved); !reflect.DeepEqual(versions, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, versions)
+			}
+		})
+	}
+}
+
+func TestResolveFromBalaCache(t *testing.T) {
+	cacheDir := writePackage(t, map[string]string{
+		"example/greeting/1.0.0/any/dependency-graph.json": `{"packages": [
+			{"org": "example", "name": "strings", "version": "1.0.0", "transitive": false},
+			{"org": "example", "name": "unicode", "version": "1.0.0", "transitive": true}
+		]}`,
+		"example/greeting/1.0.1_temp/any/package.json":      "{}",
+		"example/strings/1.0.0/any/package.json":            "{}",
+		"example/data/0.9.0/any/package.json":               "{}",
+		"example/data/1.0.0/java21/package.json":            "{}",
+		"example/unrelated/1.0.0/any/package.json":          "{}",
+		"example/unrelated/1.0.0/any/dependency-graph.json": "{",
+	})
+	compilation := compileConsumer(t, consumerToml)
+
+	cache := NewBalaRepository(os.DirFS(cacheDir))
+	resolved, err := NewResolver(models.PackageResolutionModeMedium, cache).Resolve(compilation, nil)
+	if err != nil {
+		t.Fatalf("failed to resolve dependencies: %v", err)
+	}
+	expected := []string{"example/data:1.0.0", "example/greeting:1.0.0", "example/strings:1.0.0"}
+	if versions := versionsOf(resolved); !reflect.DeepEqual(versions, expected) {
+		t.Errorf("expected %v, got %v", expected, versions)
+	}
+
+	// The bala cache takes precedence over Central for the versions both have
+	centralState, central := newFakeCentral(t)
+	resolved, err = NewResolver(models.PackageResolutionModeHard, cache, central).Resolve(compilation,
+		lockOf("example/greeting:1.0.0", "example/strings:1.0.0", "example/data:0.9.0"))
+	if err != nil {
+		t.Fatalf("failed to resolve dependencies: %v", err)
+	}
+	expected = []string{"example/data:0.9.0", "example/greeting:1.0.0", "example/strings:1.0.0"}
+	if versions := versionsOf(resolved); !reflect.DeepEqual(versions, expected) {
+		t.Errorf("expected %v, got %v", expected, versions)
+	}
+	for _, request := range centralState.requests {
+		if strings.HasPrefix(request, "POST") {
+			t.Errorf("expected the dependencies to be read from the bala cache, got request %s", request)
+		}
+	}
+}
+
+const expectedDependenciesToml = `# AUTO-GENERATED FILE. DO NOT MODIFY.
+
+# This file is auto-generated by Ballerina for managing dependency versions.
+# It should not be modified by hand.
+
+[ballerina]
+dependencies-toml-version = "2"
+distribution-version = "2201.12.0"
+
+[[package]]
+org = "example"
+name = "consumer"
+version = "0.1.0"
+dependencies = [
+	{org = "example", name = "data"},
+	{org = "example", name = "greeting"}
+]
+modules = [
+	{org = "example", packageName = "consumer", moduleName = "consumer"}
+]
+
+[[package]]
+org = "example"
+name = "data"
+version = "0.9.0"
+modules = [
+	{org = "example", packageName = "data", moduleName = "data.csv"}
+]
+
+[[package]]
+org = "example"
+name = "greeting"
+version = "2.0.0"
+dependencies = [
+	{org = "example", name = "strings"}
+]
+modules = [
+	{org = "example", packageName = "greeting", moduleName = "greeting"}
+]
+
+[[package]]
+org = "example"
+name = "strings"
+version = "1.2.0"
+`
+
+func TestDependenciesToml(t *testing.T) {
+	compilation := compileConsumer(t, consumerToml)
+	_, central := newFakeCentral(t)
+	resolved, err := NewResolver(models.PackageResolutionModeMedium, central).Resolve(compilation, nil)
+	if err != nil {
+		t.Fatalf("failed to resolve dependencies: %v", err)
+	}
+	resolved.DistributionVersion = "2201.12.0"
+	if content := resolved.String(); content != expectedDependenciesToml {
+		t.Fatalf("unexpected Dependencies.toml:\n%s", content)
+	}
+
+	root := writePackage(t, map[string]string{DependenciesTomlFile: expectedDependenciesToml})
+	loaded, err := LoadDependencyManifest(os.DirFS(root), DependenciesTomlFile)
+	if err != nil {
+		t.Fatalf("failed to load Dependencies.toml: %v", err)
+	}
+	if !reflect.DeepEqual(loaded, resolved) {
+		t.Errorf("expected the loaded manifest to be %+v, got %+v", resolved, loaded)
+	}
+
+	// Modules are resolved to the packages they are locked with
+	if pkg := loaded.ModulePackage("example", "data.csv"); pkg == nil || pkg.Name != "data" {
+		t.Errorf("expected data.csv to be locked in package data, got %+v", pkg)
+	}
+
+	missing, err := LoadDependencyManifest(os.DirFS(root), "missing.toml")
+	if missing != nil || err != nil {
+		t.Errorf("expected no manifest and no error for a missing file, got %v, %v", missing, err)
+	}
+}
+
+func TestUpdateDependenciesToml(t *testing.T) {
+	manifest := &DependencyManifest{
+		DistributionVersion: "2201.12.0",
+		Packages: []*LockedPackage{
+			{Org: "example", Name: "consumer", Version: "0.1.0",
+				Dependencies: []LockedDependency{{Org: "example", Name: "data"}, {Org: "example", Name: "greeting"}}},
+			{Org: "example", Name: "data", Version: "0.9.0"},
+			{Org: "example", Name: "greeting", Version: "2.0.0"},
+		},
+	}
+	existing := `# Pinned by hand, see the release notes
+[ballerina]
+dependencies-toml-version = "2"
+distribution-version = "2201.11.0"
+
+[[package]]
+org = "example"
+name = "consumer"
+version = "0.1.0"
+dependencies = [{org = "example", name = "greeting"}] # was data before
+
+# Kept at 1.x until the migration
+[[package]]
+org = "example"
+name = "greeting"
+version   =   "1.0.0"   # upgrade later
+
+[[package]]
+org = "example"
+name = "strings"
+version = "1.2.0"
+`
+	expected := `# Pinned by hand, see the release notes
+[ballerina]
+dependencies-toml-version = "2"
+distribution-version = "2201.12.0"
+
+[[package]]
+org = "example"
+name = "consumer"
+version = "0.1.0"
+dependencies = [
+	{org = "example", name = "data"},
+	{org = "example", name = "greeting"}
+] # was data before
+
+[[package]]
+org = "example"
+name = "data"
+version = "0.9.0"
+
+# Kept at 1.x until the migration
+[[package]]
+org = "example"
+name = "greeting"
+version   =   "2.0.0"   # upgrade later
+`
+	if content := manifest.Update(existing); content != expected {
+		t.Errorf("unexpected Dependencies.toml:\n%s", content)
+	}
+	if content := manifest.Update(expected); content != expected {
+		t.Errorf("expected an up-to-date Dependencies.toml to be kept, got:\n%s", content)
+	}
+	if content := manifest.Update("[[package]\n"); content != manifest.String() {
+		t.Errorf("expected an invalid Dependencies.toml to be regenerated, got:\n%s", content)
+	}
+}
+
+func TestLoadInvalidDependenciesToml(t *testing.T) {
+	root := writePackage(t, map[string]string{
+		DependenciesTomlFile: "[ballerina]\ndependencies-toml-version = \"1\"\n\n[[package]]\norg = \"example\"\n",
+	})
+	_, err := LoadDependencyManifest(os.DirFS(root), DependenciesTomlFile)
+	if err == nil || !strings.HasPrefix(err.Error(), "invalid Dependencies.toml: ") {
+		t.Fatalf("expected an invalid Dependencies.toml error, got %v", err)
+	}
+}
+
+func TestParseLockingMode(t *testing.T) {
+	if mode, err := ParseLockingMode("soft"); err != nil || mode != models.PackageResolutionModeSoft {
+		t.Errorf("expected soft, got %v, %v", mode, err)
+	}
+	if _, err := ParseLockingMode("strict"); err == nil {
+		t.Error("expected an error for an unknown locking mode")
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projects
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"ballerina-lang-go/tomlparser"
+)
+
+// SettingsTomlFile is the name of the file in the Ballerina home directory holding the settings of the user
+const SettingsTomlFile = "Settings.toml"
+
+// Settings is the content of a Settings.toml. Timeouts are in seconds, and zero values are unset.
+type Settings struct {
+	Central CentralSettings `toml:"central"`
+	Proxy   ProxySettings   `toml:"proxy"`
+}
+
+// CentralSettings is the `[central]` table of a Settings.toml.
+type CentralSettings struct {
+	AccessToken    string `toml:"accesstoken"`
+	ConnectTimeout int    `toml:"connect-timeout"`
+	ReadTimeout    int    `toml:"read-timeout"`
+	WriteTimeout   int    `toml:"write-timeout"`
+	CallTimeout    int    `toml:"call-timeout"`
+	MaxRetries     int    `toml:"max-retries"`
+}
+
+// ProxySettings is the `[proxy]` table of a Settings.toml.
+type ProxySettings struct {
+	Host     string `toml:"host"`
+	Port     int    `toml:"port"`
+	Username string `toml:"username"`
+	Password string `toml:"password"`
+}
+
+// LoadSettings reads the Settings.toml at path in fsys, validating it against the built-in schema of Settings.toml.
+// It returns empty settings if the file does not exist.
+func LoadSettings(fsys fs.FS, path string) (*Settings, error) {
+	if _, err := fs.Stat(fsys, path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return &Settings{}, nil
+		}
+		return nil, err
+	}
+	toml, err := tomlparser.ReadWithSchema(fsys, path, tomlparser.SettingsTomlSchema())
+	if err != nil {
+		if toml != nil && len(toml.Diagnostics()) > 0 {
+			messages := make([]string, len(toml.Diagnostics()))
+			for i, diagnostic := range toml.Diagnostics() {
+				messages[i] = formatDiagnostic(diagnostic)
+			}
+			return nil, fmt.Errorf("invalid %s: %s", SettingsTomlFile, strings.Join(messages, "; "))
+		}
+		return nil, err
+	}
+	var settings Settings
+	toml.To(&settings)
+	if len(toml.Diagnostics()) > 0 {
+		return nil, fmt.Errorf("invalid %s: %s", SettingsTomlFile, formatDiagnostic(toml.Diagnostics()[0]))
+	}
+	return &settings, nil
+}
+
+// ProxyURL returns the URL of the proxy server, or an empty string if there is no proxy.
+func (s ProxySettings) ProxyURL() string {
+	if s.Host == "" {
+		return ""
+	}
+	host := s.Host
+	if s.Port != 0 {
+		host += ":" + strconv.Itoa(s.Port)
+	}
+	proxy := url.URL{Scheme: "http", Host: host}
+	if strings.Contains(s.Host, "://") {
+		if parsed, err := url.Parse(s.Host); err == nil {
+			proxy = *parsed
+			if s.Port != 0 {
+				proxy.Host = parsed.Hostname() + ":" + strconv.Itoa(s.Port)
+			}
+		}
+	}
+	return proxy.String()
+}
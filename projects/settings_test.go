@@ -0,0 +1,58 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package projects
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadSettings(t *testing.T) {
+	fsys := fstest.MapFS{SettingsTomlFile: {Data: []byte(`[central]
+accesstoken = "secret"
+connect-timeout = 10
+max-retries = 3
+
+[proxy]
+host = "proxy.example.com"
+port = 3128
+username = "user"
+`)}}
+	settings, err := LoadSettings(fsys, SettingsTomlFile)
+	if err != nil {
+		t.Fatalf("failed to load settings: %v", err)
+	}
+	if settings.Central.AccessToken != "secret" || settings.Central.ConnectTimeout != 10 ||
+		settings.Central.MaxRetries != 3 || settings.Central.ReadTimeout != 0 {
+		t.Errorf("unexpected central settings %+v", settings.Central)
+	}
+	if settings.Proxy.Username != "user" || settings.Proxy.ProxyURL() != "http://proxy.example.com:3128" {
+		t.Errorf("unexpected proxy settings %+v", settings.Proxy)
+	}
+
+	settings, err = LoadSettings(fstest.MapFS{}, SettingsTomlFile)
+	if err != nil || settings.Central.AccessToken != "" || settings.Proxy.ProxyURL() != "" {
+		t.Errorf("expected empty settings for a missing file, got %+v, %v", settings, err)
+	}
+
+	_, err = LoadSettings(fstest.MapFS{SettingsTomlFile: {Data: []byte("[central]\ntoken = \"secret\"\n")}},
+		SettingsTomlFile)
+	if err == nil || !strings.Contains(err.Error(), "key 'token' not supported") {
+		t.Errorf("expected an unsupported key error, got %v", err)
+	}
+}
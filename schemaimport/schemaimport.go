@@ -0,0 +1,647 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package schemaimport converts JSON Schema documents to Ballerina type definitions.
+//
+// The schema is converted to Ballerina source, which is then resolved to semtypes with a semtypes.TypeParser, so that
+// the types always agree with the generated source. Schemas are mapped as follows:
+//
+//   - an object schema becomes a record whose rest type is the schema of the additional properties, which is json
+//     unless they are restricted, or a map if it has no properties;
+//   - an array schema becomes an array, or a tuple if it has prefix items;
+//   - enum and const become unions of singleton types;
+//   - oneOf and anyOf become unions, and allOf an intersection;
+//   - minimum and maximum of an integer become a range of int, if the range has a name such as int:Unsigned8 or has
+//     few enough values to be written as a union of singletons, and are ignored otherwise;
+//   - a number becomes a decimal, or a float if its format is float or double;
+//   - a schema referred to with $ref, and each definition in $defs or definitions, becomes a named type definition,
+//     which may be recursive.
+//
+// Keywords that have no counterpart in Ballerina types, such as pattern and minLength, are ignored.
+package schemaimport
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"ballerina-lang-go/identifierutil"
+	"ballerina-lang-go/semtypes"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+const schemaURL = "schema.json"
+
+// Module is the Ballerina types converted from a JSON Schema document.
+type Module struct {
+	// Types has the type of the root schema first, followed by the definitions of the document and the other
+	// schemas it refers to.
+	Types []semtypes.TypeDefinition
+	// Source is the Ballerina source of the type definitions.
+	Source string
+}
+
+// Convert converts the JSON Schema document content to Ballerina types in env, naming the type of the root schema
+// rootName. Schemas of draft 2020-12 are assumed unless the document has a $schema.
+func Convert(env semtypes.Env, content []byte, rootName string) (*Module, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema JSON: %w", err)
+	}
+	compiler := jsonschema.NewCompiler()
+	compiler.DefaultDraft(jsonschema.Draft2020)
+	if err := compiler.AddResource(schemaURL, doc); err != nil {
+		return nil, fmt.Errorf("failed to add schema resource: %w", err)
+	}
+	root, err := compiler.Compile(schemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile schema: %w", err)
+	}
+
+	g := &generator{env: env, cx: semtypes.TypeCheckContext(env), doc: doc,
+		names: make(map[*jsonschema.Schema]string), usedNames: make(map[string]bool)}
+	g.name(root, rootName)
+	for _, location := range definitionLocations(doc) {
+		defn, err := compiler.Compile(schemaURL + "#" + location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile schema: %w", err)
+		}
+		g.name(defn, lastSegment(location))
+	}
+	var source strings.Builder
+	// Converting a named schema may name the schemas it refers to
+	for i := 0; i < len(g.named); i++ {
+		s := g.named[i]
+		if i > 0 {
+			source.WriteString("\n")
+		}
+		writeDocumentation(&source, "", s)
+		fmt.Fprintf(&source, "public type %s %s;\n", g.names[s], g.typeDesc(s, "").src)
+	}
+
+	p := semtypes.NewTypeParser(env)
+	if err := p.DefineTypes(source.String()); err != nil {
+		return nil, fmt.Errorf("failed to resolve the generated types: %w", err)
+	}
+	module := &Module{Source: source.String()}
+	for _, s := range g.named {
+		t, err := p.Lookup(g.names[s])
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve the generated types: %w", err)
+		}
+		module.Types = append(module.Types, semtypes.TypeDefinition{Name: g.names[s], Type: t})
+	}
+	return module, nil
+}
+
+// definitionLocations returns the JSON pointers of the definitions of the document, in the order of their names.
+func definitionLocations(doc any) []string {
+	obj, ok := doc.(map[string]any)
+	if !ok {
+		return nil
+	}
+	var locations []string
+	for _, keyword := range []string{"$defs", "definitions"} {
+		defns, ok := obj[keyword].(map[string]any)
+		if !ok {
+			continue
+		}
+		names := make([]string, 0, len(defns))
+		for name := range defns {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			locations = append(locations, "/"+keyword+"/"+escapePointer(name))
+		}
+	}
+	return locations
+}
+
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// lastSegment returns the last token of a JSON pointer.
+func lastSegment(pointer string) string {
+	token := pointer[strings.LastIndex(pointer, "/")+1:]
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+}
+
+type precedence int
+
+const (
+	precUnion precedence = iota
+	precIntersection
+	precPrimary
+)
+
+// typeDescSource is the source of a type descriptor together with the precedence of its outermost operator, which
+// tells whether it has to be parenthesized as an operand.
+type typeDescSource struct {
+	src  string
+	prec precedence
+}
+
+func primary(src string) typeDescSource {
+	return typeDescSource{src: src, prec: precPrimary}
+}
+
+func (t typeDescSource) operand(prec precedence) string {
+	if t.prec < prec {
+		return "(" + t.src + ")"
+	}
+	return t.src
+}
+
+var jsonType = primary("json")
+
+type generator struct {
+	env semtypes.Env
+	cx  semtypes.Context
+	// doc is the schema document, which has the keywords that the compiled schemas leave out
+	doc       any
+	names     map[*jsonschema.Schema]string
+	named     []*jsonschema.Schema
+	usedNames map[string]bool
+}
+
+// name returns the name of the type definition of s, giving it a name derived from hint if it has none yet.
+func (g *generator) name(s *jsonschema.Schema, hint string) string {
+	if name, ok := g.names[s]; ok {
+		return name
+	}
+	base := typeName(hint)
+	name := base
+	for i := 2; g.usedNames[name]; i++ {
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+	g.usedNames[name] = true
+	g.names[s] = name
+	g.named = append(g.named, s)
+	return name
+}
+
+// typeName turns a schema name such as "shipping-address" into a type name such as "ShippingAddress".
+func typeName(hint string) string {
+	if identifierutil.IsPlainIdentifier(hint) {
+		return hint
+	}
+	var sb strings.Builder
+	upper := true
+	for _, r := range hint {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		sb.WriteRune(r)
+	}
+	name := sb.String()
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "Type" + name
+	}
+	return identifierutil.QuoteIdentifier(name)
+}
+
+// refName returns the name of the type definition of the schema referred to with $ref.
+func (g *generator) refName(s *jsonschema.Schema) string {
+	hint := ""
+	if _, fragment, ok := strings.Cut(s.Location, "#"); ok {
+		hint = lastSegment(fragment)
+	}
+	if hint == "" {
+		hint = "Type"
+	}
+	return g.name(s, hint)
+}
+
+// typeDesc returns the type descriptor of s, written at indent.
+func (g *generator) typeDesc(s *jsonschema.Schema, indent string) typeDescSource {
+	if s.Bool != nil {
+		if *s.Bool {
+			return jsonType
+		}
+		return primary("never")
+	}
+	var conjuncts []typeDescSource
+	if s.Ref != nil {
+		conjuncts = append(conjuncts, primary(g.refName(s.Ref)))
+	}
+	switch {
+	case s.Const != nil:
+		conjuncts = append(conjuncts, g.valuesType(s, []any{*s.Const}))
+	case s.Enum != nil:
+		conjuncts = append(conjuncts, g.valuesType(s, s.Enum.Values))
+	default:
+		if t, ok := g.typesType(s, indent); ok {
+			conjuncts = append(conjuncts, t)
+		}
+	}
+	for _, members := range [][]*jsonschema.Schema{s.AnyOf, s.OneOf} {
+		if len(members) > 0 {
+			conjuncts = append(conjuncts, g.union(members, indent))
+		}
+	}
+	for _, conjunct := range s.AllOf {
+		conjuncts = append(conjuncts, g.typeDesc(conjunct, indent))
+	}
+	conjuncts = slices.DeleteFunc(conjuncts, func(t typeDescSource) bool {
+		return t == jsonType
+	})
+	switch len(conjuncts) {
+	case 0:
+		return jsonType
+	case 1:
+		return conjuncts[0]
+	}
+	operands := make([]string, len(conjuncts))
+	for i, conjunct := range conjuncts {
+		operands[i] = conjunct.operand(precPrimary)
+	}
+	return typeDescSource{src: strings.Join(operands, " & "), prec: precIntersection}
+}
+
+func (g *generator) union(members []*jsonschema.Schema, indent string) typeDescSource {
+	var alternatives []typeDescSource
+	for _, member := range members {
+		alternatives = append(alternatives, g.typeDesc(member, indent))
+	}
+	return unionOf(alternatives)
+}
+
+func unionOf(alternatives []typeDescSource) typeDescSource {
+	var members []typeDescSource
+	nilable := false
+	for _, alternative := range alternatives {
+		switch {
+		case alternative == jsonType:
+			return jsonType
+		case alternative.src == "()":
+			nilable = true
+		case alternative.src != "never" && !slices.Contains(members, alternative):
+			members = append(members, alternative)
+		}
+	}
+	switch {
+	case len(members) == 0 && nilable:
+		return primary("()")
+	case len(members) == 0:
+		return primary("never")
+	case len(members) == 1 && nilable:
+		return primary(members[0].operand(precPrimary) + "?")
+	case len(members) == 1:
+		return members[0]
+	}
+	operands := make([]string, 0, len(members)+1)
+	for _, member := range members {
+		operands = append(operands, member.operand(precIntersection))
+	}
+	if nilable {
+		operands = append(operands, "()")
+	}
+	return typeDescSource{src: strings.Join(operands, "|"), prec: precUnion}
+}
+
+// typesType returns the union of the types of s, or false if s doesn't restrict the types of its instances.
+func (g *generator) typesType(s *jsonschema.Schema, indent string) (typeDescSource, bool) {
+	var types []string
+	switch {
+	case s.Types != nil:
+		types = s.Types.ToStrings()
+	case s.Properties != nil || s.AdditionalProperties != nil || len(s.Required) > 0:
+		types = []string{"object"}
+	case s.Items != nil || s.Items2020 != nil || s.PrefixItems != nil:
+		types = []string{"array"}
+	default:
+		return typeDescSource{}, false
+	}
+	if slices.Contains(types, "number") {
+		types = slices.DeleteFunc(types, func(t string) bool {
+			return t == "integer"
+		})
+	}
+	var alternatives []typeDescSource
+	for _, t := range types {
+		switch t {
+		case "null":
+			alternatives = append(alternatives, primary("()"))
+		case "boolean":
+			alternatives = append(alternatives, primary("boolean"))
+		case "integer":
+			alternatives = append(alternatives, g.intType(s))
+		case "number":
+			alternatives = append(alternatives, primary(g.numberType(s)))
+		case "string":
+			alternatives = append(alternatives, primary("string"))
+		case "array":
+			alternatives = append(alternatives, g.arrayType(s, indent))
+		case "object":
+			alternatives = append(alternatives, g.objectType(s, indent))
+		}
+	}
+	return unionOf(alternatives), true
+}
+
+func (g *generator) numberType(s *jsonschema.Schema) string {
+	// The compiled schema has only the formats it asserts, which don't include float and double
+	if format, _ := g.keyword(s, "format").(string); format == "float" || format == "double" {
+		return "float"
+	}
+	return "decimal"
+}
+
+// keyword returns the value of keyword in the document at the location of s, or nil if it has none.
+func (g *generator) keyword(s *jsonschema.Schema, keyword string) any {
+	_, fragment, _ := strings.Cut(s.Location, "#")
+	node := g.doc
+	if fragment != "" {
+		for _, token := range strings.Split(fragment[1:], "/") {
+			token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+			switch n := node.(type) {
+			case map[string]any:
+				node = n[token]
+			case []any:
+				i, err := strconv.Atoi(token)
+				if err != nil || i < 0 || i >= len(n) {
+					return nil
+				}
+				node = n[i]
+			default:
+				return nil
+			}
+		}
+	}
+	if obj, ok := node.(map[string]any); ok {
+		return obj[keyword]
+	}
+	return nil
+}
+
+// intType returns the int type bounded by the minimum and maximum of s, which is widened to int if the range has
+// neither a name nor few enough values.
+func (g *generator) intType(s *jsonschema.Schema) typeDescSource {
+	low, high := int64(math.MinInt64), int64(math.MaxInt64)
+	if s.Minimum != nil {
+		low = max(low, ceilInt(s.Minimum))
+	}
+	if s.ExclusiveMinimum != nil {
+		low = max(low, saturatingAdd(floorInt(s.ExclusiveMinimum), 1))
+	}
+	if s.Maximum != nil {
+		high = min(high, floorInt(s.Maximum))
+	}
+	if s.ExclusiveMaximum != nil {
+		high = min(high, saturatingAdd(ceilInt(s.ExclusiveMaximum), -1))
+	}
+	if low == math.MinInt64 && high == math.MaxInt64 {
+		return primary("int")
+	}
+	if low > high {
+		return primary("never")
+	}
+	t := semtypes.CreateBasicSemType(semtypes.BT_INT, semtypes.CreateSingleRangeSubtype(low, high))
+	src := semtypes.ToTypeString(g.cx, t)
+	if strings.Contains(src, "|") {
+		return typeDescSource{src: src, prec: precUnion}
+	}
+	return primary(src)
+}
+
+func floorInt(r *big.Rat) int64 {
+	q := new(big.Int).Div(r.Num(), r.Denom())
+	return clampInt(q)
+}
+
+func ceilInt(r *big.Rat) int64 {
+	q := new(big.Int).Div(r.Num(), r.Denom())
+	if !r.IsInt() {
+		q.Add(q, big.NewInt(1))
+	}
+	return clampInt(q)
+}
+
+func clampInt(i *big.Int) int64 {
+	switch {
+	case i.IsInt64():
+		return i.Int64()
+	case i.Sign() < 0:
+		return math.MinInt64
+	default:
+		return math.MaxInt64
+	}
+}
+
+func saturatingAdd(i int64, delta int64) int64 {
+	if (delta > 0 && i == math.MaxInt64) || (delta < 0 && i == math.MinInt64) {
+		return i
+	}
+	return i + delta
+}
+
+func (g *generator) arrayType(s *jsonschema.Schema, indent string) typeDescSource {
+	prefix := s.PrefixItems
+	var rest any = s.Items2020
+	if s.Items2020 == nil {
+		rest = nil
+	}
+	switch items := s.Items.(type) {
+	case []*jsonschema.Schema:
+		prefix = items
+		rest = s.AdditionalItems
+	case *jsonschema.Schema:
+		rest = items
+	}
+	restType := jsonType
+	closed := false
+	switch rest := rest.(type) {
+	case bool:
+		closed = !rest
+	case *jsonschema.Schema:
+		restType = g.typeDesc(rest, indent)
+		closed = restType.src == "never"
+	}
+	if len(prefix) == 0 {
+		if closed {
+			return primary("never[]")
+		}
+		member := restType.operand(precPrimary)
+		if s.MinItems != nil && s.MaxItems != nil && *s.MinItems == *s.MaxItems {
+			return primary(fmt.Sprintf("%s[%d]", member, *s.MinItems))
+		}
+		return primary(member + "[]")
+	}
+	var members []string
+	for _, member := range prefix {
+		members = append(members, g.typeDesc(member, indent).src)
+	}
+	if !closed {
+		members = append(members, restType.operand(precPrimary)+"...")
+	}
+	return primary("[" + strings.Join(members, ", ") + "]")
+}
+
+// objectType returns a record of the properties of s. The record is written closed, with a rest type of json rather
+// than anydata when additional properties are allowed, so that it has only JSON values.
+func (g *generator) objectType(s *jsonschema.Schema, indent string) typeDescSource {
+	rest := jsonType
+	switch additional := s.AdditionalProperties.(type) {
+	case bool:
+		if !additional {
+			rest = primary("never")
+		}
+	case *jsonschema.Schema:
+		rest = g.typeDesc(additional, indent)
+	}
+	if len(s.PatternProperties) > 0 {
+		// The properties matching a pattern are among the additional properties of the record
+		alternatives := []typeDescSource{rest}
+		for _, pattern := range s.PatternProperties {
+			alternatives = append(alternatives, g.typeDesc(pattern, indent))
+		}
+		rest = unionOf(alternatives)
+	}
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	for _, name := range s.Required {
+		if _, ok := s.Properties[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		if rest.src == "never" {
+			return primary("record {||}")
+		}
+		return primary("map<" + rest.src + ">")
+	}
+
+	fieldIndent := indent + "    "
+	var sb strings.Builder
+	sb.WriteString("record {|\n")
+	for _, name := range names {
+		fieldType := jsonType
+		property, ok := s.Properties[name]
+		if ok {
+			writeDocumentation(&sb, fieldIndent, property)
+			fieldType = g.typeDesc(property, fieldIndent)
+		}
+		optional := ""
+		if !slices.Contains(s.Required, name) {
+			optional = "?"
+		}
+		fmt.Fprintf(&sb, "%s%s %s%s;\n", fieldIndent, fieldType.src, identifierutil.QuoteIdentifier(name), optional)
+	}
+	if rest.src != "never" {
+		fmt.Fprintf(&sb, "%s%s...;\n", fieldIndent, rest.operand(precPrimary))
+	}
+	sb.WriteString(indent + "|}")
+	return primary(sb.String())
+}
+
+// valuesType returns the union of the singleton types of values, leaving out the values that are not of the types of
+// s.
+func (g *generator) valuesType(s *jsonschema.Schema, values []any) typeDescSource {
+	var alternatives []typeDescSource
+	for _, value := range values {
+		literal, ok := g.literal(s, value)
+		if !ok {
+			continue
+		}
+		if literal == "()" {
+			alternatives = append(alternatives, primary(literal))
+		} else {
+			alternatives = append(alternatives, typeDescSource{src: literal, prec: precIntersection})
+		}
+	}
+	return unionOf(alternatives)
+}
+
+// literal returns value written as a Ballerina literal, or false if it is not of the types of s or there is no
+// singleton type for it.
+func (g *generator) literal(s *jsonschema.Schema, value any) (string, bool) {
+	allows := func(t string) bool {
+		return s.Types == nil || slices.Contains(s.Types.ToStrings(), t)
+	}
+	switch value := value.(type) {
+	case nil:
+		return "()", allows("null")
+	case bool:
+		return semtypes.ValueFrom(value).String(), allows("boolean")
+	case string:
+		return semtypes.ValueFrom(value).String(), allows("string")
+	case interface{ String() string }:
+		// A number, which UnmarshalJSON reads as a json.Number
+		var r big.Rat
+		if _, ok := r.SetString(value.String()); !ok {
+			return "", false
+		}
+		if r.IsInt() && r.Num().IsInt64() && (allows("integer") || s.Types == nil) {
+			return semtypes.ValueFrom(r.Num().Int64()).String(), true
+		}
+		if !allows("number") {
+			return "", false
+		}
+		if g.numberType(s) == "float" {
+			f, _ := r.Float64()
+			return semtypes.ValueFrom(f).String(), true
+		}
+		return semtypes.ValueFrom(r).String(), true
+	default:
+		// There are no singleton types of arrays and objects
+		return "", false
+	}
+}
+
+// writeDocumentation writes the title and the description of s as a documentation comment.
+func writeDocumentation(sb *strings.Builder, indent string, s *jsonschema.Schema) {
+	var lines []string
+	for _, text := range []string{s.Title, s.Description} {
+		if text = strings.TrimSpace(text); text != "" {
+			lines = append(lines, strings.Split(text, "\n")...)
+		}
+	}
+	for _, line := range lines {
+		sb.WriteString(strings.TrimRight(indent+"# "+line, " ") + "\n")
+	}
+}
+
+// RootName returns the name of the root type of a schema file, derived from the file name, such as "Person" for
+// "person.schema.json".
+func RootName(fileName string) string {
+	base := fileName[strings.LastIndexAny(fileName, `/\`)+1:]
+	if unescaped, err := url.PathUnescape(base); err == nil {
+		base = unescaped
+	}
+	base, _, _ = strings.Cut(base, ".")
+	if base != "" {
+		base = strings.ToUpper(base[:1]) + base[1:]
+	}
+	return typeName(base)
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package schemaimport
+
+import (
+	"strings"
+	"testing"
+
+	"ballerina-lang-go/semtypes"
+)
+
+const personSchema = `{
+  "title": "A person",
+  "type": "object",
+  "properties": {
+    "name": {"type": "string", "description": "Full name"},
+    "age": {"type": "integer", "minimum": 0, "maximum": 255},
+    "status": {"enum": ["active", "inactive", null]},
+    "address": {"$ref": "#/$defs/shipping-address"},
+    "children": {"type": "array", "items": {"$ref": "#"}}
+  },
+  "required": ["name", "id"],
+  "additionalProperties": false,
+  "$defs": {
+    "shipping-address": {
+      "type": "object",
+      "properties": {
+        "street": {"type": "string"},
+        "zip": {"oneOf": [{"type": "string"}, {"type": "integer"}]}
+      },
+      "required": ["street"]
+    },
+    "Tags": {
+      "type": "object",
+      "additionalProperties": {"type": "string"}
+    }
+  }
+}`
+
+const personSource = `# A person
+public type Person record {|
+    ShippingAddress address?;
+    int:Unsigned8 age?;
+    Person[] children?;
+    json id;
+    # Full name
+    string name;
+    "active"|"inactive"|() status?;
+|};
+
+public type Tags map<string>;
+
+public type ShippingAddress record {|
+    string street;
+    string|int zip?;
+    json...;
+|};
+`
+
+func convert(t *testing.T, env semtypes.Env, schema string) *Module {
+	t.Helper()
+	module, err := Convert(env, []byte(schema), "Person")
+	if err != nil {
+		t.Fatalf("failed to convert schema: %v", err)
+	}
+	return module
+}
+
+func TestConvertSource(t *testing.T) {
+	module := convert(t, semtypes.NewEnv(), personSchema)
+	if module.Source != personSource {
+		t.Errorf("unexpected source:\n%s", module.Source)
+	}
+	var names []string
+	for _, defn := range module.Types {
+		names = append(names, defn.Name)
+	}
+	if got := strings.Join(names, ","); got != "Person,Tags,ShippingAddress" {
+		t.Errorf("unexpected type names %s", got)
+	}
+}
+
+func TestConvertTypes(t *testing.T) {
+	env := semtypes.NewEnv()
+	module := convert(t, env, personSchema)
+	p := semtypes.NewTypeParser(env)
+	cx := p.Context()
+	person := module.Types[0].Type
+	tests := []struct {
+		source  string
+		subtype bool
+	}{
+		{`record {| string name; json id; |}`, true},
+		{`record {| string name; json id; string extra; |}`, false},
+		{`record {| string name; json id; byte age; |}`, true},
+		{`record {| string name; json id; int age; |}`, false},
+		{`record {| string name; json id; 256 age; |}`, false},
+		{`record {| string name; json id; "active" status; |}`, true},
+		{`record {| string name; json id; "deleted" status; |}`, false},
+		{`record {| string name; json id; record {| string street; int zip; |} address; |}`, true},
+		{`record {| string name; json id; record {| string street; float zip; |} address; |}`, false},
+		{`record {| string name; json id; record {| string name; json id; |}[] children; |}`, true},
+	}
+	for _, test := range tests {
+		ty, err := p.Parse(test.source)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", test.source, err)
+		}
+		if semtypes.IsSubtype(cx, ty, person) != test.subtype {
+			t.Errorf("expected %s subtype of Person to be %v", test.source, test.subtype)
+		}
+	}
+	if !semtypes.IsSubtype(cx, person, semtypes.CreateJson(cx)) {
+		t.Errorf("expected Person to be a subtype of json")
+	}
+}
+
+func TestConvertKeywords(t *testing.T) {
+	tests := []struct {
+		schema   string
+		expected string
+	}{
+		{`true`, "json"},
+		{`false`, "never"},
+		{`{}`, "json"},
+		{`{"type": ["string", "null"]}`, "string?"},
+		{`{"type": ["string", "integer", "null"]}`, "int|string|()"},
+		{`{"type": "number"}`, "decimal"},
+		{`{"type": "number", "format": "double"}`, "float"},
+		{`{"type": "integer", "minimum": 1, "exclusiveMaximum": 4}`, "1|2|3"},
+		{`{"type": "integer", "minimum": -32768, "maximum": 32767}`, "int:Signed16"},
+		{`{"type": "integer", "minimum": 0}`, "int"},
+		{`{"type": "integer", "minimum": 5, "maximum": 4}`, "never"},
+		{`{"const": 2.5}`, "2.5d"},
+		{`{"type": "string", "enum": ["a", 1]}`, `"a"`},
+		{`{"allOf": [{"$ref": "#/$defs/A"}, {"required": ["b"]}], "$defs": {"A": {"type": "object"}}}`,
+			"A & record {|\n    json b;\n    json...;\n|}"},
+		{`{"type": "array", "items": {"type": ["string", "integer"]}}`, "(int|string)[]"},
+		{`{"type": "array", "items": {"type": "integer"}, "minItems": 3, "maxItems": 3}`, "int[3]"},
+		{`{"type": "array", "prefixItems": [{"type": "string"}, {"type": "integer"}]}`, "[string, int, json...]"},
+		{`{"type": "array", "prefixItems": [{"type": "string"}], "items": false}`, "[string]"},
+		{`{"type": "object", "additionalProperties": false}`, "record {||}"},
+		{`{"type": "object"}`, "map<json>"},
+		{`{"type": "object", "properties": {"type": {"type": "string"}},
+			"additionalProperties": {"type": "integer"}}`, "record {|\n    string 'type?;\n    int...;\n|}"},
+	}
+	for _, test := range tests {
+		module := convert(t, semtypes.NewEnv(), test.schema)
+		expected := "public type Person " + test.expected + ";\n"
+		if !strings.HasPrefix(module.Source, expected) {
+			t.Errorf("expected %s to convert to:\n%s\ngot:\n%s", test.schema, expected, module.Source)
+		}
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	env := semtypes.NewEnv()
+	tests := []struct {
+		schema string
+		err    string
+	}{
+		{`{`, "failed to parse schema JSON"},
+		{`{"$ref": "#/$defs/Missing"}`, "failed to compile schema"},
+		{`{"$ref": "#/$defs/A", "$defs": {"A": {"$ref": "#"}}}`, "failed to resolve the generated types"},
+	}
+	for _, test := range tests {
+		_, err := Convert(env, []byte(test.schema), "Person")
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("expected error %q converting %s, got %v", test.err, test.schema, err)
+		}
+	}
+}
+
+func TestRootName(t *testing.T) {
+	for fileName, expected := range map[string]string{
+		"person.json":                          "Person",
+		"schemas/shipping-address.schema.json": "ShippingAddress",
+		"2024.json":                            "Type2024",
+		"type.json":                            "Type",
+	} {
+		if got := RootName(fileName); got != expected {
+			t.Errorf("expected root name of %s to be %s, got %s", fileName, expected, got)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semtypes
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// atomicTypeKey returns a key identifying the structure of atomicType, by which the atom table interns atoms. Equal
+// atomic types built separately, such as the cells made by every subtype check that projects a list, are distinct
+// objects, so keying the table by the atomic type itself would add a new atom for each of them and keep BDD operations
+// from recognizing them as the same atom.
+func atomicTypeKey(atomicType AtomicType) string {
+	var sb strings.Builder
+	switch atomicType := atomicType.(type) {
+	case *CellAtomicType:
+		sb.WriteString("c")
+		sb.WriteString(strconv.Itoa(int(atomicType.Mut)))
+		writeSemTypeKey(&sb, atomicType.Ty)
+	case *ListAtomicType:
+		sb.WriteString("l")
+		sb.WriteString(strconv.Itoa(atomicType.Members.FixedLength))
+		for _, member := range atomicType.Members.Initial {
+			writeSemTypeKey(&sb, member)
+		}
+		sb.WriteString(";")
+		writeSemTypeKey(&sb, atomicType.Rest)
+	case *MappingAtomicType:
+		sb.WriteString("m")
+		for i, name := range atomicType.Names {
+			sb.WriteString(strconv.Quote(name))
+			writeSemTypeKey(&sb, atomicType.Types[i])
+		}
+		sb.WriteString(";")
+		writeSemTypeKey(&sb, atomicType.Rest)
+	case *FunctionAtomicType:
+		sb.WriteString("f")
+		sb.WriteString(strconv.FormatBool(atomicType.IsGeneric))
+		writeSemTypeKey(&sb, atomicType.ParamType)
+		writeSemTypeKey(&sb, atomicType.RetType)
+		writeSemTypeKey(&sb, atomicType.Qualifiers)
+	default:
+		panic("unexpected atomic type")
+	}
+	return sb.String()
+}
+
+func writeSemTypeKey(sb *strings.Builder, t SemType) {
+	switch t := t.(type) {
+	case nil:
+		sb.WriteString("_")
+	case *BasicTypeBitSet:
+		sb.WriteString("<")
+		sb.WriteString(strconv.Itoa(t.bitset))
+		sb.WriteString(">")
+	case ComplexSemType:
+		sb.WriteString("<")
+		sb.WriteString(strconv.Itoa(t.All()))
+		sb.WriteString(" ")
+		sb.WriteString(strconv.Itoa(t.Some()))
+		for _, data := range t.SubtypeDataList() {
+			sb.WriteString(" ")
+			writeSubtypeDataKey(sb, data)
+		}
+		sb.WriteString(">")
+	default:
+		panic("unexpected semtype")
+	}
+}
+
+func writeSubtypeDataKey(sb *strings.Builder, data ProperSubtypeData) {
+	switch data := data.(type) {
+	case BooleanSubtype:
+		sb.WriteString(strconv.FormatBool(data.value))
+	case IntSubtype:
+		for _, r := range data.Ranges {
+			sb.WriteString(strconv.FormatInt(r.Min, 10))
+			sb.WriteString(":")
+			sb.WriteString(strconv.FormatInt(r.Max, 10))
+			sb.WriteString(",")
+		}
+	case FloatSubtype:
+		sb.WriteString(strconv.FormatBool(data.allowed))
+		for _, value := range data.values {
+			sb.WriteString(",")
+			sb.WriteString(strconv.FormatUint(math.Float64bits(value.value), 16))
+		}
+	case DecimalSubtype:
+		sb.WriteString(strconv.FormatBool(data.allowed))
+		for _, value := range data.values {
+			sb.WriteString(",")
+			sb.WriteString(value.value.RatString())
+		}
+	case StringSubtype:
+		chars := data.charData
+		nonChars := data.nonCharData
+		writeStringValuesKey(sb, chars.Allowed(), chars.Values())
+		writeStringValuesKey(sb, nonChars.Allowed(), nonChars.Values())
+	case XmlSubtype:
+		sb.WriteString(strconv.Itoa(data.Primitives))
+		sb.WriteString(":")
+		writeBddKey(sb, data.Sequence)
+	case Bdd:
+		writeBddKey(sb, data)
+	default:
+		panic("unexpected subtype data")
+	}
+}
+
+func writeBddKey(sb *strings.Builder, b Bdd) {
+	switch b := b.(type) {
+	case BddAllOrNothing:
+		writeBddAllOrNothingKey(sb, &b)
+	case *BddAllOrNothing:
+		writeBddAllOrNothingKey(sb, b)
+	case BddNode:
+		sb.WriteByte('(')
+		writeAtomKey(sb, b.Atom())
+		sb.WriteByte(' ')
+		writeBddKey(sb, b.Left())
+		sb.WriteByte(' ')
+		writeBddKey(sb, b.Middle())
+		sb.WriteByte(' ')
+		writeBddKey(sb, b.Right())
+		sb.WriteByte(')')
+	default:
+		panic("unexpected bdd type")
+	}
+}
+
+func writeBddAllOrNothingKey(sb *strings.Builder, b *BddAllOrNothing) {
+	if b.IsAll() {
+		sb.WriteByte('1')
+	} else {
+		sb.WriteByte('0')
+	}
+}
+
+func writeAtomKey(sb *strings.Builder, atom Atom) {
+	// Rec atoms and type atoms are indexed independently, so the index and kind
+	// alone are not enough to tell them apart.
+	if _, ok := atom.(*RecAtom); ok {
+		sb.WriteByte('r')
+	} else {
+		sb.WriteByte('t')
+	}
+	sb.WriteString(strconv.Itoa(int(atom.Kind())))
+	sb.WriteByte(':')
+	sb.WriteString(strconv.Itoa(atom.Index()))
+}
+
+func writeStringValuesKey(sb *strings.Builder, allowed bool, values []EnumerableType[string]) {
+	sb.WriteString(strconv.FormatBool(allowed))
+	for _, value := range values {
+		sb.WriteString(",")
+		sb.WriteString(strconv.Quote(value.Value()))
+	}
+}
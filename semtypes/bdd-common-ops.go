@@ -53,6 +53,9 @@ func BddUnion(b1 Bdd, b2 Bdd) Bdd {
 
 func bddUnionWithMemo(memoTable *bddOpMemo, b1 Bdd, b2 Bdd) Bdd {
 	// migrated from BddCommonOps.java:47:5
+	if isTrivialBddOp(b1, b2) {
+		return bddUnionInner(memoTable, b1, b2)
+	}
 	key := bddOpMemoKey{B1: b1, B2: b2}
 	memoized, ok := memoTable.UnionMemo[key]
 	if ok {
@@ -102,6 +105,9 @@ func BddIntersect(b1 Bdd, b2 Bdd) Bdd {
 
 func bddIntersectWithMemo(memo *bddOpMemo, b1 Bdd, b2 Bdd) Bdd {
 	// migrated from BddCommonOps.java:92:5
+	if isTrivialBddOp(b1, b2) {
+		return bddIntersectInner(memo, b1, b2)
+	}
 	key := bddOpMemoKey{B1: b1, B2: b2}
 	memoized, ok := memo.IntersectionMemo[key]
 	if ok {
@@ -151,6 +157,9 @@ func BddDiff(b1 Bdd, b2 Bdd) Bdd {
 
 func bddDiffWithMemo(memo *bddOpMemo, b1 Bdd, b2 Bdd) Bdd {
 	// migrated from BddCommonOps.java:141:5
+	if isTrivialBddOp(b1, b2) {
+		return bddDiffInner(memo, b1, b2)
+	}
 	key := bddOpMemoKey{B1: b1, B2: b2}
 	memoized, ok := memo.DiffMemo[key]
 	if ok {
@@ -278,3 +287,14 @@ func createBddOpMemo() *bddOpMemo {
 		DiffMemo:         make(map[bddOpMemoKey]Bdd),
 	}
 }
+
+// isTrivialBddOp reports whether an operation on b1 and b2 is answered without recursing into them, so that its
+// result isn't worth memoizing.
+func isTrivialBddOp(b1 Bdd, b2 Bdd) bool {
+	if b1 == b2 {
+		return true
+	}
+	_, ok1 := b1.(BddAllOrNothing)
+	_, ok2 := b2.(BddAllOrNothing)
+	return ok1 || ok2
+}
@@ -19,3 +19,78 @@ package semtypes
 type Bdd interface {
 	ProperSubtypeData
 }
+
+// bddInterner gives the bdds seen by a Context ids that are equal exactly when the bdds have the same structure. Equal
+// bdds built separately are distinct objects, so memo tables must not be keyed by the bdd itself; otherwise a recursive
+// type never finds its own memo entry. The id of each bdd object is remembered, so that a bdd is walked only the first
+// time it is seen.
+type bddInterner struct {
+	ids     map[Bdd]int
+	nodeIds map[bddNodeKey]int
+}
+
+type bddNodeKey struct {
+	atom   bddAtomKey
+	left   int
+	middle int
+	right  int
+}
+
+// bddAtomKey identifies an atom. Rec atoms and type atoms are indexed independently, so the index and kind alone are
+// not enough to tell them apart.
+type bddAtomKey struct {
+	rec   bool
+	kind  Kind
+	index int
+}
+
+const (
+	bddNothingId = iota
+	bddAllId
+	firstBddNodeId
+)
+
+func newBddInterner() bddInterner {
+	return bddInterner{
+		ids:     make(map[Bdd]int),
+		nodeIds: make(map[bddNodeKey]int),
+	}
+}
+
+func (this *bddInterner) id(b Bdd) int {
+	if id, ok := this.ids[b]; ok {
+		return id
+	}
+	var id int
+	switch b := b.(type) {
+	case BddAllOrNothing:
+		id = bddAllOrNothingId(&b)
+	case *BddAllOrNothing:
+		id = bddAllOrNothingId(b)
+	case BddNode:
+		atom := b.Atom()
+		_, rec := atom.(*RecAtom)
+		key := bddNodeKey{
+			atom:   bddAtomKey{rec: rec, kind: atom.Kind(), index: atom.Index()},
+			left:   this.id(b.Left()),
+			middle: this.id(b.Middle()),
+			right:  this.id(b.Right()),
+		}
+		var ok bool
+		if id, ok = this.nodeIds[key]; !ok {
+			id = firstBddNodeId + len(this.nodeIds)
+			this.nodeIds[key] = id
+		}
+	default:
+		panic("unexpected bdd type")
+	}
+	this.ids[b] = id
+	return id
+}
+
+func bddAllOrNothingId(b *BddAllOrNothing) int {
+	if b.IsAll() {
+		return bddAllId
+	}
+	return bddNothingId
+}
@@ -50,3 +50,20 @@ func TestBddDiff(t *testing.T) {
 		t.Error("expected IsAll() to be false")
 	}
 }
+
+// TestBddInterner tests that equal BDDs built separately get the same id, and that different BDDs do not
+func TestBddInterner(t *testing.T) {
+	interner := newBddInterner()
+	b1 := BddUnion(BddAtom(common.ToPointer(CreateRecAtom(1))), BddAtom(common.ToPointer(CreateRecAtom(2))))
+	b2 := BddUnion(BddAtom(common.ToPointer(CreateRecAtom(1))), BddAtom(common.ToPointer(CreateRecAtom(2))))
+	b3 := BddUnion(BddAtom(common.ToPointer(CreateRecAtom(1))), BddAtom(common.ToPointer(CreateRecAtom(3))))
+	if interner.id(b1) != interner.id(b2) {
+		t.Error("expected equal BDDs to have the same id")
+	}
+	if interner.id(b1) == interner.id(b3) {
+		t.Error("expected different BDDs to have different ids")
+	}
+	if interner.id(BddAll()) == interner.id(BddNothing()) {
+		t.Error("expected all and nothing to have different ids")
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semtypes
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// The benchmarks check the subtyping of types that are slow to check: big unions, recursive JSON-like types, large
+// and deeply nested records, and function types. Each iteration checks the same types with a new Context, so that the
+// memos of one iteration don't answer the checks of the next. The counters of TypeCheckStats are reported per
+// iteration. Run them with
+//
+//	go test ./semtypes -run '^$' -bench . -benchmem
+//
+// and compare the results with testdata/benchmark-baseline.txt.
+
+// benchmarkSubtype reports the time of checking each pair of checks, which must hold, with a new Context.
+func benchmarkSubtype(b *testing.B, env Env, checks ...[2]SemType) {
+	b.Helper()
+	var stats TypeCheckStats
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cx := ContextFrom(env)
+		before := StatsOf(cx)
+		for _, check := range checks {
+			if !IsSubtype(cx, check[0], check[1]) {
+				b.Fatalf("expected %s to be a subtype of %s", ToTypeString(cx, check[0]), ToTypeString(cx, check[1]))
+			}
+		}
+		stats = StatsOf(cx).Sub(before)
+	}
+	b.ReportMetric(float64(stats.MemoHits), "memo-hits/op")
+	b.ReportMetric(float64(stats.MemoMisses), "memo-misses/op")
+	b.ReportMetric(float64(stats.BddNodes), "bdd-nodes/op")
+	b.ReportMetric(float64(StatsOf(ContextFrom(env)).Atoms), "atoms")
+}
+
+func benchmarkTypes(b *testing.B, source string, names ...string) (Env, []SemType) {
+	b.Helper()
+	env := NewEnv()
+	p := NewTypeParser(env)
+	if err := p.DefineTypes(source); err != nil {
+		b.Fatalf("failed to define types: %v", err)
+	}
+	types := make([]SemType, len(names))
+	for i, name := range names {
+		t, err := p.Lookup(name)
+		if err != nil {
+			b.Fatalf("failed to look up type: %v", err)
+		}
+		types[i] = t
+	}
+	return env, types
+}
+
+// BenchmarkBigUnion checks a union of 100 records tagged by a kind field, together with string and int singletons,
+// against itself and against json.
+func BenchmarkBigUnion(b *testing.B) {
+	var sb strings.Builder
+	var members []string
+	for i := 0; i < 100; i++ {
+		fmt.Fprintf(&sb, "type R%d record {| \"k%d\" kind; int v%d; string? s; |};\n", i, i, i)
+		members = append(members, fmt.Sprintf("R%d", i), fmt.Sprintf("\"s%d\"", i), fmt.Sprintf("%d", i))
+	}
+	fmt.Fprintf(&sb, "type Big %s;\n", strings.Join(members, "|"))
+	fmt.Fprintf(&sb, "type Smaller %s;\n", strings.Join(members[3:], "|"))
+	fmt.Fprintf(&sb, "type Wider %s|R0[];\n", strings.Join(members, "|"))
+	env, types := benchmarkTypes(b, sb.String(), "Big", "Smaller", "Wider")
+	json := CreateJson(ContextFrom(env))
+	benchmarkSubtype(b, env, [2]SemType{types[1], types[0]}, [2]SemType{types[0], types[2]}, [2]SemType{types[0], json})
+}
+
+// BenchmarkRecursiveJSON checks recursive JSON-like types, which are equivalent to json or subtypes of it, against
+// each other.
+func BenchmarkRecursiveJSON(b *testing.B) {
+	env, types := benchmarkTypes(b, `
+type J1 ()|boolean|int|float|decimal|string|J1[]|map<J1>;
+type J2 ()|boolean|int|float|decimal|string|J2[]|map<J2>|[J2, J2...];
+type Tree record {| string name; Tree[] children; map<Tree> named; |};
+type IntTree ()|int|IntTree[]|map<IntTree>;
+`, "J1", "J2", "Tree", "IntTree")
+	json := CreateJson(ContextFrom(env))
+	benchmarkSubtype(b, env,
+		[2]SemType{types[0], types[1]}, [2]SemType{types[1], types[0]}, [2]SemType{types[0], json},
+		[2]SemType{types[2], types[1]}, [2]SemType{types[3], types[0]})
+}
+
+// BenchmarkLargeRecord checks records of 100 fields against each other, and a hierarchy of 20 nested records.
+func BenchmarkLargeRecord(b *testing.B) {
+	var sb strings.Builder
+	var fields, widerFields []string
+	for i := 0; i < 100; i++ {
+		fields = append(fields, fmt.Sprintf("int f%d;", i))
+		widerFields = append(widerFields, fmt.Sprintf("int|string f%d?;", i))
+	}
+	fmt.Fprintf(&sb, "type Large record {| %s |};\n", strings.Join(fields, " "))
+	fmt.Fprintf(&sb, "type Wider record { %s };\n", strings.Join(widerFields, " "))
+	sb.WriteString("type N0 record {| int a; |};\ntype W0 record { int a; };\n")
+	for i := 1; i <= 20; i++ {
+		fmt.Fprintf(&sb, "type N%d record {| N%d child; N%d[] children; int a; |};\n", i, i-1, i-1)
+		fmt.Fprintf(&sb, "type W%d record { W%d child; W%d[] children; };\n", i, i-1, i-1)
+	}
+	env, types := benchmarkTypes(b, sb.String(), "Large", "Wider", "N20", "W20")
+	benchmarkSubtype(b, env, [2]SemType{types[0], types[1]}, [2]SemType{types[2], types[3]})
+}
+
+// BenchmarkFunctionTypes checks unions of function types with many parameters, which are contravariant in their
+// parameters.
+func BenchmarkFunctionTypes(b *testing.B) {
+	env := NewEnv()
+	qualifiers := FunctionQualifiersFrom(env, false, false)
+	function := func(param SemType, ret SemType) SemType {
+		params := make([]SemType, 10)
+		for i := range params {
+			params[i] = param
+		}
+		fd := NewFunctionDefinition()
+		return fd.Define(env, testTuple(env, params...), ret, qualifiers)
+	}
+	var narrow, wide SemType = &NEVER, &NEVER
+	for i := 0; i < 20; i++ {
+		ret := IntConst(int64(i))
+		narrow = Union(narrow, function(&INT, ret))
+		wide = Union(wide, function(IntConst(int64(i)), Union(ret, &STRING)))
+	}
+	benchmarkSubtype(b, env, [2]SemType{narrow, wide}, [2]SemType{function(&INT, &INT), function(BYTE, &INT)})
+}
@@ -126,9 +126,15 @@ func isNothingSubtype(t SubtypeData) bool {
 	return false
 }
 
-func memoSubtypeIsEmpty(cx Context, memoTable map[Bdd]*BddMemo, isEmptyPredicate bddIsEmptyPredicate, b Bdd) bool {
-	mm := memoTable[b]
+func memoSubtypeIsEmpty(cx Context, memoTable map[int]*BddMemo, isEmptyPredicate bddIsEmptyPredicate, b Bdd) bool {
+	key := cx.bddId(b)
+	mm := memoTable[key]
 	var m *BddMemo
+	if mm != nil && mm.isEmpty != MemoStatus_NULL {
+		cx.stats().MemoHits++
+	} else {
+		cx.stats().MemoMisses++
+	}
 	if mm != nil {
 		res := mm.isEmpty
 		switch res {
@@ -147,7 +153,7 @@ func memoSubtypeIsEmpty(cx Context, memoTable map[Bdd]*BddMemo, isEmptyPredicate
 	} else {
 		tmp := NewBddMemo()
 		m = &tmp
-		memoTable[b] = m
+		memoTable[key] = m
 	}
 	m.isEmpty = MemoStatus_PROVISIONAL
 	initStackDepth := cx.getMemoStackDepth()
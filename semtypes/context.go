@@ -16,6 +16,10 @@
 
 package semtypes
 
+import "sync"
+
+// Context holds the memos of the subtype checks done in an Env. Unlike an Env, a Context is not safe for concurrent
+// use: each goroutine checking types in parallel must use its own Context, which it may get from a ContextPool.
 type Context interface {
 	pushToMemoStack(m *BddMemo)
 	getMemoStackDepth() int
@@ -32,12 +36,15 @@ type Context interface {
 	setIsolatedObjectMemo(t SemType)
 	serviceObjectMemo() SemType
 	setServiceObjectMemo(t SemType)
-	mappingMemo() map[Bdd]*BddMemo
-	functionMemo() map[Bdd]*BddMemo
-	listMemo() map[Bdd]*BddMemo
+	bddId(b Bdd) int
+	bddNodeCount() int
+	mappingMemo() map[int]*BddMemo
+	functionMemo() map[int]*BddMemo
+	listMemo() map[int]*BddMemo
 	functionAtomType(atom Atom) *FunctionAtomicType
 	listAtomType(atom Atom) *ListAtomicType
 	mappingAtomType(atom Atom) *MappingAtomicType
+	stats() *TypeCheckStats
 }
 
 var _ Context = &contextImpl{}
@@ -45,15 +52,18 @@ var _ Context = &contextImpl{}
 type contextImpl struct {
 	_env          Env
 	_memoStack    []*BddMemo
-	_listMemo     map[Bdd]*BddMemo
-	_mappingMemo  map[Bdd]*BddMemo
-	_functionMemo map[Bdd]*BddMemo
+	_listMemo     map[int]*BddMemo
+	_mappingMemo  map[int]*BddMemo
+	_functionMemo map[int]*BddMemo
+	_bddInterner  bddInterner
 
 	_jsonMemo           SemType
 	_anydataMemo        SemType
 	_cloneableMemo      SemType
 	_isolatedObjectMemo SemType
 	_serviceObjectMemo  SemType
+
+	_stats TypeCheckStats
 }
 
 func (this *contextImpl) pushToMemoStack(m *BddMemo) {
@@ -119,15 +129,23 @@ func (this *contextImpl) setServiceObjectMemo(t SemType) {
 	this._serviceObjectMemo = t
 }
 
-func (this *contextImpl) mappingMemo() map[Bdd]*BddMemo {
+func (this *contextImpl) bddId(b Bdd) int {
+	return this._bddInterner.id(b)
+}
+
+func (this *contextImpl) bddNodeCount() int {
+	return len(this._bddInterner.nodeIds)
+}
+
+func (this *contextImpl) mappingMemo() map[int]*BddMemo {
 	return this._mappingMemo
 }
 
-func (this *contextImpl) functionMemo() map[Bdd]*BddMemo {
+func (this *contextImpl) functionMemo() map[int]*BddMemo {
 	return this._functionMemo
 }
 
-func (this *contextImpl) listMemo() map[Bdd]*BddMemo {
+func (this *contextImpl) listMemo() map[int]*BddMemo {
 	return this._listMemo
 }
 
@@ -143,11 +161,52 @@ func (this *contextImpl) mappingAtomType(atom Atom) *MappingAtomicType {
 	return this._env.mappingAtomType(atom)
 }
 
+func (this *contextImpl) stats() *TypeCheckStats {
+	return &this._stats
+}
+
+// ContextFrom creates a Context for checking the types of env.
 func ContextFrom(env Env) Context {
 	return &contextImpl{
 		_env:          env,
-		_listMemo:     make(map[Bdd]*BddMemo),
-		_mappingMemo:  make(map[Bdd]*BddMemo),
-		_functionMemo: make(map[Bdd]*BddMemo),
+		_listMemo:     make(map[int]*BddMemo),
+		_mappingMemo:  make(map[int]*BddMemo),
+		_functionMemo: make(map[int]*BddMemo),
+		_bddInterner:  newBddInterner(),
+	}
+}
+
+// ContextPool hands out Contexts of an Env to the goroutines that check types in parallel, so that the memos of a
+// Context are reused by later checks instead of being built again for every goroutine. A ContextPool is safe for
+// concurrent use.
+type ContextPool struct {
+	env  Env
+	pool sync.Pool
+}
+
+// NewContextPool creates a pool of Contexts for env.
+func NewContextPool(env Env) *ContextPool {
+	p := &ContextPool{env: env}
+	p.pool.New = func() any {
+		return ContextFrom(env)
+	}
+	return p
+}
+
+// Env returns the Env of the Contexts of the pool.
+func (p *ContextPool) Env() Env {
+	return p.env
+}
+
+// Get returns a Context for the calling goroutine to use until it gives it back with Put.
+func (p *ContextPool) Get() Context {
+	return p.pool.Get().(Context)
+}
+
+// Put gives back a Context got from Get. The Context must not be used after it is put back.
+func (p *ContextPool) Put(cx Context) {
+	if cx.env() != p.env || cx.getMemoStackDepth() != 0 {
+		panic("context does not belong to the pool or is still in use")
 	}
+	p.pool.Put(cx)
 }
@@ -229,8 +229,11 @@ func Intersect(t1, t2 SemType) SemType {
 		} else {
 			data = ops[code.Code].Intersect(data1, data2)
 		}
-		if allOrNothing, ok := data.(AllOrNothingSubtype); !ok || !allOrNothing.IsAllSubtype() {
+		if allOrNothing, ok := data.(AllOrNothingSubtype); !ok {
 			subtypes = append(subtypes, BasicSubtypeFrom(code, data.(ProperSubtypeData)))
+		} else if allOrNothing.IsAllSubtype() {
+			c := code.Code
+			all = BasicTypeBitSetFrom(all.bitset | (1 << c))
 		}
 	}
 	if len(subtypes) == 0 {
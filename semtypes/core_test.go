@@ -420,66 +420,59 @@ func recursiveTuple(env Env, f func(Env, SemType) []SemType) SemType {
 	def := NewListDefinition()
 	t := def.GetSemType(env)
 	members := f(env, t)
-	return def.DefineListTypeWrapped(env, members, len(members), &VAL, CellMutability_CELL_MUT_LIMITED)
+	return def.DefineListTypeWrapped(env, members, len(members), &NEVER, CellMutability_CELL_MUT_LIMITED)
 }
 
 // TestRec tests recursive tuple types
 // Ported from SemTypeCoreTest.java:recTest()
-// TODO: These recursive tests cause stack overflow - needs investigation
-// The issue appears to be with infinite recursion in recursive tuple creation
 func TestRec(t *testing.T) {
-	t.Skip("Skipping recursive tuple test - causes stack overflow, needs investigation")
-	// env := GetTypeEnv()
-	// ctx := ContextFrom(env)
-	//
-	// t1 := recursiveTuple(env, func(e Env, t SemType) []SemType {
-	// 	return []SemType{&INT, Union(t, &NIL)}
-	// })
-	// t2 := recursiveTuple(env, func(e Env, t SemType) []SemType {
-	// 	return []SemType{
-	// 		Union(&INT, &STRING),
-	// 		Union(t, &NIL),
-	// 	}
-	// })
-	// assertTrue(t, IsSubtype(ctx, t1, t2))
-	// assertFalse(t, IsSubtype(ctx, t2, t1))
+	env := NewEnv()
+	ctx := ContextFrom(env)
+
+	t1 := recursiveTuple(env, func(e Env, t SemType) []SemType {
+		return []SemType{&INT, Union(t, &NIL)}
+	})
+	t2 := recursiveTuple(env, func(e Env, t SemType) []SemType {
+		return []SemType{
+			Union(&INT, &STRING),
+			Union(t, &NIL),
+		}
+	})
+	assertTrue(t, IsSubtype(ctx, t1, t2))
+	assertFalse(t, IsSubtype(ctx, t2, t1))
 }
 
 // TestRec2 tests recursive tuple with nil union
 // Ported from SemTypeCoreTest.java:recTest2()
-// TODO: These recursive tests cause stack overflow - needs investigation
 func TestRec2(t *testing.T) {
-	t.Skip("Skipping recursive tuple test - causes stack overflow, needs investigation")
-	// env := GetTypeEnv()
-	// ctx := ContextFrom(env)
-	//
-	// t1 := Union(&NIL, recursiveTuple(env, func(e Env, t SemType) []SemType {
-	// 	return []SemType{&INT, Union(t, &NIL)}
-	// }))
-	// t2 := recursiveTuple(env, func(e Env, t SemType) []SemType {
-	// 	return []SemType{&INT, Union(t, &NIL)}
-	// })
-	// assertTrue(t, IsSubtype(ctx, t2, t1))
+	env := NewEnv()
+	ctx := ContextFrom(env)
+
+	t1 := Union(&NIL, recursiveTuple(env, func(e Env, t SemType) []SemType {
+		return []SemType{&INT, Union(t, &NIL)}
+	}))
+	t2 := recursiveTuple(env, func(e Env, t SemType) []SemType {
+		return []SemType{&INT, Union(t, &NIL)}
+	})
+	assertTrue(t, IsSubtype(ctx, t2, t1))
 }
 
 // TestRec3 tests recursive tuple with nested tuple
 // Ported from SemTypeCoreTest.java:recTest3()
-// TODO: These recursive tests cause stack overflow - needs investigation
 func TestRec3(t *testing.T) {
-	t.Skip("Skipping recursive tuple test - causes stack overflow, needs investigation")
-	// env := GetTypeEnv()
-	// ctx := ContextFrom(env)
-	//
-	// t1 := recursiveTuple(env, func(e Env, t SemType) []SemType {
-	// 	return []SemType{&INT, Union(t, &NIL)}
-	// })
-	// t2 := recursiveTuple(env, func(e Env, t SemType) []SemType {
-	// 	return []SemType{
-	// 		&INT,
-	// 		Union(&NIL, createTupleType(e, &INT, Union(&NIL, t))),
-	// 	}
-	// })
-	// assertTrue(t, IsSubtype(ctx, t1, t2))
+	env := NewEnv()
+	ctx := ContextFrom(env)
+
+	t1 := recursiveTuple(env, func(e Env, t SemType) []SemType {
+		return []SemType{&INT, Union(t, &NIL)}
+	})
+	t2 := recursiveTuple(env, func(e Env, t SemType) []SemType {
+		return []SemType{
+			&INT,
+			Union(&NIL, createTupleType(e, &INT, Union(&NIL, t))),
+		}
+	})
+	assertTrue(t, IsSubtype(ctx, t1, t2))
 }
 
 // TestStringCharSubtype tests string char subtype creation
@@ -561,3 +554,75 @@ func TestStringSubtypeSingleValue(t *testing.T) {
 	// extraction) is already tested by the assertions above.
 	_ = intersect3 // Suppress unused variable warning
 }
+
+// mappingType creates a mapping type with the given fields and rest type
+func mappingType(env Env, rest SemType, fields ...Field) SemType {
+	md := NewMappingDefinition()
+	return md.DefineMappingTypeWrapped(env, fields, rest)
+}
+
+// TestMappingRestFieldPairs tests that a field present on one side only is paired with the rest type of the other side
+func TestMappingRestFieldPairs(t *testing.T) {
+	env := GetTypeEnv()
+	ctx := ContextFrom(env)
+
+	open := mappingType(env, &STRING, FieldFrom("a", &INT, false, false))
+	assertTrue(t, IsSubtype(ctx, open, mappingType(env, Union(&INT, &STRING))))
+	assertFalse(t, IsSubtype(ctx, open, mappingType(env, &NEVER, FieldFrom("b", &STRING, false, true))))
+
+	closed := mappingType(env, &NEVER, FieldFrom("a", &INT, false, false))
+	assertFalse(t, IsSubtype(ctx, open, closed))
+	assertTrue(t, IsSubtype(ctx, closed, open))
+}
+
+// TestMappingFieldPairIndex tests that the index of a field pair is not moved on as the pairs are iterated
+func TestMappingFieldPairIndex(t *testing.T) {
+	env := GetTypeEnv()
+	ctx := ContextFrom(env)
+
+	pos := mappingType(env, &NEVER, FieldFrom("a", &INT, true, false), FieldFrom("b", &INT, true, false))
+	byteA := mappingType(env, &NEVER, FieldFrom("a", BYTE, true, false), FieldFrom("b", &INT, true, false))
+	byteB := mappingType(env, &NEVER, FieldFrom("a", &INT, true, false), FieldFrom("b", BYTE, true, false))
+	nonByteA := mappingType(env, &NEVER, FieldFrom("a", Diff(&INT, BYTE), true, false), FieldFrom("b", &INT, true, false))
+	assertFalse(t, IsSubtype(ctx, pos, Union(byteA, byteB)))
+	assertTrue(t, IsSubtype(ctx, pos, Union(byteA, nonByteA)))
+}
+
+// TestErrorUnion tests the union of distinct error types
+func TestErrorUnion(t *testing.T) {
+	ctx := ContextFrom(GetTypeEnv())
+
+	e1 := ErrorDistinct(0)
+	e2 := ErrorDistinct(1)
+	u := Union(e1, e2)
+	assertTrue(t, IsSubtype(ctx, e1, u))
+	assertTrue(t, IsSubtype(ctx, e2, u))
+	assertFalse(t, IsSubtype(ctx, u, e1))
+}
+
+// TestMappingDiffKeepsAtom tests that checking a mapping type against a negative one does not change the field types of
+// its atom
+func TestMappingDiffKeepsAtom(t *testing.T) {
+	env := GetTypeEnv()
+	ctx := ContextFrom(env)
+
+	pos := mappingType(env, &NEVER, FieldFrom("a", &INT, true, false), FieldFrom("b", &INT, true, false))
+	byteA := mappingType(env, &NEVER, FieldFrom("a", BYTE, true, false), FieldFrom("b", &INT, true, false))
+	nonByteA := mappingType(env, &NEVER, FieldFrom("a", Diff(&INT, BYTE), true, false), FieldFrom("b", &INT, true, false))
+	assertFalse(t, IsSubtype(ctx, pos, byteA))
+	assertFalse(t, IsSubtype(ctx, pos, nonByteA))
+}
+
+// TestStringSubtypeContains tests membership of strings in string subtypes, including characters of more than one byte
+func TestStringSubtypeContains(t *testing.T) {
+	for _, s := range []string{"a", "é", "ab", "日本"} {
+		sd := stringSubtype(StringConst(s))
+		assertTrue(t, StringSubtypeContains(sd, s), s)
+		assertFalse(t, StringSubtypeContains(sd, "z"), s)
+		assertFalse(t, StringSubtypeContains(sd, "zz"), s)
+	}
+	sd := stringSubtype(Diff(&STRING, StringConst("é")))
+	assertFalse(t, StringSubtypeContains(sd, "é"))
+	assertTrue(t, StringSubtypeContains(sd, "e"))
+	assertTrue(t, StringSubtypeContains(sd, "ée"))
+}
@@ -15,8 +15,18 @@
 // under the License.
 package semtypes
 
-import "sync"
-
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Env holds the atoms from which the semtypes of a compilation are built. An Env is safe for concurrent use: any
+// number of goroutines may define types in, and check types against, the same Env. Semtypes built in one Env must not
+// be used with another.
+//
+// The atoms of an Env are never released, so a long-running process should create a separate Env with NewEnv for each
+// compilation rather than share the one returned by GetTypeEnv.
+//
 // migration-note: we can turning this to an interface to avoid accidentally copying the env
 type Env interface {
 	cellAtom(atomicType *CellAtomicType) TypeAtom
@@ -32,74 +42,89 @@ type Env interface {
 	mappingAtomType(atom Atom) *MappingAtomicType
 	functionAtomType(atom Atom) *FunctionAtomicType
 	listAtomType(atom Atom) *ListAtomicType
+	distinctAtomCountGetAndIncrement() int
+	atomTableSize() int
 }
 
 var typeEnv Env = nil
 var typeEnvInitializer sync.Once
 
+// GetTypeEnv returns the Env shared by the whole process.
 func GetTypeEnv() Env {
 	typeEnvInitializer.Do(func() {
-		env := &envImpl{
-			atomTable: make(map[AtomicType]TypeAtom),
-			types:     make(map[string]SemType),
-		}
-		fillRecAtoms(predefinedTypeEnv, &env.recListAtoms, predefinedTypeEnv.initializedRecListAtoms)
-		fillRecAtoms(predefinedTypeEnv, &env.recMappingAtoms, predefinedTypeEnv.initializedRecMappingAtoms)
-		for _, each := range predefinedTypeEnv.initializedCellAtoms {
-			env.cellAtom(each.atomicType)
-		}
-		for _, each := range predefinedTypeEnv.initializedListAtoms {
-			env.listAtom(each.atomicType)
-		}
-		typeEnv = env
+		typeEnv = NewEnv()
 	})
 	return typeEnv
 }
 
+// NewEnv creates an env independent of the shared one returned by GetTypeEnv.
+func NewEnv() Env {
+	env := &envImpl{}
+	fillRecAtoms(predefinedTypeEnv, &env.recListAtoms, predefinedTypeEnv.initializedRecListAtoms)
+	fillRecAtoms(predefinedTypeEnv, &env.recMappingAtoms, predefinedTypeEnv.initializedRecMappingAtoms)
+	for _, each := range predefinedTypeEnv.initializedCellAtoms {
+		env.cellAtom(each.atomicType)
+	}
+	for _, each := range predefinedTypeEnv.initializedListAtoms {
+		env.listAtom(each.atomicType)
+	}
+	return env
+}
+
+// NextDistinctId returns a fresh id, unique within env, to be used with ErrorDistinct for a distinct type.
+func NextDistinctId(env Env) int {
+	return env.distinctAtomCountGetAndIncrement()
+}
+
 type envImpl struct {
+	// The rec atoms are read far more often than they are created, by every subtype check that reaches them
 	recListAtoms      []*ListAtomicType
-	recListAtomsMutex sync.Mutex
+	recListAtomsMutex sync.RWMutex
 
 	recMappingAtoms      []*MappingAtomicType
-	recMappingAtomsMutex sync.Mutex
+	recMappingAtomsMutex sync.RWMutex
 
 	recFunctionAtoms      []*FunctionAtomicType
-	recFunctionAtomsMutex sync.Mutex
+	recFunctionAtomsMutex sync.RWMutex
 
-	distinctAtoms     int
-	distinctAtomMutex sync.Mutex
+	distinctAtoms atomic.Int64
 	// migration-note: unlike java implementation this will leak memory. So be careful about adding atoms in an unbounded way.
-	atomTableMutex sync.Mutex
-	atomTable      map[AtomicType]TypeAtom
-
-	types map[string]SemType
+	// The atom table is keyed by the atomicTypeKey of the AtomicType of each atom, and its lookups don't take a lock,
+	// so that contexts checking types in parallel don't contend on it.
+	atomTable sync.Map
+	atomCount atomic.Int64
 }
 
 var _ Env = &envImpl{}
 
 func (this *envImpl) recListAtomCount() int {
+	this.recListAtomsMutex.RLock()
+	defer this.recListAtomsMutex.RUnlock()
 	return len(this.recListAtoms)
 }
 
 func (this *envImpl) recMappingAtomCount() int {
+	this.recMappingAtomsMutex.RLock()
+	defer this.recMappingAtomsMutex.RUnlock()
 	return len(this.recMappingAtoms)
 }
 
 func (this *envImpl) recFunctionAtomCount() int {
+	this.recFunctionAtomsMutex.RLock()
+	defer this.recFunctionAtomsMutex.RUnlock()
 	return len(this.recFunctionAtoms)
 }
 
+func (this *envImpl) atomTableSize() int {
+	return int(this.atomCount.Load())
+}
+
 func (this *envImpl) distinctAtomCount() int {
-	this.distinctAtomMutex.Lock()
-	defer this.distinctAtomMutex.Unlock()
-	return this.distinctAtoms
+	return int(this.distinctAtoms.Load())
 }
 
 func (this *envImpl) distinctAtomCountGetAndIncrement() int {
-	this.distinctAtomMutex.Lock()
-	defer this.distinctAtomMutex.Unlock()
-	this.distinctAtoms++
-	return this.distinctAtoms
+	return int(this.distinctAtoms.Add(1))
 }
 
 func (this *envImpl) recFunctionAtom() RecAtom {
@@ -118,8 +143,8 @@ func (this *envImpl) setRecFunctionAtomType(rec RecAtom, atomicType *FunctionAto
 }
 
 func (this *envImpl) getRecFunctionAtomType(rec RecAtom) *FunctionAtomicType {
-	this.recFunctionAtomsMutex.Lock()
-	defer this.recFunctionAtomsMutex.Unlock()
+	this.recFunctionAtomsMutex.RLock()
+	defer this.recFunctionAtomsMutex.RUnlock()
 	return this.recFunctionAtoms[rec.Index()]
 }
 
@@ -140,15 +165,14 @@ func (this *envImpl) cellAtom(atomicType *CellAtomicType) TypeAtom {
 }
 
 func (this *envImpl) typeAtom(atomicType AtomicType) TypeAtom {
-	this.atomTableMutex.Lock()
-	defer this.atomTableMutex.Unlock()
-	ta, ok := this.atomTable[atomicType]
-	if ok {
-		return ta
+	key := atomicTypeKey(atomicType)
+	if ta, ok := this.atomTable.Load(key); ok {
+		return ta.(TypeAtom)
 	}
-	ta = CreateTypeAtom(len(this.atomTable), atomicType)
-	this.atomTable[atomicType] = ta
-	return ta
+	// If another goroutine adds the same atomic type first, its atom is used and this index is never used
+	ta := CreateTypeAtom(int(this.atomCount.Add(1)-1), atomicType)
+	actual, _ := this.atomTable.LoadOrStore(key, ta)
+	return actual.(TypeAtom)
 }
 
 func (this *envImpl) listAtomType(atom Atom) *ListAtomicType {
@@ -203,14 +227,14 @@ func (this *envImpl) setRecMappingAtomType(rec RecAtom, atomicType *MappingAtomi
 }
 
 func (this *envImpl) getRecListAtomType(rec RecAtom) *ListAtomicType {
-	this.recListAtomsMutex.Lock()
-	defer this.recListAtomsMutex.Unlock()
+	this.recListAtomsMutex.RLock()
+	defer this.recListAtomsMutex.RUnlock()
 	return this.recListAtoms[rec.Index()]
 }
 
 func (this *envImpl) getRecMappingAtomType(rec RecAtom) *MappingAtomicType {
-	this.recMappingAtomsMutex.Lock()
-	defer this.recMappingAtomsMutex.Unlock()
+	this.recMappingAtomsMutex.RLock()
+	defer this.recMappingAtomsMutex.RUnlock()
 	return this.recMappingAtoms[rec.Index()]
 }
 
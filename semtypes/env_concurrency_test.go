@@ -0,0 +1,132 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semtypes
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+const concurrentTypeDefinitions = `
+type IntList ()|[int, IntList];
+type Json ()|boolean|int|float|decimal|string|Json[]|map<Json>;
+type Person record {| string name; int age; Person? parent; |};
+type Named record { string name; };
+type E distinct error;
+`
+
+// TestEnvConcurrentTypeChecks defines and checks types in one Env from many goroutines, each with a Context from a
+// ContextPool. Run with -race to check that the Env is safe for concurrent use.
+func TestEnvConcurrentTypeChecks(t *testing.T) {
+	env := NewEnv()
+	pool := NewContextPool(env)
+	const goroutines = 16
+	const iterations = 20
+
+	var wg sync.WaitGroup
+	errs := make(chan error, goroutines)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				if err := checkTypesConcurrently(env, pool); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func checkTypesConcurrently(env Env, pool *ContextPool) error {
+	p := NewTypeParser(env)
+	if err := p.DefineTypes(concurrentTypeDefinitions); err != nil {
+		return err
+	}
+	cx := pool.Get()
+	defer pool.Put(cx)
+	lookup := func(name string) SemType {
+		t, err := p.Lookup(name)
+		if err != nil {
+			panic(err)
+		}
+		return t
+	}
+	intList := lookup("IntList")
+	json := lookup("Json")
+	person := lookup("Person")
+	e := lookup("E")
+	checks := []struct {
+		name     string
+		t1, t2   SemType
+		expected bool
+	}{
+		{"IntList < Json", intList, json, true},
+		{"Json < IntList", json, intList, false},
+		{"Person < Named", person, lookup("Named"), true},
+		{"Person < Json", person, json, true},
+		{"Json < anydata", json, CreateAnydata(cx), true},
+		{"E < error", e, &ERROR, true},
+		{"error < E", &ERROR, e, false},
+	}
+	for _, check := range checks {
+		if IsSubtype(cx, check.t1, check.t2) != check.expected {
+			return fmt.Errorf("%s: expected %v", check.name, check.expected)
+		}
+	}
+	if _, ok := Witness(cx, Diff(json, intList)); !ok {
+		return fmt.Errorf("expected a witness of Json minus IntList")
+	}
+	return nil
+}
+
+func TestContextPool(t *testing.T) {
+	env := NewEnv()
+	pool := NewContextPool(env)
+	cx := pool.Get()
+	assertTrue(t, cx.env() == env, "pooled context belongs to another env")
+	pool.Put(cx)
+
+	defer func() {
+		assertTrue(t, recover() != nil, "expected a panic putting a context of another env")
+	}()
+	pool.Put(ContextFrom(GetTypeEnv()))
+}
+
+func TestSeparateEnvs(t *testing.T) {
+	env1 := NewEnv()
+	env2 := NewEnv()
+	assertTrue(t, env1 != env2, "expected separate envs")
+	assertEqual(t, NextDistinctId(env1), NextDistinctId(env2))
+
+	for _, env := range []Env{env1, env2} {
+		p := NewTypeParser(env)
+		ty, err := p.Parse("[int, string...]")
+		if err != nil {
+			t.Fatalf("failed to parse type: %v", err)
+		}
+		assertTrue(t, IsSubtype(p.Context(), ty, &LIST))
+		assertFalse(t, IsEmpty(p.Context(), ty))
+	}
+}
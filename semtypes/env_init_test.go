@@ -19,6 +19,16 @@ import (
 	"testing"
 )
 
+// atomTableSnapshot returns the atoms of env's atom table.
+func atomTableSnapshot(env *envImpl) map[string]TypeAtom {
+	atomTable := make(map[string]TypeAtom)
+	env.atomTable.Range(func(key, value any) bool {
+		atomTable[key.(string)] = value.(TypeAtom)
+		return true
+	})
+	return atomTable
+}
+
 // TestEnvInitAtomTable tests environment initialization with atom table
 // Ported from EnvInitTest.java:testEnvInitAtomTable()
 func TestEnvInitAtomTable(t *testing.T) {
@@ -28,9 +38,7 @@ func TestEnvInitAtomTable(t *testing.T) {
 		t.Fatal("expected *envImpl")
 	}
 
-	envImpl.atomTableMutex.Lock()
-	atomTable := envImpl.atomTable
-	envImpl.atomTableMutex.Unlock()
+	atomTable := atomTableSnapshot(envImpl)
 
 	// Ensure atoms are in the table by calling Env methods
 	cellAtomicVal := CellAtomicTypeFrom(&VAL, CellMutability_CELL_MUT_LIMITED)
@@ -66,63 +74,61 @@ func TestEnvInitAtomTable(t *testing.T) {
 	typeAtom9 := env.listAtom(&listAtomicTwoElement)
 
 	// Now check the atomTable
-	envImpl.atomTableMutex.Lock()
-	atomTable = envImpl.atomTable
-	envImpl.atomTableMutex.Unlock()
+	atomTable = atomTableSnapshot(envImpl)
 
 	// Check that the atomTable contains at least the expected entries
 	// Note: The Go implementation may have more atoms than the Java version
 	assertTrue(t, len(atomTable) >= 19, "atomTable should have at least 19 entries, got %d", len(atomTable))
 
 	// Verify the atoms are in the table and match
-	ta0, ok := atomTable[&cellAtomicVal]
+	ta0, ok := atomTable[atomicTypeKey(&cellAtomicVal)]
 	assertTrue(t, ok, "cellAtomicVal should be in atomTable")
-	assertEqual(t, ta0.AtomicType, &cellAtomicVal)
+	assertEqual(t, atomicTypeKey(ta0.AtomicType), atomicTypeKey(&cellAtomicVal))
 	assertEqual(t, ta0, typeAtom0)
 
-	ta1, ok := atomTable[&cellAtomicNever]
+	ta1, ok := atomTable[atomicTypeKey(&cellAtomicNever)]
 	assertTrue(t, ok, "cellAtomicNever should be in atomTable")
-	assertEqual(t, ta1.AtomicType, &cellAtomicNever)
+	assertEqual(t, atomicTypeKey(ta1.AtomicType), atomicTypeKey(&cellAtomicNever))
 	assertEqual(t, ta1, typeAtom1)
 
-	ta2, ok := atomTable[&cellAtomicInner]
+	ta2, ok := atomTable[atomicTypeKey(&cellAtomicInner)]
 	assertTrue(t, ok, "cellAtomicInner should be in atomTable")
-	assertEqual(t, ta2.AtomicType, &cellAtomicInner)
+	assertEqual(t, atomicTypeKey(ta2.AtomicType), atomicTypeKey(&cellAtomicInner))
 	assertEqual(t, ta2, typeAtom2)
 
-	ta3, ok := atomTable[&cellAtomicInnerMapping]
+	ta3, ok := atomTable[atomicTypeKey(&cellAtomicInnerMapping)]
 	assertTrue(t, ok, "cellAtomicInnerMapping should be in atomTable")
-	assertEqual(t, ta3.AtomicType, &cellAtomicInnerMapping)
+	assertEqual(t, atomicTypeKey(ta3.AtomicType), atomicTypeKey(&cellAtomicInnerMapping))
 	assertEqual(t, ta3, typeAtom3)
 
-	ta4, ok := atomTable[&listAtomicMapping]
+	ta4, ok := atomTable[atomicTypeKey(&listAtomicMapping)]
 	assertTrue(t, ok, "listAtomicMapping should be in atomTable")
-	assertEqual(t, ta4.AtomicType, &listAtomicMapping)
+	assertEqual(t, atomicTypeKey(ta4.AtomicType), atomicTypeKey(&listAtomicMapping))
 	assertEqual(t, ta4, typeAtom4)
 
-	ta5, ok := atomTable[CELL_ATOMIC_INNER_MAPPING_RO]
+	ta5, ok := atomTable[atomicTypeKey(CELL_ATOMIC_INNER_MAPPING_RO)]
 	assertTrue(t, ok, "CELL_ATOMIC_INNER_MAPPING_RO should be in atomTable")
-	assertEqual(t, ta5.AtomicType, CELL_ATOMIC_INNER_MAPPING_RO)
+	assertEqual(t, atomicTypeKey(ta5.AtomicType), atomicTypeKey(CELL_ATOMIC_INNER_MAPPING_RO))
 	assertEqual(t, ta5, typeAtom5)
 
-	ta6, ok := atomTable[&listAtomicMappingRo]
+	ta6, ok := atomTable[atomicTypeKey(&listAtomicMappingRo)]
 	assertTrue(t, ok, "listAtomicMappingRo should be in atomTable")
-	assertEqual(t, ta6.AtomicType, &listAtomicMappingRo)
+	assertEqual(t, atomicTypeKey(ta6.AtomicType), atomicTypeKey(&listAtomicMappingRo))
 	assertEqual(t, ta6, typeAtom6)
 
-	ta7, ok := atomTable[&cellAtomicInnerRo]
+	ta7, ok := atomTable[atomicTypeKey(&cellAtomicInnerRo)]
 	assertTrue(t, ok, "cellAtomicInnerRo should be in atomTable")
-	assertEqual(t, ta7.AtomicType, &cellAtomicInnerRo)
+	assertEqual(t, atomicTypeKey(ta7.AtomicType), atomicTypeKey(&cellAtomicInnerRo))
 	assertEqual(t, ta7, typeAtom7)
 
-	ta8, ok := atomTable[&cellAtomicUndef]
+	ta8, ok := atomTable[atomicTypeKey(&cellAtomicUndef)]
 	assertTrue(t, ok, "cellAtomicUndef should be in atomTable")
-	assertEqual(t, ta8.AtomicType, &cellAtomicUndef)
+	assertEqual(t, atomicTypeKey(ta8.AtomicType), atomicTypeKey(&cellAtomicUndef))
 	assertEqual(t, ta8, typeAtom8)
 
-	ta9, ok := atomTable[&listAtomicTwoElement]
+	ta9, ok := atomTable[atomicTypeKey(&listAtomicTwoElement)]
 	assertTrue(t, ok, "listAtomicTwoElement should be in atomTable")
-	assertEqual(t, ta9.AtomicType, &listAtomicTwoElement)
+	assertEqual(t, atomicTypeKey(ta9.AtomicType), atomicTypeKey(&listAtomicTwoElement))
 	assertEqual(t, ta9, typeAtom9)
 }
 
@@ -135,9 +141,7 @@ func TestTypeAtomIndices(t *testing.T) {
 		t.Fatal("expected *envImpl")
 	}
 
-	envImpl.atomTableMutex.Lock()
-	atomTable := envImpl.atomTable
-	envImpl.atomTableMutex.Unlock()
+	atomTable := atomTableSnapshot(envImpl)
 
 	indices := make(map[int]bool)
 	for _, typeAtom := range atomTable {
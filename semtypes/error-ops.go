@@ -17,7 +17,7 @@
 package semtypes
 
 type ErrorOps struct {
-	CommonOps
+	CommonOpsBase
 }
 
 var _ BasicTypeOps = &ErrorOps{}
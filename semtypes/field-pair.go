@@ -89,26 +89,28 @@ func (i *mappingPairIterator) next() FieldPair {
 
 func (i *mappingPairIterator) internalNext() *FieldPair {
 	var p *FieldPair
+	// Indices are copied so that the pair does not alias the iterator's cursors.
+	i1, i2 := i.i1, i.i2
 	if i.i1 >= i.len1 {
 		if i.i2 >= i.len2 {
 			return nil
 		}
-		p = common.ToPointer(CreateFieldPair(i.curName2(), i.rest1, i.curType2(), nil, &i.i2))
+		p = common.ToPointer(CreateFieldPair(i.curName2(), i.rest1, i.curType2(), nil, &i2))
 		i.i2++
 	} else if i.i2 >= i.len2 {
-		p = common.ToPointer(CreateFieldPair(i.curName1(), i.curType1(), i.rest2, &i.i1, nil))
+		p = common.ToPointer(CreateFieldPair(i.curName1(), i.curType1(), i.rest2, &i1, nil))
 		i.i1++
 	} else {
 		name1 := i.curName1()
 		name2 := i.curName2()
 		if codePointCompare(name1, name2) {
-			p = common.ToPointer(CreateFieldPair(name1, i.curType1(), i.rest2, &i.i1, nil))
+			p = common.ToPointer(CreateFieldPair(name1, i.curType1(), i.rest2, &i1, nil))
 			i.i1++
 		} else if codePointCompare(name2, name1) {
-			p = common.ToPointer(CreateFieldPair(name2, i.rest1, i.curType2(), nil, &i.i2))
+			p = common.ToPointer(CreateFieldPair(name2, i.rest1, i.curType2(), nil, &i2))
 			i.i2++
 		} else {
-			p = common.ToPointer(CreateFieldPair(name1, i.curType1(), i.curType2(), &i.i1, &i.i2))
+			p = common.ToPointer(CreateFieldPair(name1, i.curType1(), i.curType2(), &i1, &i2))
 			i.i1++
 			i.i2++
 		}
@@ -157,12 +159,15 @@ func (i *mappingPairIterator) toIterator() iter.Seq[FieldPair] {
 
 func NewFieldPairs(m1 MappingAtomicType, m2 MappingAtomicType) iter.Seq[FieldPair] {
 	i := &mappingPairIterator{
-		names1: m1.Names,
-		names2: m2.Names,
-		types1: m1.Types,
-		types2: m2.Types,
-		len1:   len(m1.Names),
-		len2:   len(m2.Names),
+		names1:          m1.Names,
+		names2:          m2.Names,
+		types1:          m1.Types,
+		types2:          m2.Types,
+		len1:            len(m1.Names),
+		len2:            len(m2.Names),
+		rest1:           m1.Rest,
+		rest2:           m2.Rest,
+		shouldCalculate: true,
 	}
 	return i.toIterator()
 }
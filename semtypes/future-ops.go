@@ -17,7 +17,7 @@
 package semtypes
 
 type FutureOps struct {
-	CommonOps
+	CommonOpsBase
 }
 
 var _ BasicTypeOps = &FutureOps{}
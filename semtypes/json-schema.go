@@ -0,0 +1,480 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semtypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+)
+
+// JSONSchemaDraft is the $schema of the schemas returned by ToJSONSchema.
+const JSONSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonTypeCodes are the basic types that have JSON values, in the order they are written in a schema.
+var jsonTypeCodes = []BasicTypeCode{BT_NIL, BT_BOOLEAN, BT_INT, BT_FLOAT, BT_DECIMAL, BT_STRING, BT_LIST, BT_MAPPING}
+
+// ToJSONSchema returns a JSON Schema (draft 2020-12) of the JSON values of t, as a JSON object that can be passed to
+// json.Marshal. A type that has all the JSON values, such as json or anydata, has the schema {}, so the rest type of an
+// open record doesn't restrict the additional properties. Float and decimal both become number. Recursive list and
+// mapping types are written as definitions in $defs. The schema has an example of each basic type of t, found by
+// Witness.
+//
+// It is an error for t to have values of a basic type that has no JSON values, such as functions and objects, or a
+// float singleton of NaN or an infinity.
+func ToJSONSchema(cx Context, t SemType) (map[string]any, error) {
+	g := &jsonSchemaGenerator{
+		cx:       cx,
+		json:     CreateJson(cx),
+		defs:     make(map[string]any),
+		defNames: make(map[string]string),
+		visiting: make(map[string]bool),
+	}
+	schema, err := g.schema(t)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]any{"$schema": JSONSchemaDraft}
+	for keyword, value := range schema {
+		result[keyword] = value
+	}
+	if len(g.defs) > 0 {
+		result["$defs"] = g.defs
+	}
+	if examples := g.examples(t); len(examples) > 0 {
+		result["examples"] = examples
+	}
+	return result, nil
+}
+
+type jsonSchemaGenerator struct {
+	cx   Context
+	json SemType
+	// defs has the schemas of the recursive list and mapping atoms, by the name they are referred to with
+	defs map[string]any
+	// defNames has the names in defs of the atoms that turned out to be recursive, by the key of the atom
+	defNames map[string]string
+	// visiting has the keys of the atoms being written
+	visiting map[string]bool
+}
+
+func (g *jsonSchemaGenerator) schema(t SemType) (map[string]any, error) {
+	if IsSubtype(g.cx, g.json, t) {
+		return map[string]any{}, nil
+	}
+	bits := widenToBasicTypes(t).bitset & VT_MASK
+	for code := BT_NIL.Code; code < VT_COUNT; code++ {
+		if bits&(1<<code) != 0 && !slices.Contains(jsonTypeCodes, BasicTypeCodeFrom(code)) {
+			basicType := BasicType(BasicTypeCodeFrom(code))
+			component := Intersect(t, &basicType)
+			if !IsSubtypeSimple(t, basicType) {
+				return nil, fmt.Errorf("cannot convert %s to JSON Schema: its component %s is not JSON",
+					ToTypeString(g.cx, t), ToTypeString(g.cx, component))
+			}
+			name, _, _ := strings.Cut(basicTypeNames[code], "<")
+			return nil, fmt.Errorf("cannot convert %s to JSON Schema: %s values are not JSON", ToTypeString(g.cx, t),
+				name)
+		}
+	}
+
+	var types []string
+	var values []any
+	var alternatives []map[string]any
+	for _, code := range jsonTypeCodes[1:] {
+		if bits&(1<<code.Code) == 0 {
+			continue
+		}
+		data := subtypeData(t, code)
+		if isAllSubtype(data) {
+			if name := jsonTypeName(code); !slices.Contains(types, name) {
+				types = append(types, name)
+			}
+			continue
+		}
+		var err error
+		switch code {
+		case BT_BOOLEAN:
+			values = append(values, BooleanSubtypeSingleValue(data).Get())
+		case BT_INT:
+			alternatives, values = intSchemas(data.(IntSubtype), alternatives, values)
+		case BT_FLOAT:
+			alternatives, values, err = floatSchemas(data.(FloatSubtype), alternatives, values)
+		case BT_DECIMAL:
+			alternatives, values = decimalSchemas(data.(DecimalSubtype), alternatives, values)
+		case BT_STRING:
+			alternatives, values = stringSchemas(data.(StringSubtype), alternatives, values)
+		case BT_LIST:
+			alternatives, err = g.bddSchemas(BT_LIST, data.(Bdd), alternatives, func(atom Atom) (map[string]any, error) {
+				return g.listAtomSchema(g.cx.listAtomType(atom))
+			})
+		case BT_MAPPING:
+			alternatives, err = g.bddSchemas(BT_MAPPING, data.(Bdd), alternatives, func(atom Atom) (map[string]any,
+				error) {
+				return g.mappingAtomSchema(g.cx.mappingAtomType(atom))
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Merge the basic types into a single type keyword and the singletons into a single enum, which has nil if there
+	// are other singletons
+	if bits&NIL.bitset != 0 {
+		if len(values) > 0 {
+			values = append(values, nil)
+		} else {
+			types = append([]string{"null"}, types...)
+		}
+	}
+	switch len(types) {
+	case 0:
+	case 1:
+		alternatives = append([]map[string]any{{"type": types[0]}}, alternatives...)
+	default:
+		alternatives = append([]map[string]any{{"type": types}}, alternatives...)
+	}
+	switch len(values) {
+	case 0:
+	case 1:
+		alternatives = append(alternatives, map[string]any{"const": values[0]})
+	default:
+		alternatives = append(alternatives, map[string]any{"enum": values})
+	}
+	switch len(alternatives) {
+	case 0:
+		return map[string]any{"not": map[string]any{}}, nil
+	case 1:
+		return alternatives[0], nil
+	default:
+		return map[string]any{"anyOf": alternatives}, nil
+	}
+}
+
+func jsonTypeName(code BasicTypeCode) string {
+	switch code {
+	case BT_NIL:
+		return "null"
+	case BT_BOOLEAN:
+		return "boolean"
+	case BT_INT:
+		return "integer"
+	case BT_FLOAT, BT_DECIMAL:
+		return "number"
+	case BT_STRING:
+		return "string"
+	case BT_LIST:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+func intSchemas(data IntSubtype, alternatives []map[string]any, values []any) ([]map[string]any, []any) {
+	for _, r := range data.Ranges {
+		if r.Min == r.Max {
+			values = append(values, r.Min)
+			continue
+		}
+		schema := map[string]any{"type": "integer"}
+		if r.Min != math.MinInt64 {
+			schema["minimum"] = r.Min
+		}
+		if r.Max != math.MaxInt64 {
+			schema["maximum"] = r.Max
+		}
+		alternatives = append(alternatives, schema)
+	}
+	return alternatives, values
+}
+
+func floatSchemas(data FloatSubtype, alternatives []map[string]any, values []any) ([]map[string]any, []any, error) {
+	var floats []any
+	for _, value := range data.values {
+		if math.IsNaN(value.value) || math.IsInf(value.value, 0) {
+			if data.allowed {
+				return nil, nil, fmt.Errorf("cannot convert %s to JSON Schema: it is not a JSON number",
+					floatLiteral(value.value))
+			}
+			// The values that are not JSON numbers needn't be excluded
+			continue
+		}
+		floats = append(floats, value.value)
+	}
+	if data.allowed {
+		return alternatives, append(values, floats...), nil
+	}
+	if len(floats) == 0 {
+		return append(alternatives, map[string]any{"type": "number"}), values, nil
+	}
+	return append(alternatives, map[string]any{"type": "number", "not": map[string]any{"enum": floats}}), values, nil
+}
+
+func decimalSchemas(data DecimalSubtype, alternatives []map[string]any, values []any) ([]map[string]any, []any) {
+	decimals := make([]any, len(data.values))
+	for i, value := range data.values {
+		decimals[i] = json.Number(decimalLiteral(value.value))
+	}
+	if data.allowed {
+		return alternatives, append(values, decimals...)
+	}
+	return append(alternatives, map[string]any{"type": "number", "not": map[string]any{"enum": decimals}}), values
+}
+
+// stringSchemas returns the schemas of the strings of a single character and of the other strings.
+func stringSchemas(data StringSubtype, alternatives []map[string]any, values []any) ([]map[string]any, []any) {
+	chars := data.charData
+	nonChars := data.nonCharData
+	if chars.Allowed() {
+		values = appendStringValues(values, chars.Values())
+	} else {
+		char := map[string]any{"type": "string", "minLength": 1, "maxLength": 1}
+		if excluded := appendStringValues(nil, chars.Values()); len(excluded) > 0 {
+			char["not"] = map[string]any{"enum": excluded}
+		}
+		alternatives = append(alternatives, char)
+	}
+	if nonChars.Allowed() {
+		values = appendStringValues(values, nonChars.Values())
+	} else {
+		var not any = map[string]any{"minLength": 1, "maxLength": 1}
+		if excluded := appendStringValues(nil, nonChars.Values()); len(excluded) > 0 {
+			not = map[string]any{"anyOf": []any{not, map[string]any{"enum": excluded}}}
+		}
+		alternatives = append(alternatives, map[string]any{"type": "string", "not": not})
+	}
+	return alternatives, values
+}
+
+func appendStringValues(values []any, strings []EnumerableType[string]) []any {
+	for _, value := range strings {
+		values = append(values, value.Value())
+	}
+	return values
+}
+
+// bddSchemas appends a schema of each path of bdd, which is the intersection of its positive atoms less the union of
+// its negative atoms. The atom that makes a list or mapping readonly is left out, since it doesn't restrict the shape
+// of a value.
+func (g *jsonSchemaGenerator) bddSchemas(code BasicTypeCode, bdd Bdd, alternatives []map[string]any,
+	atomSchema func(atom Atom) (map[string]any, error)) ([]map[string]any, error) {
+	var paths []BddPath
+	BddPaths(bdd, &paths, BddPathFrom())
+	for _, path := range paths {
+		var conjuncts []any
+		for _, atom := range path.pos {
+			if isReadonlyAtom(atom) {
+				continue
+			}
+			schema, err := g.atomSchema(code, atom, atomSchema)
+			if err != nil {
+				return nil, err
+			}
+			conjuncts = append(conjuncts, schema)
+		}
+		for _, atom := range path.neg {
+			if isReadonlyAtom(atom) {
+				continue
+			}
+			schema, err := g.atomSchema(code, atom, atomSchema)
+			if err != nil {
+				return nil, err
+			}
+			conjuncts = append(conjuncts, map[string]any{"not": schema})
+		}
+		switch len(conjuncts) {
+		case 0:
+			alternatives = append(alternatives, map[string]any{"type": jsonTypeName(code)})
+		case 1:
+			if schema, ok := conjuncts[0].(map[string]any); ok && schema["not"] == nil {
+				alternatives = append(alternatives, schema)
+				continue
+			}
+			fallthrough
+		default:
+			alternatives = append(alternatives, map[string]any{"type": jsonTypeName(code), "allOf": conjuncts})
+		}
+	}
+	return alternatives, nil
+}
+
+// atomSchema returns the schema of atom written with atomSchema, or a reference to its definition in $defs if atom
+// is recursive.
+func (g *jsonSchemaGenerator) atomSchema(code BasicTypeCode, atom Atom,
+	atomSchema func(atom Atom) (map[string]any, error)) (map[string]any, error) {
+	key := fmt.Sprintf("%d:%T:%d", code.Code, atom, atom.Index())
+	if g.visiting[key] {
+		if _, ok := g.defNames[key]; !ok {
+			g.defNames[key] = fmt.Sprintf("%s%d", jsonTypeName(code), len(g.defNames)+1)
+		}
+	}
+	if name, ok := g.defNames[key]; ok {
+		return map[string]any{"$ref": "#/$defs/" + name}, nil
+	}
+	g.visiting[key] = true
+	schema, err := atomSchema(atom)
+	delete(g.visiting, key)
+	if err != nil {
+		return nil, err
+	}
+	if name, ok := g.defNames[key]; ok {
+		g.defs[name] = schema
+		return map[string]any{"$ref": "#/$defs/" + name}, nil
+	}
+	return schema, nil
+}
+
+func (g *jsonSchemaGenerator) listAtomSchema(atomicType *ListAtomicType) (map[string]any, error) {
+	members := atomicType.Members
+	schema := map[string]any{"type": "array"}
+	if members.FixedLength > 0 {
+		schema["minItems"] = members.FixedLength
+	}
+	rest := CellInnerVal(atomicType.Rest)
+	if IsNever(rest) {
+		schema["maxItems"] = members.FixedLength
+	}
+	if members.FixedLength == 0 || len(members.Initial) == 1 && IsNever(rest) {
+		// An array, whose members are all of the same type
+		itemType := rest
+		if members.FixedLength > 0 {
+			itemType = CellInnerVal(members.Initial[0])
+		}
+		if !IsNever(itemType) {
+			items, err := g.schema(itemType)
+			if err != nil {
+				return nil, err
+			}
+			schema["items"] = items
+		}
+		return schema, nil
+	}
+	prefixItems := make([]any, members.FixedLength)
+	for i := range prefixItems {
+		member, err := g.schema(listMemberInnerVal(members, i))
+		if err != nil {
+			return nil, err
+		}
+		prefixItems[i] = member
+	}
+	schema["prefixItems"] = prefixItems
+	if !IsNever(rest) {
+		items, err := g.schema(rest)
+		if err != nil {
+			return nil, err
+		}
+		schema["items"] = items
+	}
+	return schema, nil
+}
+
+func (g *jsonSchemaGenerator) mappingAtomSchema(atomicType *MappingAtomicType) (map[string]any, error) {
+	schema := map[string]any{"type": "object"}
+	properties := make(map[string]any, len(atomicType.Names))
+	var required []string
+	for i, name := range atomicType.Names {
+		fieldType := cellAtomicType(atomicType.Types[i]).Ty
+		property, err := g.schema(Diff(fieldType, &UNDEF))
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", name, err)
+		}
+		properties[name] = property
+		if IsNever(Intersect(fieldType, &UNDEF)) {
+			required = append(required, name)
+		}
+	}
+	if len(properties) > 0 {
+		schema["properties"] = properties
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	rest := Diff(CellInnerVal(atomicType.Rest), &UNDEF)
+	switch {
+	case IsNever(rest):
+		schema["additionalProperties"] = false
+	case IsSubtype(g.cx, g.json, rest):
+		// An open record, whose additional properties may be any JSON value
+	default:
+		additional, err := g.schema(rest)
+		if err != nil {
+			return nil, err
+		}
+		schema["additionalProperties"] = additional
+	}
+	return schema, nil
+}
+
+// examples returns a JSON value of each basic type of t, as far as Witness can find one. Values that are written the
+// same, such as the int, float and decimal zeros, are given once.
+func (g *jsonSchemaGenerator) examples(t SemType) []any {
+	var examples []any
+	seen := make(map[string]bool)
+	for _, code := range jsonTypeCodes {
+		basicType := BasicType(code)
+		witness, ok := Witness(g.cx, Intersect(Intersect(t, g.json), &basicType))
+		if !ok {
+			continue
+		}
+		example, ok := jsonValue(witness)
+		if !ok {
+			continue
+		}
+		if text, err := json.Marshal(example); err == nil && !seen[string(text)] {
+			seen[string(text)] = true
+			examples = append(examples, example)
+		}
+	}
+	return examples
+}
+
+// jsonValue returns value as a value that json.Marshal writes as JSON, or false if it has no JSON representation.
+func jsonValue(value Value) (any, bool) {
+	switch v := value.Value.(type) {
+	case nil, bool, int64, string:
+		return v, true
+	case float64:
+		return v, !math.IsNaN(v) && !math.IsInf(v, 0)
+	case big.Rat:
+		return json.Number(decimalLiteral(v)), true
+	case ListShape:
+		members := make([]any, len(v.Members))
+		for i, member := range v.Members {
+			m, ok := jsonValue(member)
+			if !ok {
+				return nil, false
+			}
+			members[i] = m
+		}
+		return members, true
+	case MappingShape:
+		fields := make(map[string]any, len(v.Names))
+		for i, name := range v.Names {
+			field, ok := jsonValue(v.Values[i])
+			if !ok {
+				return nil, false
+			}
+			fields[name] = field
+		}
+		return fields, true
+	default:
+		return nil, false
+	}
+}
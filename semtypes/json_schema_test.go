@@ -0,0 +1,212 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semtypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// compileJSONSchema marshals schema and compiles it, so that the schema is checked against the draft 2020-12
+// metaschema.
+func compileJSONSchema(t *testing.T, schema map[string]any) (*jsonschema.Schema, string) {
+	t.Helper()
+	data, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatalf("failed to marshal schema: %v", err)
+	}
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to read schema: %v", err)
+	}
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource("schema.json", doc); err != nil {
+		t.Fatalf("failed to add schema: %v", err)
+	}
+	compiled, err := compiler.Compile("schema.json")
+	if err != nil {
+		t.Fatalf("failed to compile schema %s: %v", data, err)
+	}
+	return compiled, string(data)
+}
+
+func validateJSON(schema *jsonschema.Schema, instance string) error {
+	value, err := jsonschema.UnmarshalJSON(strings.NewReader(instance))
+	if err != nil {
+		return err
+	}
+	return schema.Validate(value)
+}
+
+func TestToJSONSchema(t *testing.T) {
+	p := NewTypeParser(NewEnv())
+	if err := p.DefineTypes(`
+type IntList ()|[int, IntList];
+type Person record {| string name; int:Unsigned8 age?; Person[] children; |};
+type Named record { string name; };
+`); err != nil {
+		t.Fatalf("failed to define types: %v", err)
+	}
+	tests := []struct {
+		source   string
+		valid    []string
+		invalid  []string
+		expected string
+	}{
+		{"json", []string{`null`, `{"a": [1, "b"]}`}, nil, `{}`},
+		{"anydata", []string{`[true]`}, nil, `{}`},
+		{"()", []string{`null`}, []string{`0`}, `{"type":"null"}`},
+		{"string?", []string{`null`, `"a"`}, []string{`1`}, `{"type":["null","string"]}`},
+		{`"a"|"b"|()`, []string{`"a"`, `null`}, []string{`"c"`}, `{"enum":["a","b",null]}`},
+		{"true|1|2.5|3.5d", []string{`true`, `1`, `2.5`, `3.5`}, []string{`false`, `2`}, ``},
+		{"int:Unsigned8", []string{`0`, `255`}, []string{`256`, `-1`, `1.5`}, `{"maximum":255,"minimum":0,"type":"integer"}`},
+		{"float|decimal", []string{`1.5`}, []string{`"1.5"`}, `{"type":"number"}`},
+		{"string:Char", []string{`"a"`}, []string{`"ab"`, `""`}, ``},
+		{"int[]", []string{`[]`, `[1, 2]`}, []string{`[1.5]`, `{}`}, `{"items":{"type":"integer"},"type":"array"}`},
+		{"byte[3]", []string{`[1, 2, 3]`}, []string{`[1, 2]`, `[1, 2, 3, 4]`, `[1, 2, 256]`}, ``},
+		{"[string, int...]", []string{`["a"]`, `["a", 1, 2]`}, []string{`[]`, `["a", "b"]`}, ``},
+		{"[string, boolean]", []string{`["a", true]`}, []string{`["a", true, 1]`}, ``},
+		{"map<int>", []string{`{"a": 1}`}, []string{`{"a": "b"}`}, ``},
+		{"Named", []string{`{"name": "a", "other": [1]}`}, []string{`{}`, `{"name": 1}`}, ``},
+		{"Person", []string{`{"name": "a", "children": [{"name": "b", "children": [], "age": 1}]}`},
+			[]string{`{"name": "a", "children": [{"name": "b"}]}`, `{"name": "a", "children": [], "x": 1}`}, ``},
+		{"IntList", []string{`null`, `[1, null]`, `[1, [2, null]]`}, []string{`[1]`, `[1, [2]]`}, ``},
+	}
+	for _, test := range tests {
+		ty, err := p.Parse(test.source)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", test.source, err)
+		}
+		schema, err := ToJSONSchema(p.Context(), ty)
+		if err != nil {
+			t.Errorf("failed to convert %s: %v", test.source, err)
+			continue
+		}
+		assertEqual(t, schema["$schema"], JSONSchemaDraft)
+		compiled, data := compileJSONSchema(t, schema)
+		examples, _ := schema["examples"].([]any)
+		if test.expected != "" {
+			delete(schema, "$schema")
+			delete(schema, "examples")
+			actual, _ := json.Marshal(schema)
+			assertEqual(t, string(actual), test.expected)
+		}
+		for _, instance := range test.valid {
+			if err := validateJSON(compiled, instance); err != nil {
+				t.Errorf("expected %s to be valid against schema of %s %s: %v", instance, test.source, data, err)
+			}
+		}
+		for _, instance := range test.invalid {
+			if err := validateJSON(compiled, instance); err == nil {
+				t.Errorf("expected %s to be invalid against schema of %s %s", instance, test.source, data)
+			}
+		}
+		// The examples must be values of the schema
+		if len(examples) == 0 && !IsEmpty(p.Context(), ty) {
+			t.Errorf("expected examples in schema of %s", test.source)
+		}
+		for _, example := range examples {
+			instance, _ := json.Marshal(example)
+			if err := validateJSON(compiled, string(instance)); err != nil {
+				t.Errorf("expected example %s to be valid against schema of %s %s: %v", instance, test.source, data, err)
+			}
+		}
+	}
+}
+
+func TestToJSONSchemaDiff(t *testing.T) {
+	p := NewTypeParser(NewEnv())
+	cx := p.Context()
+	for _, test := range []struct {
+		t1, t2  string
+		valid   []string
+		invalid []string
+	}{
+		{"string", `"a"`, []string{`"b"`, `"ab"`, `""`}, []string{`"a"`}},
+		{"string", "string:Char", []string{`""`, `"ab"`}, []string{`"a"`}},
+		{"float", "1.5", []string{`1`, `2.5`}, []string{`1.5`}},
+		{"int[]", "never[]", []string{`[1]`}, []string{`[]`}},
+	} {
+		t1, err := p.Parse(test.t1)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", test.t1, err)
+		}
+		t2, err := p.Parse(test.t2)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", test.t2, err)
+		}
+		schema, err := ToJSONSchema(cx, Diff(t1, t2))
+		if err != nil {
+			t.Fatalf("failed to convert %s minus %s: %v", test.t1, test.t2, err)
+		}
+		compiled, data := compileJSONSchema(t, schema)
+		for _, instance := range test.valid {
+			if err := validateJSON(compiled, instance); err != nil {
+				t.Errorf("expected %s to be valid against %s: %v", instance, data, err)
+			}
+		}
+		for _, instance := range test.invalid {
+			if err := validateJSON(compiled, instance); err == nil {
+				t.Errorf("expected %s to be invalid against %s", instance, data)
+			}
+		}
+	}
+}
+
+func TestToJSONSchemaErrors(t *testing.T) {
+	env := NewEnv()
+	cx := ContextFrom(env)
+	fd := NewFunctionDefinition()
+	function := fd.Define(env, testTuple(env, &INT), &BOOLEAN, FunctionQualifiersFrom(env, false, false))
+	md := NewMappingDefinition()
+	record := md.DefineMappingTypeWrapped(env, []Field{FieldFrom("f", function, false, false)}, &NEVER)
+	tests := []struct {
+		t   SemType
+		err string
+	}{
+		{function, "cannot convert function (int) returns boolean to JSON Schema: function values are not JSON"},
+		{Union(&INT, &OBJECT), "cannot convert int|object to JSON Schema: its component object is not JSON"},
+		{record, "field f: cannot convert function (int) returns boolean to JSON Schema: function values are not JSON"},
+		{&XML, "cannot convert xml to JSON Schema: xml values are not JSON"},
+		{VAL_READONLY, "cannot convert readonly to JSON Schema: its component error is not JSON"},
+		{FloatConst(math.Inf(1)), "cannot convert float:Infinity to JSON Schema: it is not a JSON number"},
+	}
+	for _, test := range tests {
+		_, err := ToJSONSchema(cx, test.t)
+		if err == nil {
+			t.Errorf("expected error %q", test.err)
+			continue
+		}
+		assertEqual(t, err.Error(), test.err)
+	}
+}
+
+func TestToJSONSchemaExamples(t *testing.T) {
+	cx := ContextFrom(NewEnv())
+	schema, err := ToJSONSchema(cx, CreateJson(cx))
+	if err != nil {
+		t.Fatalf("failed to convert json: %v", err)
+	}
+	// The int, float and decimal zeros are the same JSON value, so they are given once
+	examples, _ := json.Marshal(schema["examples"])
+	assertEqual(t, string(examples), `[null,true,0,"",[],{}]`)
+}
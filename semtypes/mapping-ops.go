@@ -16,7 +16,11 @@
 
 package semtypes
 
-import "ballerina-lang-go/common"
+import (
+	"slices"
+
+	"ballerina-lang-go/common"
+)
 
 type MappingOps struct {
 }
@@ -102,14 +106,14 @@ func mappingInhabited(cx Context, pos MappingAtomicType, negList *Conjunction) b
 			if IsEmpty(cx, intersect) {
 				return mappingInhabited(cx, pos, negList.Next)
 			}
-			d := Diff(fieldPair.Type1, fieldPair.Type2).(*CellSemType)
+			d := Diff(fieldPair.Type1, fieldPair.Type2).(CellSemType)
 			if !IsEmpty(cx, d) {
 				var mt MappingAtomicType
 				if fieldPair.Index1 == nil {
-					mt = insertField(pos, fieldPair.Name, *d)
+					mt = insertField(pos, fieldPair.Name, d)
 				} else {
-					posTypes := pos.Types
-					posTypes[*fieldPair.Index1] = *d
+					posTypes := slices.Clone(pos.Types)
+					posTypes[*fieldPair.Index1] = d
 					mt = MappingAtomicTypeFrom(pos.Names, posTypes, pos.Rest)
 				}
 				if mappingInhabited(cx, mt, negList.Next) {
@@ -17,7 +17,7 @@
 package semtypes
 
 type StreamOps struct {
-	CommonOps
+	CommonOpsBase
 }
 
 var _ BasicTypeOps = &StreamOps{}
@@ -16,7 +16,11 @@
 
 package semtypes
 
-import "ballerina-lang-go/common"
+import (
+	"unicode/utf8"
+
+	"ballerina-lang-go/common"
+)
 
 type StringSubtypeListCoverage struct {
 	IsSubtype bool
@@ -64,22 +68,19 @@ func StringSubtypeContains(d SubtypeData, s string) bool {
 	st := d.(StringSubtype)
 	chara := st.charData
 	nonChar := st.nonCharData
-	if len(s) == 1 {
-		charString := EnumerableCharStringFrom(s)
-		for _, value := range chara.Values() {
-			if value == charString {
-				return chara.Allowed()
-			}
-		}
-		return !nonChar.Allowed()
+	if utf8.RuneCountInString(s) == 1 {
+		return containsStringValue(chara.Values(), s) == chara.Allowed()
 	}
-	stringString := EnumerableStringFrom(s)
-	for _, value := range nonChar.Values() {
-		if value == stringString {
-			return nonChar.Allowed()
+	return containsStringValue(nonChar.Values(), s) == nonChar.Allowed()
+}
+
+func containsStringValue(values []EnumerableType[string], s string) bool {
+	for _, value := range values {
+		if value.Value() == s {
+			return true
 		}
 	}
-	return !nonChar.Allowed()
+	return false
 }
 
 func CreateStringSubtype(chara CharStringSubtype, nonChar NonCharStringSubtype) SubtypeData {
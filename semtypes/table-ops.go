@@ -17,7 +17,7 @@
 package semtypes
 
 type TableOps struct {
-	CommonOps
+	CommonOpsBase
 }
 
 var _ BasicTypeOps = &TableOps{}
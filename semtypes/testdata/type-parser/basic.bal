@@ -0,0 +1,27 @@
+// @type Byte < Int
+// @type Byte = ByteRange
+// @type Signed8 <> Byte
+// @type IntOrNil = OptInt
+// @type Int < IntOrString
+// @type Char < String
+// @type Str1 < Char
+// @type Str2 <> Char
+// @type Never < Int
+// @type Any < AnyOrError
+// @type Error <> Any
+
+type Byte byte;
+type ByteRange int:Unsigned8;
+type Signed8 int:Signed8;
+type Int int;
+type IntOrNil int|();
+type OptInt int?;
+type IntOrString int|string;
+type Char string:Char;
+type String string;
+type Str1 "a";
+type Str2 "ab";
+type Never never;
+type Any any;
+type AnyOrError any|error;
+type Error error;
@@ -0,0 +1,22 @@
+// @type IntToString < IntToAny
+// @type AnyToString < IntToString
+// @type IntToString <> StringToString
+// @type IsolatedIntToString < IntToString
+// @type IntToString < AnyFunction
+// @type NoReturn = ReturnsNil
+// @type IntsToInt < IntToInt
+// @type IntToInt <> IntsToInt2
+// @type Callback < AnyFunction
+
+type IntToString function (int) returns string;
+type IntToAny function (int) returns any;
+type AnyToString function (any) returns string;
+type StringToString function (string) returns string;
+type IsolatedIntToString isolated function (int) returns string;
+type AnyFunction function;
+type NoReturn function ();
+type ReturnsNil function () returns ();
+type IntsToInt function (int...) returns int;
+type IntToInt function (int x) returns int;
+type IntsToInt2 function (int, int) returns int;
+type Callback function (Callback?) returns int;
@@ -0,0 +1,23 @@
+// @type IntPair < IntArray
+// @type IntPair < Ints
+// @type Ints < IntArray
+// @type IntArray = Ints2
+// @type IntPair <> StringPair
+// @type EmptyTuple < IntArray
+// @type EmptyTuple = EmptyTuple2
+// @type IntPair = FixedIntPair
+// @type NestedArray < AnyArray
+// @type IntArray < AnyArray
+// @type RoIntArray < IntArray
+
+type IntPair [int, int];
+type FixedIntPair int[2];
+type StringPair [string, string];
+type Ints [int, int...];
+type Ints2 [int...];
+type IntArray int[];
+type EmptyTuple int[0];
+type EmptyTuple2 [];
+type NestedArray int[2][];
+type AnyArray any[];
+type RoIntArray int[] & readonly;
@@ -0,0 +1,34 @@
+// @type Person < Named
+// @type ClosedPerson < Person
+// @type ClosedPerson < IntOrStringMap
+// @type Person < OptionalAge
+// @type IntMap < IntOrStringMap
+// @type EmptyRecord < IntMap
+// @type QuotedName = Named
+
+type Named record {
+    string name;
+};
+
+type Person record {
+    string name;
+    int age;
+};
+
+type ClosedPerson record {|
+    string name;
+    int age;
+|};
+
+type OptionalAge record {
+    string name;
+    int age?;
+};
+
+type IntMap map<int>;
+type IntOrStringMap map<int|string>;
+type EmptyRecord record {||};
+
+type QuotedName record {
+    string 'name;
+};
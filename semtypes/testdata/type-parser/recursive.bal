@@ -0,0 +1,21 @@
+// @type IntList < List
+// @type IntList < Json
+// @type List <> Json
+// @type IntList <> StringList
+// @type Tree < Json
+// @type IntTree < Tree
+// @type Json = Json2
+
+type List ()|[any, List];
+type IntList ()|[int, IntList];
+type StringList ()|[string, StringList];
+type Json ()|boolean|int|float|decimal|string|Json[]|map<Json>;
+type Json2 json;
+type Tree record {|
+    Tree[] children;
+    Json...;
+|};
+type IntTree record {|
+    int value;
+    IntTree[] children;
+|};
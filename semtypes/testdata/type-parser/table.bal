@@ -0,0 +1,16 @@
+// @type KeyedPersonTable < PersonTable
+// @type KeyedPersonTable < IntKeyPersonTable
+// @type IntKeyPersonTable < PersonTable
+// @type PersonTable < MapTable
+// @type PersonTable <> IdTable
+
+type Person record {|
+    int id;
+    string name;
+|};
+
+type PersonTable table<Person>;
+type KeyedPersonTable table<Person> key(id);
+type IntKeyPersonTable table<Person> key<int>;
+type MapTable table<map<any>>;
+type IdTable table<record {| int id; |}>;
@@ -0,0 +1,49 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semtypes
+
+// TypeCheckStats are counters of the work done by the subtype checks of a Context, for finding out where the time of a
+// slow check goes. The counters only grow, so the work of a single check is the difference between the counters taken
+// before and after it.
+type TypeCheckStats struct {
+	// MemoHits is the number of emptiness checks of a list, mapping or function BDD answered by the memo of the Context
+	MemoHits int
+	// MemoMisses is the number of emptiness checks of a BDD that had to be computed
+	MemoMisses int
+	// BddNodes is the number of structurally distinct BDD nodes interned by the memos of the Context
+	BddNodes int
+	// Atoms is the number of atoms in the atom table of the Env
+	Atoms int
+}
+
+// StatsOf returns the counters of cx and its Env.
+func StatsOf(cx Context) TypeCheckStats {
+	stats := *cx.stats()
+	stats.BddNodes = cx.bddNodeCount()
+	stats.Atoms = cx.env().atomTableSize()
+	return stats
+}
+
+// Sub returns the counters of the work done since before was taken.
+func (s TypeCheckStats) Sub(before TypeCheckStats) TypeCheckStats {
+	return TypeCheckStats{
+		MemoHits:   s.MemoHits - before.MemoHits,
+		MemoMisses: s.MemoMisses - before.MemoMisses,
+		BddNodes:   s.BddNodes - before.BddNodes,
+		Atoms:      s.Atoms - before.Atoms,
+	}
+}
@@ -0,0 +1,791 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semtypes
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"ballerina-lang-go/common"
+)
+
+// The binary encoding of a set of types is
+//
+//	magic version atomCount atom* typeCount (name type)*
+//
+// Integers are written as varints and strings as their length followed by their bytes. Every atom reached from the
+// types is written once, as a tag followed by the length and the bytes of its body, and is referred to by its position
+// in the atom table. A rec atom may be referred to before its body is read, which is how recursive types are encoded.
+// Distinct atoms carry no id: each distinct atom of the table gets a fresh distinct id of the Env it is decoded into.
+//
+// A type is written as
+//
+//	typeBitSet all | typeComplex all some subtypeData*
+//
+// where there is one subtype data for each bit of some, in the order of the basic type codes.
+
+const (
+	typeCodecMagic   = "BSEM"
+	typeCodecVersion = 1
+)
+
+const (
+	typeBitSet byte = iota
+	typeComplex
+)
+
+const (
+	bddNothing byte = iota
+	bddAll
+	bddNode
+)
+
+type atomTag byte
+
+const (
+	atomTagCell atomTag = iota
+	atomTagList
+	atomTagMapping
+	atomTagFunction
+	atomTagRecList
+	atomTagRecMapping
+	atomTagRecFunction
+	atomTagPredefinedRec
+	atomTagDistinct
+	atomTagXml
+)
+
+// TypeDefinition is a named type of a set of types encoded together, such as the types exported by a module.
+type TypeDefinition struct {
+	Name string
+	Type SemType
+}
+
+// EncodeSemType encodes t, which must belong to env.
+func EncodeSemType(env Env, t SemType) ([]byte, error) {
+	return EncodeTypeDefinitions(env, []TypeDefinition{{Type: t}})
+}
+
+// DecodeSemType decodes a type encoded with EncodeSemType into env, which need not be the Env it was encoded from.
+func DecodeSemType(env Env, data []byte) (SemType, error) {
+	defns, err := DecodeTypeDefinitions(env, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(defns) != 1 {
+		return nil, fmt.Errorf("expected a single type, found %d", len(defns))
+	}
+	return defns[0].Type, nil
+}
+
+// EncodeTypeDefinitions encodes defns, whose types must belong to env. The atoms the types share, including the rec
+// atoms of recursive types, are encoded once, and the encoding of the same types is always the same.
+func EncodeTypeDefinitions(env Env, defns []TypeDefinition) ([]byte, error) {
+	e := &typeEncoder{env: env, atomIds: make(map[atomKey]int)}
+	var types []byte
+	types = binary.AppendUvarint(types, uint64(len(defns)))
+	for _, defn := range defns {
+		types = appendString(types, defn.Name)
+		types = e.appendSemType(types, defn.Type)
+	}
+	// Encoding the body of an atom may add further atoms to the table
+	for i := 0; i < len(e.atoms) && e.err == nil; i++ {
+		e.atoms[i].body = e.appendAtomBody(nil, e.atoms[i])
+	}
+	if e.err != nil {
+		return nil, e.err
+	}
+	out := []byte(typeCodecMagic)
+	out = binary.AppendUvarint(out, typeCodecVersion)
+	out = binary.AppendUvarint(out, uint64(len(e.atoms)))
+	for _, atom := range e.atoms {
+		out = append(out, byte(atom.tag))
+		out = appendBytes(out, atom.body)
+	}
+	return append(out, types...), nil
+}
+
+// DecodeTypeDefinitions decodes type definitions encoded with EncodeTypeDefinitions into env. Decoding the same data
+// twice gives types with different distinct atoms.
+func DecodeTypeDefinitions(env Env, data []byte) ([]TypeDefinition, error) {
+	d := &typeDecoder{env: env, data: data}
+	if len(data) < len(typeCodecMagic) || string(data[:len(typeCodecMagic)]) != typeCodecMagic {
+		return nil, errors.New("invalid type encoding: bad magic")
+	}
+	d.pos = len(typeCodecMagic)
+	if version := d.uvarint(); d.err == nil && version != typeCodecVersion {
+		return nil, fmt.Errorf("unsupported type encoding version %d", version)
+	}
+	d.readAtomTable()
+	for i := range d.atoms {
+		if d.err != nil {
+			break
+		}
+		d.defineRecAtom(&d.atoms[i])
+	}
+	count := d.count()
+	var defns []TypeDefinition
+	for i := 0; i < count && d.err == nil; i++ {
+		name := d.string()
+		defns = append(defns, TypeDefinition{Name: name, Type: d.semType()})
+	}
+	if d.err == nil && d.pos != len(d.data) {
+		d.fail("unexpected data after the types")
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return defns, nil
+}
+
+type atomKey struct {
+	tag   atomTag
+	index int
+}
+
+type encodedAtom struct {
+	tag  atomTag
+	atom Atom
+	body []byte
+}
+
+type typeEncoder struct {
+	env     Env
+	atomIds map[atomKey]int
+	atoms   []encodedAtom
+	err     error
+}
+
+func (e *typeEncoder) fail(format string, args ...any) {
+	if e.err == nil {
+		e.err = fmt.Errorf("cannot encode type: "+format, args...)
+	}
+}
+
+func (e *typeEncoder) appendSemType(out []byte, t SemType) []byte {
+	if b, ok := t.(*BasicTypeBitSet); ok {
+		out = append(out, typeBitSet)
+		return binary.AppendUvarint(out, uint64(b.bitset))
+	}
+	ct, ok := t.(ComplexSemType)
+	if !ok {
+		e.fail("unexpected semtype %T", t)
+		return out
+	}
+	out = append(out, typeComplex)
+	out = binary.AppendUvarint(out, uint64(ct.All()))
+	out = binary.AppendUvarint(out, uint64(ct.Some()))
+	for code := BT_NIL.Code; code <= BT_CELL.Code; code++ {
+		if ct.Some()&(1<<code) != 0 {
+			out = e.appendSubtypeData(out, BasicTypeCodeFrom(code), getComplexSubtypeData(ct, BasicTypeCodeFrom(code)))
+		}
+	}
+	return out
+}
+
+func (e *typeEncoder) appendSubtypeData(out []byte, code BasicTypeCode, data SubtypeData) []byte {
+	switch code {
+	case BT_BOOLEAN:
+		return appendBool(out, data.(BooleanSubtype).value)
+	case BT_INT:
+		ranges := data.(IntSubtype).Ranges
+		out = binary.AppendUvarint(out, uint64(len(ranges)))
+		for _, r := range ranges {
+			out = binary.AppendVarint(out, r.Min)
+			out = binary.AppendVarint(out, r.Max)
+		}
+		return out
+	case BT_FLOAT:
+		subtype := data.(FloatSubtype)
+		out = appendBool(out, subtype.allowed)
+		out = binary.AppendUvarint(out, uint64(len(subtype.values)))
+		for _, value := range subtype.values {
+			out = binary.LittleEndian.AppendUint64(out, math.Float64bits(value.value))
+		}
+		return out
+	case BT_DECIMAL:
+		subtype := data.(DecimalSubtype)
+		out = appendBool(out, subtype.allowed)
+		out = binary.AppendUvarint(out, uint64(len(subtype.values)))
+		for _, value := range subtype.values {
+			out = appendString(out, value.value.String())
+		}
+		return out
+	case BT_STRING:
+		subtype := data.(StringSubtype)
+		out = appendStrings(out, subtype.charData.allowed, subtype.charData.values)
+		return appendStrings(out, subtype.nonCharData.allowed, subtype.nonCharData.values)
+	case BT_XML:
+		subtype := data.(XmlSubtype)
+		out = binary.AppendUvarint(out, uint64(subtype.Primitives))
+		return e.appendBdd(out, Kind_XML_ATOM, subtype.Sequence)
+	case BT_LIST, BT_STREAM, BT_TABLE:
+		return e.appendBdd(out, Kind_LIST_ATOM, data.(Bdd))
+	case BT_MAPPING, BT_ERROR, BT_TYPEDESC, BT_FUTURE, BT_OBJECT:
+		return e.appendBdd(out, Kind_MAPPING_ATOM, data.(Bdd))
+	case BT_FUNCTION:
+		return e.appendBdd(out, Kind_FUNCTION_ATOM, data.(Bdd))
+	case BT_CELL:
+		return e.appendBdd(out, Kind_CELL_ATOM, data.(Bdd))
+	default:
+		e.fail("unexpected subtype data of %s", code)
+		return out
+	}
+}
+
+// appendBdd writes bdd, whose rec atoms are of kind unless they are distinct or xml atoms. The kind of a rec atom is
+// not set when it is created, so it is known only from the basic type of the bdd.
+func (e *typeEncoder) appendBdd(out []byte, kind Kind, bdd Bdd) []byte {
+	switch b := bdd.(type) {
+	case BddAllOrNothing:
+		return append(out, bddAllOrNothingTag(&b))
+	case *BddAllOrNothing:
+		return append(out, bddAllOrNothingTag(b))
+	case BddNode:
+		out = append(out, bddNode)
+		out = binary.AppendUvarint(out, uint64(e.atomId(kind, b.Atom())))
+		out = e.appendBdd(out, kind, b.Left())
+		out = e.appendBdd(out, kind, b.Middle())
+		return e.appendBdd(out, kind, b.Right())
+	default:
+		e.fail("unexpected bdd %T", bdd)
+		return out
+	}
+}
+
+func bddAllOrNothingTag(b *BddAllOrNothing) byte {
+	if b.IsAll() {
+		return bddAll
+	}
+	return bddNothing
+}
+
+// atomId returns the position of atom in the atom table, adding it if it is not there yet.
+func (e *typeEncoder) atomId(kind Kind, atom Atom) int {
+	var tag atomTag
+	switch atom := atom.(type) {
+	case *RecAtom:
+		switch {
+		case atom.Kind() == Kind_DISTINCT_ATOM:
+			tag = atomTagDistinct
+		case atom.Kind() == Kind_XML_ATOM:
+			tag = atomTagXml
+		case predefinedTypeEnv.IsPredefinedRecAtom(atom.Index()):
+			tag = atomTagPredefinedRec
+		default:
+			tag = recAtomTags[kind]
+		}
+	case *TypeAtom:
+		tag = typeAtomTags[atom.Kind()]
+	default:
+		e.fail("unexpected atom %T", atom)
+		return 0
+	}
+	key := atomKey{tag: tag, index: atom.Index()}
+	if id, ok := e.atomIds[key]; ok {
+		return id
+	}
+	id := len(e.atoms)
+	e.atomIds[key] = id
+	e.atoms = append(e.atoms, encodedAtom{tag: tag, atom: atom})
+	return id
+}
+
+var typeAtomTags = map[Kind]atomTag{
+	Kind_CELL_ATOM:     atomTagCell,
+	Kind_LIST_ATOM:     atomTagList,
+	Kind_MAPPING_ATOM:  atomTagMapping,
+	Kind_FUNCTION_ATOM: atomTagFunction,
+}
+
+var recAtomTags = map[Kind]atomTag{
+	Kind_LIST_ATOM:     atomTagRecList,
+	Kind_MAPPING_ATOM:  atomTagRecMapping,
+	Kind_FUNCTION_ATOM: atomTagRecFunction,
+}
+
+func (e *typeEncoder) appendAtomBody(out []byte, atom encodedAtom) []byte {
+	switch atom.tag {
+	case atomTagCell:
+		atomicType := atom.atom.(*TypeAtom).AtomicType.(*CellAtomicType)
+		out = e.appendSemType(out, atomicType.Ty)
+		return binary.AppendUvarint(out, uint64(atomicType.Mut))
+	case atomTagList, atomTagRecList:
+		atomicType := e.env.listAtomType(atom.atom)
+		if atomicType == nil {
+			e.fail("undefined recursive list type")
+			return out
+		}
+		out = binary.AppendUvarint(out, uint64(atomicType.Members.FixedLength))
+		out = e.appendCells(out, atomicType.Members.Initial)
+		return e.appendSemType(out, atomicType.Rest)
+	case atomTagMapping, atomTagRecMapping:
+		atomicType := e.env.mappingAtomType(atom.atom)
+		if atomicType == nil {
+			e.fail("undefined recursive mapping type")
+			return out
+		}
+		out = binary.AppendUvarint(out, uint64(len(atomicType.Names)))
+		for _, name := range atomicType.Names {
+			out = appendString(out, name)
+		}
+		out = e.appendCells(out, atomicType.Types)
+		return e.appendSemType(out, atomicType.Rest)
+	case atomTagFunction, atomTagRecFunction:
+		atomicType := e.env.functionAtomType(atom.atom)
+		if atomicType == nil {
+			e.fail("undefined recursive function type")
+			return out
+		}
+		out = e.appendSemType(out, atomicType.ParamType)
+		out = e.appendSemType(out, atomicType.RetType)
+		out = e.appendSemType(out, atomicType.Qualifiers)
+		return appendBool(out, atomicType.IsGeneric)
+	case atomTagPredefinedRec, atomTagXml:
+		return binary.AppendUvarint(out, uint64(atom.atom.Index()))
+	default:
+		return out
+	}
+}
+
+func (e *typeEncoder) appendCells(out []byte, cells []CellSemType) []byte {
+	out = binary.AppendUvarint(out, uint64(len(cells)))
+	for _, cell := range cells {
+		out = e.appendSemType(out, cell)
+	}
+	return out
+}
+
+func appendBool(out []byte, b bool) []byte {
+	if b {
+		return append(out, 1)
+	}
+	return append(out, 0)
+}
+
+func appendBytes(out []byte, b []byte) []byte {
+	out = binary.AppendUvarint(out, uint64(len(b)))
+	return append(out, b...)
+}
+
+func appendString(out []byte, s string) []byte {
+	out = binary.AppendUvarint(out, uint64(len(s)))
+	return append(out, s...)
+}
+
+func appendStrings(out []byte, allowed bool, values []EnumerableType[string]) []byte {
+	out = appendBool(out, allowed)
+	out = binary.AppendUvarint(out, uint64(len(values)))
+	for _, value := range values {
+		out = appendString(out, value.Value())
+	}
+	return out
+}
+
+type decodedAtom struct {
+	tag  atomTag
+	body []byte
+	// atom is set when the atom is created, which for a type atom is when it is first referred to
+	atom      Atom
+	resolving bool
+}
+
+type typeDecoder struct {
+	env   Env
+	data  []byte
+	pos   int
+	atoms []decodedAtom
+	err   error
+	// parent is the decoder of the data that refers to the atom this decoder decodes the body of
+	parent *typeDecoder
+}
+
+func (d *typeDecoder) fail(format string, args ...any) {
+	if d.err == nil {
+		d.err = fmt.Errorf("invalid type encoding: "+format, args...)
+	}
+}
+
+// readAtomTable reads the atom table and creates its rec atoms, so that they can be referred to before their bodies
+// are decoded.
+func (d *typeDecoder) readAtomTable() {
+	count := d.count()
+	for i := 0; i < count && d.err == nil; i++ {
+		tag := atomTag(d.byte())
+		body := d.bytes()
+		atom := decodedAtom{tag: tag, body: body}
+		switch tag {
+		case atomTagRecList:
+			rec := d.env.recListAtom()
+			atom.atom = &rec
+		case atomTagRecMapping:
+			rec := d.env.recMappingAtom()
+			atom.atom = &rec
+		case atomTagRecFunction:
+			rec := d.env.recFunctionAtom()
+			atom.atom = &rec
+		case atomTagDistinct:
+			atom.atom = common.ToPointer(CreateDistinctRecAtom(-NextDistinctId(d.env) - 1))
+		case atomTagPredefinedRec, atomTagXml:
+			sub := d.subDecoder(body)
+			index := sub.int()
+			sub.finish()
+			if tag == atomTagXml {
+				atom.atom = common.ToPointer(CreateXMLRecAtom(index))
+			} else if predefinedTypeEnv.IsPredefinedRecAtom(index) {
+				atom.atom = common.ToPointer(CreateRecAtom(index))
+			} else {
+				d.fail("%d is not a predefined rec atom", index)
+			}
+		case atomTagCell, atomTagList, atomTagMapping, atomTagFunction:
+		default:
+			d.fail("unknown atom tag %d", tag)
+		}
+		d.atoms = append(d.atoms, atom)
+	}
+}
+
+// defineRecAtom sets the atomic type of atom if it is a rec atom.
+func (d *typeDecoder) defineRecAtom(atom *decodedAtom) {
+	rec, ok := atom.atom.(*RecAtom)
+	if !ok {
+		return
+	}
+	body := d.subDecoder(atom.body)
+	switch atom.tag {
+	case atomTagRecList:
+		atomicType := body.listAtomicType()
+		d.env.setRecListAtomType(*rec, &atomicType)
+	case atomTagRecMapping:
+		atomicType := body.mappingAtomicType()
+		d.env.setRecMappingAtomType(*rec, &atomicType)
+	case atomTagRecFunction:
+		atomicType := body.functionAtomicType()
+		d.env.setRecFunctionAtomType(*rec, &atomicType)
+	default:
+		return
+	}
+	body.finish()
+}
+
+// subDecoder returns a decoder of the body of an atom, which shares the atom table and the error of d.
+func (d *typeDecoder) subDecoder(body []byte) *typeDecoder {
+	return &typeDecoder{env: d.env, data: body, atoms: d.atoms, err: d.err, parent: d}
+}
+
+func (d *typeDecoder) finish() {
+	if d.err == nil && d.pos != len(d.data) {
+		d.fail("unexpected data after an atom")
+	}
+	d.propagate()
+}
+
+func (d *typeDecoder) propagate() {
+	if d.parent != nil && d.parent.err == nil {
+		d.parent.err = d.err
+	}
+}
+
+func (d *typeDecoder) atom(id int) Atom {
+	if id < 0 || id >= len(d.atoms) {
+		d.fail("atom %d out of range", id)
+		return nil
+	}
+	atom := &d.atoms[id]
+	if atom.atom != nil {
+		return atom.atom
+	}
+	// Type atoms can't refer to themselves, except through a rec atom
+	if atom.resolving {
+		d.fail("cyclic type atom %d", id)
+		return nil
+	}
+	atom.resolving = true
+	body := d.subDecoder(atom.body)
+	var typeAtom TypeAtom
+	switch atom.tag {
+	case atomTagCell:
+		ty := body.semType()
+		mut := CellMutability(body.uvarint())
+		if body.err == nil {
+			atomicType := CellAtomicTypeFrom(ty, mut)
+			typeAtom = d.env.cellAtom(&atomicType)
+		}
+	case atomTagList:
+		atomicType := body.listAtomicType()
+		typeAtom = d.env.listAtom(&atomicType)
+	case atomTagMapping:
+		atomicType := body.mappingAtomicType()
+		typeAtom = d.env.mappingAtom(&atomicType)
+	case atomTagFunction:
+		atomicType := body.functionAtomicType()
+		typeAtom = d.env.functionAtom(&atomicType)
+	}
+	body.finish()
+	atom.resolving = false
+	if body.err != nil {
+		d.err = body.err
+		return nil
+	}
+	atom.atom = &typeAtom
+	return atom.atom
+}
+
+func (d *typeDecoder) listAtomicType() ListAtomicType {
+	fixedLength := d.int()
+	initial := d.cells()
+	rest := d.cell()
+	return ListAtomicTypeFrom(FixedLengthArrayFrom(initial, fixedLength), rest)
+}
+
+func (d *typeDecoder) mappingAtomicType() MappingAtomicType {
+	count := d.count()
+	var names []string
+	for i := 0; i < count && d.err == nil; i++ {
+		names = append(names, d.string())
+	}
+	types := d.cells()
+	rest := d.cell()
+	if d.err == nil && len(names) != len(types) {
+		d.fail("mapping with %d names and %d types", len(names), len(types))
+	}
+	return MappingAtomicTypeFrom(names, types, rest)
+}
+
+func (d *typeDecoder) functionAtomicType() FunctionAtomicType {
+	paramType := d.semType()
+	retType := d.semType()
+	qualifiers := d.semType()
+	return NewFunctionAtomicType(paramType, retType, qualifiers, d.bool())
+}
+
+func (d *typeDecoder) cells() []CellSemType {
+	count := d.count()
+	var cells []CellSemType
+	for i := 0; i < count && d.err == nil; i++ {
+		cells = append(cells, d.cell())
+	}
+	return cells
+}
+
+func (d *typeDecoder) cell() CellSemType {
+	cell, ok := d.semType().(CellSemType)
+	if !ok {
+		d.fail("expected a cell type")
+	}
+	return cell
+}
+
+func (d *typeDecoder) semType() SemType {
+	switch d.byte() {
+	case typeBitSet:
+		return &BasicTypeBitSet{bitset: int(d.uvarint())}
+	case typeComplex:
+		all := int(d.uvarint())
+		some := int(d.uvarint())
+		var dataList []ProperSubtypeData
+		for code := BT_NIL.Code; code <= BT_CELL.Code && d.err == nil; code++ {
+			if some&(1<<code) != 0 {
+				dataList = append(dataList, d.subtypeData(BasicTypeCodeFrom(code)))
+			}
+		}
+		if d.err != nil {
+			return &NEVER
+		}
+		if some>>(BT_CELL.Code+1) != 0 || all&some != 0 {
+			d.fail("invalid basic types %#x and %#x", all, some)
+			return &NEVER
+		}
+		return CreateComplexSemTypeWithAllBitSetSomeBitSetSubtypeDataList(all, some, dataList)
+	default:
+		d.fail("unknown type tag")
+		return &NEVER
+	}
+}
+
+func (d *typeDecoder) subtypeData(code BasicTypeCode) ProperSubtypeData {
+	switch code {
+	case BT_BOOLEAN:
+		return BooleanSubtypeFrom(d.bool())
+	case BT_INT:
+		count := d.count()
+		var ranges []Range
+		for i := 0; i < count && d.err == nil; i++ {
+			ranges = append(ranges, RangeFrom(d.varint(), d.varint()))
+		}
+		return CreateIntSubtype(ranges...)
+	case BT_FLOAT:
+		subtype := FloatSubtype{allowed: d.bool()}
+		count := d.count()
+		for i := 0; i < count && d.err == nil; i++ {
+			subtype.values = append(subtype.values, EnumerableFloatFrom(math.Float64frombits(d.uint64())))
+		}
+		return subtype
+	case BT_DECIMAL:
+		subtype := DecimalSubtype{allowed: d.bool()}
+		count := d.count()
+		for i := 0; i < count && d.err == nil; i++ {
+			str := d.string()
+			var value big.Rat
+			if _, ok := value.SetString(str); !ok {
+				d.fail("invalid decimal %q", str)
+			}
+			subtype.values = append(subtype.values, EnumerableDecimalFrom(value))
+		}
+		return subtype
+	case BT_STRING:
+		charAllowed, chars := d.strings(EnumerableCharStringFrom)
+		nonCharAllowed, nonChars := d.strings(EnumerableStringFrom)
+		return StringSubtypeFrom(CharStringSubtypeFrom(charAllowed, chars),
+			NonCharStringSubtypeFrom(nonCharAllowed, nonChars))
+	case BT_XML:
+		primitives := int(d.uvarint())
+		return XmlSubtypeFrom(primitives, d.bdd())
+	case BT_LIST, BT_STREAM, BT_TABLE, BT_MAPPING, BT_ERROR, BT_TYPEDESC, BT_FUTURE, BT_OBJECT, BT_FUNCTION, BT_CELL:
+		return d.bdd()
+	default:
+		d.fail("unexpected subtype data of %s", code)
+		return BddNothing()
+	}
+}
+
+func (d *typeDecoder) bdd() Bdd {
+	switch d.byte() {
+	case bddNothing:
+		return BddNothing()
+	case bddAll:
+		return BddAll()
+	case bddNode:
+		atom := d.atom(d.int())
+		left := d.bdd()
+		middle := d.bdd()
+		right := d.bdd()
+		if d.err != nil {
+			return BddNothing()
+		}
+		return BddNodeCreate(atom, left, middle, right)
+	default:
+		d.fail("unknown bdd tag")
+		return BddNothing()
+	}
+}
+
+func (d *typeDecoder) strings(from func(string) EnumerableType[string]) (bool, []EnumerableType[string]) {
+	allowed := d.bool()
+	count := d.count()
+	var values []EnumerableType[string]
+	for i := 0; i < count && d.err == nil; i++ {
+		values = append(values, from(d.string()))
+	}
+	return allowed, values
+}
+
+func (d *typeDecoder) byte() byte {
+	if d.err != nil {
+		return 0
+	}
+	if d.pos >= len(d.data) {
+		d.fail("unexpected end of data")
+		return 0
+	}
+	b := d.data[d.pos]
+	d.pos++
+	return b
+}
+
+func (d *typeDecoder) bool() bool {
+	return d.byte() != 0
+}
+
+func (d *typeDecoder) uint64() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.data)-d.pos < 8 {
+		d.fail("unexpected end of data")
+		return 0
+	}
+	v := binary.LittleEndian.Uint64(d.data[d.pos:])
+	d.pos += 8
+	return v
+}
+
+func (d *typeDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data[d.pos:])
+	if n <= 0 {
+		d.fail("bad varint")
+		return 0
+	}
+	d.pos += n
+	return v
+}
+
+func (d *typeDecoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.data[d.pos:])
+	if n <= 0 {
+		d.fail("bad varint")
+		return 0
+	}
+	d.pos += n
+	return v
+}
+
+func (d *typeDecoder) int() int {
+	v := d.uvarint()
+	if v > math.MaxInt32 {
+		d.fail("%d out of range", v)
+		return 0
+	}
+	return int(v)
+}
+
+// count reads a length, which can't be more than the number of bytes left since every element takes at least a byte.
+func (d *typeDecoder) count() int {
+	v := d.uvarint()
+	if v > uint64(len(d.data)-d.pos) {
+		d.fail("length %d out of range", v)
+		return 0
+	}
+	return int(v)
+}
+
+func (d *typeDecoder) bytes() []byte {
+	n := d.uvarint()
+	if d.err != nil {
+		return nil
+	}
+	if n > uint64(len(d.data)-d.pos) {
+		d.fail("unexpected end of data")
+		return nil
+	}
+	b := d.data[d.pos : d.pos+int(n)]
+	d.pos += int(n)
+	return b
+}
+
+func (d *typeDecoder) string() string {
+	return string(d.bytes())
+}
@@ -0,0 +1,670 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semtypes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	balCommon "ballerina-lang-go/common"
+	"ballerina-lang-go/identifierutil"
+	"ballerina-lang-go/parser"
+	"ballerina-lang-go/parser/common"
+	"ballerina-lang-go/parser/tree"
+)
+
+// TypeParser parses Ballerina type descriptors into semtypes using the type descriptor rules of the Ballerina parser.
+// The names in a type descriptor refer to the types bound by Bind or defined by DefineTypes.
+//
+// Type definitions are resolved lazily, in the order they are referenced. A reference to a type definition that is
+// being resolved is only valid if it goes through a list, mapping, function or stream constructor, in which case the
+// recursion is broken by the definition cached for the constructor's syntax node. Such references are resolved at a
+// greater depth, so a reference found at the same depth as the type definition is an invalid cycle.
+type TypeParser struct {
+	env      Env
+	cx       Context
+	bindings map[string]*typeBinding
+	// definitions of the list, mapping, function and stream type descriptors being resolved, keyed by their syntax node
+	defns map[tree.STNode]Definition
+}
+
+type typeBinding struct {
+	name       string
+	typeNode   tree.Node
+	semType    SemType
+	cycleDepth int
+}
+
+// NewTypeParser creates a parser of type descriptors in env, with no named types.
+func NewTypeParser(env Env) *TypeParser {
+	return &TypeParser{
+		env:      env,
+		cx:       TypeCheckContext(env),
+		bindings: make(map[string]*typeBinding),
+		defns:    make(map[tree.STNode]Definition),
+	}
+}
+
+// ParseType parses source as a type descriptor that refers to no named types.
+func ParseType(env Env, source string) (SemType, error) {
+	return NewTypeParser(env).Parse(source)
+}
+
+// Context returns the type check context of the parser's environment.
+func (p *TypeParser) Context() Context {
+	return p.cx
+}
+
+// Bind makes name refer to t, replacing any type previously bound to or defined with name.
+func (p *TypeParser) Bind(name string, t SemType) {
+	p.bindings[name] = &typeBinding{name: name, semType: t, cycleDepth: -1}
+}
+
+// DefineTypes parses source as a module containing only type definitions and makes the defined types resolvable by
+// name. The type definitions may refer to each other and to the types defined before.
+func (p *TypeParser) DefineTypes(source string) error {
+	syntaxTree := parser.GetSyntaxTreeFromText(nil, "types.bal", source)
+	if hasSyntaxErrors(syntaxTree.RootNode.InternalNode()) {
+		return fmt.Errorf("invalid type definitions")
+	}
+	modulePart := syntaxTree.RootNode.(*tree.ModulePart)
+	imports := modulePart.Imports()
+	if imports.Size() > 0 {
+		return fmt.Errorf("unsupported import declaration")
+	}
+	members := modulePart.Members()
+	for member := range members.Iterator() {
+		typeDefinition, ok := member.(*tree.TypeDefinitionNode)
+		if !ok {
+			return fmt.Errorf("unsupported module member: %s", sourceText(member))
+		}
+		name := identifierName(typeDefinition.TypeName())
+		if binding, ok := p.bindings[name]; ok && binding.typeNode != nil {
+			return fmt.Errorf("redeclared type '%s'", name)
+		}
+		p.bindings[name] = &typeBinding{name: name, typeNode: typeDefinition.TypeDescriptor(), cycleDepth: -1}
+	}
+	return nil
+}
+
+// Lookup returns the type named name, resolving its type definition if necessary.
+func (p *TypeParser) Lookup(name string) (SemType, error) {
+	binding, ok := p.bindings[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown type '%s'", name)
+	}
+	return p.resolveBinding(binding, 0)
+}
+
+// Parse parses source as a type descriptor.
+func (p *TypeParser) Parse(source string) (SemType, error) {
+	typeNode := parser.ParseTypeDescriptor(nil, source)
+	if hasSyntaxErrors(typeNode.InternalNode()) {
+		return nil, fmt.Errorf("invalid type descriptor: %s", source)
+	}
+	return p.resolveTypeDesc(0, typeNode)
+}
+
+func (p *TypeParser) resolveBinding(binding *typeBinding, depth int) (SemType, error) {
+	if binding.semType != nil {
+		return binding.semType, nil
+	}
+	if depth == binding.cycleDepth {
+		return nil, fmt.Errorf("invalid cyclic type reference in '%s'", binding.name)
+	}
+	binding.cycleDepth = depth
+	s, err := p.resolveTypeDesc(depth, binding.typeNode)
+	if err != nil {
+		binding.cycleDepth = -1
+		return nil, err
+	}
+	if binding.semType == nil {
+		// The type definition may already have been resolved by a recursive reference
+		binding.semType = s
+		binding.cycleDepth = -1
+	}
+	return s, nil
+}
+
+func (p *TypeParser) resolveTypeDesc(depth int, td tree.Node) (SemType, error) {
+	switch td := td.(type) {
+	case *tree.BuiltinSimpleNameReferenceNode:
+		return p.resolveBuiltinType(td)
+	case *tree.NilTypeDescriptorNode:
+		return &NIL, nil
+	case *tree.SimpleNameReferenceNode:
+		return p.resolveTypeReference(depth, identifierName(td.Name()))
+	case *tree.QualifiedNameReferenceNode:
+		return resolveLangLibType(td.ModulePrefix().Text(), identifierName(td.Identifier()))
+	case *tree.SingletonTypeDescriptorNode:
+		return resolveSingletonType(td.SimpleContExprNode())
+	case *tree.ParenthesisedTypeDescriptorNode:
+		return p.resolveTypeDesc(depth, td.Typedesc())
+	case *tree.OptionalTypeDescriptorNode:
+		t, err := p.resolveTypeDesc(depth, td.TypeDescriptor())
+		if err != nil {
+			return nil, err
+		}
+		return Union(t, &NIL), nil
+	case *tree.UnionTypeDescriptorNode:
+		t1, err := p.resolveTypeDesc(depth, td.LeftTypeDesc())
+		if err != nil {
+			return nil, err
+		}
+		t2, err := p.resolveTypeDesc(depth, td.RightTypeDesc())
+		if err != nil {
+			return nil, err
+		}
+		return Union(t1, t2), nil
+	case *tree.IntersectionTypeDescriptorNode:
+		t1, err := p.resolveTypeDesc(depth, td.LeftTypeDesc())
+		if err != nil {
+			return nil, err
+		}
+		t2, err := p.resolveTypeDesc(depth, td.RightTypeDesc())
+		if err != nil {
+			return nil, err
+		}
+		return Intersect(t1, t2), nil
+	case *tree.DistinctTypeDescriptorNode:
+		t, err := p.resolveTypeDesc(depth, td.TypeDescriptor())
+		if err != nil {
+			return nil, err
+		}
+		if !IsSubtype(p.cx, t, &ERROR) {
+			return nil, fmt.Errorf("unsupported distinct type: %s", sourceText(td))
+		}
+		return Intersect(ErrorDistinct(NextDistinctId(p.env)), t), nil
+	case *tree.ArrayTypeDescriptorNode:
+		return p.resolveArrayType(depth, td)
+	case *tree.TupleTypeDescriptorNode:
+		return p.resolveTupleType(depth, td)
+	case *tree.RecordTypeDescriptorNode:
+		return p.resolveRecordType(depth, td)
+	case *tree.MapTypeDescriptorNode:
+		return p.resolveMapType(depth, td)
+	case *tree.ParameterizedTypeDescriptorNode:
+		return p.resolveParameterizedType(depth, td)
+	case *tree.StreamTypeDescriptorNode:
+		return p.resolveStreamType(depth, td)
+	case *tree.FunctionTypeDescriptorNode:
+		return p.resolveFunctionType(depth, td)
+	case *tree.TableTypeDescriptorNode:
+		return p.resolveTableType(depth, td)
+	default:
+		return nil, fmt.Errorf("unsupported type descriptor: %s", sourceText(td))
+	}
+}
+
+func (p *TypeParser) resolveBuiltinType(td *tree.BuiltinSimpleNameReferenceNode) (SemType, error) {
+	switch td.Kind() {
+	case common.INT_TYPE_DESC:
+		return &INT, nil
+	case common.BYTE_TYPE_DESC:
+		return BYTE, nil
+	case common.FLOAT_TYPE_DESC:
+		return &FLOAT, nil
+	case common.DECIMAL_TYPE_DESC:
+		return &DECIMAL, nil
+	case common.STRING_TYPE_DESC:
+		return &STRING, nil
+	case common.BOOLEAN_TYPE_DESC:
+		return &BOOLEAN, nil
+	case common.NIL_TYPE_DESC:
+		return &NIL, nil
+	case common.ANY_TYPE_DESC:
+		return &ANY, nil
+	case common.ANYDATA_TYPE_DESC:
+		return CreateAnydata(p.cx), nil
+	case common.JSON_TYPE_DESC:
+		return CreateJson(p.cx), nil
+	case common.READONLY_TYPE_DESC:
+		return VAL_READONLY, nil
+	case common.HANDLE_TYPE_DESC:
+		return &HANDLE, nil
+	case common.NEVER_TYPE_DESC:
+		return &NEVER, nil
+	default:
+		return nil, fmt.Errorf("unsupported type descriptor: %s", sourceText(td))
+	}
+}
+
+func (p *TypeParser) resolveTypeReference(depth int, name string) (SemType, error) {
+	binding, ok := p.bindings[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown type '%s'", name)
+	}
+	return p.resolveBinding(binding, depth)
+}
+
+func resolveLangLibType(module, name string) (SemType, error) {
+	switch module + ":" + name {
+	case "int:Signed8":
+		return IntWidthSigned(8), nil
+	case "int:Signed16":
+		return IntWidthSigned(16), nil
+	case "int:Signed32":
+		return IntWidthSigned(32), nil
+	case "int:Unsigned8":
+		return IntWidthUnsigned(8), nil
+	case "int:Unsigned16":
+		return IntWidthUnsigned(16), nil
+	case "int:Unsigned32":
+		return IntWidthUnsigned(32), nil
+	case "string:Char":
+		return STRING_CHAR, nil
+	case "string:RegExp":
+		return &REGEXP, nil
+	case "xml:Element":
+		return XML_ELEMENT, nil
+	case "xml:Comment":
+		return XML_COMMENT, nil
+	case "xml:Text":
+		return XML_TEXT, nil
+	case "xml:ProcessingInstruction":
+		return XML_PI, nil
+	default:
+		return nil, fmt.Errorf("unsupported type reference: '%s:%s'", module, name)
+	}
+}
+
+// resolveSingletonType returns the singleton type of a (possibly signed) literal.
+func resolveSingletonType(literal tree.Node) (SemType, error) {
+	sign := ""
+	if unaryExpr, ok := literal.(*tree.UnaryExpressionNode); ok {
+		if unaryExpr.UnaryOperator().Kind() == common.MINUS_TOKEN {
+			sign = "-"
+		}
+		literal = unaryExpr.Expression()
+	}
+	basicLiteral, ok := literal.(*tree.BasicLiteralNode)
+	if !ok {
+		return nil, fmt.Errorf("unsupported value in singleton type: %s", sourceText(literal))
+	}
+	token := basicLiteral.LiteralToken()
+	text := token.Text()
+	switch token.Kind() {
+	case common.DECIMAL_INTEGER_LITERAL_TOKEN:
+		value, err := strconv.ParseInt(sign+text, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid int literal: %s%s", sign, text)
+		}
+		return IntConst(value), nil
+	case common.HEX_INTEGER_LITERAL_TOKEN:
+		value, err := strconv.ParseInt(sign+strings.ToLower(text)[2:], 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid int literal: %s%s", sign, text)
+		}
+		return IntConst(value), nil
+	case common.DECIMAL_FLOATING_POINT_LITERAL_TOKEN:
+		if balCommon.IsDecimalDiscriminated(text) {
+			return DecimalConstFromStringValue(sign + strings.TrimRight(text, "dD")), nil
+		}
+		value, err := strconv.ParseFloat(sign+strings.TrimRight(text, "fF"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid float literal: %s%s", sign, text)
+		}
+		return FloatConst(value), nil
+	case common.HEX_FLOATING_POINT_LITERAL_TOKEN:
+		if !strings.ContainsAny(text, "pP") {
+			text += "p0"
+		}
+		value, err := strconv.ParseFloat(sign+text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid float literal: %s%s", sign, text)
+		}
+		return FloatConst(value), nil
+	}
+	if sign != "" {
+		return nil, fmt.Errorf("unsupported value in singleton type: %s", sourceText(literal))
+	}
+	switch basicLiteral.Kind() {
+	case common.STRING_LITERAL:
+		return StringConst(identifierutil.UnescapeBallerina(text[1 : len(text)-1])), nil
+	case common.BOOLEAN_LITERAL:
+		return BooleanConst(text == "true"), nil
+	case common.NIL_LITERAL:
+		return &NIL, nil
+	default:
+		return nil, fmt.Errorf("unsupported value in singleton type: %s", sourceText(literal))
+	}
+}
+
+func (p *TypeParser) resolveArrayType(depth int, td *tree.ArrayTypeDescriptorNode) (SemType, error) {
+	if defn, ok := p.defns[td.InternalNode()]; ok {
+		return defn.GetSemType(p.env), nil
+	}
+	ld := NewListDefinition()
+	p.defns[td.InternalNode()] = &ld
+	accum, err := p.resolveTypeDesc(depth+1, td.MemberTypeDesc())
+	if err != nil {
+		return nil, err
+	}
+	dimensions := td.Dimensions()
+	// The last dimension is the innermost one
+	for i := dimensions.Size() - 1; i >= 0; i-- {
+		size, err := arrayLength(dimensions.Get(i))
+		if err != nil {
+			return nil, err
+		}
+		defn := &ld
+		if i > 0 {
+			innerDefn := NewListDefinition()
+			defn = &innerDefn
+		}
+		if size < 0 {
+			accum = defn.DefineListTypeWrapped(p.env, nil, 0, accum, CellMutability_CELL_MUT_LIMITED)
+		} else {
+			accum = defn.DefineListTypeWrapped(p.env, []SemType{accum}, size, &NEVER, CellMutability_CELL_MUT_LIMITED)
+		}
+	}
+	return accum, nil
+}
+
+// arrayLength returns the length of an array dimension, or -1 if the array is open.
+func arrayLength(dimension *tree.ArrayDimensionNode) (int, error) {
+	length := dimension.ArrayLength()
+	if length == nil {
+		return -1, nil
+	}
+	if literal, ok := length.(*tree.BasicLiteralNode); ok && literal.LiteralToken().Kind() == common.DECIMAL_INTEGER_LITERAL_TOKEN {
+		if size, err := strconv.Atoi(literal.LiteralToken().Text()); err == nil {
+			return size, nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported array length: %s", sourceText(length))
+}
+
+func (p *TypeParser) resolveTupleType(depth int, td *tree.TupleTypeDescriptorNode) (SemType, error) {
+	if defn, ok := p.defns[td.InternalNode()]; ok {
+		return defn.GetSemType(p.env), nil
+	}
+	ld := NewListDefinition()
+	p.defns[td.InternalNode()] = &ld
+	var members []SemType
+	var rest SemType = &NEVER
+	memberTypeDescs := td.MemberTypeDesc()
+	for member := range memberTypeDescs.Iterator() {
+		var err error
+		switch member := member.(type) {
+		case *tree.MemberTypeDescriptorNode:
+			var t SemType
+			t, err = p.resolveTypeDesc(depth+1, member.TypeDescriptor())
+			members = append(members, t)
+		case *tree.RestDescriptorNode:
+			rest, err = p.resolveTypeDesc(depth+1, member.TypeDescriptor())
+		default:
+			// Separators
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ld.DefineListTypeWrapped(p.env, members, len(members), rest, CellMutability_CELL_MUT_LIMITED), nil
+}
+
+func (p *TypeParser) resolveRecordType(depth int, td *tree.RecordTypeDescriptorNode) (SemType, error) {
+	if defn, ok := p.defns[td.InternalNode()]; ok {
+		return defn.GetSemType(p.env), nil
+	}
+	md := NewMappingDefinition()
+	p.defns[td.InternalNode()] = &md
+	var fields []Field
+	names := make(map[string]bool)
+	recordFields := td.Fields()
+	for field := range recordFields.Iterator() {
+		recordField, ok := field.(*tree.RecordFieldNode)
+		if !ok {
+			return nil, fmt.Errorf("unsupported record field: %s", sourceText(field))
+		}
+		name := identifierName(recordField.FieldName())
+		if names[name] {
+			return nil, fmt.Errorf("redeclared symbol '%s'", name)
+		}
+		names[name] = true
+		t, err := p.resolveTypeDesc(depth+1, recordField.TypeName())
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, FieldFrom(name, t, recordField.ReadonlyKeyword() != nil,
+			recordField.QuestionMarkToken() != nil))
+	}
+	var rest SemType
+	switch {
+	case td.RecordRestDescriptor() != nil:
+		var err error
+		rest, err = p.resolveTypeDesc(depth+1, td.RecordRestDescriptor().TypeName())
+		if err != nil {
+			return nil, err
+		}
+	case td.BodyStartDelimiter().Kind() == common.OPEN_BRACE_PIPE_TOKEN:
+		rest = &NEVER
+	default:
+		rest = CreateAnydata(p.cx)
+	}
+	return md.DefineMappingTypeWrapped(p.env, fields, rest), nil
+}
+
+func (p *TypeParser) resolveMapType(depth int, td *tree.MapTypeDescriptorNode) (SemType, error) {
+	if defn, ok := p.defns[td.InternalNode()]; ok {
+		return defn.GetSemType(p.env), nil
+	}
+	md := NewMappingDefinition()
+	p.defns[td.InternalNode()] = &md
+	rest, err := p.resolveTypeDesc(depth+1, td.MapTypeParamsNode().TypeNode())
+	if err != nil {
+		return nil, err
+	}
+	return md.DefineMappingTypeWrapped(p.env, nil, rest), nil
+}
+
+func (p *TypeParser) resolveParameterizedType(depth int, td *tree.ParameterizedTypeDescriptorNode) (SemType, error) {
+	typeParam := td.TypeParamNode()
+	if typeParam == nil {
+		switch td.Kind() {
+		case common.ERROR_TYPE_DESC:
+			return &ERROR, nil
+		case common.XML_TYPE_DESC:
+			return &XML, nil
+		case common.FUTURE_TYPE_DESC:
+			return &FUTURE, nil
+		case common.TYPEDESC_TYPE_DESC:
+			return &TYPEDESC, nil
+		default:
+			return nil, fmt.Errorf("unsupported type descriptor: %s", sourceText(td))
+		}
+	}
+	// There is no definition to break a recursive reference through these constraints
+	constraint, err := p.resolveTypeDesc(depth, typeParam.TypeNode())
+	if err != nil {
+		return nil, err
+	}
+	switch td.Kind() {
+	case common.ERROR_TYPE_DESC:
+		return ErrorDetail(constraint), nil
+	case common.XML_TYPE_DESC:
+		return XmlSequence(constraint), nil
+	case common.FUTURE_TYPE_DESC:
+		return FutureContaining(p.env, constraint), nil
+	case common.TYPEDESC_TYPE_DESC:
+		return TypedescContaining(p.env, constraint), nil
+	default:
+		return nil, fmt.Errorf("unsupported type descriptor: %s", sourceText(td))
+	}
+}
+
+func (p *TypeParser) resolveStreamType(depth int, td *tree.StreamTypeDescriptorNode) (SemType, error) {
+	typeParams, ok := td.StreamTypeParamsNode().(*tree.StreamTypeParamsNode)
+	if !ok {
+		return &STREAM, nil
+	}
+	if defn, ok := p.defns[td.InternalNode()]; ok {
+		return defn.GetSemType(p.env), nil
+	}
+	sd := NewStreamDefinition()
+	p.defns[td.InternalNode()] = &sd
+	valueType, err := p.resolveTypeDesc(depth+1, typeParams.LeftTypeDescNode())
+	if err != nil {
+		return nil, err
+	}
+	var completionType SemType = &NIL
+	if typeParams.RightTypeDescNode() != nil {
+		completionType, err = p.resolveTypeDesc(depth+1, typeParams.RightTypeDescNode())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sd.Define(p.env, valueType, completionType), nil
+}
+
+// resolveFunctionType resolves a function type. The parameters are a tuple of the parameter types, and a function
+// without a return type returns nil. A function type descriptor without a signature is the type of all functions.
+func (p *TypeParser) resolveFunctionType(depth int, td *tree.FunctionTypeDescriptorNode) (SemType, error) {
+	var isolated, transactional bool
+	qualifiers := td.QualifierList()
+	for qualifier := range qualifiers.Iterator() {
+		switch qualifier.Kind() {
+		case common.ISOLATED_KEYWORD:
+			isolated = true
+		case common.TRANSACTIONAL_KEYWORD:
+			transactional = true
+		default:
+			return nil, fmt.Errorf("unsupported function qualifier: %s", qualifier.Text())
+		}
+	}
+	signature := td.FunctionSignature()
+	if signature == nil {
+		if isolated || transactional {
+			return nil, fmt.Errorf("unsupported type descriptor: %s", sourceText(td))
+		}
+		return &FUNCTION, nil
+	}
+	if defn, ok := p.defns[td.InternalNode()]; ok {
+		return defn.GetSemType(p.env), nil
+	}
+	fd := NewFunctionDefinition()
+	p.defns[td.InternalNode()] = &fd
+	var params []SemType
+	var rest SemType = &NEVER
+	parameters := signature.Parameters()
+	for param := range parameters.Iterator() {
+		var err error
+		switch param := param.(type) {
+		case *tree.RequiredParameterNode:
+			var t SemType
+			t, err = p.resolveTypeDesc(depth+1, param.TypeName())
+			params = append(params, t)
+		case *tree.DefaultableParameterNode:
+			// The default value doesn't change the type of the parameter
+			var t SemType
+			t, err = p.resolveTypeDesc(depth+1, param.TypeName())
+			params = append(params, t)
+		case *tree.RestParameterNode:
+			rest, err = p.resolveTypeDesc(depth+1, param.TypeName())
+		default:
+			// Separators
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var ret SemType = &NIL
+	if returnTypeDesc := signature.ReturnTypeDesc(); returnTypeDesc != nil {
+		var err error
+		ret, err = p.resolveTypeDesc(depth+1, returnTypeDesc.Type())
+		if err != nil {
+			return nil, err
+		}
+	}
+	ld := NewListDefinition()
+	args := ld.DefineListTypeWrapped(p.env, params, len(params), rest, CellMutability_CELL_MUT_NONE)
+	return fd.Define(p.env, args, ret, FunctionQualifiersFrom(p.env, isolated, transactional)), nil
+}
+
+// resolveTableType resolves a table type. As with the other constrained types, there is no definition to break a
+// recursive reference through the row type.
+func (p *TypeParser) resolveTableType(depth int, td *tree.TableTypeDescriptorNode) (SemType, error) {
+	rowTypeParam, ok := td.RowTypeParameterNode().(*tree.TypeParameterNode)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type descriptor: %s", sourceText(td))
+	}
+	constraint, err := p.resolveTypeDesc(depth, rowTypeParam.TypeNode())
+	if err != nil {
+		return nil, err
+	}
+	if !IsSubtypeSimple(constraint, MAPPING) {
+		return nil, fmt.Errorf("invalid table constraint: %s", sourceText(rowTypeParam.TypeNode()))
+	}
+	switch key := td.KeyConstraintNode().(type) {
+	case nil:
+		return TableContaining(p.env, constraint), nil
+	case *tree.KeySpecifierNode:
+		var fieldNames []string
+		names := key.FieldNames()
+		for name := range names.Iterator() {
+			fieldNames = append(fieldNames, identifierName(name))
+		}
+		return TableContainingKeySpecifier(p.cx, constraint, fieldNames), nil
+	case *tree.KeyTypeConstraintNode:
+		keyTypeParam, ok := key.TypeParameterNode().(*tree.TypeParameterNode)
+		if !ok {
+			return nil, fmt.Errorf("unsupported type descriptor: %s", sourceText(td))
+		}
+		keyType, err := p.resolveTypeDesc(depth, keyTypeParam.TypeNode())
+		if err != nil {
+			return nil, err
+		}
+		return TableContainingKeyConstraint(p.cx, constraint, keyType), nil
+	default:
+		return nil, fmt.Errorf("unsupported type descriptor: %s", sourceText(td))
+	}
+}
+
+// hasSyntaxErrors reports whether node has a diagnostic other than the missing type descriptor that the parser reports
+// for the empty tuple type `[]`, which is a valid type descriptor.
+func hasSyntaxErrors(node tree.STNode) bool {
+	if node == nil || !node.HasDiagnostics() {
+		return false
+	}
+	if tuple, ok := node.(*tree.STTupleTypeDescriptorNode); ok && tuple.MemberTypeDesc.BucketCount() == 0 {
+		for _, diagnostic := range tuple.OpenBracketToken.Diagnostics() {
+			if diagnostic.DiagnosticCode().DiagnosticId() != common.ERROR_MISSING_TYPE_DESC.DiagnosticId() {
+				return true
+			}
+		}
+		return len(tuple.Diagnostics()) > 0 || hasSyntaxErrors(tuple.CloseBracketToken)
+	}
+	if _, ok := node.(tree.STToken); ok || len(node.Diagnostics()) > 0 {
+		return true
+	}
+	for i := 0; i < node.BucketCount(); i++ {
+		if hasSyntaxErrors(node.ChildInBucket(i)) {
+			return true
+		}
+	}
+	return false
+}
+
+// identifierName returns the name an identifier stands for, without the quote of a quoted identifier.
+func identifierName(token tree.Token) string {
+	return identifierutil.UnescapeBallerina(strings.TrimPrefix(token.Text(), "'"))
+}
+
+func sourceText(node tree.Node) string {
+	return strings.TrimSpace(tree.ToSourceCode(node.InternalNode()))
+}
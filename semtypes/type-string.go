@@ -0,0 +1,696 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semtypes
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"ballerina-lang-go/identifierutil"
+)
+
+// maxEnumeratedInts is the number of values from which an int range without a name is written as an intersection of
+// named ranges instead of by its values
+const maxEnumeratedInts = 8
+
+var basicTypeNames = map[int]string{
+	BT_NIL.Code:      "()",
+	BT_BOOLEAN.Code:  "boolean",
+	BT_INT.Code:      "int",
+	BT_FLOAT.Code:    "float",
+	BT_DECIMAL.Code:  "decimal",
+	BT_STRING.Code:   "string",
+	BT_ERROR.Code:    "error",
+	BT_TYPEDESC.Code: "typedesc",
+	BT_HANDLE.Code:   "handle",
+	BT_FUNCTION.Code: "function",
+	BT_REGEXP.Code:   "string:RegExp",
+	BT_FUTURE.Code:   "future",
+	BT_STREAM.Code:   "stream",
+	BT_LIST.Code:     "(any|error)[]",
+	BT_MAPPING.Code:  "map<any|error>",
+	BT_TABLE.Code:    "table<map<any|error>>",
+	BT_XML.Code:      "xml",
+	BT_OBJECT.Code:   "object",
+}
+
+var intRangeNames = []struct {
+	name     string
+	min, max int64
+}{
+	{"int:Signed8", -1 << 7, 1<<7 - 1},
+	{"int:Signed16", -1 << 15, 1<<15 - 1},
+	{"int:Signed32", -1 << 31, 1<<31 - 1},
+	{"int:Unsigned8", 0, 1<<8 - 1},
+	{"int:Unsigned16", 0, 1<<16 - 1},
+	{"int:Unsigned32", 0, 1<<32 - 1},
+}
+
+// ToTypeString returns t written in Ballerina type descriptor syntax, for use in diagnostics and hover. Subtypes of
+// a basic type that can't be written exactly, such as int ranges that are not an intersection of named ranges or the
+// negative atoms of a list type, are widened, and a recursive reference to a list, mapping or function type that is being written is shown as `...`.
+func ToTypeString(cx Context, t SemType) string {
+	anydata := CreateAnydata(cx)
+	json := CreateJson(cx)
+	s := &typeStringer{
+		cx: cx,
+		namedTypes: []namedType{
+			{"any", &ANY},
+			{"anydata", anydata},
+			{"json", json},
+			{"readonly", VAL_READONLY},
+			{"anydata & readonly", Intersect(anydata, VAL_READONLY)},
+			{"json & readonly", Intersect(json, VAL_READONLY)},
+		},
+		visiting: make(map[string]bool),
+	}
+	return s.typeString(t)
+}
+
+type namedType struct {
+	name string
+	ty   SemType
+}
+
+type typeStringer struct {
+	cx         Context
+	namedTypes []namedType
+	// visiting has the keys of the atoms being written
+	visiting map[string]bool
+}
+
+func (s *typeStringer) typeString(t SemType) string {
+	remaining := widenToBasicTypes(t).bitset & VT_MASK
+	if remaining == 0 {
+		return "never"
+	}
+	var parts []string
+	for _, named := range s.namedTypes {
+		bits := widenToBasicTypes(named.ty)
+		if remaining&bits.bitset != bits.bitset {
+			continue
+		}
+		if IsSameType(s.cx, Intersect(t, &bits), named.ty) {
+			parts = append(parts, named.name)
+			remaining &^= bits.bitset
+		}
+	}
+	for code := BT_BOOLEAN.Code; code < VT_COUNT; code++ {
+		if remaining&(1<<code) != 0 {
+			parts = appendUnique(parts, s.basicTypeParts(t, BasicTypeCodeFrom(code))...)
+		}
+	}
+	if remaining&NIL.bitset != 0 {
+		if len(parts) == 1 {
+			return parenthesize(parts[0], needsParens(parts[0])) + "?"
+		}
+		parts = append(parts, "()")
+	}
+	if len(parts) > 1 {
+		for i, part := range parts {
+			parts[i] = parenthesize(part, topLevelSyntax(part).returns)
+		}
+	}
+	return strings.Join(parts, "|")
+}
+
+// basicTypeParts returns the members of the union that is the subtype of t of the basic type code.
+func (s *typeStringer) basicTypeParts(t SemType, code BasicTypeCode) []string {
+	data := subtypeData(t, code)
+	if allOrNothing, ok := data.(AllOrNothingSubtype); ok {
+		if allOrNothing.IsAllSubtype() {
+			return []string{basicTypeNames[code.Code]}
+		}
+		return nil
+	}
+	switch code {
+	case BT_BOOLEAN:
+		return []string{strconv.FormatBool(BooleanSubtypeSingleValue(data).Get())}
+	case BT_INT:
+		return intParts(data.(IntSubtype))
+	case BT_FLOAT:
+		return floatParts(data.(FloatSubtype))
+	case BT_DECIMAL:
+		return decimalParts(data.(DecimalSubtype))
+	case BT_STRING:
+		return stringParts(data.(StringSubtype))
+	case BT_ERROR:
+		return s.errorParts(data.(Bdd))
+	case BT_FUNCTION:
+		return s.bddParts(BT_FUNCTION, data.(Bdd), func(atom Atom) string {
+			return s.functionAtomString(s.cx.functionAtomType(atom))
+		})
+	case BT_LIST:
+		return s.bddParts(BT_LIST, data.(Bdd), func(atom Atom) string {
+			return s.listAtomString(s.cx.listAtomType(atom))
+		})
+	case BT_MAPPING:
+		return s.bddParts(BT_MAPPING, data.(Bdd), func(atom Atom) string {
+			return s.mappingAtomString(s.cx.mappingAtomType(atom))
+		})
+	case BT_FUTURE, BT_TYPEDESC:
+		return []string{s.constrainedTypeString(code, data)}
+	case BT_STREAM:
+		return []string{s.streamString(data)}
+	case BT_TABLE:
+		return []string{s.tableString(data)}
+	case BT_XML:
+		return []string{s.xmlString(CreateBasicSemType(BT_XML, data))}
+	default:
+		return []string{basicTypeNames[code.Code]}
+	}
+}
+
+func intParts(data IntSubtype) []string {
+	var parts []string
+	for _, r := range data.Ranges {
+		if name := intRangeName(r); name != "" {
+			parts = append(parts, name)
+			continue
+		}
+		if uint64(r.Max)-uint64(r.Min) >= maxEnumeratedInts {
+			parts = append(parts, intRangeIntersection(r))
+			continue
+		}
+		for value := r.Min; ; value++ {
+			parts = append(parts, strconv.FormatInt(value, 10))
+			if value == r.Max {
+				break
+			}
+		}
+	}
+	return parts
+}
+
+// intRangeIntersection writes r as the intersection of at most two named ranges that is the smallest one containing r,
+// which is r itself if it can be written exactly, such as int:Signed8 & int:Unsigned8 for 0..127.
+func intRangeIntersection(r Range) string {
+	best := ""
+	bestSize := uint64(math.MaxUint64)
+	for i, named1 := range intRangeNames {
+		if named1.min > r.Min || named1.max < r.Max {
+			continue
+		}
+		for _, named2 := range intRangeNames[i:] {
+			if named2.min > r.Min || named2.max < r.Max {
+				continue
+			}
+			size := uint64(min(named1.max, named2.max)) - uint64(max(named1.min, named2.min))
+			if size >= bestSize {
+				continue
+			}
+			bestSize = size
+			if named1 == named2 {
+				best = named1.name
+			} else {
+				best = named1.name + " & " + named2.name
+			}
+		}
+	}
+	if best == "" {
+		return "int"
+	}
+	return best
+}
+
+func intRangeName(r Range) string {
+	for _, named := range intRangeNames {
+		if r.Min == named.min && r.Max == named.max {
+			return named.name
+		}
+	}
+	return ""
+}
+
+func floatParts(data FloatSubtype) []string {
+	if !data.allowed {
+		return []string{"float"}
+	}
+	parts := make([]string, len(data.values))
+	for i, value := range data.values {
+		parts[i] = floatLiteral(value.value)
+	}
+	return parts
+}
+
+func floatLiteral(value float64) string {
+	switch {
+	case value != value:
+		return "float:NaN"
+	case value > 0 && value*0.5 == value:
+		return "float:Infinity"
+	case value < 0 && value*0.5 == value:
+		return "-float:Infinity"
+	}
+	s := strconv.FormatFloat(value, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s
+}
+
+func decimalParts(data DecimalSubtype) []string {
+	if !data.allowed {
+		return []string{"decimal"}
+	}
+	parts := make([]string, len(data.values))
+	for i, value := range data.values {
+		parts[i] = decimalLiteral(value.value) + "d"
+	}
+	return parts
+}
+
+// decimalLiteral returns the shortest decimal representation of value, which is exact for the values of a decimal.
+func decimalLiteral(value big.Rat) string {
+	if value.IsInt() {
+		return value.Num().String()
+	}
+	scaled := new(big.Rat)
+	ten := big.NewRat(10, 1)
+	scaled.Set(&value)
+	for precision := 1; precision < 40; precision++ {
+		scaled.Mul(scaled, ten)
+		if scaled.IsInt() {
+			return value.FloatString(precision)
+		}
+	}
+	return value.FloatString(34)
+}
+
+func stringParts(data StringSubtype) []string {
+	chars := data.charData
+	nonChars := data.nonCharData
+	switch {
+	case chars.Allowed() && nonChars.Allowed():
+		return stringLiterals(slices.Concat(chars.Values(), nonChars.Values()))
+	case !chars.Allowed() && len(chars.Values()) == 0 && nonChars.Allowed():
+		return append([]string{"string:Char"}, stringLiterals(nonChars.Values())...)
+	default:
+		return []string{"string"}
+	}
+}
+
+func stringLiterals(values []EnumerableType[string]) []string {
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = value.Value()
+	}
+	sort.Strings(result)
+	for i, value := range result {
+		result[i] = stringLiteral(value)
+	}
+	return result
+}
+
+func stringLiteral(value string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range value {
+		switch r {
+		case '"':
+			sb.WriteString(`\"`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		default:
+			if unicode.IsPrint(r) {
+				sb.WriteRune(r)
+			} else {
+				fmt.Fprintf(&sb, `\u{%X}`, r)
+			}
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// bddParts writes the union of the intersections of the positive atoms of each path of bdd. The negative atoms are
+// left out, which widens the type.
+func (s *typeStringer) bddParts(code BasicTypeCode, bdd Bdd, atomString func(atom Atom) string) []string {
+	var parts []string
+	for _, atoms := range positiveAtoms(bdd) {
+		var conjuncts []string
+		readonly := false
+		for _, atom := range atoms {
+			if code != BT_FUNCTION && isReadonlyAtom(atom) {
+				readonly = true
+				continue
+			}
+			conjuncts = append(conjuncts, s.atomString(code, atom, atomString))
+		}
+		if len(conjuncts) == 0 {
+			conjuncts = append(conjuncts, basicTypeNames[code.Code])
+		}
+		if readonly {
+			conjuncts = append(conjuncts, "readonly")
+		}
+		parts = appendUnique(parts, intersectionString(conjuncts))
+	}
+	return parts
+}
+
+func (s *typeStringer) errorParts(bdd Bdd) []string {
+	var parts []string
+	for _, atoms := range positiveAtoms(bdd) {
+		distinct := false
+		var details []string
+		for _, atom := range atoms {
+			if recAtom, ok := atom.(*RecAtom); ok && recAtom.Kind() == Kind_DISTINCT_ATOM {
+				distinct = true
+				continue
+			}
+			if isReadonlyAtom(atom) {
+				continue
+			}
+			details = append(details, s.atomString(BT_ERROR, atom, func(atom Atom) string {
+				return s.mappingAtomString(s.cx.mappingAtomType(atom))
+			}))
+		}
+		part := "error"
+		if len(details) > 0 {
+			part += "<" + intersectionString(details) + ">"
+		}
+		if distinct {
+			part = "distinct " + part
+		}
+		parts = appendUnique(parts, part)
+	}
+	return parts
+}
+
+func positiveAtoms(bdd Bdd) [][]Atom {
+	var paths []BddPath
+	BddPaths(bdd, &paths, BddPathFrom())
+	result := make([][]Atom, len(paths))
+	for i, path := range paths {
+		result[i] = path.pos
+	}
+	return result
+}
+
+// isReadonlyAtom reports whether atom is the atom that list and mapping types are intersected with to make them
+// readonly.
+func isReadonlyAtom(atom Atom) bool {
+	recAtom, ok := atom.(*RecAtom)
+	return ok && recAtom.Kind() != Kind_DISTINCT_ATOM && recAtom.Index() == BDD_REC_ATOM_READONLY
+}
+
+// atomString writes atom with atomString, unless atom is being written, which means the type is recursive.
+func (s *typeStringer) atomString(code BasicTypeCode, atom Atom, atomString func(atom Atom) string) string {
+	key := fmt.Sprintf("%d:%T:%d", code.Code, atom, atom.Index())
+	if s.visiting[key] {
+		return "..."
+	}
+	s.visiting[key] = true
+	defer delete(s.visiting, key)
+	return atomString(atom)
+}
+
+func (s *typeStringer) listAtomString(atomicType *ListAtomicType) string {
+	members := atomicType.Members
+	rest := CellInnerVal(atomicType.Rest)
+	if members.FixedLength == 0 {
+		if IsNever(rest) {
+			return "[]"
+		}
+		return s.memberString(rest) + "[]"
+	}
+	if IsNever(rest) && len(members.Initial) == 1 && members.FixedLength > 1 {
+		return s.memberString(CellInnerVal(members.Initial[0])) + "[" + strconv.Itoa(members.FixedLength) + "]"
+	}
+	return "[" + strings.Join(s.listMemberStrings(atomicType), ", ") + "]"
+}
+
+// listMemberStrings returns the members of a tuple, followed by its rest type if it has one.
+func (s *typeStringer) listMemberStrings(atomicType *ListAtomicType) []string {
+	members := atomicType.Members
+	result := make([]string, 0, members.FixedLength+1)
+	for i := 0; i < members.FixedLength; i++ {
+		result = append(result, s.typeString(listMemberInnerVal(members, i)))
+	}
+	if rest := CellInnerVal(atomicType.Rest); !IsNever(rest) {
+		result = append(result, s.memberString(rest)+"...")
+	}
+	return result
+}
+
+// listMemberInnerVal returns the type of the member i of members, whose trailing members of the same type are
+// represented by the last of them.
+func listMemberInnerVal(members FixedLengthArray, i int) SemType {
+	return CellInnerVal(members.Initial[min(i, len(members.Initial)-1)])
+}
+
+func (s *typeStringer) mappingAtomString(atomicType *MappingAtomicType) string {
+	rest := CellInnerVal(atomicType.Rest)
+	if len(atomicType.Names) == 0 {
+		if IsNever(rest) {
+			return "record {||}"
+		}
+		return "map<" + s.typeString(rest) + ">"
+	}
+	open := IsSameType(s.cx, rest, s.namedTypes[1].ty)
+	var sb strings.Builder
+	if open {
+		sb.WriteString("record {")
+	} else {
+		sb.WriteString("record {|")
+	}
+	for i, name := range atomicType.Names {
+		field := cellAtomicType(atomicType.Types[i])
+		sb.WriteString(" ")
+		if field.Mut == CellMutability_CELL_MUT_NONE {
+			sb.WriteString("readonly ")
+		}
+		sb.WriteString(s.typeString(Diff(field.Ty, &UNDEF)))
+		sb.WriteString(" ")
+		sb.WriteString(identifierutil.QuoteIdentifier(name))
+		if !IsNever(Intersect(field.Ty, &UNDEF)) {
+			sb.WriteString("?")
+		}
+		sb.WriteString(";")
+	}
+	if open {
+		sb.WriteString(" }")
+		return sb.String()
+	}
+	if !IsNever(rest) {
+		sb.WriteString(" " + s.memberString(rest) + "...;")
+	}
+	sb.WriteString(" |}")
+	return sb.String()
+}
+
+func (s *typeStringer) functionAtomString(atomicType *FunctionAtomicType) string {
+	var sb strings.Builder
+	if atomicType.Qualifiers != nil {
+		if qualifiers := listAtomicType(s.cx, atomicType.Qualifiers); qualifiers != nil &&
+			qualifiers.Members.FixedLength == 2 {
+			if IsSubtype(s.cx, listMemberInnerVal(qualifiers.Members, 0), BooleanConst(true)) {
+				sb.WriteString("isolated ")
+			}
+			if !IsSubtype(s.cx, listMemberInnerVal(qualifiers.Members, 1), BooleanConst(false)) {
+				sb.WriteString("transactional ")
+			}
+		}
+	}
+	params := listAtomicType(s.cx, atomicType.ParamType)
+	if params == nil {
+		sb.WriteString("function")
+		return sb.String()
+	}
+	sb.WriteString("function (")
+	sb.WriteString(strings.Join(s.listMemberStrings(params), ", "))
+	sb.WriteString(")")
+	if !IsSameType(s.cx, atomicType.RetType, &NIL) {
+		sb.WriteString(" returns ")
+		sb.WriteString(s.typeString(atomicType.RetType))
+	}
+	return sb.String()
+}
+
+// constrainedTypeString writes a future or typedesc type, whose subtype data is a mapping whose rest type is the
+// type parameter.
+func (s *typeStringer) constrainedTypeString(code BasicTypeCode, data SubtypeData) string {
+	atomicType := mappingAtomicType(s.cx, CreateBasicSemType(BT_MAPPING, data))
+	if atomicType == nil {
+		return basicTypeNames[code.Code]
+	}
+	return basicTypeNames[code.Code] + "<" + s.typeString(CellInnerVal(atomicType.Rest)) + ">"
+}
+
+// streamString writes a stream type, whose subtype data is a tuple of the value and completion types.
+func (s *typeStringer) streamString(data SubtypeData) string {
+	atomicType := listAtomicType(s.cx, CreateBasicSemType(BT_LIST, data))
+	if atomicType == nil || atomicType.Members.FixedLength != 2 {
+		return "stream"
+	}
+	value := s.typeString(listMemberInnerVal(atomicType.Members, 0))
+	completion := listMemberInnerVal(atomicType.Members, 1)
+	if IsSameType(s.cx, completion, &NIL) {
+		return "stream<" + value + ">"
+	}
+	return "stream<" + value + ", " + s.typeString(completion) + ">"
+}
+
+// tableString writes a table type, whose subtype data is a tuple of an array of the constraint, the key constraint
+// and the tuple of the names of the key fields.
+func (s *typeStringer) tableString(data SubtypeData) string {
+	atomicType := listAtomicType(s.cx, CreateBasicSemType(BT_LIST, data))
+	if atomicType == nil || atomicType.Members.FixedLength != 3 {
+		return basicTypeNames[BT_TABLE.Code]
+	}
+	rows := listAtomicType(s.cx, listMemberInnerVal(atomicType.Members, 0))
+	if rows == nil {
+		return basicTypeNames[BT_TABLE.Code]
+	}
+	result := "table<" + s.typeString(CellInnerVal(rows.Rest)) + ">"
+	keyConstraint := listMemberInnerVal(atomicType.Members, 1)
+	keySpecifier := listMemberInnerVal(atomicType.Members, 2)
+	if !IsSameType(s.cx, keySpecifier, &VAL) {
+		if keyNames := listAtomicType(s.cx, keySpecifier); keyNames != nil {
+			names := make([]string, keyNames.Members.FixedLength)
+			for i := range names {
+				name := StringSubtypeSingleValue(subtypeData(listMemberInnerVal(keyNames.Members, i), BT_STRING))
+				if name.IsEmpty() {
+					return result
+				}
+				names[i] = identifierutil.QuoteIdentifier(name.Get())
+			}
+			return result + " key(" + strings.Join(names, ", ") + ")"
+		}
+	}
+	if !IsSameType(s.cx, keyConstraint, &VAL) {
+		return result + " key<" + s.typeString(keyConstraint) + ">"
+	}
+	return result
+}
+
+var xmlSingletonNames = []namedType{
+	{"xml:Element", XML_ELEMENT},
+	{"xml:Comment", XML_COMMENT},
+	{"xml:ProcessingInstruction", XML_PI},
+	{"xml:Text", XML_TEXT},
+}
+
+// xmlString writes an xml type as a union of the xml singleton types or as a sequence of them.
+func (s *typeStringer) xmlString(t SemType) string {
+	var names []string
+	var singletons SemType = &NEVER
+	for _, singleton := range xmlSingletonNames {
+		if IsSubtype(s.cx, singleton.ty, t) {
+			names = append(names, singleton.name)
+			singletons = Union(singletons, singleton.ty)
+		}
+	}
+	switch {
+	case len(names) == 0:
+		return "xml"
+	case IsSameType(s.cx, singletons, t):
+		return strings.Join(names, "|")
+	case IsSameType(s.cx, XmlSequence(singletons), t):
+		return "xml<" + strings.Join(names, "|") + ">"
+	default:
+		return "xml"
+	}
+}
+
+// memberString writes t as a member of an array, an optional type or a rest type.
+func (s *typeStringer) memberString(t SemType) string {
+	str := s.typeString(t)
+	return parenthesize(str, needsParens(str))
+}
+
+func intersectionString(conjuncts []string) string {
+	if len(conjuncts) > 1 {
+		for i, conjunct := range conjuncts {
+			syntax := topLevelSyntax(conjunct)
+			conjuncts[i] = parenthesize(conjunct, syntax.union || syntax.returns)
+		}
+	}
+	return strings.Join(conjuncts, " & ")
+}
+
+type typeSyntax struct {
+	union, intersection, returns bool
+}
+
+// topLevelSyntax reports the operators of a type descriptor that are not nested in brackets or string literals.
+func topLevelSyntax(str string) typeSyntax {
+	var syntax typeSyntax
+	depth := 0
+	inString := false
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if inString {
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+			continue
+		}
+		switch c {
+		case '\\':
+			i++
+		case '"':
+			inString = true
+		case '(', '[', '{', '<':
+			depth++
+		case ')', ']', '}', '>':
+			depth--
+		case '|':
+			syntax.union = syntax.union || depth == 0
+		case '&':
+			syntax.intersection = syntax.intersection || depth == 0
+		case ' ':
+			syntax.returns = syntax.returns || depth == 0 && strings.HasPrefix(str[i:], " returns ")
+		}
+	}
+	return syntax
+}
+
+func needsParens(str string) bool {
+	syntax := topLevelSyntax(str)
+	return syntax.union || syntax.intersection || syntax.returns
+}
+
+func parenthesize(str string, parens bool) string {
+	if parens {
+		return "(" + str + ")"
+	}
+	return str
+}
+
+func appendUnique(parts []string, newParts ...string) []string {
+	for _, part := range newParts {
+		if !slices.Contains(parts, part) {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semtypes
+
+import "testing"
+
+// TestTypeCheckStatsPerContext tests that the counters of a Context count only the checks done with it
+func TestTypeCheckStatsPerContext(t *testing.T) {
+	env := NewEnv()
+	cx1 := ContextFrom(env)
+	cx2 := ContextFrom(env)
+	t1 := createTupleType(env, &INT, Union(&STRING, &NIL))
+	t2 := createTupleType(env, Union(&INT, &STRING), &ANY)
+
+	assertTrue(t, IsSubtype(cx1, t1, t2))
+	stats1 := StatsOf(cx1)
+	assertTrue(t, stats1.BddNodes > 0)
+	assertTrue(t, stats1.MemoMisses > 0)
+	assertEqual(t, StatsOf(cx2).BddNodes, 0)
+	assertEqual(t, StatsOf(cx2).MemoMisses, 0)
+
+	// Checking the same types again finds the nodes interned by the first check
+	assertTrue(t, IsSubtype(cx1, t1, t2))
+	assertEqual(t, StatsOf(cx1).Sub(stats1).BddNodes, 0)
+}
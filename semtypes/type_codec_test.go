@@ -0,0 +1,180 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semtypes
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const codecTestSource = `
+type IntList ()|[int, IntList];
+type Json ()|boolean|int|float|decimal|string|Json[]|map<Json>;
+type Person record {| string name; int age?; Person? parent; |};
+type Tree record { Tree[] children; };
+type Singletons 1|-2|2.5|1.5d|"a"|"hello"|true;
+type Tuple [int, string, float...];
+type Bytes byte[4];
+type ReadonlyList int[] & readonly;
+type Elements xml<xml:Element>;
+type Err error<map<int>>;
+type Fut future<int>;
+type PersonDesc typedesc<Person>;
+type Ints stream<int, error?>;
+`
+
+var codecTestNames = []string{"IntList", "Json", "Person", "Tree", "Singletons", "Tuple", "Bytes", "ReadonlyList",
+	"Elements", "Err", "Fut", "PersonDesc", "Ints"}
+
+// codecTestTypes builds the same types in any env, so that the types decoded into an env can be compared with them.
+func codecTestTypes(t *testing.T, env Env) []TypeDefinition {
+	p := NewTypeParser(env)
+	if err := p.DefineTypes(codecTestSource); err != nil {
+		t.Fatalf("failed to define types: %v", err)
+	}
+	var defns []TypeDefinition
+	for _, name := range codecTestNames {
+		ty, err := p.Lookup(name)
+		if err != nil {
+			t.Fatalf("failed to look up type: %v", err)
+		}
+		defns = append(defns, TypeDefinition{Name: name, Type: ty})
+	}
+	fd := NewFunctionDefinition()
+	function := fd.Define(env, testTuple(env, &INT, &STRING), &BOOLEAN, FunctionQualifiersFrom(env, true, false))
+	person := defns[2].Type
+	return append(defns,
+		TypeDefinition{Name: "Function", Type: function},
+		TypeDefinition{Name: "People", Type: TableContaining(env, person)},
+		TypeDefinition{Name: "Mixed", Type: UnionWithSemTypeSemTypesSemType(&INT, defns[0].Type, function)})
+}
+
+func TestTypeCodecRoundTrip(t *testing.T) {
+	env := NewEnv()
+	defns := codecTestTypes(t, env)
+	data, err := EncodeTypeDefinitions(env, defns)
+	if err != nil {
+		t.Fatalf("failed to encode types: %v", err)
+	}
+
+	for _, targetEnv := range []Env{env, NewEnv()} {
+		decoded, err := DecodeTypeDefinitions(targetEnv, data)
+		if err != nil {
+			t.Fatalf("failed to decode types: %v", err)
+		}
+		expected := defns
+		if targetEnv != env {
+			expected = codecTestTypes(t, targetEnv)
+		}
+		cx := ContextFrom(targetEnv)
+		assertEqual(t, len(decoded), len(expected))
+		for i, defn := range decoded {
+			assertEqual(t, defn.Name, expected[i].Name)
+			assertTrue(t, IsSameType(cx, defn.Type, expected[i].Type), "%s decoded as %s", defn.Name,
+				ToTypeString(cx, defn.Type))
+		}
+
+		reencoded, err := EncodeTypeDefinitions(targetEnv, decoded)
+		if err != nil {
+			t.Fatalf("failed to encode decoded types: %v", err)
+		}
+		assertTrue(t, bytes.Equal(data, reencoded), "encoding of the decoded types differs")
+	}
+}
+
+func TestTypeCodecSemType(t *testing.T) {
+	env := NewEnv()
+	cx := ContextFrom(env)
+	for _, ty := range []SemType{&NEVER, &INT, VAL_READONLY, CreateJson(cx), StringConst("abc"), XML_TEXT} {
+		data, err := EncodeSemType(env, ty)
+		if err != nil {
+			t.Fatalf("failed to encode type: %v", err)
+		}
+		decodedEnv := NewEnv()
+		decoded, err := DecodeSemType(decodedEnv, data)
+		if err != nil {
+			t.Fatalf("failed to decode type: %v", err)
+		}
+		roundTripped, err := DecodeSemType(env, data)
+		if err != nil {
+			t.Fatalf("failed to decode type: %v", err)
+		}
+		assertTrue(t, IsSameType(cx, roundTripped, ty), "%s decoded as %s", ToTypeString(cx, ty),
+			ToTypeString(cx, roundTripped))
+		assertEqual(t, ToTypeString(ContextFrom(decodedEnv), decoded), ToTypeString(cx, ty))
+	}
+}
+
+func TestTypeCodecDistinctTypes(t *testing.T) {
+	env := NewEnv()
+	p := NewTypeParser(env)
+	if err := p.DefineTypes("type E distinct error;\ntype Es E[];"); err != nil {
+		t.Fatalf("failed to define types: %v", err)
+	}
+	e, _ := p.Lookup("E")
+	es, _ := p.Lookup("Es")
+	data, err := EncodeTypeDefinitions(env, []TypeDefinition{{"E", e}, {"Es", es}})
+	if err != nil {
+		t.Fatalf("failed to encode types: %v", err)
+	}
+
+	targetEnv := NewEnv()
+	cx := ContextFrom(targetEnv)
+	decoded, err := DecodeTypeDefinitions(targetEnv, data)
+	if err != nil {
+		t.Fatalf("failed to decode types: %v", err)
+	}
+	decodedE := decoded[0].Type
+	ld := NewListDefinition()
+	assertTrue(t, IsSameType(cx, decoded[1].Type, ld.DefineListTypeWrappedWithEnvSemType(targetEnv, decodedE)))
+	assertSemTypeRelation(t, cx, decodedE, &ERROR, RelationSubtype)
+	otherE := Intersect(ErrorDistinct(NextDistinctId(targetEnv)), &ERROR)
+	assertSemTypeRelation(t, cx, decodedE, otherE, RelationNoRelation)
+
+	again, err := DecodeTypeDefinitions(targetEnv, data)
+	if err != nil {
+		t.Fatalf("failed to decode types: %v", err)
+	}
+	assertSemTypeRelation(t, cx, decodedE, again[0].Type, RelationNoRelation)
+}
+
+func TestTypeCodecErrors(t *testing.T) {
+	env := NewEnv()
+	ld := NewListDefinition()
+	_, err := EncodeSemType(env, ld.GetSemType(env))
+	if err == nil || !strings.Contains(err.Error(), "undefined recursive list type") {
+		t.Errorf("expected an undefined type error, got %v", err)
+	}
+
+	data, err := EncodeTypeDefinitions(env, codecTestTypes(t, env))
+	if err != nil {
+		t.Fatalf("failed to encode types: %v", err)
+	}
+	_, err = DecodeTypeDefinitions(env, []byte("BAD!"))
+	assertEqual(t, err.Error(), "invalid type encoding: bad magic")
+	_, err = DecodeSemType(env, data)
+	assertEqual(t, err.Error(), "expected a single type, found 16")
+	// Every truncation of the data must be rejected rather than decoded into a broken type
+	for i := 0; i < len(data); i++ {
+		if _, err := DecodeTypeDefinitions(NewEnv(), data[:i]); err == nil {
+			t.Fatalf("expected an error decoding %d of %d bytes", i, len(data))
+		}
+	}
+	_, err = DecodeTypeDefinitions(env, append(data, 0))
+	assertEqual(t, err.Error(), "invalid type encoding: unexpected data after the types")
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semtypes
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestParseType(t *testing.T) {
+	env := GetTypeEnv()
+	cx := ContextFrom(env)
+
+	record := func(rest SemType, fields ...Field) SemType {
+		md := NewMappingDefinition()
+		return md.DefineMappingTypeWrapped(env, fields, rest)
+	}
+	array := func(member SemType) SemType {
+		ld := NewListDefinition()
+		return ld.DefineListTypeWrappedWithEnvSemType(env, member)
+	}
+	fixedArray := func(member SemType, length int) SemType {
+		ld := NewListDefinition()
+		return ld.DefineListTypeWrappedWithEnvSemTypesInt(env, []SemType{member}, length)
+	}
+	tupleWithRest := func(rest SemType, members ...SemType) SemType {
+		ld := NewListDefinition()
+		return ld.DefineListTypeWrappedWithEnvSemTypesSemType(env, members, rest)
+	}
+
+	function := func(ret SemType, params ...SemType) SemType {
+		ld := NewListDefinition()
+		fd := NewFunctionDefinition()
+		args := ld.DefineListTypeWrapped(env, params, len(params), &NEVER, CellMutability_CELL_MUT_NONE)
+		return fd.Define(env, args, ret, FunctionQualifiersFrom(env, false, false))
+	}
+
+	tests := []struct {
+		source   string
+		expected SemType
+	}{
+		{"int", &INT},
+		{"()", &NIL},
+		{"byte", BYTE},
+		{"int:Signed16", IntWidthSigned(16)},
+		{"string:Char", STRING_CHAR},
+		{"int?", Union(&INT, &NIL)},
+		{"int|string|boolean", UnionWithSemTypeSemTypesSemType(&INT, &STRING, &BOOLEAN)},
+		{"(int|string) & string", &STRING},
+		{"json", CreateJson(cx)},
+		{"anydata & readonly", Intersect(CreateAnydata(cx), VAL_READONLY)},
+		{`1|-2|0x10|"a"|true|2.5|-1.5d|()`, UnionWithSemTypeSemTypesSemType(IntConst(1), IntConst(-2), IntConst(16),
+			StringConst("a"), BooleanConst(true), FloatConst(2.5), DecimalConstFromStringValue("-1.5"), &NIL)},
+		{`"\u{61}"`, StringConst("a")},
+		{"int[]", array(&INT)},
+		{"int[2]", fixedArray(&INT, 2)},
+		{"int[2][]", fixedArray(array(&INT), 2)},
+		{"[int, string]", testTuple(env, &INT, &STRING)},
+		{"[int, string...]", tupleWithRest(&STRING, &INT)},
+		{"[]", testTuple(env)},
+		{"[][]", array(testTuple(env))},
+		{"map<int>", record(&INT)},
+		{"record {| int a; string...; |}", record(&STRING, FieldFrom("a", &INT, false, false))},
+		{"record { int a?; }", record(CreateAnydata(cx), FieldFrom("a", &INT, false, true))},
+		{"record {| readonly int 'b; |}", record(&NEVER, FieldFrom("b", &INT, true, false))},
+		{"error<map<int>>", ErrorDetail(record(&INT))},
+		{"xml<xml:Element>", XmlSequence(XML_ELEMENT)},
+		{"future<int>", FutureContaining(env, &INT)},
+		{"typedesc<int>", TypedescContaining(env, &INT)},
+		{"function", &FUNCTION},
+		{"function (int) returns string", function(&STRING, &INT)},
+		{"function (int a, string b)", function(&NIL, &INT, &STRING)},
+		{"table<map<int>>", TableContaining(env, record(&INT))},
+		{"table<record {| int id; |}> key(id)", TableContainingKeySpecifier(cx,
+			record(&NEVER, FieldFrom("id", &INT, false, false)), []string{"id"})},
+		{"table<map<int>> key<string>", TableContainingKeyConstraint(cx, record(&INT), &STRING)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			ty, err := ParseType(env, tt.source)
+			if err != nil {
+				t.Fatalf("failed to parse type: %v", err)
+			}
+			assertTrue(t, IsSameType(cx, ty, tt.expected), "parsed %s", ToTypeString(cx, ty))
+		})
+	}
+}
+
+func TestParseTypeErrors(t *testing.T) {
+	env := GetTypeEnv()
+	tests := []struct {
+		source   string
+		expected string
+	}{
+		{"int|", "invalid type descriptor: int|"},
+		{"int string", "invalid type descriptor: int string"},
+		{"Foo", "unknown type 'Foo'"},
+		{"foo:Bar", "unsupported type reference: 'foo:Bar'"},
+		{"record {| int a; int a; |}", "redeclared symbol 'a'"},
+		{"distinct int", "unsupported distinct type: distinct int"},
+		{"[int, ]", "invalid type descriptor: [int, ]"},
+		{"table<int>", "invalid table constraint: int"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			_, err := ParseType(env, tt.source)
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			assertEqual(t, err.Error(), tt.expected)
+		})
+	}
+}
+
+func TestTypeParserBindings(t *testing.T) {
+	env := GetTypeEnv()
+	p := NewTypeParser(env)
+	cx := p.Context()
+	p.Bind("Small", IntConst(1))
+	err := p.DefineTypes(`
+type Pair [Small, Small];
+type IntList ()|[int, IntList];
+`)
+	if err != nil {
+		t.Fatalf("failed to define types: %v", err)
+	}
+	pair, err := p.Parse("Pair")
+	if err != nil {
+		t.Fatalf("failed to parse type: %v", err)
+	}
+	assertTrue(t, IsSameType(cx, pair, testTuple(env, IntConst(1), IntConst(1))))
+	intList, err := p.Lookup("IntList")
+	if err != nil {
+		t.Fatalf("failed to look up type: %v", err)
+	}
+	assertTrue(t, IsSubtype(cx, testTuple(env, &INT, &NIL), intList))
+	assertFalse(t, IsSubtype(cx, testTuple(env, &STRING, &NIL), intList))
+
+	for _, source := range []string{"type A A|int;", "type A B;\ntype B A;"} {
+		p := NewTypeParser(env)
+		if err := p.DefineTypes(source); err != nil {
+			t.Fatalf("failed to define types: %v", err)
+		}
+		_, err := p.Parse("A")
+		if err == nil || !strings.HasPrefix(err.Error(), "invalid cyclic type reference") {
+			t.Errorf("expected an invalid cycle error for %q, got %v", source, err)
+		}
+	}
+}
+
+var typeAssertionPattern = regexp.MustCompile(`^//\s*@type\s+(\S+)\s+(<>|<|=)\s+(\S+)\s*$`)
+
+// TestTypeParserSourceFiles checks the `// @type T1 < T2` style assertions of the test source files against the
+// types defined in the same files.
+func TestTypeParserSourceFiles(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "type-parser", "*.bal"))
+	if err != nil {
+		t.Fatalf("failed to list test files: %v", err)
+	}
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatalf("failed to read test file: %v", err)
+			}
+			p := NewTypeParser(GetTypeEnv())
+			if err := p.DefineTypes(string(content)); err != nil {
+				t.Fatalf("failed to define types: %v", err)
+			}
+			for _, line := range strings.Split(string(content), "\n") {
+				match := typeAssertionPattern.FindStringSubmatch(strings.TrimSpace(line))
+				if match == nil {
+					continue
+				}
+				t1, err := p.Lookup(match[1])
+				if err != nil {
+					t.Fatalf("%s: %v", line, err)
+				}
+				t2, err := p.Lookup(match[3])
+				if err != nil {
+					t.Fatalf("%s: %v", line, err)
+				}
+				t.Run(match[0], func(t *testing.T) {
+					assertSemTypeRelation(t, p.Context(), t1, t2, Relation(match[2]))
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semtypes
+
+import (
+	"testing"
+)
+
+func TestToTypeString(t *testing.T) {
+	env := GetTypeEnv()
+	cx := ContextFrom(env)
+
+	array := func(member SemType) SemType {
+		ld := NewListDefinition()
+		return ld.DefineListTypeWrappedWithEnvSemType(env, member)
+	}
+	fixedArray := func(member SemType, length int) SemType {
+		ld := NewListDefinition()
+		return ld.DefineListTypeWrappedWithEnvSemTypesInt(env, []SemType{member}, length)
+	}
+	tupleWithRest := func(rest SemType, members ...SemType) SemType {
+		ld := NewListDefinition()
+		return ld.DefineListTypeWrappedWithEnvSemTypesSemType(env, members, rest)
+	}
+	mapping := func(rest SemType, fields ...Field) SemType {
+		md := NewMappingDefinition()
+		return md.DefineMappingTypeWrapped(env, fields, rest)
+	}
+	function := func(ret SemType, isolated bool, rest SemType, params ...SemType) SemType {
+		ld := NewListDefinition()
+		args := ld.DefineListTypeWrappedWithEnvSemTypesSemType(env, params, rest)
+		fd := NewFunctionDefinition()
+		return fd.Define(env, args, ret, FunctionQualifiersFrom(env, isolated, false))
+	}
+
+	tests := []struct {
+		name     string
+		ty       SemType
+		expected string
+	}{
+		{"never", &NEVER, "never"},
+		{"nil", &NIL, "()"},
+		{"basic union", Union(&INT, &STRING), "int|string"},
+		{"optional", Union(&INT, &NIL), "int?"},
+		{"optional union", UnionWithSemTypeSemTypesSemType(&INT, &STRING, &NIL), "int|string|()"},
+		{"any or error", &VAL, "any|error"},
+		{"int singletons", Union(IntConst(1), IntConst(3)), "1|3"},
+		{"int range", IntWidthUnsigned(8), "int:Unsigned8"},
+		{"signed int range", IntWidthSigned(16), "int:Signed16"},
+		{"int ranges", Union(IntWidthUnsigned(8), IntConst(1000)), "int:Unsigned8|1000"},
+		{"unnamed int range", Intersect(IntWidthUnsigned(8), IntWidthSigned(8)), "int:Signed8 & int:Unsigned8"},
+		{"widened int range", Intersect(IntWidthSigned(8), Diff(&INT, IntWidthUnsigned(8))), "int:Signed8"},
+		{"unbounded int range", Diff(&INT, IntWidthUnsigned(8)), "int"},
+		{"boolean singleton", BooleanConst(false), "false"},
+		{"float singletons", Union(FloatConst(1), FloatConst(2.5)), "1.0|2.5"},
+		{"decimal singleton", DecimalConstFromStringValue("1.25"), "1.25d"},
+		{"string singletons", Union(StringConst("b"), StringConst("a\"c")), `"a\"c"|"b"`},
+		{"string char", STRING_CHAR, "string:Char"},
+		{"mixed singletons", Union(IntConst(1), StringConst("one")), `1|"one"`},
+		{"json", CreateJson(cx), "json"},
+		{"json or error", Union(CreateJson(cx), &ERROR), "json|error"},
+		{"anydata", CreateAnydata(cx), "anydata"},
+		{"any", &ANY, "any"},
+		{"readonly", VAL_READONLY, "readonly"},
+		{"anydata and readonly", Intersect(CreateAnydata(cx), VAL_READONLY), "anydata & readonly"},
+		{"array", array(&INT), "int[]"},
+		{"array of union", array(Union(&INT, &STRING)), "(int|string)[]"},
+		{"array of json", array(CreateJson(cx)), "json[]"},
+		{"nested array", array(array(&STRING)), "string[][]"},
+		{"fixed length array", fixedArray(&INT, 3), "int[3]"},
+		{"tuple", testTuple(env, &INT, &STRING), "[int, string]"},
+		{"tuple with rest", tupleWithRest(&BOOLEAN, &INT), "[int, boolean...]"},
+		{"readonly array", Intersect(array(&INT), VAL_READONLY), "int[] & readonly"},
+		{"map", mapping(&STRING), "map<string>"},
+		{"open record", mapping(CreateAnydata(cx), FieldFrom("b", &STRING, false, true), FieldFrom("a", &INT, false, false)),
+			"record { int a; string b?; }"},
+		{"closed record", mapping(&NEVER, FieldFrom("id", &INT, true, false)), "record {| readonly int id; |}"},
+		{"record with rest", mapping(&STRING, FieldFrom("type", &INT, false, false)),
+			"record {| int 'type; string...; |}"},
+		{"readonly record", Intersect(mapping(&NEVER, FieldFrom("x", &FLOAT, false, false)), VAL_READONLY),
+			"record {| float x; |} & readonly"},
+		{"function", function(&INT, false, &NEVER, &STRING, &BOOLEAN), "function (string, boolean) returns int"},
+		{"isolated function", function(&NIL, true, &INT), "isolated function (int...)"},
+		{"optional function", Union(function(&INT, false, &NEVER), &NIL), "(function () returns int)?"},
+		{"function top", &FUNCTION, "function"},
+		{"error", &ERROR, "error"},
+		{"xml element", XML_ELEMENT, "xml:Element"},
+		{"xml sequence", XmlSequence(XML_ELEMENT), "xml<xml:Element>"},
+		{"future", FutureContaining(env, &INT), "future<int>"},
+		{"typedesc", TypedescContaining(env, &STRING), "typedesc<string>"},
+		{"table", TableContaining(env, mapping(&INT)), "table<map<int>>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertEqual(t, ToTypeString(cx, tt.ty), tt.expected)
+		})
+	}
+}
+
+func TestToTypeStringRecursive(t *testing.T) {
+	env := GetTypeEnv()
+	cx := ContextFrom(env)
+	ld := NewListDefinition()
+	list := ld.GetSemType(env)
+	ty := Union(&INT, list)
+	ld.DefineListTypeWrappedWithEnvSemType(env, ty)
+	assertEqual(t, ToTypeString(cx, ty), "int|(int|...)[]")
+}
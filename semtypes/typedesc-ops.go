@@ -17,7 +17,7 @@
 package semtypes
 
 type TypedescOps struct {
-	CommonOps
+	CommonOpsBase
 }
 
 var _ BasicTypeOps = &TypedescOps{}
@@ -16,6 +16,15 @@
 
 package semtypes
 
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"ballerina-lang-go/identifierutil"
+)
+
 type Value struct {
 	Value any
 }
@@ -29,3 +38,42 @@ func newValue(value any) Value {
 func ValueFrom(value any) Value {
 	return newValue(value)
 }
+
+// String returns the value written in Ballerina literal syntax. An OpaqueShape is written as its type in angle
+// brackets.
+func (v Value) String() string {
+	switch value := v.Value.(type) {
+	case nil:
+		return "()"
+	case bool:
+		return strconv.FormatBool(value)
+	case int64:
+		return strconv.FormatInt(value, 10)
+	case float64:
+		return floatLiteral(value)
+	case big.Rat:
+		return decimalLiteral(value) + "d"
+	case string:
+		return stringLiteral(value)
+	case ListShape:
+		members := make([]string, len(value.Members))
+		for i, member := range value.Members {
+			members[i] = member.String()
+		}
+		return "[" + strings.Join(members, ", ") + "]"
+	case MappingShape:
+		fields := make([]string, len(value.Names))
+		for i, name := range value.Names {
+			if identifierutil.IsPlainIdentifier(name) {
+				fields[i] = name + ": " + value.Values[i].String()
+			} else {
+				fields[i] = stringLiteral(name) + ": " + value.Values[i].String()
+			}
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	case OpaqueShape:
+		return "<" + value.Type + ">"
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
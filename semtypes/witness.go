@@ -0,0 +1,377 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semtypes
+
+import (
+	"math/big"
+	"slices"
+	"sort"
+)
+
+const (
+	// maxWitnessDepth bounds the nesting of the lists and mappings of a witness, so that the search terminates for
+	// recursive types
+	maxWitnessDepth = 16
+	// maxWitnessCandidates is the number of witnesses of a member type that are tried before giving up on a length of
+	// a list or on a field of a mapping
+	maxWitnessCandidates = 4
+)
+
+// ListShape is the shape of a list value found by Witness.
+type ListShape struct {
+	Members []Value
+}
+
+// MappingShape is the shape of a mapping value found by Witness. The fields are sorted by name.
+type MappingShape struct {
+	Names  []string
+	Values []Value
+}
+
+// OpaqueShape stands for a value of a basic type, such as error or function, whose values have no literal syntax,
+// or of a list or mapping type whose values Witness couldn't construct.
+type OpaqueShape struct {
+	// Type is the type the value belongs to, written in Ballerina syntax
+	Type string
+	ty   SemType
+}
+
+// Witness returns a value of t, or false if t is empty. Applied to Diff(t1, t2) it gives an example of a value of t1
+// that is not a value of t2. The Value of the result is nil, a bool, an int64, a float64, a big.Rat for a decimal, a
+// string, a ListShape, a MappingShape or an OpaqueShape.
+func Witness(cx Context, t SemType) (Value, bool) {
+	finder := &witnessFinder{cx: cx}
+	return finder.witness(t, 0)
+}
+
+// witnessTypeCodes are the basic types in the order they are searched for a witness: the types with literal syntax
+// come first.
+var witnessTypeCodes = []BasicTypeCode{
+	BT_NIL, BT_BOOLEAN, BT_INT, BT_FLOAT, BT_DECIMAL, BT_STRING, BT_LIST, BT_MAPPING,
+	BT_ERROR, BT_TYPEDESC, BT_HANDLE, BT_FUNCTION, BT_REGEXP, BT_FUTURE, BT_STREAM, BT_TABLE, BT_XML, BT_OBJECT,
+}
+
+type witnessFinder struct {
+	cx Context
+}
+
+func (w *witnessFinder) witness(t SemType, depth int) (Value, bool) {
+	if depth > maxWitnessDepth || IsEmpty(w.cx, t) {
+		return Value{}, false
+	}
+	for _, code := range witnessTypeCodes {
+		basicType := BasicType(code)
+		part := Intersect(t, &basicType)
+		if IsEmpty(w.cx, part) {
+			continue
+		}
+		data := subtypeData(part, code)
+		switch code {
+		case BT_NIL:
+			return ValueFrom(nil), true
+		case BT_BOOLEAN:
+			if isAllSubtype(data) {
+				return ValueFrom(true), true
+			}
+			return ValueFrom(BooleanSubtypeSingleValue(data).Get()), true
+		case BT_INT:
+			return ValueFrom(intWitness(data)), true
+		case BT_FLOAT:
+			return ValueFrom(floatWitness(data)), true
+		case BT_DECIMAL:
+			return ValueFrom(decimalWitness(data)), true
+		case BT_STRING:
+			return ValueFrom(stringWitness(data)), true
+		case BT_LIST:
+			if value, ok := w.listWitness(part, depth); ok {
+				return value, true
+			}
+		case BT_MAPPING:
+			if value, ok := w.mappingWitness(part, depth); ok {
+				return value, true
+			}
+		}
+		return ValueFrom(OpaqueShape{Type: ToTypeString(w.cx, part), ty: part}), true
+	}
+	return Value{}, false
+}
+
+// intWitness returns the value of an int subtype that is closest to zero.
+func intWitness(data SubtypeData) int64 {
+	if isAllSubtype(data) {
+		return 0
+	}
+	var result int64
+	for i, r := range data.(IntSubtype).Ranges {
+		value := r.Min
+		if r.Min <= 0 && r.Max >= 0 {
+			value = 0
+		} else if r.Max < 0 {
+			value = r.Max
+		}
+		if i == 0 || absInt(value) < absInt(result) {
+			result = value
+		}
+	}
+	return result
+}
+
+func absInt(value int64) uint64 {
+	if value < 0 {
+		return uint64(-(value + 1)) + 1
+	}
+	return uint64(value)
+}
+
+func floatWitness(data SubtypeData) float64 {
+	if subtype, ok := data.(FloatSubtype); ok && subtype.allowed {
+		return subtype.values[0].value
+	}
+	value := 0.0
+	for !FloatSubtypeContains(data, EnumerableFloatFrom(value)) {
+		value++
+	}
+	return value
+}
+
+func decimalWitness(data SubtypeData) big.Rat {
+	if subtype, ok := data.(DecimalSubtype); ok && subtype.allowed {
+		return subtype.values[0].value
+	}
+	for i := int64(0); ; i++ {
+		value := big.NewRat(i, 1)
+		if DecimalSubtypeContains(data, EnumerableDecimalFrom(*value)) {
+			return *value
+		}
+	}
+}
+
+func stringWitness(data SubtypeData) string {
+	if isAllSubtype(data) {
+		return ""
+	}
+	subtype := data.(StringSubtype)
+	chars := subtype.charData
+	nonChars := subtype.nonCharData
+	if chars.Allowed() && len(chars.Values()) > 0 {
+		return chars.Values()[0].Value()
+	}
+	if nonChars.Allowed() && len(nonChars.Values()) > 0 {
+		return nonChars.Values()[0].Value()
+	}
+	if !chars.Allowed() {
+		for c := 'a'; ; c++ {
+			if StringSubtypeContains(data, string(c)) {
+				return string(c)
+			}
+		}
+	}
+	if StringSubtypeContains(data, "") {
+		return ""
+	}
+	for c := 'a'; ; c++ {
+		if value := "a" + string(c); StringSubtypeContains(data, value) {
+			return value
+		}
+	}
+}
+
+// listWitness looks for a list of t of each length up to the longest length given by the atoms of t. The members are
+// chosen from first to last, each from the member type of the lists of t with the members chosen so far.
+func (w *witnessFinder) listWitness(t SemType, depth int) (Value, bool) {
+	env := w.cx.env()
+	for length := 0; length <= w.maxListLength(t); length++ {
+		members := make([]SemType, length)
+		for i := range members {
+			members[i] = &VAL
+		}
+		narrowed := Intersect(t, tupleShapeType(env, members))
+		if IsEmpty(w.cx, narrowed) {
+			continue
+		}
+		shape := make([]Value, length)
+		found := true
+		for i := 0; i < length && found; i++ {
+			memberType := listMemberTypeInnerVal(w.cx, narrowed, IntConst(int64(i)))
+			shape[i], found = w.narrow(memberType, depth+1, func(ty SemType) bool {
+				members[i] = ty
+				next := Intersect(t, tupleShapeType(env, members))
+				if IsEmpty(w.cx, next) {
+					return false
+				}
+				narrowed = next
+				return true
+			})
+		}
+		if found {
+			return ValueFrom(ListShape{Members: shape}), true
+		}
+	}
+	return Value{}, false
+}
+
+func (w *witnessFinder) maxListLength(t SemType) int {
+	result := 0
+	data := subtypeData(t, BT_LIST)
+	bdd, ok := data.(Bdd)
+	if !ok {
+		return result
+	}
+	var paths []BddPath
+	BddPaths(bdd, &paths, BddPathFrom())
+	for _, path := range paths {
+		for _, atom := range slices.Concat(path.pos, path.neg) {
+			result = max(result, w.cx.listAtomType(atom).Members.FixedLength+1)
+		}
+	}
+	return result
+}
+
+// mappingWitness looks for a mapping of t having only fields named by the atoms of t. Optional fields are left out
+// in the first attempt and given a value in the second.
+func (w *witnessFinder) mappingWitness(t SemType, depth int) (Value, bool) {
+	env := w.cx.env()
+	names := w.mappingFieldNames(t)
+	for _, preferAbsent := range []bool{true, false} {
+		narrowed := t
+		var shape MappingShape
+		var fields []Field
+		found := true
+		for _, name := range names {
+			memberType := MappingMemberTypeInner(w.cx, narrowed, StringConst(name))
+			absent := func() bool {
+				if IsNever(Intersect(memberType, &UNDEF)) {
+					return false
+				}
+				next := Intersect(narrowed, recordShapeType(env, &VAL, FieldFrom(name, &NEVER, false, true)))
+				if IsEmpty(w.cx, next) {
+					return false
+				}
+				narrowed = next
+				return true
+			}
+			if preferAbsent && absent() {
+				continue
+			}
+			var fieldType SemType
+			value, ok := w.narrow(Diff(memberType, &UNDEF), depth+1, func(ty SemType) bool {
+				next := Intersect(narrowed, recordShapeType(env, &VAL, FieldFrom(name, ty, false, false)))
+				if IsEmpty(w.cx, next) {
+					return false
+				}
+				narrowed = next
+				fieldType = ty
+				return true
+			})
+			if ok {
+				shape.Names = append(shape.Names, name)
+				shape.Values = append(shape.Values, value)
+				fields = append(fields, FieldFrom(name, fieldType, false, false))
+			} else if preferAbsent || !absent() {
+				found = false
+				break
+			}
+		}
+		if found && !IsEmpty(w.cx, Intersect(narrowed, recordShapeType(env, &NEVER, fields...))) {
+			return ValueFrom(shape), true
+		}
+	}
+	return Value{}, false
+}
+
+func (w *witnessFinder) mappingFieldNames(t SemType) []string {
+	var names []string
+	data := subtypeData(t, BT_MAPPING)
+	bdd, ok := data.(Bdd)
+	if !ok {
+		return names
+	}
+	var paths []BddPath
+	BddPaths(bdd, &paths, BddPathFrom())
+	for _, path := range paths {
+		for _, atom := range slices.Concat(path.pos, path.neg) {
+			for _, name := range w.cx.mappingAtomType(atom).Names {
+				if !slices.Contains(names, name) {
+					names = append(names, name)
+				}
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// narrow returns a witness of t whose shape type is accepted, trying up to maxWitnessCandidates witnesses.
+func (w *witnessFinder) narrow(t SemType, depth int, accept func(ty SemType) bool) (Value, bool) {
+	for i := 0; i < maxWitnessCandidates; i++ {
+		value, ok := w.witness(t, depth)
+		if !ok {
+			return Value{}, false
+		}
+		ty := shapeType(w.cx.env(), value)
+		if accept(ty) {
+			return value, true
+		}
+		t = Diff(t, ty)
+	}
+	return Value{}, false
+}
+
+// shapeType returns the type whose only value is the shape of value, except for an OpaqueShape, whose type is the
+// type it stands for.
+func shapeType(env Env, value Value) SemType {
+	switch v := value.Value.(type) {
+	case nil:
+		return &NIL
+	case bool:
+		return BooleanConst(v)
+	case int64:
+		return IntConst(v)
+	case float64:
+		return FloatConst(v)
+	case big.Rat:
+		return DecimalConst(v)
+	case string:
+		return StringConst(v)
+	case ListShape:
+		members := make([]SemType, len(v.Members))
+		for i, member := range v.Members {
+			members[i] = shapeType(env, member)
+		}
+		return tupleShapeType(env, members)
+	case MappingShape:
+		fields := make([]Field, len(v.Names))
+		for i, name := range v.Names {
+			fields[i] = FieldFrom(name, shapeType(env, v.Values[i]), false, false)
+		}
+		return recordShapeType(env, &NEVER, fields...)
+	case OpaqueShape:
+		return v.ty
+	default:
+		panic("unexpected witness value")
+	}
+}
+
+func tupleShapeType(env Env, members []SemType) SemType {
+	ld := NewListDefinition()
+	return ld.DefineListTypeWrappedWithEnvSemTypesInt(env, members, len(members))
+}
+
+func recordShapeType(env Env, rest SemType, fields ...Field) SemType {
+	md := NewMappingDefinition()
+	return md.DefineMappingTypeWrapped(env, fields, rest)
+}
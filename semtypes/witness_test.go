@@ -0,0 +1,90 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package semtypes
+
+import (
+	"testing"
+)
+
+func TestWitness(t *testing.T) {
+	env := GetTypeEnv()
+	cx := ContextFrom(env)
+
+	record := func(rest SemType, fields ...Field) SemType {
+		md := NewMappingDefinition()
+		return md.DefineMappingTypeWrapped(env, fields, rest)
+	}
+	array := func(member SemType) SemType {
+		ld := NewListDefinition()
+		return ld.DefineListTypeWrappedWithEnvSemType(env, member)
+	}
+	person := record(CreateAnydata(cx), FieldFrom("name", &STRING, false, false), FieldFrom("age", &INT, false, false))
+	nullablePerson := record(CreateAnydata(cx), FieldFrom("name", &STRING, false, false),
+		FieldFrom("age", Union(&INT, &NIL), false, false))
+
+	tests := []struct {
+		name     string
+		ty       SemType
+		expected string
+	}{
+		{"nil", &NIL, "()"},
+		{"int", &INT, "0"},
+		{"int except zero", Diff(&INT, IntConst(0)), "-1"},
+		{"positive int range", Diff(IntWidthUnsigned(8), IntConst(0)), "1"},
+		{"boolean", Diff(&BOOLEAN, BooleanConst(true)), "false"},
+		{"float", Diff(&FLOAT, FloatConst(0)), "1.0"},
+		{"decimal", DecimalConstFromStringValue("2.5"), "2.5d"},
+		{"string", Diff(&STRING, StringConst("")), `"a"`},
+		{"non-char string", Diff(&STRING, STRING_CHAR), `""`},
+		{"char", STRING_CHAR, `"a"`},
+		{"char except a", Diff(STRING_CHAR, StringConst("a")), `"b"`},
+		{"union", Union(&STRING, &INT), "0"},
+		{"json", CreateJson(cx), "()"},
+		{"array", array(&INT), "[]"},
+		{"non-empty array", Diff(array(&INT), testTuple(env)), "[0]"},
+		{"tuple", Diff(testTuple(env, &INT, &STRING), testTuple(env, &INT, StringConst(""))), `[0, "a"]`},
+		{"map", record(&INT), "{}"},
+		{"record", person, `{age: 0, name: ""}`},
+		{"record difference", Diff(nullablePerson, person), `{age: (), name: ""}`},
+		{"optional field", record(&NEVER, FieldFrom("x", &INT, false, true)), "{}"},
+		{"required optional field", Diff(record(&NEVER, FieldFrom("x", &INT, false, true)), record(&NEVER)), "{x: 0}"},
+		{"error", &ERROR, "<error>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			witness, ok := Witness(cx, tt.ty)
+			assertTrue(t, ok, "expected a witness")
+			assertEqual(t, witness.String(), tt.expected)
+			assertTrue(t, IsSubtype(cx, shapeType(env, witness), tt.ty), "witness %s is not in the type", witness)
+		})
+	}
+}
+
+func TestWitnessOfEmptyType(t *testing.T) {
+	env := GetTypeEnv()
+	cx := ContextFrom(env)
+	_, ok := Witness(cx, &NEVER)
+	assertFalse(t, ok, "never has no witness")
+	_, ok = Witness(cx, Diff(&INT, IntWidthSigned(64)))
+	assertFalse(t, ok, "int minus int has no witness")
+	ld := NewListDefinition()
+	infinite := ld.GetSemType(env)
+	ld.DefineListTypeWrappedWithEnvSemTypesInt(env, []SemType{&INT, infinite}, 2)
+	_, ok = Witness(cx, infinite)
+	assertFalse(t, ok, "a list that contains itself has no witness")
+}
@@ -32,24 +32,24 @@ func NewXmlOps() XmlOps {
 
 func (this *XmlOps) Union(d1 SubtypeData, d2 SubtypeData) SubtypeData {
 	// migrated from XmlOps.java:45:5
-	v1 := d1.(*XmlSubtype)
-	v2 := d2.(*XmlSubtype)
+	v1 := d1.(XmlSubtype)
+	v2 := d2.(XmlSubtype)
 	primitives := (v1.Primitives | v2.Primitives)
 	return CreateXmlSubtype(primitives, BddUnion(v1.Sequence, v2.Sequence))
 }
 
 func (this *XmlOps) Intersect(d1 SubtypeData, d2 SubtypeData) SubtypeData {
 	// migrated from XmlOps.java:53:5
-	v1 := d1.(*XmlSubtype)
-	v2 := d2.(*XmlSubtype)
+	v1 := d1.(XmlSubtype)
+	v2 := d2.(XmlSubtype)
 	primitives := (v1.Primitives & v2.Primitives)
 	return CreateXmlSubtypeOrEmpty(primitives, BddIntersect(v1.Sequence, v2.Sequence))
 }
 
 func (this *XmlOps) Diff(d1 SubtypeData, d2 SubtypeData) SubtypeData {
 	// migrated from XmlOps.java:61:5
-	v1 := d1.(*XmlSubtype)
-	v2 := d2.(*XmlSubtype)
+	v1 := d1.(XmlSubtype)
+	v2 := d2.(XmlSubtype)
 	primitives := (v1.Primitives & (^v2.Primitives))
 	return CreateXmlSubtypeOrEmpty(primitives, BddDiff(v1.Sequence, v2.Sequence))
 }
@@ -61,7 +61,7 @@ func (this *XmlOps) Complement(d SubtypeData) SubtypeData {
 
 func (this *XmlOps) IsEmpty(cx Context, t SubtypeData) bool {
 	// migrated from XmlOps.java:74:5
-	sd := t.(*XmlSubtype)
+	sd := t.(XmlSubtype)
 	if sd.Primitives != 0 {
 		return false
 	}
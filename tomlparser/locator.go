@@ -0,0 +1,121 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tomlparser
+
+import (
+	"strconv"
+	"strings"
+
+	"ballerina-lang-go/tomlparser/syntax"
+	"ballerina-lang-go/tools/diagnostics"
+)
+
+// keyLocations maps the JSON pointer of each key, table and array element of a TOML document to its location in
+// content: the key of a key/value pair, the key of a table header, or the value of an array element.
+func keyLocations(content string) map[string]*Location {
+	tree := syntax.Parse("", content)
+	l := &keyLocator{nodeLocations: tree.Locations(), locations: make(map[string]*Location),
+		arrayTables: make(map[string]int)}
+	var table []string
+	for _, member := range tree.RootNode().Members {
+		switch member := member.(type) {
+		case *syntax.KeyValueNode:
+			l.keyValue(nil, member)
+		case *syntax.TableNode:
+			table = l.tableHeader(member.Key, false)
+			for _, field := range member.Fields {
+				l.keyValue(table, field)
+			}
+		case *syntax.TableArrayNode:
+			table = l.tableHeader(member.Key, true)
+			for _, field := range member.Fields {
+				l.keyValue(table, field)
+			}
+		}
+	}
+	return l.locations
+}
+
+// JSONPointer returns the JSON pointer of the value at path.
+func JSONPointer(path []string) string {
+	var sb strings.Builder
+	for _, token := range path {
+		sb.WriteByte('/')
+		token = strings.ReplaceAll(token, "~", "~0")
+		sb.WriteString(strings.ReplaceAll(token, "/", "~1"))
+	}
+	return sb.String()
+}
+
+type keyLocator struct {
+	nodeLocations map[syntax.Node]diagnostics.Location
+	locations     map[string]*Location
+	// number of tables of each array of tables, keyed by the JSON pointer of the array
+	arrayTables map[string]int
+}
+
+func (l *keyLocator) record(path []string, node syntax.Node) {
+	pointer := JSONPointer(path)
+	if _, ok := l.locations[pointer]; !ok {
+		l.locations[pointer] = newLocation(l.nodeLocations[node])
+	}
+}
+
+// tableHeader records the key of a table header, returning the path of the table. A key naming an array of tables
+// refers to its last table, while the header of an array of tables adds a table to it.
+func (l *keyLocator) tableHeader(key *syntax.KeyNode, isArray bool) []string {
+	var path []string
+	for i, part := range key.Parts {
+		path = append(path, part.Value())
+		l.record(path, part)
+		pointer := JSONPointer(path)
+		if i == len(key.Parts)-1 && isArray {
+			index := l.arrayTables[pointer]
+			l.arrayTables[pointer] = index + 1
+			path = append(path, strconv.Itoa(index))
+			l.record(path, part)
+		} else if count, ok := l.arrayTables[pointer]; ok {
+			path = append(path, strconv.Itoa(count-1))
+		}
+	}
+	return path
+}
+
+func (l *keyLocator) keyValue(table []string, keyValue *syntax.KeyValueNode) {
+	path := append([]string{}, table...)
+	for _, part := range keyValue.Key.Parts {
+		path = append(path, part.Value())
+		l.record(path, part)
+	}
+	l.value(path, keyValue.Value)
+}
+
+// value records the keys of an inline table and the elements of an array.
+func (l *keyLocator) value(path []string, value syntax.Node) {
+	switch value := value.(type) {
+	case *syntax.ArrayNode:
+		for i, element := range value.Elements {
+			elementPath := append(append([]string{}, path...), strconv.Itoa(i))
+			l.record(elementPath, element.Value)
+			l.value(elementPath, element.Value)
+		}
+	case *syntax.InlineTableNode:
+		for _, field := range value.Fields {
+			l.keyValue(path, field.KeyValue)
+		}
+	}
+}
@@ -33,6 +33,8 @@ type Schema interface {
 
 type schemaImpl struct {
 	compiled *jsonschema.Schema
+	// the JSON document of the schema, in which validation errors look up their messages
+	document any
 }
 
 func NewSchemaFromPath(fsys fs.FS, path string) (Schema, error) {
@@ -62,6 +64,7 @@ func NewSchemaFromString(content string) (Schema, error) {
 
 	return &schemaImpl{
 		compiled: schema,
+		document: schemaDoc,
 	}, nil
 }
 
@@ -78,7 +81,7 @@ func NewSchemaFromFile(file fs.File) (Schema, error) {
 }
 
 func (s *schemaImpl) Validate(data any) error {
-	if err := s.compiled.Validate(data); err != nil {
+	if err := s.compiled.Validate(toJSONValue(data)); err != nil {
 		return fmt.Errorf("schema validation failed: %w", err)
 	}
 	return nil
@@ -91,3 +94,32 @@ func (s *schemaImpl) FromPath(fsys fs.FS, path string) (Schema, error) {
 func (s *schemaImpl) FromString(content string) (Schema, error) {
 	return NewSchemaFromString(content)
 }
+
+// toJSONValue converts a decoded TOML value to the types of a decoded JSON value, which are the ones the validator
+// understands. Arrays of tables become arrays and date-times become strings.
+func toJSONValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, val := range v {
+			result[key] = toJSONValue(val)
+		}
+		return result
+	case []map[string]any:
+		result := make([]any, len(v))
+		for i, val := range v {
+			result[i] = toJSONValue(val)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, val := range v {
+			result[i] = toJSONValue(val)
+		}
+		return result
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return value
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tomlparser
+
+import (
+	_ "embed"
+	"fmt"
+	"sync"
+)
+
+var (
+	//go:embed schemas/ballerina-toml-schema.json
+	ballerinaTomlSchema string
+	//go:embed schemas/dependencies-toml-schema.json
+	dependenciesTomlSchema string
+	//go:embed schemas/config-toml-schema.json
+	configTomlSchema string
+	//go:embed schemas/settings-toml-schema.json
+	settingsTomlSchema string
+)
+
+// BallerinaTomlSchema returns the schema of Ballerina.toml, the manifest of a package.
+var BallerinaTomlSchema = builtinSchema("Ballerina.toml", ballerinaTomlSchema)
+
+// DependenciesTomlSchema returns the schema of Dependencies.toml, the locked dependencies of a package.
+var DependenciesTomlSchema = builtinSchema("Dependencies.toml", dependenciesTomlSchema)
+
+// ConfigTomlSchema returns the schema of Config.toml, the values of the configurable variables of a program.
+var ConfigTomlSchema = builtinSchema("Config.toml", configTomlSchema)
+
+// SettingsTomlSchema returns the schema of Settings.toml, the settings of the user.
+var SettingsTomlSchema = builtinSchema("Settings.toml", settingsTomlSchema)
+
+// builtinSchema compiles an embedded schema on first use. An embedded schema that fails to compile is a bug, so it
+// panics.
+func builtinSchema(name, content string) func() Schema {
+	return sync.OnceValue(func() Schema {
+		schema, err := NewSchemaFromString(content)
+		if err != nil {
+			panic(fmt.Sprintf("invalid built-in schema of %s: %v", name, err))
+		}
+		return schema
+	})
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tomlparser
+
+import (
+	"testing"
+)
+
+func TestBuiltinSchemas(t *testing.T) {
+	tests := []struct {
+		path   string
+		schema func() Schema
+	}{
+		{"testdata/Ballerina.toml", BallerinaTomlSchema},
+		{"testdata/Dependencies.toml", DependenciesTomlSchema},
+		{"testdata/Config.toml", ConfigTomlSchema},
+		{"testdata/Settings.toml", SettingsTomlSchema},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			toml, err := ReadWithSchema(fsys, test.path, test.schema())
+			if err != nil {
+				t.Fatalf("expected %s to be valid, got %v: %v", test.path, err, toml.Diagnostics())
+			}
+		})
+	}
+}
+
+func TestBuiltinSchemaDiagnostics(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  func() Schema
+		content string
+		message string
+		line    int
+		column  int
+	}{
+		{"unsupported key", BallerinaTomlSchema, "[package]\norg = \"foo\"\n  exported = [\"a\"]\n",
+			"key 'exported' not supported in schema 'package'", 3, 3},
+		{"unsupported table", BallerinaTomlSchema, "[package]\norg = \"foo\"\n\n[build]\n",
+			"key 'build' not supported in schema 'Ballerina Toml Spec'", 4, 2},
+		{"invalid org", BallerinaTomlSchema, "[package]\norg = \"foo-bar\"\n",
+			"invalid 'org' under [package]: 'org' can only contain alphanumerics, underscores and periods", 2, 1},
+		{"invalid version", BallerinaTomlSchema, "[package]\norg = \"foo\"\nversion = \"1.x\"\n",
+			"invalid 'version' under [package]: 'version' should be compatible with semver", 3, 1},
+		{"incompatible type", BallerinaTomlSchema, "[build-options]\noffline = \"yes\"\n",
+			"incompatible type for key 'offline': expected 'BOOLEAN', found 'STRING'", 2, 1},
+		{"array element", BallerinaTomlSchema, "[package]\nlicense = [\"MIT\",\n    1]\n",
+			"incompatible type for key '1': expected 'STRING', found 'NUMBER'", 3, 5},
+		{"missing field", BallerinaTomlSchema, "[[dependency]]\norg = \"a\"\nname = \"b\"\n\n[[dependency]]\norg = \"c\"\n",
+			"missing required field 'name'", 5, 3},
+		{"inline table", DependenciesTomlSchema,
+			"[[package]]\norg = \"a\"\nname = \"b\"\nversion = \"1.0.0\"\ndependencies = [{org = \"c\", nam = \"d\"}]\n",
+			"key 'nam' not supported in schema 'dependencies'", 5, 29},
+		{"unsupported version", DependenciesTomlSchema, "[ballerina]\ndependencies-toml-version = \"1\"\n",
+			"unsupported 'dependencies-toml-version' under [ballerina]: only version '2' is supported", 2, 1},
+		{"dotted key", SettingsTomlSchema, "central.max-retries = -1\n",
+			"invalid value for key 'max-retries'", 1, 9},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			toml, err := ReadStringWithSchema(test.content, test.schema())
+			if err == nil {
+				t.Fatal("expected a validation error")
+			}
+			for _, diagnostic := range toml.Diagnostics() {
+				if len(diagnostic.Message) < len(test.message) || diagnostic.Message[:len(test.message)] != test.message {
+					continue
+				}
+				if diagnostic.Location == nil {
+					t.Fatalf("expected a location for %q", diagnostic.Message)
+				}
+				if diagnostic.Location.StartLine != test.line || diagnostic.Location.StartColumn != test.column {
+					t.Errorf("expected %q at %d:%d, got %d:%d", diagnostic.Message, test.line, test.column,
+						diagnostic.Location.StartLine, diagnostic.Location.StartColumn)
+				}
+				return
+			}
+			t.Errorf("expected a diagnostic starting with %q, got %v", test.message, toml.Diagnostics())
+		})
+	}
+}
+
+func TestKeyLocations(t *testing.T) {
+	content := `title = "example" # comment
+description = """
+multi
+line"""
+
+[server.http]
+"quoted.key" = 1
+ports = [80,
+    443]
+
+[[users]]
+name = "a"
+
+[[users]]
+name = "b"
+address = {city = "c", zip = 1}
+
+[users.role]
+level = 2
+`
+	locations := keyLocations(content)
+	tests := []struct {
+		pointer      string
+		line, column int
+	}{
+		{"/title", 1, 1},
+		{"/description", 2, 1},
+		{"/server", 6, 2},
+		{"/server/http", 6, 9},
+		{"/server/http/quoted.key", 7, 1},
+		{"/server/http/ports/0", 8, 10},
+		{"/server/http/ports/1", 9, 5},
+		{"/users/0", 11, 3},
+		{"/users/0/name", 12, 1},
+		{"/users/1", 14, 3},
+		{"/users/1/name", 15, 1},
+		{"/users/1/address/zip", 16, 24},
+		{"/users/1/role", 18, 8},
+		{"/users/1/role/level", 19, 1},
+	}
+	for _, test := range tests {
+		location, ok := locations[test.pointer]
+		if !ok {
+			t.Errorf("expected a location for %s", test.pointer)
+			continue
+		}
+		if location.StartLine != test.line || location.StartColumn != test.column {
+			t.Errorf("expected %s at %d:%d, got %d:%d", test.pointer, test.line, test.column, location.StartLine,
+				location.StartColumn)
+		}
+	}
+}
+
+func TestJSONPointer(t *testing.T) {
+	if pointer := JSONPointer([]string{"a/b", "c~d", "0"}); pointer != "/a~1b/c~0d/0" {
+		t.Errorf("unexpected pointer %s", pointer)
+	}
+}
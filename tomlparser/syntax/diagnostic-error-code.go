@@ -0,0 +1,78 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syntax
+
+import "ballerina-lang-go/tools/diagnostics"
+
+// DiagnosticErrorCode identifies a syntax error of a TOML document.
+type DiagnosticErrorCode struct {
+	diagnosticId  string
+	messageKey    string
+	messageFormat string
+}
+
+var _ diagnostics.DiagnosticCode = &DiagnosticErrorCode{}
+
+var (
+	ERROR_INVALID_TOKEN = DiagnosticErrorCode{diagnosticId: "TOML0001", messageKey: "error.invalid.token",
+		messageFormat: "invalid token '%s'"}
+	ERROR_MISSING_KEY = DiagnosticErrorCode{diagnosticId: "TOML0002", messageKey: "error.missing.key",
+		messageFormat: "missing key"}
+	ERROR_MISSING_EQUAL_TOKEN = DiagnosticErrorCode{diagnosticId: "TOML0003", messageKey: "error.missing.equal.token",
+		messageFormat: "missing '='"}
+	ERROR_MISSING_VALUE = DiagnosticErrorCode{diagnosticId: "TOML0004", messageKey: "error.missing.value",
+		messageFormat: "missing value"}
+	ERROR_MISSING_CLOSE_BRACKET_TOKEN = DiagnosticErrorCode{diagnosticId: "TOML0005",
+		messageKey: "error.missing.close.bracket.token", messageFormat: "missing ']'"}
+	ERROR_MISSING_DOUBLE_CLOSE_BRACKET_TOKEN = DiagnosticErrorCode{diagnosticId: "TOML0006",
+		messageKey: "error.missing.double.close.bracket.token", messageFormat: "missing ']]'"}
+	ERROR_MISSING_CLOSE_BRACE_TOKEN = DiagnosticErrorCode{diagnosticId: "TOML0007",
+		messageKey: "error.missing.close.brace.token", messageFormat: "missing '}'"}
+	ERROR_MISSING_COMMA_TOKEN = DiagnosticErrorCode{diagnosticId: "TOML0008", messageKey: "error.missing.comma.token",
+		messageFormat: "missing ','"}
+	ERROR_MISSING_NEW_LINE = DiagnosticErrorCode{diagnosticId: "TOML0009", messageKey: "error.missing.new.line",
+		messageFormat: "missing new line before '%s'"}
+	ERROR_MISSING_DOUBLE_QUOTE = DiagnosticErrorCode{diagnosticId: "TOML0010", messageKey: "error.missing.double.quote",
+		messageFormat: "missing double quote"}
+	ERROR_MISSING_SINGLE_QUOTE = DiagnosticErrorCode{diagnosticId: "TOML0011", messageKey: "error.missing.single.quote",
+		messageFormat: "missing single quote"}
+	ERROR_MISSING_TRIPLE_DOUBLE_QUOTE = DiagnosticErrorCode{diagnosticId: "TOML0012",
+		messageKey: "error.missing.triple.double.quote", messageFormat: `missing '"""'`}
+	ERROR_MISSING_TRIPLE_SINGLE_QUOTE = DiagnosticErrorCode{diagnosticId: "TOML0013",
+		messageKey: "error.missing.triple.single.quote", messageFormat: "missing \"'''\""}
+	ERROR_INVALID_ESCAPE_SEQUENCE = DiagnosticErrorCode{diagnosticId: "TOML0014",
+		messageKey: "error.invalid.escape.sequence", messageFormat: "invalid escape sequence '%s'"}
+	ERROR_INVALID_VALUE = DiagnosticErrorCode{diagnosticId: "TOML0015", messageKey: "error.invalid.value",
+		messageFormat: "invalid value '%s'"}
+)
+
+func (d *DiagnosticErrorCode) DiagnosticId() string {
+	return d.diagnosticId
+}
+
+func (d *DiagnosticErrorCode) MessageKey() string {
+	return d.messageKey
+}
+
+// MessageFormat is the format of the message of the diagnostic, whose arguments are supplied when it is reported.
+func (d *DiagnosticErrorCode) MessageFormat() string {
+	return d.messageFormat
+}
+
+func (d *DiagnosticErrorCode) Severity() diagnostics.DiagnosticSeverity {
+	return diagnostics.Error
+}
@@ -0,0 +1,319 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syntax
+
+import (
+	"regexp"
+
+	"ballerina-lang-go/tools/text"
+)
+
+// lexerMode selects how the lexer reads the characters of a token, since the same text is lexed differently as a
+// key and as a value: `1.5` is a float value but a dotted key, and `true` is a boolean value but a bare key.
+type lexerMode uint8
+
+const (
+	keyMode lexerMode = iota
+	valueMode
+)
+
+var (
+	integerPattern  = regexp.MustCompile(`^[+-]?(0|[1-9](_?[0-9])*)$|^0x[0-9A-Fa-f](_?[0-9A-Fa-f])*$|^0o[0-7](_?[0-7])*$|^0b[01](_?[01])*$`)
+	floatPattern    = regexp.MustCompile(`^[+-]?(0|[1-9](_?[0-9])*)(\.[0-9](_?[0-9])*([eE][+-]?[0-9](_?[0-9])*)?|[eE][+-]?[0-9](_?[0-9])*)$|^[+-]?(inf|nan)$`)
+	dateTimePattern = regexp.MustCompile(`^([0-9]{4}-[0-9]{2}-[0-9]{2}([Tt ][0-9]{2}:[0-9]{2}:[0-9]{2}(\.[0-9]+)?([Zz]|[+-][0-9]{2}:[0-9]{2})?)?|[0-9]{2}:[0-9]{2}:[0-9]{2}(\.[0-9]+)?)$`)
+	datePattern     = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`)
+)
+
+type lexer struct {
+	reader text.CharReader
+	// diagnostics of the token being read
+	diagnostics []tokenDiagnostic
+}
+
+func newLexer(content string) *lexer {
+	return &lexer{reader: text.CharReaderFromText(content)}
+}
+
+// reset moves the lexer to offset, to read the token at offset again in another mode.
+func (l *lexer) reset(offset int) {
+	l.reader.Reset(offset)
+}
+
+func (l *lexer) nextToken(mode lexerMode) *Token {
+	leadingMinutiae := l.readMinutiae(true)
+	l.diagnostics = nil
+	l.reader.Mark()
+	kind := l.readToken(mode)
+	tokenText := l.reader.GetMarkedChars()
+	token := CreateToken(kind, tokenText, leadingMinutiae, nil)
+	token.diagnostics = l.diagnostics
+	if kind != EOF_TOKEN {
+		token.trailingMinutiae = l.readMinutiae(false)
+	}
+	return token
+}
+
+// readMinutiae reads whitespace, comments and new lines. Trailing minutiae end with the first new line.
+func (l *lexer) readMinutiae(leading bool) []Minutiae {
+	var minutiae []Minutiae
+	for !l.reader.IsEOF() {
+		l.reader.Mark()
+		var kind SyntaxKind
+		switch c := l.reader.Peek(); {
+		case c == ' ' || c == '\t':
+			for c := l.reader.Peek(); (c == ' ' || c == '\t') && !l.reader.IsEOF(); c = l.reader.Peek() {
+				l.reader.Advance()
+			}
+			kind = WHITESPACE_MINUTIAE
+		case c == '#':
+			for c := l.reader.Peek(); c != '\n' && !(c == '\r' && l.reader.PeekN(1) == '\n') && !l.reader.IsEOF(); c = l.reader.Peek() {
+				l.reader.Advance()
+			}
+			kind = COMMENT_MINUTIAE
+		case c == '\n':
+			l.reader.Advance()
+			kind = NEWLINE_MINUTIAE
+		case c == '\r' && l.reader.PeekN(1) == '\n':
+			l.reader.AdvanceN(2)
+			kind = NEWLINE_MINUTIAE
+		default:
+			return minutiae
+		}
+		minutiae = append(minutiae, CreateMinutiae(kind, l.reader.GetMarkedChars()))
+		if kind == NEWLINE_MINUTIAE && !leading {
+			return minutiae
+		}
+	}
+	return minutiae
+}
+
+func (l *lexer) readToken(mode lexerMode) SyntaxKind {
+	if l.reader.IsEOF() {
+		return EOF_TOKEN
+	}
+	c := l.reader.Peek()
+	switch c {
+	case '[':
+		if mode == keyMode && l.reader.PeekN(1) == '[' {
+			l.reader.AdvanceN(2)
+			return DOUBLE_OPEN_BRACKET_TOKEN
+		}
+		l.reader.Advance()
+		return OPEN_BRACKET_TOKEN
+	case ']':
+		if mode == keyMode && l.reader.PeekN(1) == ']' {
+			l.reader.AdvanceN(2)
+			return DOUBLE_CLOSE_BRACKET_TOKEN
+		}
+		l.reader.Advance()
+		return CLOSE_BRACKET_TOKEN
+	case '{':
+		l.reader.Advance()
+		return OPEN_BRACE_TOKEN
+	case '}':
+		l.reader.Advance()
+		return CLOSE_BRACE_TOKEN
+	case '=':
+		l.reader.Advance()
+		return EQUAL_TOKEN
+	case ',':
+		l.reader.Advance()
+		return COMMA_TOKEN
+	case '"':
+		if l.reader.PeekN(1) == '"' && l.reader.PeekN(2) == '"' {
+			return l.readMultilineString('"', ML_STRING_LITERAL_TOKEN, &ERROR_MISSING_TRIPLE_DOUBLE_QUOTE)
+		}
+		return l.readString()
+	case '\'':
+		if l.reader.PeekN(1) == '\'' && l.reader.PeekN(2) == '\'' {
+			return l.readMultilineString('\'', ML_LITERAL_STRING_TOKEN, &ERROR_MISSING_TRIPLE_SINGLE_QUOTE)
+		}
+		return l.readLiteralString()
+	}
+	if mode == keyMode {
+		if c == '.' {
+			l.reader.Advance()
+			return DOT_TOKEN
+		}
+		if isBareKeyChar(c) {
+			for isBareKeyChar(l.reader.Peek()) && !l.reader.IsEOF() {
+				l.reader.Advance()
+			}
+			return IDENTIFIER_TOKEN
+		}
+	} else if isValueChar(c) {
+		return l.readValue()
+	}
+	// an invalid token extends to the next whitespace or delimiter
+	l.reader.Advance()
+	for c := l.reader.Peek(); !l.reader.IsEOF() && !isDelimiter(c) && !isBareKeyChar(c) && !isValueChar(c); c = l.reader.Peek() {
+		l.reader.Advance()
+	}
+	return INVALID_TOKEN
+}
+
+func (l *lexer) readString() SyntaxKind {
+	l.reader.Advance()
+	for {
+		c := l.reader.Peek()
+		switch {
+		case l.reader.IsEOF() || c == '\n' || c == '\r':
+			l.report(&ERROR_MISSING_DOUBLE_QUOTE, len(l.reader.GetMarkedChars()), 0)
+			return STRING_LITERAL_TOKEN
+		case c == '"':
+			l.reader.Advance()
+			return STRING_LITERAL_TOKEN
+		case c == '\\':
+			l.readEscapeSequence(false)
+		default:
+			l.reader.Advance()
+		}
+	}
+}
+
+func (l *lexer) readLiteralString() SyntaxKind {
+	l.reader.Advance()
+	for {
+		c := l.reader.Peek()
+		switch {
+		case l.reader.IsEOF() || c == '\n' || c == '\r':
+			l.report(&ERROR_MISSING_SINGLE_QUOTE, len(l.reader.GetMarkedChars()), 0)
+			return LITERAL_STRING_TOKEN
+		case c == '\'':
+			l.reader.Advance()
+			return LITERAL_STRING_TOKEN
+		default:
+			l.reader.Advance()
+		}
+	}
+}
+
+// readMultilineString reads a multi-line string delimited by three quotes. The string may end with up to two quotes
+// of its own right before the closing delimiter.
+func (l *lexer) readMultilineString(quote rune, kind SyntaxKind, missingCode *DiagnosticErrorCode) SyntaxKind {
+	l.reader.AdvanceN(3)
+	for {
+		c := l.reader.Peek()
+		switch {
+		case l.reader.IsEOF():
+			l.report(missingCode, len(l.reader.GetMarkedChars()), 0)
+			return kind
+		case c == quote && l.reader.PeekN(1) == quote && l.reader.PeekN(2) == quote:
+			l.reader.AdvanceN(3)
+			for i := 0; i < 2 && l.reader.Peek() == quote && !l.reader.IsEOF(); i++ {
+				l.reader.Advance()
+			}
+			return kind
+		case c == '\\' && quote == '"':
+			l.readEscapeSequence(true)
+		default:
+			l.reader.Advance()
+		}
+	}
+}
+
+// readEscapeSequence reads an escape sequence of a basic string. A multi-line basic string may also escape the end of
+// a line.
+func (l *lexer) readEscapeSequence(multiline bool) {
+	start := len(l.reader.GetMarkedChars())
+	l.reader.Advance()
+	c := l.reader.Peek()
+	switch {
+	case l.reader.IsEOF():
+	case c == 'b' || c == 't' || c == 'n' || c == 'f' || c == 'r' || c == '"' || c == '\\':
+		l.reader.Advance()
+		return
+	case c == 'u' || c == 'U':
+		digits := 4
+		if c == 'U' {
+			digits = 8
+		}
+		l.reader.Advance()
+		for i := 0; i < digits; i++ {
+			if !isHexDigit(l.reader.Peek()) || l.reader.IsEOF() {
+				l.report(&ERROR_INVALID_ESCAPE_SEQUENCE, start, len(l.reader.GetMarkedChars())-start,
+					l.reader.GetMarkedChars()[start:])
+				return
+			}
+			l.reader.Advance()
+		}
+		return
+	case multiline && (c == ' ' || c == '\t' || c == '\n' || c == '\r'):
+		for c := l.reader.Peek(); (c == ' ' || c == '\t' || c == '\n' || c == '\r') && !l.reader.IsEOF(); c = l.reader.Peek() {
+			l.reader.Advance()
+		}
+		return
+	default:
+		l.reader.Advance()
+	}
+	l.report(&ERROR_INVALID_ESCAPE_SEQUENCE, start, len(l.reader.GetMarkedChars())-start,
+		l.reader.GetMarkedChars()[start:])
+}
+
+// readValue reads a number, boolean or date-time. A date may be followed by a time after a space.
+func (l *lexer) readValue() SyntaxKind {
+	for isValueChar(l.reader.Peek()) && !l.reader.IsEOF() {
+		l.reader.Advance()
+	}
+	if datePattern.MatchString(l.reader.GetMarkedChars()) && l.reader.Peek() == ' ' &&
+		isDigit(l.reader.PeekN(1)) && isDigit(l.reader.PeekN(2)) && l.reader.PeekN(3) == ':' {
+		l.reader.Advance()
+		for isValueChar(l.reader.Peek()) && !l.reader.IsEOF() {
+			l.reader.Advance()
+		}
+	}
+	value := l.reader.GetMarkedChars()
+	switch {
+	case value == "true" || value == "false":
+		return BOOLEAN_LITERAL_TOKEN
+	case integerPattern.MatchString(value):
+		return INTEGER_LITERAL_TOKEN
+	case floatPattern.MatchString(value):
+		return FLOAT_LITERAL_TOKEN
+	case dateTimePattern.MatchString(value):
+		return DATE_TIME_LITERAL_TOKEN
+	}
+	return INVALID_TOKEN
+}
+
+func (l *lexer) report(code *DiagnosticErrorCode, offset, length int, args ...any) {
+	l.diagnostics = append(l.diagnostics, tokenDiagnostic{code: code, offset: offset, length: length, args: args})
+}
+
+func isBareKeyChar(c rune) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || isDigit(c) || c == '_' || c == '-'
+}
+
+func isValueChar(c rune) bool {
+	return isBareKeyChar(c) || c == '+' || c == '.' || c == ':'
+}
+
+func isDelimiter(c rune) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '#', '[', ']', '{', '}', '=', ',', '.', '"', '\'':
+		return true
+	}
+	return false
+}
+
+func isDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isHexDigit(c rune) bool {
+	return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
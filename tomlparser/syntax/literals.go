@@ -0,0 +1,141 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syntax
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// stringValue returns the value of a string token of the given kind and text, and the text of any other token.
+// Invalid escape sequences are kept as they are.
+func stringValue(kind SyntaxKind, text string) string {
+	switch kind {
+	case STRING_LITERAL_TOKEN:
+		return unescape(trimQuotes(text, `"`), false)
+	case LITERAL_STRING_TOKEN:
+		return trimQuotes(text, "'")
+	case ML_STRING_LITERAL_TOKEN:
+		return unescape(trimFirstNewLine(trimQuotes(text, `"""`)), true)
+	case ML_LITERAL_STRING_TOKEN:
+		return trimFirstNewLine(trimQuotes(text, "'''"))
+	}
+	return text
+}
+
+func trimQuotes(text, quote string) string {
+	text = strings.TrimPrefix(text, quote)
+	// the closing quote is missing from an unterminated string
+	return strings.TrimSuffix(text, quote)
+}
+
+// trimFirstNewLine trims a new line immediately following the opening delimiter of a multi-line string.
+func trimFirstNewLine(text string) string {
+	if strings.HasPrefix(text, "\r\n") {
+		return text[2:]
+	}
+	return strings.TrimPrefix(text, "\n")
+}
+
+func unescape(text string, multiline bool) string {
+	if !strings.Contains(text, `\`) {
+		return text
+	}
+	var sb strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] != '\\' || i+1 >= len(text) {
+			sb.WriteByte(text[i])
+			continue
+		}
+		switch c := text[i+1]; c {
+		case 'b':
+			sb.WriteByte('\b')
+		case 't':
+			sb.WriteByte('\t')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'r':
+			sb.WriteByte('\r')
+		case '"', '\\':
+			sb.WriteByte(c)
+		case 'u', 'U':
+			digits := 4
+			if c == 'U' {
+				digits = 8
+			}
+			if i+2+digits <= len(text) {
+				if code, err := strconv.ParseUint(text[i+2:i+2+digits], 16, 32); err == nil && utf8.ValidRune(rune(code)) {
+					sb.WriteRune(rune(code))
+					i += 1 + digits
+					continue
+				}
+			}
+			sb.WriteString(text[i : i+2])
+		case ' ', '\t', '\n', '\r':
+			if !multiline {
+				sb.WriteString(text[i : i+2])
+				break
+			}
+			// a backslash at the end of a line trims the whitespace up to the next non-whitespace character
+			j := i + 1
+			for j < len(text) && strings.IndexByte(" \t\n\r", text[j]) >= 0 {
+				j++
+			}
+			i = j - 1
+			continue
+		default:
+			sb.WriteString(text[i : i+2])
+		}
+		i++
+	}
+	return sb.String()
+}
+
+// quote returns value as a basic string.
+func quote(value string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range value {
+		switch r {
+		case '"':
+			sb.WriteString(`\"`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\b':
+			sb.WriteString(`\b`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\f':
+			sb.WriteString(`\f`)
+		case '\r':
+			sb.WriteString(`\r`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				sb.WriteString(`\u` + strings.ToUpper(strconv.FormatInt(int64(r)+0x10000, 16)[1:]))
+			} else {
+				sb.WriteRune(r)
+			}
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
@@ -0,0 +1,368 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syntax
+
+import (
+	"strings"
+)
+
+// Node is a node of a TOML syntax tree. The tree is lossless: the tokens of a tree, together with their minutiae, hold
+// every character of the source, so that a tree prints back to its source byte for byte. Nodes are mutable; a tree
+// can be edited by replacing the tokens and nodes held in the fields of its nodes.
+type Node interface {
+	Kind() SyntaxKind
+	// Children returns the child nodes of the node in source order
+	Children() []Node
+}
+
+// Minutiae is the text between tokens that carries no meaning: whitespace, new lines, comments, and tokens skipped
+// by the parser.
+type Minutiae struct {
+	kind SyntaxKind
+	text string
+}
+
+func CreateMinutiae(kind SyntaxKind, text string) Minutiae {
+	return Minutiae{kind: kind, text: text}
+}
+
+func (m Minutiae) Kind() SyntaxKind {
+	return m.kind
+}
+
+func (m Minutiae) Text() string {
+	return m.text
+}
+
+// Token is a terminal node. The leading minutiae of a token are the minutiae before it on its own line and any lines
+// before it, while the trailing minutiae are the ones after it on its line, up to and including the new line.
+type Token struct {
+	kind             SyntaxKind
+	text             string
+	leadingMinutiae  []Minutiae
+	trailingMinutiae []Minutiae
+	isMissing        bool
+	// diagnostics reported by the lexer, with offsets relative to the start of the text of the token
+	diagnostics []tokenDiagnostic
+}
+
+type tokenDiagnostic struct {
+	code   *DiagnosticErrorCode
+	offset int
+	length int
+	args   []any
+}
+
+func CreateToken(kind SyntaxKind, text string, leadingMinutiae, trailingMinutiae []Minutiae) *Token {
+	return &Token{kind: kind, text: text, leadingMinutiae: leadingMinutiae, trailingMinutiae: trailingMinutiae}
+}
+
+func createMissingToken(kind SyntaxKind) *Token {
+	return &Token{kind: kind, isMissing: true}
+}
+
+// CreateStringLiteral creates a basic string token with the given value.
+func CreateStringLiteral(value string) *Token {
+	return CreateToken(STRING_LITERAL_TOKEN, quote(value), nil, nil)
+}
+
+func (t *Token) Kind() SyntaxKind {
+	return t.kind
+}
+
+func (t *Token) Children() []Node {
+	return nil
+}
+
+func (t *Token) Text() string {
+	return t.text
+}
+
+func (t *Token) LeadingMinutiae() []Minutiae {
+	return t.leadingMinutiae
+}
+
+func (t *Token) TrailingMinutiae() []Minutiae {
+	return t.trailingMinutiae
+}
+
+// IsMissing reports whether the token was inserted by the parser in place of an expected token that is not in the
+// source.
+func (t *Token) IsMissing() bool {
+	return t.isMissing
+}
+
+// Value returns the value of a string token, with its quotes removed and its escape sequences replaced, and the text
+// of any other token.
+func (t *Token) Value() string {
+	return stringValue(t.kind, t.text)
+}
+
+// WithText returns a copy of the token with the given text, keeping its minutiae.
+func (t *Token) WithText(kind SyntaxKind, text string) *Token {
+	return CreateToken(kind, text, t.leadingMinutiae, t.trailingMinutiae)
+}
+
+// WithMinutiae returns a copy of the token with the given minutiae.
+func (t *Token) WithMinutiae(leadingMinutiae, trailingMinutiae []Minutiae) *Token {
+	return CreateToken(t.kind, t.text, leadingMinutiae, trailingMinutiae)
+}
+
+// DocumentNode is the root of the syntax tree of a TOML document. Its members are the key/value pairs before the
+// first table, followed by the tables and arrays of tables, each holding its own key/value pairs.
+type DocumentNode struct {
+	Members  []Node
+	EOFToken *Token
+}
+
+func (n *DocumentNode) Kind() SyntaxKind {
+	return DOCUMENT
+}
+
+func (n *DocumentNode) Children() []Node {
+	return appendNodes(n.Members, n.EOFToken)
+}
+
+// TableNode is a `[key]` table header and the key/value pairs that follow it.
+type TableNode struct {
+	OpenBracket  *Token
+	Key          *KeyNode
+	CloseBracket *Token
+	Fields       []*KeyValueNode
+}
+
+func (n *TableNode) Kind() SyntaxKind {
+	return TABLE
+}
+
+func (n *TableNode) Children() []Node {
+	return appendNodes([]Node{n.OpenBracket, n.Key, n.CloseBracket}, n.Fields...)
+}
+
+// TableArrayNode is a `[[key]]` header of a table of an array of tables and the key/value pairs that follow it.
+type TableArrayNode struct {
+	DoubleOpenBracket  *Token
+	Key                *KeyNode
+	DoubleCloseBracket *Token
+	Fields             []*KeyValueNode
+}
+
+func (n *TableArrayNode) Kind() SyntaxKind {
+	return TABLE_ARRAY
+}
+
+func (n *TableArrayNode) Children() []Node {
+	return appendNodes([]Node{n.DoubleOpenBracket, n.Key, n.DoubleCloseBracket}, n.Fields...)
+}
+
+// KeyValueNode is a `key = value` pair. The value is a literal token, an ArrayNode or an InlineTableNode.
+type KeyValueNode struct {
+	Key   *KeyNode
+	Equal *Token
+	Value Node
+}
+
+func (n *KeyValueNode) Kind() SyntaxKind {
+	return KEY_VALUE
+}
+
+func (n *KeyValueNode) Children() []Node {
+	return []Node{n.Key, n.Equal, n.Value}
+}
+
+// SetValue replaces the value of the pair, moving the trailing minutiae of the old value, such as a comment after it
+// and the new line ending the pair, to the new value.
+func (n *KeyValueNode) SetValue(value Node) {
+	if last, oldLast := lastToken(value), lastToken(n.Value); last != nil && oldLast != nil {
+		last.trailingMinutiae = oldLast.trailingMinutiae
+	}
+	n.Value = value
+}
+
+// KeyNode is a possibly dotted key. Parts are the bare or quoted keys, and Dots the tokens separating them.
+type KeyNode struct {
+	Parts []*Token
+	Dots  []*Token
+}
+
+func (n *KeyNode) Kind() SyntaxKind {
+	return KEY
+}
+
+func (n *KeyNode) Children() []Node {
+	var children []Node
+	for i, part := range n.Parts {
+		if i > 0 && i-1 < len(n.Dots) {
+			children = append(children, n.Dots[i-1])
+		}
+		children = append(children, part)
+	}
+	return children
+}
+
+// Names returns the names of the parts of the key.
+func (n *KeyNode) Names() []string {
+	names := make([]string, len(n.Parts))
+	for i, part := range n.Parts {
+		names[i] = part.Value()
+	}
+	return names
+}
+
+// ArrayNode is an array value.
+type ArrayNode struct {
+	OpenBracket  *Token
+	Elements     []*ArrayElementNode
+	CloseBracket *Token
+}
+
+func (n *ArrayNode) Kind() SyntaxKind {
+	return ARRAY
+}
+
+func (n *ArrayNode) Children() []Node {
+	return appendNodes(appendNodes([]Node{n.OpenBracket}, n.Elements...), n.CloseBracket)
+}
+
+// ArrayElementNode is a value of an array and the comma after it, which is nil for a last element without a trailing
+// comma.
+type ArrayElementNode struct {
+	Value Node
+	Comma *Token
+}
+
+func (n *ArrayElementNode) Kind() SyntaxKind {
+	return ARRAY_ELEMENT
+}
+
+func (n *ArrayElementNode) Children() []Node {
+	return appendNodes([]Node{n.Value}, n.Comma)
+}
+
+// InlineTableNode is an inline table value.
+type InlineTableNode struct {
+	OpenBrace  *Token
+	Fields     []*InlineTableFieldNode
+	CloseBrace *Token
+}
+
+func (n *InlineTableNode) Kind() SyntaxKind {
+	return INLINE_TABLE
+}
+
+func (n *InlineTableNode) Children() []Node {
+	return appendNodes(appendNodes([]Node{n.OpenBrace}, n.Fields...), n.CloseBrace)
+}
+
+// InlineTableFieldNode is a key/value pair of an inline table and the comma after it, which is nil for the last pair.
+type InlineTableFieldNode struct {
+	KeyValue *KeyValueNode
+	Comma    *Token
+}
+
+func (n *InlineTableFieldNode) Kind() SyntaxKind {
+	return INLINE_TABLE_FIELD
+}
+
+func (n *InlineTableFieldNode) Children() []Node {
+	return appendNodes([]Node{n.KeyValue}, n.Comma)
+}
+
+// appendNodes appends the non-nil nodes to children.
+func appendNodes[T Node](children []Node, nodes ...T) []Node {
+	var zero T
+	for _, node := range nodes {
+		if any(node) != any(zero) {
+			children = append(children, node)
+		}
+	}
+	return children
+}
+
+// CreateKeyValue creates a `key = value` pair on a line of its own, quoting the parts of the key that aren't bare keys.
+func CreateKeyValue(key []string, value Node) *KeyValueNode {
+	keyNode := &KeyNode{}
+	for i, name := range key {
+		if i > 0 {
+			keyNode.Dots = append(keyNode.Dots, CreateToken(DOT_TOKEN, ".", nil, nil))
+		}
+		if isBareKey(name) {
+			keyNode.Parts = append(keyNode.Parts, CreateToken(IDENTIFIER_TOKEN, name, nil, nil))
+		} else {
+			keyNode.Parts = append(keyNode.Parts, CreateStringLiteral(name))
+		}
+	}
+	space := []Minutiae{CreateMinutiae(WHITESPACE_MINUTIAE, " ")}
+	equal := CreateToken(EQUAL_TOKEN, "=", space, space)
+	if last := lastToken(value); last != nil && !endsWithNewLine(last.trailingMinutiae) {
+		last.trailingMinutiae = append(last.trailingMinutiae, CreateMinutiae(NEWLINE_MINUTIAE, "\n"))
+	}
+	return &KeyValueNode{Key: keyNode, Equal: equal, Value: value}
+}
+
+func isBareKey(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if !isBareKeyChar(rune(name[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+func lastToken(node Node) *Token {
+	if token, ok := node.(*Token); ok {
+		return token
+	}
+	children := node.Children()
+	for i := len(children) - 1; i >= 0; i-- {
+		if token := lastToken(children[i]); token != nil {
+			return token
+		}
+	}
+	return nil
+}
+
+func endsWithNewLine(minutiae []Minutiae) bool {
+	return len(minutiae) > 0 && minutiae[len(minutiae)-1].kind == NEWLINE_MINUTIAE
+}
+
+// ToSourceCode returns the source of node, including the minutiae of its tokens.
+func ToSourceCode(node Node) string {
+	var sb strings.Builder
+	writeSourceCode(&sb, node)
+	return sb.String()
+}
+
+func writeSourceCode(sb *strings.Builder, node Node) {
+	token, ok := node.(*Token)
+	if !ok {
+		for _, child := range node.Children() {
+			writeSourceCode(sb, child)
+		}
+		return
+	}
+	for _, minutiae := range token.leadingMinutiae {
+		sb.WriteString(minutiae.text)
+	}
+	sb.WriteString(token.text)
+	for _, minutiae := range token.trailingMinutiae {
+		sb.WriteString(minutiae.text)
+	}
+}
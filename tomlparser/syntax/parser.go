@@ -0,0 +1,323 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syntax
+
+// parser is a recursive descent parser of TOML documents. It recovers from syntax errors by inserting missing tokens
+// and skipping invalid ones, which are kept as minutiae of the next token so that the tree stays lossless.
+type parser struct {
+	lexer *lexer
+	// token is the lookahead token, read in tokenMode, which starts at offset
+	token     *Token
+	tokenMode lexerMode
+	offset    int
+	// lastTokenEnd is the offset of the end of the text of the last consumed or skipped token
+	lastTokenEnd int
+	// atLineStart reports whether the last consumed or skipped token ends its line
+	atLineStart bool
+	// invalidMinutiae are the minutiae of skipped tokens, to be prepended to the leading minutiae of the next token
+	invalidMinutiae []Minutiae
+	diagnostics     []parserDiagnostic
+}
+
+type parserDiagnostic struct {
+	code   *DiagnosticErrorCode
+	offset int
+	length int
+	args   []any
+}
+
+func newParser(content string) *parser {
+	return &parser{lexer: newLexer(content), atLineStart: true}
+}
+
+// peek returns the lookahead token read in mode, reading the token again if it was read in another mode.
+func (p *parser) peek(mode lexerMode) *Token {
+	if p.token == nil || p.tokenMode != mode {
+		p.lexer.reset(p.offset)
+		p.token = p.lexer.nextToken(mode)
+		p.tokenMode = mode
+	}
+	return p.token
+}
+
+// onNewLine reports whether the lookahead token is on a line after the last token. The end of the document counts
+// as a new line.
+func (p *parser) onNewLine(mode lexerMode) bool {
+	return p.atLineStart || p.peek(mode).kind == EOF_TOKEN
+}
+
+func (p *parser) consume() *Token {
+	token := p.token
+	textOffset := p.advance(token)
+	for _, diagnostic := range token.diagnostics {
+		p.report(diagnostic.code, textOffset+diagnostic.offset, diagnostic.length, diagnostic.args...)
+	}
+	token.diagnostics = nil
+	if len(p.invalidMinutiae) > 0 {
+		token.leadingMinutiae = append(p.invalidMinutiae, token.leadingMinutiae...)
+		p.invalidMinutiae = nil
+	}
+	return token
+}
+
+// skip skips the lookahead token, reporting it as an invalid token unless code is nil.
+func (p *parser) skip(code *DiagnosticErrorCode) {
+	token := p.token
+	textOffset := p.advance(token)
+	if code != nil {
+		p.report(code, textOffset, len(token.text), token.text)
+	}
+	p.invalidMinutiae = append(p.invalidMinutiae, token.leadingMinutiae...)
+	p.invalidMinutiae = append(p.invalidMinutiae, CreateMinutiae(INVALID_NODE_MINUTIAE, token.text))
+	p.invalidMinutiae = append(p.invalidMinutiae, token.trailingMinutiae...)
+}
+
+// skipLine skips the tokens up to the end of the line.
+func (p *parser) skipLine() {
+	for !p.onNewLine(keyMode) {
+		p.skip(nil)
+	}
+}
+
+// advance moves past token, returning the offset of its text.
+func (p *parser) advance(token *Token) int {
+	textOffset := p.offset + minutiaeWidth(token.leadingMinutiae)
+	p.lastTokenEnd = textOffset + len(token.text)
+	p.offset = p.lastTokenEnd + minutiaeWidth(token.trailingMinutiae)
+	p.atLineStart = endsWithNewLine(token.trailingMinutiae)
+	p.token = nil
+	return textOffset
+}
+
+func (p *parser) expect(kind SyntaxKind, mode lexerMode, code *DiagnosticErrorCode) *Token {
+	if !p.atLineStart && p.peek(mode).kind == kind {
+		return p.consume()
+	}
+	return p.missing(kind, code)
+}
+
+// missing returns a missing token of kind, reporting it at the end of the last token, which is on the line the
+// missing token belongs to.
+func (p *parser) missing(kind SyntaxKind, code *DiagnosticErrorCode) *Token {
+	p.report(code, p.lastTokenEnd, 0)
+	return createMissingToken(kind)
+}
+
+func (p *parser) report(code *DiagnosticErrorCode, offset, length int, args ...any) {
+	p.diagnostics = append(p.diagnostics, parserDiagnostic{code: code, offset: offset, length: length, args: args})
+}
+
+func (p *parser) parseDocument() *DocumentNode {
+	document := &DocumentNode{}
+	var fields *[]*KeyValueNode
+	for {
+		token := p.peek(keyMode)
+		// a statement starts a line; new lines within it are checked against its first token
+		p.atLineStart = false
+		switch token.kind {
+		case EOF_TOKEN:
+			document.EOFToken = p.consume()
+			return document
+		case OPEN_BRACKET_TOKEN:
+			table := &TableNode{OpenBracket: p.consume()}
+			table.Key = p.parseKey()
+			table.CloseBracket = p.expect(CLOSE_BRACKET_TOKEN, keyMode, &ERROR_MISSING_CLOSE_BRACKET_TOKEN)
+			document.Members = append(document.Members, table)
+			fields = &table.Fields
+		case DOUBLE_OPEN_BRACKET_TOKEN:
+			table := &TableArrayNode{DoubleOpenBracket: p.consume()}
+			table.Key = p.parseKey()
+			table.DoubleCloseBracket = p.expect(DOUBLE_CLOSE_BRACKET_TOKEN, keyMode,
+				&ERROR_MISSING_DOUBLE_CLOSE_BRACKET_TOKEN)
+			if table.DoubleCloseBracket.isMissing && !p.atLineStart && p.peek(keyMode).kind == CLOSE_BRACKET_TOKEN {
+				// a header such as `[[key]` is missing one bracket, which is reported already
+				p.skip(nil)
+			}
+			document.Members = append(document.Members, table)
+			fields = &table.Fields
+		case IDENTIFIER_TOKEN, STRING_LITERAL_TOKEN, LITERAL_STRING_TOKEN:
+			keyValue := p.parseKeyValue()
+			if fields == nil {
+				document.Members = append(document.Members, keyValue)
+			} else {
+				*fields = append(*fields, keyValue)
+			}
+		default:
+			p.skip(&ERROR_INVALID_TOKEN)
+			p.skipLine()
+			continue
+		}
+		p.expectNewLine()
+	}
+}
+
+// expectNewLine checks that a statement ends its line, skipping the rest of the line otherwise.
+func (p *parser) expectNewLine() {
+	if p.onNewLine(keyMode) {
+		return
+	}
+	token := p.peek(keyMode)
+	p.report(&ERROR_MISSING_NEW_LINE, p.offset+minutiaeWidth(token.leadingMinutiae), len(token.text), token.text)
+	p.skipLine()
+}
+
+func isKeyStart(kind SyntaxKind) bool {
+	return kind == IDENTIFIER_TOKEN || kind == STRING_LITERAL_TOKEN || kind == LITERAL_STRING_TOKEN
+}
+
+func (p *parser) parseKey() *KeyNode {
+	key := &KeyNode{Parts: []*Token{p.parseKeyPart()}}
+	for !p.atLineStart && p.peek(keyMode).kind == DOT_TOKEN {
+		key.Dots = append(key.Dots, p.consume())
+		key.Parts = append(key.Parts, p.parseKeyPart())
+	}
+	return key
+}
+
+func (p *parser) parseKeyPart() *Token {
+	if !p.atLineStart && isKeyStart(p.peek(keyMode).kind) {
+		return p.consume()
+	}
+	return p.missing(IDENTIFIER_TOKEN, &ERROR_MISSING_KEY)
+}
+
+func (p *parser) parseKeyValue() *KeyValueNode {
+	keyValue := &KeyValueNode{Key: p.parseKey()}
+	keyValue.Equal = p.expect(EQUAL_TOKEN, valueMode, &ERROR_MISSING_EQUAL_TOKEN)
+	keyValue.Value = p.parseValue()
+	return keyValue
+}
+
+func isLiteral(kind SyntaxKind) bool {
+	switch kind {
+	case STRING_LITERAL_TOKEN, LITERAL_STRING_TOKEN, ML_STRING_LITERAL_TOKEN, ML_LITERAL_STRING_TOKEN,
+		INTEGER_LITERAL_TOKEN, FLOAT_LITERAL_TOKEN, BOOLEAN_LITERAL_TOKEN, DATE_TIME_LITERAL_TOKEN:
+		return true
+	}
+	return false
+}
+
+// isValueStart reports whether the lookahead token starts a value. A value can't start on a new line.
+func (p *parser) isValueStart() bool {
+	if p.atLineStart {
+		return false
+	}
+	kind := p.peek(valueMode).kind
+	return isLiteral(kind) || kind == OPEN_BRACKET_TOKEN || kind == OPEN_BRACE_TOKEN || kind == INVALID_TOKEN
+}
+
+func (p *parser) parseValue() Node {
+	if !p.isValueStart() {
+		return p.missing(INVALID_TOKEN, &ERROR_MISSING_VALUE)
+	}
+	token := p.peek(valueMode)
+	switch token.kind {
+	case OPEN_BRACKET_TOKEN:
+		return p.parseArray()
+	case OPEN_BRACE_TOKEN:
+		return p.parseInlineTable()
+	case INVALID_TOKEN:
+		textOffset := p.offset + minutiaeWidth(token.leadingMinutiae)
+		p.report(&ERROR_INVALID_VALUE, textOffset, len(token.text), token.text)
+	}
+	return p.consume()
+}
+
+// parseArray parses an array. The elements of an array may span lines, but a line starting with something other than
+// a value, such as the next key/value pair, ends an array missing its closing bracket.
+func (p *parser) parseArray() *ArrayNode {
+	array := &ArrayNode{OpenBracket: p.consume()}
+	for {
+		newLine := p.atLineStart
+		// new lines are allowed between the elements
+		p.atLineStart = false
+		token := p.peek(valueMode)
+		var last *ArrayElementNode
+		if len(array.Elements) > 0 {
+			last = array.Elements[len(array.Elements)-1]
+		}
+		switch {
+		case token.kind == CLOSE_BRACKET_TOKEN || token.kind == EOF_TOKEN:
+		case token.kind == COMMA_TOKEN && last != nil && last.Comma == nil:
+			last.Comma = p.consume()
+			continue
+		case isLiteral(token.kind) || token.kind == OPEN_BRACKET_TOKEN || token.kind == OPEN_BRACE_TOKEN ||
+			token.kind == INVALID_TOKEN && !newLine:
+			if last != nil && last.Comma == nil {
+				last.Comma = p.missing(COMMA_TOKEN, &ERROR_MISSING_COMMA_TOKEN)
+			}
+			array.Elements = append(array.Elements, &ArrayElementNode{Value: p.parseValue()})
+			continue
+		case newLine:
+			p.atLineStart = true
+		default:
+			p.skip(&ERROR_INVALID_TOKEN)
+			continue
+		}
+		break
+	}
+	array.CloseBracket = p.expect(CLOSE_BRACKET_TOKEN, valueMode, &ERROR_MISSING_CLOSE_BRACKET_TOKEN)
+	return array
+}
+
+// parseInlineTable parses an inline table, which must be on a single line.
+func (p *parser) parseInlineTable() *InlineTableNode {
+	table := &InlineTableNode{OpenBrace: p.consume()}
+	for !p.atLineStart {
+		token := p.peek(keyMode)
+		if token.kind == CLOSE_BRACE_TOKEN || token.kind == EOF_TOKEN {
+			break
+		}
+		if !isKeyStart(token.kind) {
+			p.skip(&ERROR_INVALID_TOKEN)
+			continue
+		}
+		field := &InlineTableFieldNode{KeyValue: p.parseKeyValue()}
+		table.Fields = append(table.Fields, field)
+		if p.atLineStart {
+			break
+		}
+		switch p.peek(keyMode).kind {
+		case COMMA_TOKEN:
+			field.Comma = p.consume()
+		case CLOSE_BRACE_TOKEN, EOF_TOKEN:
+		default:
+			if isKeyStart(p.peek(keyMode).kind) {
+				field.Comma = p.missing(COMMA_TOKEN, &ERROR_MISSING_COMMA_TOKEN)
+			}
+		}
+	}
+	table.CloseBrace = p.expect(CLOSE_BRACE_TOKEN, keyMode, &ERROR_MISSING_CLOSE_BRACE_TOKEN)
+	return table
+}
+
+func minutiaeWidth(minutiae []Minutiae) int {
+	width := 0
+	for _, m := range minutiae {
+		width += len(m.text)
+	}
+	return width
+}
+
+func hasNewLine(minutiae []Minutiae) bool {
+	for _, m := range minutiae {
+		if m.kind == NEWLINE_MINUTIAE {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syntax
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const sampleDocument = `# The package
+[package]
+org = "foo"   # the organization
+name = 'winery'
+version = "0.1.0"
+"quoted key".'literal key' = 1_000
+description = """
+Multi-line \
+  description"""
+
+[[dependency]]
+org = "ballerina"
+versions = [
+    "1.0.0", # oldest
+    "2.0.0",
+]
+options = {offline = true, timeout = 1.5e3, since = 1979-05-27 07:32:00Z}
+`
+
+func TestParseRoundTrip(t *testing.T) {
+	files, err := filepath.Glob("../testdata/*.toml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sources := []string{sampleDocument, "", "\n\n", "a = 1", "a = 1\r\nb = [\r\n  2,\r\n]\r\n", "[a\nb = [1 2\n@ = {x = 1"}
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sources = append(sources, string(content))
+	}
+	for _, source := range sources {
+		tree := Parse("test.toml", source)
+		if tree.ToSourceCode() != source {
+			t.Errorf("expected the tree to print back to\n%s\ngot\n%s", source, tree.ToSourceCode())
+		}
+	}
+}
+
+func TestParseTree(t *testing.T) {
+	tree := Parse("test.toml", sampleDocument)
+	if tree.HasDiagnostics() {
+		t.Fatalf("unexpected diagnostics %v", tree.Diagnostics())
+	}
+	members := tree.RootNode().Members
+	if len(members) != 2 || members[0].Kind() != TABLE || members[1].Kind() != TABLE_ARRAY {
+		t.Fatalf("expected a table and a table array, got %d members", len(members))
+	}
+	table := members[0].(*TableNode)
+	if len(table.Fields) != 5 {
+		t.Fatalf("expected 5 fields, got %d", len(table.Fields))
+	}
+	tests := []struct {
+		field *KeyValueNode
+		key   string
+		kind  SyntaxKind
+		value string
+	}{
+		{table.Fields[0], "org", STRING_LITERAL_TOKEN, "foo"},
+		{table.Fields[1], "name", LITERAL_STRING_TOKEN, "winery"},
+		{table.Fields[3], "quoted key/literal key", INTEGER_LITERAL_TOKEN, "1_000"},
+		{table.Fields[4], "description", ML_STRING_LITERAL_TOKEN, "Multi-line description"},
+	}
+	for _, test := range tests {
+		if key := strings.Join(test.field.Key.Names(), "/"); key != test.key {
+			t.Errorf("expected key %s, got %s", test.key, key)
+		}
+		value, ok := test.field.Value.(*Token)
+		if !ok || value.Kind() != test.kind || value.Value() != test.value {
+			t.Errorf("expected %s %q for %s, got %v", test.kind, test.value, test.key, test.field.Value)
+		}
+	}
+
+	dependency := members[1].(*TableArrayNode)
+	array, ok := dependency.Fields[1].Value.(*ArrayNode)
+	if !ok || len(array.Elements) != 2 || array.Elements[1].Comma == nil {
+		t.Fatalf("expected an array of 2 elements with a trailing comma")
+	}
+	options, ok := dependency.Fields[2].Value.(*InlineTableNode)
+	if !ok || len(options.Fields) != 3 {
+		t.Fatalf("expected an inline table of 3 fields")
+	}
+	for i, kind := range []SyntaxKind{BOOLEAN_LITERAL_TOKEN, FLOAT_LITERAL_TOKEN, DATE_TIME_LITERAL_TOKEN} {
+		if value := options.Fields[i].KeyValue.Value; value.Kind() != kind {
+			t.Errorf("expected %s, got %s", kind, value.Kind())
+		}
+	}
+}
+
+func TestParseDiagnostics(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		expected []string
+	}{
+		{"missing value", "a =\nb = 1\n", []string{"ERROR [test.toml:(1:4,1:4)] missing value"}},
+		{"missing equal", "a 1\n", []string{"ERROR [test.toml:(1:2,1:2)] missing '='"}},
+		{"missing key", "[a.]\n", []string{"ERROR [test.toml:(1:4,1:4)] missing key"}},
+		{"missing new line", "a = 1 b = 2\n", []string{"ERROR [test.toml:(1:7,1:8)] missing new line before 'b'"}},
+		{"missing brackets", "[a\n[[b]\n[[c\n", []string{
+			"ERROR [test.toml:(1:3,1:3)] missing ']'",
+			"ERROR [test.toml:(2:4,2:4)] missing ']]'",
+			"ERROR [test.toml:(3:4,3:4)] missing ']]'",
+		}},
+		{"unterminated array", "a = [1,\n  2\nb = 3\n", []string{"ERROR [test.toml:(2:4,2:4)] missing ']'"}},
+		{"array separators", "a = [1 2,, 3]\n", []string{
+			"ERROR [test.toml:(1:7,1:7)] missing ','",
+			"ERROR [test.toml:(1:10,1:11)] invalid token ','",
+		}},
+		{"inline table", "a = {x = 1 y = 2\n", []string{
+			"ERROR [test.toml:(1:11,1:11)] missing ','",
+			"ERROR [test.toml:(1:17,1:17)] missing '}'",
+		}},
+		{"strings", "a = \"abc\nb = 'abc\nc = \"\\q\\u12\"\nd = '''abc\n", []string{
+			"ERROR [test.toml:(1:9,1:9)] missing double quote",
+			"ERROR [test.toml:(2:9,2:9)] missing single quote",
+			"ERROR [test.toml:(3:6,3:8)] invalid escape sequence '\\q'",
+			"ERROR [test.toml:(3:8,3:12)] invalid escape sequence '\\u12'",
+			"ERROR [test.toml:(5:1,5:1)] missing \"'''\"",
+		}},
+		{"invalid values", "a = abc\nb = 1.2.3\nc = 01\n", []string{
+			"ERROR [test.toml:(1:5,1:8)] invalid value 'abc'",
+			"ERROR [test.toml:(2:5,2:10)] invalid value '1.2.3'",
+			"ERROR [test.toml:(3:5,3:7)] invalid value '01'",
+		}},
+		{"invalid token", "@@ = 1\na = 1\n", []string{"ERROR [test.toml:(1:1,1:3)] invalid token '@@'"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tree := Parse("test.toml", test.source)
+			var actual []string
+			for _, diagnostic := range tree.Diagnostics() {
+				actual = append(actual, diagnostic.String())
+			}
+			if strings.Join(actual, "\n") != strings.Join(test.expected, "\n") {
+				t.Errorf("expected diagnostics\n%s\ngot\n%s", strings.Join(test.expected, "\n"), strings.Join(actual, "\n"))
+			}
+			if tree.ToSourceCode() != test.source {
+				t.Errorf("expected the tree to print back to its source")
+			}
+		})
+	}
+}
+
+func TestModifyTree(t *testing.T) {
+	tree := Parse("test.toml", sampleDocument)
+	table := tree.RootNode().Members[0].(*TableNode)
+	version := table.Fields[2]
+	version.Value = version.Value.(*Token).WithText(STRING_LITERAL_TOKEN, `"0.2.0"`)
+	table.Fields = append(table.Fields, CreateKeyValue([]string{"build", "target dir"}, CreateStringLiteral("out\\bin")))
+
+	expected := strings.Replace(sampleDocument, `version = "0.1.0"`, `version = "0.2.0"`, 1)
+	expected = strings.Replace(expected, "  description\"\"\"\n", "  description\"\"\"\nbuild.\"target dir\" = \"out\\\\bin\"\n", 1)
+	if source := tree.ToSourceCode(); source != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, source)
+	}
+	reparsed := Parse("test.toml", tree.ToSourceCode())
+	added := reparsed.RootNode().Members[0].(*TableNode).Fields[5]
+	if key := strings.Join(added.Key.Names(), "/"); key != "build/target dir" || added.Value.(*Token).Value() != "out\\bin" {
+		t.Errorf("unexpected field %s", ToSourceCode(added))
+	}
+}
+
+func TestLocations(t *testing.T) {
+	tree := Parse("test.toml", sampleDocument)
+	locations := tree.Locations()
+	dependency := tree.RootNode().Members[1].(*TableArrayNode)
+	tests := []struct {
+		node  Node
+		start string
+		end   string
+	}{
+		{dependency, "10:0", "16:73"},
+		{dependency.Key, "10:2", "10:12"},
+		{dependency.Fields[1].Value, "12:11", "15:1"},
+		{dependency.Fields[1].Value.(*ArrayNode).Elements[1].Value, "14:4", "14:11"},
+	}
+	for _, test := range tests {
+		lineRange := locations[test.node].LineRange()
+		if lineRange.StartLine().String() != test.start || lineRange.EndLine().String() != test.end {
+			t.Errorf("expected %s at (%s,%s), got %s", test.node.Kind(), test.start, test.end, lineRange)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syntax
+
+// SyntaxKind is the kind of a token, minutiae or node of a TOML syntax tree.
+type SyntaxKind uint8
+
+const (
+	// Tokens
+	EOF_TOKEN SyntaxKind = iota
+	OPEN_BRACKET_TOKEN
+	CLOSE_BRACKET_TOKEN
+	DOUBLE_OPEN_BRACKET_TOKEN
+	DOUBLE_CLOSE_BRACKET_TOKEN
+	OPEN_BRACE_TOKEN
+	CLOSE_BRACE_TOKEN
+	EQUAL_TOKEN
+	DOT_TOKEN
+	COMMA_TOKEN
+	// IDENTIFIER_TOKEN is a bare key
+	IDENTIFIER_TOKEN
+	STRING_LITERAL_TOKEN
+	LITERAL_STRING_TOKEN
+	ML_STRING_LITERAL_TOKEN
+	ML_LITERAL_STRING_TOKEN
+	INTEGER_LITERAL_TOKEN
+	FLOAT_LITERAL_TOKEN
+	BOOLEAN_LITERAL_TOKEN
+	DATE_TIME_LITERAL_TOKEN
+	INVALID_TOKEN
+
+	// Minutiae
+	WHITESPACE_MINUTIAE
+	NEWLINE_MINUTIAE
+	COMMENT_MINUTIAE
+	// INVALID_NODE_MINUTIAE is a token skipped by the parser during error recovery
+	INVALID_NODE_MINUTIAE
+
+	// Nodes
+	DOCUMENT
+	TABLE
+	TABLE_ARRAY
+	KEY_VALUE
+	KEY
+	ARRAY
+	ARRAY_ELEMENT
+	INLINE_TABLE
+	INLINE_TABLE_FIELD
+)
+
+var syntaxKindNames = [...]string{
+	EOF_TOKEN:                  "EOF_TOKEN",
+	OPEN_BRACKET_TOKEN:         "OPEN_BRACKET_TOKEN",
+	CLOSE_BRACKET_TOKEN:        "CLOSE_BRACKET_TOKEN",
+	DOUBLE_OPEN_BRACKET_TOKEN:  "DOUBLE_OPEN_BRACKET_TOKEN",
+	DOUBLE_CLOSE_BRACKET_TOKEN: "DOUBLE_CLOSE_BRACKET_TOKEN",
+	OPEN_BRACE_TOKEN:           "OPEN_BRACE_TOKEN",
+	CLOSE_BRACE_TOKEN:          "CLOSE_BRACE_TOKEN",
+	EQUAL_TOKEN:                "EQUAL_TOKEN",
+	DOT_TOKEN:                  "DOT_TOKEN",
+	COMMA_TOKEN:                "COMMA_TOKEN",
+	IDENTIFIER_TOKEN:           "IDENTIFIER_TOKEN",
+	STRING_LITERAL_TOKEN:       "STRING_LITERAL_TOKEN",
+	LITERAL_STRING_TOKEN:       "LITERAL_STRING_TOKEN",
+	ML_STRING_LITERAL_TOKEN:    "ML_STRING_LITERAL_TOKEN",
+	ML_LITERAL_STRING_TOKEN:    "ML_LITERAL_STRING_TOKEN",
+	INTEGER_LITERAL_TOKEN:      "INTEGER_LITERAL_TOKEN",
+	FLOAT_LITERAL_TOKEN:        "FLOAT_LITERAL_TOKEN",
+	BOOLEAN_LITERAL_TOKEN:      "BOOLEAN_LITERAL_TOKEN",
+	DATE_TIME_LITERAL_TOKEN:    "DATE_TIME_LITERAL_TOKEN",
+	INVALID_TOKEN:              "INVALID_TOKEN",
+	WHITESPACE_MINUTIAE:        "WHITESPACE_MINUTIAE",
+	NEWLINE_MINUTIAE:           "NEWLINE_MINUTIAE",
+	COMMENT_MINUTIAE:           "COMMENT_MINUTIAE",
+	INVALID_NODE_MINUTIAE:      "INVALID_NODE_MINUTIAE",
+	DOCUMENT:                   "DOCUMENT",
+	TABLE:                      "TABLE",
+	TABLE_ARRAY:                "TABLE_ARRAY",
+	KEY_VALUE:                  "KEY_VALUE",
+	KEY:                        "KEY",
+	ARRAY:                      "ARRAY",
+	ARRAY_ELEMENT:              "ARRAY_ELEMENT",
+	INLINE_TABLE:               "INLINE_TABLE",
+	INLINE_TABLE_FIELD:         "INLINE_TABLE_FIELD",
+}
+
+func (k SyntaxKind) String() string {
+	if int(k) < len(syntaxKindNames) {
+		return syntaxKindNames[k]
+	}
+	return "UNKNOWN"
+}
+
+// IsStringLiteral reports whether k is the kind of a string token.
+func (k SyntaxKind) IsStringLiteral() bool {
+	switch k {
+	case STRING_LITERAL_TOKEN, LITERAL_STRING_TOKEN, ML_STRING_LITERAL_TOKEN, ML_LITERAL_STRING_TOKEN:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2026, WSO2 LLC. (http://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package syntax parses TOML documents to lossless syntax trees, reporting every syntax error of a document.
+package syntax
+
+import (
+	"fmt"
+	"sort"
+
+	"ballerina-lang-go/tools/diagnostics"
+	"ballerina-lang-go/tools/text"
+)
+
+// SyntaxTree is the syntax tree of a TOML document.
+type SyntaxTree struct {
+	rootNode    *DocumentNode
+	filePath    string
+	diagnostics []diagnostics.Diagnostic
+}
+
+// Parse parses content, the TOML document at filePath. Syntax errors don't stop the parser, which reports them as the
+// diagnostics of the tree.
+func Parse(filePath, content string) *SyntaxTree {
+	p := newParser(content)
+	tree := &SyntaxTree{rootNode: p.parseDocument(), filePath: filePath}
+	sort.SliceStable(p.diagnostics, func(i, j int) bool {
+		return p.diagnostics[i].offset < p.diagnostics[j].offset
+	})
+	lineMap := text.NewStringTextDocument(content).Lines()
+	for _, diagnostic := range p.diagnostics {
+		code := diagnostic.code
+		id := code.DiagnosticId()
+		info := diagnostics.NewDiagnosticInfo(&id, code.MessageFormat(), code.Severity())
+		location := newLocation(lineMap, filePath, diagnostic.offset, diagnostic.length)
+		tree.diagnostics = append(tree.diagnostics, diagnostics.CreateDiagnostic(info, location, diagnostic.args...))
+	}
+	return tree
+}
+
+func newLocation(lineMap text.LineMap, filePath string, offset, length int) diagnostics.Location {
+	start, err := lineMap.LinePositionFromPosition(offset)
+	if err != nil {
+		panic(fmt.Sprintf("invalid offset %d: %v", offset, err))
+	}
+	end, err := lineMap.LinePositionFromPosition(offset + length)
+	if err != nil {
+		panic(fmt.Sprintf("invalid offset %d: %v", offset+length, err))
+	}
+	return diagnostics.NewBLangDiagnosticLocation(filePath, start.Line(), end.Line(), start.Offset(), end.Offset(),
+		offset, length)
+}
+
+func (t *SyntaxTree) RootNode() *DocumentNode {
+	return t.rootNode
+}
+
+func (t *SyntaxTree) FilePath() string {
+	return t.filePath
+}
+
+// Diagnostics returns the syntax errors of the parsed document, ordered by location.
+func (t *SyntaxTree) Diagnostics() []diagnostics.Diagnostic {
+	return t.diagnostics
+}
+
+func (t *SyntaxTree) HasDiagnostics() bool {
+	return len(t.diagnostics) > 0
+}
+
+// ToSourceCode prints the tree, reproducing the parsed document unless the tree was modified.
+func (t *SyntaxTree) ToSourceCode() string {
+	return ToSourceCode(t.rootNode)
+}
+
+// Locations returns the locations of the nodes of the tree in its source code. The location of a node spans the text
+// of its tokens, leaving out the leading minutiae of its first token and the trailing minutiae of its last.
+func (t *SyntaxTree) Locations() map[Node]diagnostics.Location {
+	lineMap := text.NewStringTextDocument(t.ToSourceCode()).Lines()
+	locations := make(map[Node]diagnostics.Location)
+	offset := 0
+	var visit func(node Node) (start, end int)
+	visit = func(node Node) (start, end int) {
+		if token, ok := node.(*Token); ok {
+			start = offset + minutiaeWidth(token.leadingMinutiae)
+			end = start + len(token.text)
+			offset = end + minutiaeWidth(token.trailingMinutiae)
+		} else {
+			start, end = -1, offset
+			for _, child := range node.Children() {
+				childStart, childEnd := visit(child)
+				if start < 0 {
+					start = childStart
+				}
+				end = childEnd
+			}
+			if start < 0 {
+				start = end
+			}
+		}
+		locations[node] = newLocation(lineMap, t.filePath, start, end-start)
+		return start, end
+	}
+	visit(t.rootNode)
+	return locations
+}
@@ -16,14 +16,13 @@
 
 package tomlparser
 
-// TODO: Currently toml parser gets a single diagnostic at a time. After migrating the parser, should use the same for toml as well.
-
 import (
 	"errors"
 	"io"
 	"io/fs"
 	"strings"
 
+	"ballerina-lang-go/tomlparser/syntax"
 	"ballerina-lang-go/tools/diagnostics"
 
 	"github.com/BurntSushi/toml"
@@ -109,7 +108,7 @@ func ReadString(content string) (*Toml, error) {
 	}
 
 	if err != nil {
-		t.diagnostics = append(t.diagnostics, parseErrorDiagnostic(err))
+		t.diagnostics = append(t.diagnostics, parseErrorDiagnostics(content, err)...)
 	}
 
 	return t, err
@@ -294,6 +293,36 @@ func (t *Toml) getValueByPath(keys []string) (any, bool) {
 	return current, true
 }
 
+// parseErrorDiagnostics returns the diagnostics of a document that failed to parse. The syntax errors of the document
+// are reported by the syntax parser, which unlike the decoder reports all of them; other errors, such as duplicate
+// keys, are reported by the decoder.
+func parseErrorDiagnostics(content string, err error) []Diagnostic {
+	tree := syntax.Parse("", content)
+	if !tree.HasDiagnostics() {
+		return []Diagnostic{parseErrorDiagnostic(err)}
+	}
+	result := make([]Diagnostic, len(tree.Diagnostics()))
+	for i, diagnostic := range tree.Diagnostics() {
+		result[i] = Diagnostic{
+			Message:  diagnostic.Message(),
+			Severity: diagnostic.DiagnosticInfo().Severity(),
+			Location: newLocation(diagnostic.Location()),
+		}
+	}
+	return result
+}
+
+// newLocation converts a location of a syntax tree, whose lines and columns are zero-based, to a Location.
+func newLocation(location diagnostics.Location) *Location {
+	lineRange := location.LineRange()
+	return &Location{
+		StartLine:   lineRange.StartLine().Line() + 1,
+		StartColumn: lineRange.StartLine().Offset() + 1,
+		EndLine:     lineRange.EndLine().Line() + 1,
+		EndColumn:   lineRange.EndLine().Offset() + 1,
+	}
+}
+
 func parseErrorDiagnostic(err error) Diagnostic {
 	diagnostic := Diagnostic{
 		Message:  err.Error(),
@@ -441,8 +441,40 @@ key = "value"
 	if diag.Location == nil {
 		t.Fatal("Expected location information, got nil")
 	}
-	// The error should be on line 3 where "key" starts
-	if diag.Location.StartLine != 3 {
-		t.Errorf("Expected StartLine 3, got %d", diag.Location.StartLine)
+	// The missing ']' should be reported on line 2 right after "[section"
+	if diag.Location.StartLine != 2 || diag.Location.StartColumn != 9 {
+		t.Errorf("Expected location 2:9, got %d:%d", diag.Location.StartLine, diag.Location.StartColumn)
+	}
+}
+
+func TestErrorLocation_MultipleSyntaxErrors(t *testing.T) {
+	invalidToml := `[package
+org = "foo"
+name = 
+version = "1.0.0" extra
+`
+
+	tomlDoc, err := ReadString(invalidToml)
+	if err == nil {
+		t.Fatal("Expected error for invalid syntax, got nil")
+	}
+
+	expected := []struct {
+		message      string
+		line, column int
+	}{
+		{"missing ']'", 1, 9},
+		{"missing value", 3, 7},
+		{"missing new line before 'extra'", 4, 19},
+	}
+	diags := tomlDoc.Diagnostics()
+	if len(diags) != len(expected) {
+		t.Fatalf("Expected %d diagnostics, got %v", len(expected), diags)
+	}
+	for i, diag := range diags {
+		if diag.Message != expected[i].message || diag.Location == nil ||
+			diag.Location.StartLine != expected[i].line || diag.Location.StartColumn != expected[i].column {
+			t.Errorf("Expected %q at %d:%d, got %+v", expected[i].message, expected[i].line, expected[i].column, diag)
+		}
 	}
 }
@@ -17,11 +17,22 @@
 package tomlparser
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"ballerina-lang-go/tools/diagnostics"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
 )
 
+var messagePrinter = message.NewPrinter(language.English)
+
 type Validator interface {
 	Validate(toml *Toml) error
 }
@@ -44,13 +55,156 @@ func (v *validatorImpl) Validate(toml *Toml) error {
 	data := toml.ToMap()
 
 	if err := v.schema.Validate(data); err != nil {
-		diagnostic := Diagnostic{
-			Message:  err.Error(),
-			Severity: diagnostics.Error,
+		var validationErr *jsonschema.ValidationError
+		if !errors.As(err, &validationErr) {
+			toml.diagnostics = append(toml.diagnostics, Diagnostic{
+				Message:  err.Error(),
+				Severity: diagnostics.Error,
+			})
+			return err
+		}
+		var locations map[string]*Location
+		if toml.content != "" {
+			locations = keyLocations(toml.content)
+		}
+		var document any
+		if schema, ok := v.schema.(*schemaImpl); ok {
+			document = schema.document
+		}
+		var causes []*jsonschema.ValidationError
+		collectCauses(validationErr, &causes)
+		for _, cause := range causes {
+			toml.diagnostics = append(toml.diagnostics, validationDiagnostics(cause, document, locations)...)
 		}
-		toml.diagnostics = append(toml.diagnostics, diagnostic)
 		return err
 	}
 
 	return nil
 }
+
+// collectCauses collects the leaf errors of err, which are the ones that point at the offending values.
+func collectCauses(err *jsonschema.ValidationError, causes *[]*jsonschema.ValidationError) {
+	if len(err.Causes) == 0 {
+		*causes = append(*causes, err)
+		return
+	}
+	for _, cause := range err.Causes {
+		collectCauses(cause, causes)
+	}
+}
+
+// validationDiagnostics converts a leaf validation error to diagnostics. An unsupported key gets a diagnostic of its
+// own, located at the key, while other errors are located at the key of the offending value, or at the nearest
+// enclosing key found in the document.
+func validationDiagnostics(err *jsonschema.ValidationError, document any, locations map[string]*Location) []Diagnostic {
+	path := err.InstanceLocation
+	switch errorKind := err.ErrorKind.(type) {
+	case *kind.AdditionalProperties:
+		properties := append([]string{}, errorKind.Properties...)
+		sort.Strings(properties)
+		result := make([]Diagnostic, len(properties))
+		for i, property := range properties {
+			propertyPath := append(append([]string{}, path...), property)
+			result[i] = Diagnostic{
+				Message:  fmt.Sprintf("key '%s' not supported in schema '%s'", property, schemaName(path, document)),
+				Severity: diagnostics.Error,
+				Location: locate(propertyPath, locations),
+			}
+		}
+		return result
+	case *kind.Required:
+		result := make([]Diagnostic, len(errorKind.Missing))
+		for i, missing := range errorKind.Missing {
+			result[i] = Diagnostic{
+				Message:  fmt.Sprintf("missing required field '%s'", missing),
+				Severity: diagnostics.Error,
+				Location: locate(path, locations),
+			}
+		}
+		return result
+	}
+	return []Diagnostic{{
+		Message:  validationMessage(err, document),
+		Severity: diagnostics.Error,
+		Location: locate(path, locations),
+	}}
+}
+
+// validationMessage returns the message for err. A schema may give the message for a keyword in the `message` object
+// of the subschema; `message: {"pattern": "..."}` for example.
+func validationMessage(err *jsonschema.ValidationError, document any) string {
+	keywordPath := err.ErrorKind.KeywordPath()
+	if len(keywordPath) > 0 {
+		if subschema, ok := subschemaAt(document, err.SchemaURL).(map[string]any); ok {
+			if messages, ok := subschema["message"].(map[string]any); ok {
+				if message, ok := messages[keywordPath[0]].(string); ok {
+					return message
+				}
+			}
+		}
+	}
+	key := "root"
+	if len(err.InstanceLocation) > 0 {
+		key = err.InstanceLocation[len(err.InstanceLocation)-1]
+	}
+	if typeKind, ok := err.ErrorKind.(*kind.Type); ok {
+		return fmt.Sprintf("incompatible type for key '%s': expected '%s', found '%s'", key,
+			strings.ToUpper(strings.Join(typeKind.Want, "|")), strings.ToUpper(typeKind.Got))
+	}
+	return fmt.Sprintf("invalid value for key '%s': %s", key, err.ErrorKind.LocalizedString(messagePrinter))
+}
+
+// schemaName names the table at path for messages, using the title of the schema for the root table. A table in an
+// array is named after the array.
+func schemaName(path []string, document any) string {
+	for i := len(path) - 1; i >= 0; i-- {
+		if _, err := strconv.Atoi(path[i]); err != nil {
+			return path[i]
+		}
+	}
+	if schema, ok := document.(map[string]any); ok {
+		if title, ok := schema["title"].(string); ok {
+			return title
+		}
+	}
+	return "root"
+}
+
+// subschemaAt returns the subschema of document identified by the fragment of schemaURL.
+func subschemaAt(document any, schemaURL string) any {
+	_, fragment, ok := strings.Cut(schemaURL, "#")
+	if !ok || document == nil {
+		return nil
+	}
+	current := document
+	for _, token := range strings.Split(strings.TrimPrefix(fragment, "/"), "/") {
+		if token == "" {
+			continue
+		}
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch value := current.(type) {
+		case map[string]any:
+			current = value[token]
+		case []any:
+			index := 0
+			if _, err := fmt.Sscanf(token, "%d", &index); err != nil || index >= len(value) {
+				return nil
+			}
+			current = value[index]
+		default:
+			return nil
+		}
+	}
+	return current
+}
+
+// locate returns the location of the value at path, falling back to that of its nearest enclosing value with a
+// location.
+func locate(path []string, locations map[string]*Location) *Location {
+	for i := len(path); i > 0; i-- {
+		if location, ok := locations[JSONPointer(path[:i])]; ok {
+			return location
+		}
+	}
+	return nil
+}